@@ -0,0 +1,17 @@
+package main
+
+import (
+	"sync"
+
+	"github.com/h4x4d/parking_net/booking/cmd/grpc"
+	"github.com/h4x4d/parking_net/booking/cmd/rest"
+)
+
+func main() {
+	group := sync.WaitGroup{}
+	group.Add(2)
+	go rest.StartServer(&group)
+	go grpc.StartServer(&group)
+
+	group.Wait()
+}
@@ -1,29 +1,27 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package main
+package rest
 
 import (
-	restapi2 "github.com/h4x4d/parking_net/booking/internal/restapi"
-	"github.com/h4x4d/parking_net/booking/internal/restapi/operations"
+	"errors"
 	"log"
 	"os"
+	"strconv"
+	"sync"
 
 	"github.com/go-openapi/loads"
-	flags "github.com/jessevdk/go-flags"
+	"github.com/h4x4d/parking_net/booking/internal/restapi"
+	"github.com/h4x4d/parking_net/booking/internal/restapi/operations"
+	"github.com/jessevdk/go-flags"
 )
 
-// This file was generated by the swagger tool.
-// Make sure not to overwrite this file after you generated it because all your edits would be lost!
-
-func main() {
-
-	swaggerSpec, err := loads.Embedded(restapi2.SwaggerJSON, restapi2.FlatSwaggerJSON)
+func StartServer(group *sync.WaitGroup) {
+	defer group.Done()
+	swaggerSpec, err := loads.Embedded(restapi.SwaggerJSON, restapi.FlatSwaggerJSON)
 	if err != nil {
 		log.Fatalln(err)
 	}
 
 	api := operations.NewParkingsBookingAPI(swaggerSpec)
-	server := restapi2.NewServer(api)
+	server := restapi.NewServer(api)
 	defer server.Shutdown()
 
 	parser := flags.NewParser(server, flags.Default)
@@ -39,8 +37,9 @@ func main() {
 
 	if _, err := parser.Parse(); err != nil {
 		code := 1
-		if fe, ok := err.(*flags.Error); ok {
-			if fe.Type == flags.ErrHelp {
+		var fe *flags.Error
+		if errors.As(err, &fe) {
+			if errors.Is(fe.Type, flags.ErrHelp) {
 				code = 0
 			}
 		}
@@ -49,8 +48,16 @@ func main() {
 
 	server.ConfigureAPI()
 
+	server.Port, err = strconv.Atoi(os.Getenv("BOOKING_REST_PORT"))
+	server.Host = os.Getenv("BOOKING_HOST")
+	if err != nil {
+		server.Port = 8880
+	}
+	if server.Host == "" {
+		server.Host = "0.0.0.0"
+	}
+
 	if err := server.Serve(); err != nil {
 		log.Fatalln(err)
 	}
-
 }
@@ -0,0 +1,42 @@
+// Package audit carries who is changing bookings through the context, so the
+// database layer can attribute every booking event without threading the user
+// through each call.
+package audit
+
+import (
+	"context"
+
+	"github.com/h4x4d/parking_net/pkg/domain"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type actorKey struct{}
+
+// unknownActor is blamed for changes made without an actor in the context.
+var unknownActor = domain.SystemActor("booking")
+
+func WithActor(ctx context.Context, actor domain.Actor) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// WithUser attributes the changes made with ctx to the user with the role.
+func WithUser(ctx context.Context, userID string, role string) context.Context {
+	return WithActor(ctx, domain.Actor{ID: userID, Role: domain.UserRole(role)})
+}
+
+func ActorFrom(ctx context.Context) domain.Actor {
+	if actor, ok := ctx.Value(actorKey{}).(domain.Actor); ok {
+		return actor
+	}
+	return unknownActor
+}
+
+// TraceID returns the ID of the trace ctx belongs to, or an empty string
+// outside of a trace.
+func TraceID(ctx context.Context) string {
+	spanContext := trace.SpanContextFromContext(ctx)
+	if !spanContext.HasTraceID() {
+		return ""
+	}
+	return spanContext.TraceID().String()
+}
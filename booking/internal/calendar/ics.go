@@ -0,0 +1,109 @@
+// Package calendar writes iCalendar (RFC 5545) feeds.
+package calendar
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RefreshInterval is how often calendar apps are asked to fetch a feed again.
+const RefreshInterval = 15 * time.Minute
+
+type EventStatus string
+
+const (
+	StatusTentative EventStatus = "TENTATIVE"
+	StatusConfirmed EventStatus = "CONFIRMED"
+	StatusCancelled EventStatus = "CANCELLED"
+)
+
+// Event is a VEVENT. Calendar apps replace the event with the same UID when
+// Sequence grows, so it has to grow with every change.
+type Event struct {
+	UID          string
+	Sequence     int64
+	Start        time.Time
+	End          time.Time
+	Summary      string
+	Location     string
+	Description  string
+	Status       EventStatus
+	Created      time.Time
+	LastModified time.Time
+}
+
+// Encode returns the feed named name with the events.
+func Encode(name string, events []Event, now time.Time) string {
+	var b strings.Builder
+	writeLine(&b, "BEGIN:VCALENDAR")
+	writeLine(&b, "VERSION:2.0")
+	writeLine(&b, "PRODID:-//Parking Net//Bookings//EN")
+	writeLine(&b, "CALSCALE:GREGORIAN")
+	writeLine(&b, "METHOD:PUBLISH")
+	writeLine(&b, "X-WR-CALNAME:"+escapeText(name))
+	writeLine(&b, "REFRESH-INTERVAL;VALUE=DURATION:"+duration(RefreshInterval))
+	writeLine(&b, "X-PUBLISHED-TTL:"+duration(RefreshInterval))
+	for _, event := range events {
+		writeLine(&b, "BEGIN:VEVENT")
+		writeLine(&b, "UID:"+escapeText(event.UID))
+		writeLine(&b, "DTSTAMP:"+timestamp(now))
+		writeLine(&b, "DTSTART:"+timestamp(event.Start))
+		writeLine(&b, "DTEND:"+timestamp(event.End))
+		writeLine(&b, "SEQUENCE:"+strconv.FormatInt(event.Sequence, 10))
+		writeLine(&b, "STATUS:"+string(event.Status))
+		writeLine(&b, "SUMMARY:"+escapeText(event.Summary))
+		if event.Location != "" {
+			writeLine(&b, "LOCATION:"+escapeText(event.Location))
+		}
+		if event.Description != "" {
+			writeLine(&b, "DESCRIPTION:"+escapeText(event.Description))
+		}
+		if !event.Created.IsZero() {
+			writeLine(&b, "CREATED:"+timestamp(event.Created))
+		}
+		if !event.LastModified.IsZero() {
+			writeLine(&b, "LAST-MODIFIED:"+timestamp(event.LastModified))
+		}
+		writeLine(&b, "END:VEVENT")
+	}
+	writeLine(&b, "END:VCALENDAR")
+	return b.String()
+}
+
+func timestamp(t time.Time) string {
+	return t.UTC().Format("20060102T150405Z")
+}
+
+func duration(d time.Duration) string {
+	return "PT" + strconv.Itoa(int(d.Minutes())) + "M"
+}
+
+var textEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`, "\r", `\n`)
+
+func escapeText(s string) string {
+	return textEscaper.Replace(s)
+}
+
+// writeLine ends the content line with CRLF and folds it into lines of at most
+// 75 octets without splitting a UTF-8 character. Continuation lines start with
+// a space, which counts towards their length.
+func writeLine(b *strings.Builder, line string) {
+	limit := 75
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !isRuneStart(line[cut]) {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		limit = 74
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+}
+
+func isRuneStart(c byte) bool {
+	return c&0xC0 != 0x80
+}
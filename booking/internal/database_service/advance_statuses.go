@@ -0,0 +1,92 @@
+package database_service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/h4x4d/parking_net/pkg/domain"
+	"go.opentelemetry.io/otel"
+)
+
+// DueField names the booking column whose moment triggers a scheduled transition.
+type DueField string
+
+const (
+	DueAtDateFrom DueField = "date_from"
+	DueAtDateTo   DueField = "date_to"
+)
+
+type StatusChange struct {
+	BookingID      int64
+	ParkingPlaceID int64
+	UserID         string
+	From           domain.BookingStatus
+	To             domain.BookingStatus
+}
+
+// AdvanceStatuses moves every booking in status from whose due column is not
+// after now into status to. Rows locked by a concurrent update are skipped and
+// picked up on the next run.
+func (ds *DatabaseService) AdvanceStatuses(ctx context.Context, from domain.BookingStatus, to domain.BookingStatus,
+	due DueField, now time.Time) ([]StatusChange, error) {
+	if err := domain.ValidateTransition(from, to); err != nil {
+		return nil, err
+	}
+	if due != DueAtDateFrom && due != DueAtDateTo {
+		return nil, fmt.Errorf("unknown due field %q", due)
+	}
+
+	tracer := otel.Tracer("Booking")
+	ctx, span := tracer.Start(ctx, "advance statuses")
+	defer span.End()
+
+	tx, err := ds.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := fmt.Sprintf(`UPDATE bookings SET status = $2
+		WHERE id IN (
+			SELECT id FROM bookings WHERE status = $1 AND %s <= $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, parking_place_id, user_id`, due)
+	rows, err := tx.Query(ctx, query, string(from), string(to), now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to advance bookings from %s to %s: %w", from, to, err)
+	}
+
+	changes := make([]StatusChange, 0)
+	for rows.Next() {
+		change := StatusChange{From: from, To: to}
+		if err := rows.Scan(&change.BookingID, &change.ParkingPlaceID, &change.UserID); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		changes = append(changes, change)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, change := range changes {
+		err := recordEvent(ctx, tx, bookingEvent{
+			bookingID:      change.BookingID,
+			userID:         change.UserID,
+			parkingPlaceID: change.ParkingPlaceID,
+			eventType:      domain.BookingEventStatusChanged,
+			oldValue:       statusValues(from),
+			newValue:       statusValues(to),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return changes, nil
+}
@@ -0,0 +1,197 @@
+package database_service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/h4x4d/parking_net/booking/internal/audit"
+	"github.com/h4x4d/parking_net/pkg/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.opentelemetry.io/otel"
+)
+
+// bookingEvent is a change of a booking to record. The values are encoded as
+// JSON objects; nil values are stored as NULL.
+type bookingEvent struct {
+	bookingID      int64
+	userID         string
+	parkingPlaceID int64
+	eventType      domain.BookingEventType
+	oldValue       any
+	newValue       any
+}
+
+// eventValues maps the field names of an event value to the values.
+type eventValues map[string]any
+
+// termsValues describes the priced parts and status of a booking in events.
+func termsValues(terms BookingTerms, status domain.BookingStatus) eventValues {
+	return eventValues{
+		"date_from":        terms.DateFrom,
+		"date_to":          terms.DateTo,
+		"parking_place_id": terms.ParkingPlaceID,
+		"full_cost":        terms.FullCost,
+		"status":           status,
+	}
+}
+
+func statusValues(status domain.BookingStatus) eventValues {
+	return eventValues{"status": status}
+}
+
+// recordEvent appends event to the history of its booking, attributed to the
+// actor and trace of ctx. It belongs in the transaction of the change itself,
+// so the history never misses a committed change.
+func recordEvent(ctx context.Context, q querier, event bookingEvent) error {
+	oldValue, err := encodeEventValue(event.oldValue)
+	if err != nil {
+		return err
+	}
+	newValue, err := encodeEventValue(event.newValue)
+	if err != nil {
+		return err
+	}
+	var traceID *string
+	if id := audit.TraceID(ctx); id != "" {
+		traceID = &id
+	}
+
+	actor := audit.ActorFrom(ctx)
+	var id int64
+	err = q.QueryRow(ctx,
+		`INSERT INTO booking_events
+		(booking_id, user_id, parking_place_id, event_type, actor_id, actor_role, old_value, new_value, trace_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) RETURNING id`,
+		event.bookingID, event.userID, event.parkingPlaceID, string(event.eventType), actor.ID, string(actor.Role),
+		oldValue, newValue, traceID).Scan(&id)
+	if err != nil {
+		return fmt.Errorf("failed to record %s event of booking %d: %w", event.eventType, event.bookingID, err)
+	}
+	return nil
+}
+
+func encodeEventValue(value any) ([]byte, error) {
+	if value == nil {
+		return nil, nil
+	}
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode booking event: %w", err)
+	}
+	return raw, nil
+}
+
+// GetBookingEvents returns the history of the booking, oldest first. It is
+// empty for bookings that never existed.
+func (ds *DatabaseService) GetBookingEvents(ctx context.Context, bookingID int64) ([]*domain.BookingEvent, error) {
+	tracer := otel.Tracer("Booking")
+	ctx, span := tracer.Start(ctx, "get booking events")
+	defer span.End()
+
+	rows, err := ds.pool.Query(ctx,
+		`SELECT `+bookingEventColumns+` FROM booking_events WHERE booking_id = $1 ORDER BY id`,
+		bookingID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get booking events: %w", err)
+	}
+	return scanBookingEvents(rows)
+}
+
+// PublishBookingEvents hands the oldest events not yet published, up to limit,
+// to publish and marks them published once it succeeds. Only one caller at a
+// time gets events, so they are published in the order they happened. It
+// returns how many events were published.
+func (ds *DatabaseService) PublishBookingEvents(ctx context.Context, limit int,
+	publish func(ctx context.Context, events []*domain.BookingEvent) error) (int, error) {
+	tracer := otel.Tracer("Booking")
+	ctx, span := tracer.Start(ctx, "publish booking events")
+	defer span.End()
+
+	tx, err := ds.pool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var locked bool
+	err = tx.QueryRow(ctx, "SELECT pg_try_advisory_xact_lock($1::INTEGER, 0)", int32(lockClassPublisher)).Scan(&locked)
+	if err != nil {
+		return 0, fmt.Errorf("failed to lock booking events: %w", err)
+	}
+	if !locked {
+		return 0, nil
+	}
+
+	rows, err := tx.Query(ctx,
+		`SELECT `+bookingEventColumns+` FROM booking_events WHERE published_at IS NULL ORDER BY id LIMIT $1`,
+		limit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get unpublished booking events: %w", err)
+	}
+	events, err := scanBookingEvents(rows)
+	if err != nil || len(events) == 0 {
+		return 0, err
+	}
+
+	if err := publish(ctx, events); err != nil {
+		return 0, err
+	}
+	ids := make([]int64, 0, len(events))
+	for _, event := range events {
+		ids = append(ids, event.ID)
+	}
+	_, err = tx.Exec(ctx, "UPDATE booking_events SET published_at = now() WHERE id = ANY($1)", ids)
+	if err != nil {
+		return 0, fmt.Errorf("failed to mark booking events published: %w", err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return len(events), nil
+}
+
+const bookingEventColumns = `id, booking_id, user_id, parking_place_id, event_type, actor_id, actor_role,
+	old_value, new_value, COALESCE(trace_id, ''), created_at`
+
+func scanBookingEvents(rows pgx.Rows) ([]*domain.BookingEvent, error) {
+	defer rows.Close()
+	events := make([]*domain.BookingEvent, 0)
+	for rows.Next() {
+		event := new(domain.BookingEvent)
+		var eventType, actorRole string
+		var oldValue, newValue []byte
+		var createdAt pgtype.Timestamp
+		err := rows.Scan(&event.ID, &event.BookingID, &event.UserID, &event.ParkingPlaceID, &eventType,
+			&event.Actor.ID, &actorRole, &oldValue, &newValue, &event.TraceID, &createdAt)
+		if err != nil {
+			return nil, err
+		}
+		event.Type = domain.BookingEventType(eventType)
+		event.Actor.Role = domain.UserRole(actorRole)
+		event.CreatedAt = createdAt.Time
+		if event.OldValue, err = decodeEventValue(oldValue); err != nil {
+			return nil, err
+		}
+		if event.NewValue, err = decodeEventValue(newValue); err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+func decodeEventValue(raw []byte) (map[string]any, error) {
+	if raw == nil {
+		return nil, nil
+	}
+	var value map[string]any
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return nil, fmt.Errorf("failed to decode booking event: %w", err)
+	}
+	return value, nil
+}
@@ -0,0 +1,379 @@
+package database_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/h4x4d/parking_net/booking/internal/models"
+	"github.com/h4x4d/parking_net/booking/internal/utils"
+	"github.com/h4x4d/parking_net/pkg/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.opentelemetry.io/otel"
+)
+
+const seriesColumns = "id, user_id, parking_place_id, date_from, date_to, rrule, until, billing, status, " +
+	"COALESCE(vehicle_id, 0)"
+
+// seriesBooking is an occurrence of a series that is still to be canceled.
+type seriesBooking struct {
+	id     int64
+	userID string
+	status domain.BookingStatus
+}
+
+// CreateSeries stores series and books every occurrence that still has a
+// free spot at the parking place of the given capacity. A series billed per
+// occurrence enqueues the charge of its first booking right away and the charge
+// of every other one domain.OccurrenceChargeLead before it starts; an upfront
+// series enqueues a single charge of all its bookings. Occurrences without a
+// free spot are reported as conflicts instead of failing the series;
+// utils.ErrNoFreeSpots is returned only when no occurrence could be booked.
+func (ds *DatabaseService) CreateSeries(ctx context.Context, series *models.BookingSeries,
+	occurrences []*models.Booking, capacity int64, ownerID string) (*models.BookingSeries, error) {
+	if err := utils.ValidateUserID(series.UserID); err != nil {
+		return nil, fmt.Errorf("invalid user ID")
+	}
+
+	tracer := otel.Tracer("Booking")
+	ctx, span := tracer.Start(ctx, "create booking series in database")
+	defer span.End()
+
+	tx, err := ds.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	created := *series
+	created.Status = models.BookingSeriesStatusActive
+	created.Bookings = make([]*models.Booking, 0, len(occurrences))
+	created.Conflicts = make([]*models.SeriesConflict, 0)
+	var upfront []OccurrenceCharge
+	var total int64
+	err = tx.QueryRow(ctx,
+		`INSERT INTO booking_series (user_id, parking_place_id, date_from, date_to, rrule, until, billing, vehicle_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING id`,
+		series.UserID, *series.ParkingPlaceID, time.Time(*series.DateFrom).UTC(), time.Time(*series.DateTo).UTC(),
+		*series.Rrule, time.Time(*series.Until).UTC(), series.Billing, series.VehicleID).Scan(&created.SeriesID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create booking series: %w", err)
+	}
+
+	for _, occurrence := range occurrences {
+		dateFrom := time.Time(*occurrence.DateFrom).UTC()
+		dateTo := time.Time(*occurrence.DateTo).UTC()
+		errCapacity := checkCapacity(ctx, tx, *series.ParkingPlaceID, capacity, dateFrom, dateTo, 0)
+		if errors.Is(errCapacity, utils.ErrNoFreeSpots) {
+			created.Conflicts = append(created.Conflicts, &models.SeriesConflict{
+				DateFrom: *occurrence.DateFrom,
+				DateTo:   *occurrence.DateTo,
+				Reason:   "no free parking spots",
+			})
+			continue
+		}
+		if errCapacity != nil {
+			return nil, errCapacity
+		}
+
+		booking := *occurrence
+		booking.Status = string(domain.BookingStatusWaiting)
+		booking.UserID = series.UserID
+		booking.SeriesID = created.SeriesID
+		booking.VehicleID = series.VehicleID
+		switch {
+		case series.Billing == string(domain.SeriesBillingUpfront):
+			_, err = insertBooking(ctx, tx, &booking)
+			upfront = append(upfront, OccurrenceCharge{BookingID: booking.BookingID, Amount: booking.FullCost})
+			total += booking.FullCost
+		case len(created.Bookings) == 0:
+			_, err = insertCharged(ctx, tx, &booking, ownerID)
+		default:
+			due := dateFrom.Add(-domain.OccurrenceChargeLead)
+			if _, err = insertBooking(ctx, tx, &booking); err == nil {
+				_, err = enqueueOutboxAt(ctx, tx, booking.BookingID, OutboxCharge, chargePayload(&booking, ownerID), &due)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		created.Bookings = append(created.Bookings, &booking)
+	}
+	if len(created.Bookings) == 0 {
+		return nil, fmt.Errorf("%w: every occurrence of the series is taken", utils.ErrNoFreeSpots)
+	}
+	if len(upfront) > 0 {
+		charge := chargePayload(created.Bookings[0], ownerID)
+		charge.Amount = total
+		charge.Occurrences = upfront
+		if _, err := enqueueOutbox(ctx, tx, created.Bookings[0].BookingID, OutboxCharge, charge); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return &created, nil
+}
+
+// GetSeries returns the series with its bookings ordered by start, or nil if
+// there is no such series.
+func (ds *DatabaseService) GetSeries(ctx context.Context, seriesID int64) (*models.BookingSeries, error) {
+	series := new(models.BookingSeries)
+	series.ParkingPlaceID = new(int64)
+	series.Rrule = new(string)
+	var dateFrom, dateTo, until pgtype.Timestamp
+	err := ds.pool.QueryRow(ctx, "SELECT "+seriesColumns+" FROM booking_series WHERE id = $1", seriesID).Scan(
+		&series.SeriesID, &series.UserID, series.ParkingPlaceID, &dateFrom, &dateTo, series.Rrule, &until,
+		&series.Billing, &series.Status, &series.VehicleID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	fromDT := strfmt.DateTime(dateFrom.Time)
+	toDT := strfmt.DateTime(dateTo.Time)
+	untilDT := strfmt.DateTime(until.Time)
+	series.DateFrom = &fromDT
+	series.DateTo = &toDT
+	series.Until = &untilDT
+
+	rows, err := ds.pool.Query(ctx,
+		"SELECT "+bookingColumns+" FROM bookings WHERE series_id = $1 ORDER BY date_from", seriesID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	series.Bookings = make([]*models.Booking, 0)
+	for rows.Next() {
+		booking := new(models.Booking)
+		if err := scanBooking(rows, booking); err != nil {
+			return nil, err
+		}
+		series.Bookings = append(series.Bookings, booking)
+	}
+	return series, rows.Err()
+}
+
+// CancelSeries cancels every occurrence of the series that has not started yet
+// and marks the series Canceled. Paid occurrences are refunded as their entry
+// in cancellations says; it fails with utils.ErrBookingChanged when one of
+// them has none, because it was paid after the caller decided. The IDs of all
+// canceled bookings are returned so their outbox messages can be delivered
+// right away.
+func (ds *DatabaseService) CancelSeries(ctx context.Context, seriesID int64,
+	cancellations map[int64]Cancellation) ([]int64, error) {
+	tracer := otel.Tracer("Booking")
+	ctx, span := tracer.Start(ctx, "cancel booking series")
+	defer span.End()
+
+	tx, err := ds.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var parkingPlaceID int64
+	err = tx.QueryRow(ctx,
+		"UPDATE booking_series SET status = 'Canceled' WHERE id = $1 RETURNING parking_place_id",
+		seriesID).Scan(&parkingPlaceID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("booking series not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to cancel booking series: %w", err)
+	}
+
+	pending, err := lockSeriesBookings(ctx, tx, seriesID, 0)
+	if err != nil {
+		return nil, err
+	}
+	canceled := make([]int64, 0, len(pending))
+	for _, booking := range pending {
+		if _, err := transitionStatus(ctx, tx, booking.id, booking.status, domain.BookingStatusCanceled); err != nil {
+			return nil, err
+		}
+		if booking.status == domain.BookingStatusConfirmed {
+			cancellation, ok := cancellations[booking.id]
+			if !ok {
+				return nil, utils.ErrBookingChanged
+			}
+			err := recordCancellation(ctx, tx, booking.id, booking.userID, parkingPlaceID, cancellation)
+			if err != nil {
+				return nil, err
+			}
+		} else if err := dropDeferredCharge(ctx, tx, booking.id); err != nil {
+			return nil, err
+		}
+		canceled = append(canceled, booking.id)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return canceled, nil
+}
+
+// CancelOccurrence cancels a single booking of the series and leaves the other
+// occurrences untouched. A paid booking is refunded as cancellation says. It
+// fails with domain.ErrInvalidStatusTransition when the booking can no longer
+// be canceled and with utils.ErrBookingChanged when it is paid but
+// cancellation is nil.
+func (ds *DatabaseService) CancelOccurrence(ctx context.Context, seriesID int64, bookingID int64,
+	cancellation *Cancellation) error {
+	tracer := otel.Tracer("Booking")
+	ctx, span := tracer.Start(ctx, "cancel series occurrence")
+	defer span.End()
+
+	tx, err := ds.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var status, userID string
+	var parkingPlaceID int64
+	err = tx.QueryRow(ctx,
+		`SELECT status, user_id, parking_place_id FROM bookings
+		WHERE id = $1 AND series_id = $2 FOR UPDATE`,
+		bookingID, seriesID).Scan(&status, &userID, &parkingPlaceID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("booking not found")
+	}
+	if err != nil {
+		return err
+	}
+
+	current := domain.BookingStatus(status)
+	if err := domain.ValidateTransition(current, domain.BookingStatusCanceled); err != nil {
+		return err
+	}
+	if _, err := transitionStatus(ctx, tx, bookingID, current, domain.BookingStatusCanceled); err != nil {
+		return err
+	}
+	if current == domain.BookingStatusConfirmed {
+		if cancellation == nil {
+			return utils.ErrBookingChanged
+		}
+		if err := recordCancellation(ctx, tx, bookingID, userID, parkingPlaceID, *cancellation); err != nil {
+			return err
+		}
+	} else if err := dropDeferredCharge(ctx, tx, bookingID); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// CancelUpfrontSeries cancels the rest of an upfront-billed series after the
+// payment of its booking failed. It reports false when the booking does not
+// belong to an active upfront series.
+func (ds *DatabaseService) CancelUpfrontSeries(ctx context.Context, bookingID int64) (bool, error) {
+	tracer := otel.Tracer("Booking")
+	ctx, span := tracer.Start(ctx, "cancel upfront series")
+	defer span.End()
+
+	tx, err := ds.pool.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	canceled, err := cancelUpfrontSeries(ctx, tx, bookingID)
+	if err != nil {
+		return false, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return canceled, nil
+}
+
+// cancelUpfrontSeries cancels the series of bookingID when it is billed up
+// front. Waiting siblings are refunded by the compensation of their own charge
+// commands, Confirmed ones get a full refund here; occurrences that already
+// started are kept.
+func cancelUpfrontSeries(ctx context.Context, tx pgx.Tx, bookingID int64) (bool, error) {
+	var seriesID, parkingPlaceID int64
+	var driverID string
+	err := tx.QueryRow(ctx,
+		`UPDATE booking_series SET status = 'Canceled'
+		WHERE id = (SELECT series_id FROM bookings WHERE id = $1)
+		AND billing = 'upfront' AND status = 'Active'
+		RETURNING id, user_id, parking_place_id`,
+		bookingID).Scan(&seriesID, &driverID, &parkingPlaceID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to cancel booking series: %w", err)
+	}
+
+	pending, err := lockSeriesBookings(ctx, tx, seriesID, bookingID)
+	if err != nil {
+		return false, err
+	}
+	for _, booking := range pending {
+		if _, err := transitionStatus(ctx, tx, booking.id, booking.status, domain.BookingStatusCanceled); err != nil {
+			return false, err
+		}
+		if booking.status != domain.BookingStatusConfirmed {
+			continue
+		}
+		refund := OutboxPayload{
+			DriverID:       booking.userID,
+			ParkingPlaceID: parkingPlaceID,
+			Reason:         "series payment failed",
+		}
+		if _, err := enqueueOutbox(ctx, tx, booking.id, OutboxRefund, refund); err != nil {
+			return false, err
+		}
+	}
+
+	notification := OutboxPayload{
+		UserID: driverID,
+		Name:   "Booking series canceled",
+		Text: fmt.Sprintf("Your booking series %d was canceled because the payment for booking %d failed",
+			seriesID, bookingID),
+	}
+	if _, err := enqueueOutbox(ctx, tx, bookingID, OutboxNotify, notification); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// lockSeriesBookings locks the Waiting and Confirmed bookings of the series,
+// ignoring the booking with excludeID.
+func lockSeriesBookings(ctx context.Context, tx pgx.Tx, seriesID int64, excludeID int64) ([]seriesBooking, error) {
+	rows, err := tx.Query(ctx,
+		`SELECT id, user_id, status FROM bookings
+		WHERE series_id = $1 AND id <> $2 AND status = ANY($3)
+		ORDER BY date_from FOR UPDATE`,
+		seriesID, excludeID,
+		[]string{string(domain.BookingStatusWaiting), string(domain.BookingStatusConfirmed)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock series bookings: %w", err)
+	}
+	defer rows.Close()
+
+	bookings := make([]seriesBooking, 0)
+	for rows.Next() {
+		var booking seriesBooking
+		var status string
+		if err := rows.Scan(&booking.id, &booking.userID, &status); err != nil {
+			return nil, err
+		}
+		booking.status = domain.BookingStatus(status)
+		bookings = append(bookings, booking)
+	}
+	return bookings, rows.Err()
+}
@@ -0,0 +1,101 @@
+package database_service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/h4x4d/parking_net/booking/internal/models"
+	"github.com/h4x4d/parking_net/pkg/domain"
+)
+
+// seriesCharge is a charge command as CreateSeries enqueues it.
+type seriesCharge struct {
+	bookingID int64
+	payload   OutboxPayload
+	deferred  bool
+}
+
+func TestCreateSeriesCharges(t *testing.T) {
+	ds := testDatabase(t)
+	ctx := context.Background()
+	from := time.Now().UTC().Add(72 * time.Hour).Truncate(time.Hour)
+
+	for _, billing := range []domain.SeriesBilling{domain.SeriesBillingPerOccurrence, domain.SeriesBillingUpfront} {
+		t.Run(string(billing), func(t *testing.T) {
+			placeID := testParkingPlace()
+			var vehicleID int64
+			err := ds.pool.QueryRow(ctx,
+				`INSERT INTO vehicles (user_id, plate, country, size) VALUES ('driver-1', $1, 'RU', 'small')
+				RETURNING id`,
+				fmt.Sprintf("S%d", placeID)).Scan(&vehicleID)
+			if err != nil {
+				t.Fatalf("insert vehicle: %v", err)
+			}
+
+			dateFrom := strfmt.DateTime(from)
+			dateTo := strfmt.DateTime(from.Add(2 * time.Hour))
+			until := strfmt.DateTime(from.AddDate(0, 0, 14))
+			rrule := "FREQ=WEEKLY"
+			series := &models.BookingSeries{UserID: "driver-1", ParkingPlaceID: &placeID, VehicleID: vehicleID,
+				DateFrom: &dateFrom, DateTo: &dateTo, Rrule: &rrule, Until: &until, Billing: string(billing)}
+			occurrences := make([]*models.Booking, 0, 3)
+			for week := range 3 {
+				start := strfmt.DateTime(from.AddDate(0, 0, 7*week))
+				end := strfmt.DateTime(from.AddDate(0, 0, 7*week).Add(2 * time.Hour))
+				occurrences = append(occurrences, &models.Booking{DateFrom: &start, DateTo: &end,
+					ParkingPlaceID: &placeID, FullCost: 200})
+			}
+
+			created, err := ds.CreateSeries(ctx, series, occurrences, 1, "owner-1")
+			if err != nil {
+				t.Fatalf("create series: %v", err)
+			}
+			bookingIDs := make([]int64, 0, len(created.Bookings))
+			for _, booking := range created.Bookings {
+				bookingIDs = append(bookingIDs, booking.BookingID)
+			}
+
+			rows, err := ds.pool.Query(ctx,
+				`SELECT booking_id, payload, next_attempt_at > CURRENT_TIMESTAMP FROM outbox
+				WHERE booking_id = ANY($1) AND command = 'charge' ORDER BY booking_id`,
+				bookingIDs)
+			if err != nil {
+				t.Fatalf("query charges: %v", err)
+			}
+			defer rows.Close()
+			charges := make([]seriesCharge, 0)
+			for rows.Next() {
+				var charge seriesCharge
+				var raw []byte
+				if err := rows.Scan(&charge.bookingID, &raw, &charge.deferred); err != nil {
+					t.Fatalf("scan charge: %v", err)
+				}
+				if err := json.Unmarshal(raw, &charge.payload); err != nil {
+					t.Fatalf("decode charge: %v", err)
+				}
+				charges = append(charges, charge)
+			}
+
+			if billing == domain.SeriesBillingUpfront {
+				if len(charges) != 1 || charges[0].bookingID != bookingIDs[0] || charges[0].deferred ||
+					charges[0].payload.Amount != 600 || len(charges[0].payload.Occurrences) != 3 {
+					t.Fatalf("charges = %+v, want a single charge of every occurrence with booking %d",
+						charges, bookingIDs[0])
+				}
+				return
+			}
+			if len(charges) != 3 {
+				t.Fatalf("enqueued %d charges, want one per occurrence", len(charges))
+			}
+			for i, charge := range charges {
+				if charge.deferred != (i > 0) || charge.payload.Amount != 200 {
+					t.Errorf("charge of occurrence %d = %+v, want deferred %v", i+1, charge, i > 0)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package database_service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/h4x4d/parking_net/pkg/domain"
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel"
+)
+
+// maxCalendarBookings caps a feed; calendar apps fetch it whole on every refresh.
+const maxCalendarBookings = 2000
+
+// CalendarFeed is the user a calendar feed token belongs to.
+type CalendarFeed struct {
+	UserID string
+	Role   string
+}
+
+// CalendarBooking is a booking as a calendar feed shows it. Revision grows with
+// every change of its dates, place or status.
+type CalendarBooking struct {
+	ID             int64
+	ParkingPlaceID int64
+	UserID         string
+	DateFrom       time.Time
+	DateTo         time.Time
+	Status         domain.BookingStatus
+	FullCost       int64
+	Revision       int64
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func feedTokenHash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// CreateCalendarFeed issues a new feed token for the user and revokes the one
+// issued before. Only the hash of the token is stored.
+func (ds *DatabaseService) CreateCalendarFeed(ctx context.Context, userID string, role string) (string, time.Time, error) {
+	tracer := otel.Tracer("Booking")
+	ctx, span := tracer.Start(ctx, "create calendar feed")
+	defer span.End()
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to generate feed token: %w", err)
+	}
+	token := base64.RawURLEncoding.EncodeToString(secret)
+
+	tx, err := ds.pool.Begin(ctx)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx,
+		"UPDATE calendar_feeds SET revoked_at = CURRENT_TIMESTAMP WHERE user_id = $1 AND revoked_at IS NULL",
+		userID)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to revoke calendar feed: %w", err)
+	}
+	var createdAt time.Time
+	err = tx.QueryRow(ctx,
+		"INSERT INTO calendar_feeds (token_hash, user_id, role) VALUES ($1, $2, $3) RETURNING created_at",
+		feedTokenHash(token), userID, role).Scan(&createdAt)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to create calendar feed: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return token, createdAt, nil
+}
+
+// RevokeCalendarFeed stops the feed of the user. It reports false if the user
+// had none.
+func (ds *DatabaseService) RevokeCalendarFeed(ctx context.Context, userID string) (bool, error) {
+	tracer := otel.Tracer("Booking")
+	ctx, span := tracer.Start(ctx, "revoke calendar feed")
+	defer span.End()
+
+	result, err := ds.pool.Exec(ctx,
+		"UPDATE calendar_feeds SET revoked_at = CURRENT_TIMESTAMP WHERE user_id = $1 AND revoked_at IS NULL",
+		userID)
+	if err != nil {
+		return false, fmt.Errorf("failed to revoke calendar feed: %w", err)
+	}
+	return result.RowsAffected() > 0, nil
+}
+
+// GetCalendarFeed returns the user of a feed token that is not revoked, or nil.
+func (ds *DatabaseService) GetCalendarFeed(ctx context.Context, token string) (*CalendarFeed, error) {
+	feed := new(CalendarFeed)
+	err := ds.pool.QueryRow(ctx,
+		"SELECT user_id, role FROM calendar_feeds WHERE token_hash = $1 AND revoked_at IS NULL",
+		feedTokenHash(token)).Scan(&feed.UserID, &feed.Role)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get calendar feed: %w", err)
+	}
+	return feed, nil
+}
+
+// GetCalendarBookings returns the bookings that end after since, of every
+// status so calendars learn about cancellations. userID selects the bookings
+// of a driver and parkingPlaceIDs those at the places; either may be nil.
+func (ds *DatabaseService) GetCalendarBookings(ctx context.Context, userID *string, parkingPlaceIDs []int64,
+	since time.Time) ([]*CalendarBooking, error) {
+	tracer := otel.Tracer("Booking")
+	ctx, span := tracer.Start(ctx, "get calendar bookings")
+	defer span.End()
+
+	rows, err := ds.pool.Query(ctx,
+		`SELECT id, parking_place_id, user_id, date_from, date_to, status, full_cost, revision, created_at, updated_at
+		FROM bookings
+		WHERE date_to > $1 AND ($2::text IS NULL OR user_id = $2) AND ($3::int[] IS NULL OR parking_place_id = ANY($3))
+		ORDER BY date_from, id LIMIT $4`,
+		since, userID, parkingPlaceIDs, maxCalendarBookings)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bookings: %w", err)
+	}
+	defer rows.Close()
+
+	bookings := make([]*CalendarBooking, 0)
+	for rows.Next() {
+		booking := new(CalendarBooking)
+		var status string
+		if err := rows.Scan(&booking.ID, &booking.ParkingPlaceID, &booking.UserID, &booking.DateFrom,
+			&booking.DateTo, &status, &booking.FullCost, &booking.Revision, &booking.CreatedAt,
+			&booking.UpdatedAt); err != nil {
+			return nil, err
+		}
+		booking.Status = domain.BookingStatus(status)
+		bookings = append(bookings, booking)
+	}
+	return bookings, rows.Err()
+}
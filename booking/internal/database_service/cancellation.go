@@ -0,0 +1,59 @@
+package database_service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/h4x4d/parking_net/pkg/domain"
+)
+
+// Cancellation is the refund decision for a paid booking: the version of the
+// cancellation policy it was made under and the share of the price kept as a fee.
+type Cancellation struct {
+	PolicyVersion int64
+	FeePercent    int64
+}
+
+// recordCancellation stores an already decided cancellation of a paid booking
+// and enqueues its refund, if any.
+func recordCancellation(ctx context.Context, q querier, bookingID int64, driverID string, parkingPlaceID int64,
+	cancellation Cancellation) error {
+	var recorded int64
+	err := q.QueryRow(ctx,
+		`INSERT INTO booking_cancellations (booking_id, user_id, parking_place_id, policy_version, fee_percent)
+		VALUES ($1, $2, $3, $4, $5) RETURNING booking_id`,
+		bookingID, driverID, parkingPlaceID, cancellation.PolicyVersion, cancellation.FeePercent).Scan(&recorded)
+	if err != nil {
+		return fmt.Errorf("failed to record cancellation: %w", err)
+	}
+	err = recordEvent(ctx, q, bookingEvent{
+		bookingID:      bookingID,
+		userID:         driverID,
+		parkingPlaceID: parkingPlaceID,
+		eventType:      domain.BookingEventCanceled,
+		newValue: eventValues{
+			"policy_version": cancellation.PolicyVersion,
+			"fee_percent":    cancellation.FeePercent,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if cancellation.FeePercent >= 100 {
+		return nil
+	}
+
+	reason := fmt.Sprintf("free cancellation (policy v%d)", cancellation.PolicyVersion)
+	if cancellation.FeePercent > 0 {
+		reason = fmt.Sprintf("late cancellation, %d%% fee (policy v%d)", cancellation.FeePercent,
+			cancellation.PolicyVersion)
+	}
+	refund := OutboxPayload{
+		DriverID:       driverID,
+		ParkingPlaceID: parkingPlaceID,
+		FeePercent:     cancellation.FeePercent,
+		Reason:         reason,
+	}
+	_, err = enqueueOutbox(ctx, q, bookingID, OutboxRefund, refund)
+	return err
+}
@@ -0,0 +1,164 @@
+package database_service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/h4x4d/parking_net/booking/internal/utils"
+	"github.com/h4x4d/parking_net/pkg/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// activeStatuses are the booking statuses that occupy a parking spot.
+var activeStatuses = []string{
+	string(domain.BookingStatusWaiting),
+	string(domain.BookingStatusConfirmed),
+	string(domain.BookingStatusActive),
+}
+
+// querier is satisfied by both *pgxpool.Pool and pgx.Tx.
+type querier interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+}
+
+// lockParkingPlace serializes capacity checks for a single parking place until
+// the surrounding transaction ends.
+func lockParkingPlace(ctx context.Context, tx pgx.Tx, parkingPlaceID int64) error {
+	if _, err := tx.Exec(ctx, "SELECT pg_advisory_xact_lock($1)", parkingPlaceID); err != nil {
+		return fmt.Errorf("failed to lock parking place: %w", err)
+	}
+	return nil
+}
+
+// occupiedPeriods selects the periods of the parking place $1 that take a spot:
+// active bookings ($2 lists their statuses), open waitlist offers and holds.
+const occupiedPeriods = `SELECT id, date_from, date_to, 'booking' AS kind FROM bookings
+	WHERE parking_place_id = $1 AND status = ANY($2)
+	UNION ALL
+	SELECT id, date_from, date_to, 'offer' AS kind FROM waitlist_entries
+	WHERE parking_place_id = $1 AND status = 'Offered' AND offer_expires_at > CURRENT_TIMESTAMP
+	UNION ALL
+	SELECT id, date_from, date_to, 'hold' AS kind FROM booking_holds
+	WHERE parking_place_id = $1 AND status = 'Held' AND expires_at > CURRENT_TIMESTAMP`
+
+// occupiedDuring returns the periods of the parking place that overlap
+// [dateFrom, dateTo), or that end after dateFrom when dateTo is nil, ignoring
+// the booking with excludeID.
+func occupiedDuring(ctx context.Context, q querier, parkingPlaceID int64, dateFrom time.Time, dateTo *time.Time,
+	excludeID int64) ([]Period, error) {
+	rows, err := q.Query(ctx,
+		`SELECT date_from, date_to FROM (`+occupiedPeriods+`) occupied
+		WHERE date_to > $3 AND ($4::timestamp IS NULL OR date_from < $4) AND NOT (kind = 'booking' AND id = $5)`,
+		parkingPlaceID, activeStatuses, dateFrom, dateTo, excludeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bookings: %w", err)
+	}
+	defer rows.Close()
+
+	var periods []Period
+	for rows.Next() {
+		from := new(pgtype.Timestamp)
+		to := new(pgtype.Timestamp)
+		if err := rows.Scan(from, to); err != nil {
+			return nil, err
+		}
+		periods = append(periods, Period{from.Time, to.Time})
+	}
+	return periods, rows.Err()
+}
+
+// peakOverlapping returns the number of spots of the parking place taken at the
+// busiest moment of [dateFrom, dateTo), ignoring the booking with excludeID.
+func peakOverlapping(ctx context.Context, q querier, parkingPlaceID int64, dateFrom, dateTo time.Time,
+	excludeID int64) (int64, error) {
+	periods, err := occupiedDuring(ctx, q, parkingPlaceID, dateFrom, &dateTo, excludeID)
+	if err != nil {
+		return 0, err
+	}
+	return PeakOccupancy(periods, dateFrom, dateTo), nil
+}
+
+// checkPlaceOpen fails with domain.ErrParkingUnavailable when a closure of the
+// parking place canceled its bookings. The caller holds the lock of the place,
+// so a closure cannot start between the check and the commit.
+func checkPlaceOpen(ctx context.Context, tx pgx.Tx, parkingPlaceID int64) error {
+	var closed bool
+	err := tx.QueryRow(ctx,
+		"SELECT EXISTS (SELECT 1 FROM closed_parking_places WHERE parking_place_id = $1)",
+		parkingPlaceID).Scan(&closed)
+	if err != nil {
+		return fmt.Errorf("failed to check parking place: %w", err)
+	}
+	if closed {
+		return domain.ErrParkingUnavailable
+	}
+	return nil
+}
+
+// checkCapacity locks the parking place and fails with utils.ErrNoFreeSpots when
+// every spot is taken at some moment of the requested period, or with
+// domain.ErrParkingUnavailable when the place was closed.
+func checkCapacity(ctx context.Context, tx pgx.Tx, parkingPlaceID int64, capacity int64, dateFrom, dateTo time.Time, excludeID int64) error {
+	if err := lockParkingPlace(ctx, tx, parkingPlaceID); err != nil {
+		return err
+	}
+	if err := checkPlaceOpen(ctx, tx, parkingPlaceID); err != nil {
+		return err
+	}
+	peak, err := peakOverlapping(ctx, tx, parkingPlaceID, dateFrom, dateTo, excludeID)
+	if err != nil {
+		return err
+	}
+	if peak >= capacity {
+		return fmt.Errorf("%w: %d of %d spots taken", utils.ErrNoFreeSpots, peak, capacity)
+	}
+	return nil
+}
+
+// Period is a half-open [from, to) interval during which a spot is taken.
+type Period struct{ From, To time.Time }
+
+// PeakOccupancy returns the largest number of periods that overlap at any
+// instant of [dateFrom, dateTo). Periods that only touch each other, like one
+// ending at 11:00 and the next starting at 11:00, share a single spot.
+func PeakOccupancy(periods []Period, dateFrom, dateTo time.Time) int64 {
+	type edge struct {
+		at    time.Time
+		delta int64
+	}
+	edges := make([]edge, 0, 2*len(periods))
+	for _, p := range periods {
+		from, to := p.From, p.To
+		if from.Before(dateFrom) {
+			from = dateFrom
+		}
+		if to.After(dateTo) {
+			to = dateTo
+		}
+		if !from.Before(to) {
+			continue
+		}
+		edges = append(edges, edge{from, 1}, edge{to, -1})
+	}
+	// Ends sort before starts at the same instant, so a spot freed at 11:00
+	// can be taken again at 11:00.
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i].at.Equal(edges[j].at) {
+			return edges[i].delta < edges[j].delta
+		}
+		return edges[i].at.Before(edges[j].at)
+	})
+
+	var current, peak int64
+	for _, e := range edges {
+		current += e.delta
+		if current > peak {
+			peak = current
+		}
+	}
+	return peak
+}
@@ -0,0 +1,145 @@
+package database_service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/h4x4d/parking_net/booking/internal/models"
+	"github.com/h4x4d/parking_net/booking/internal/utils"
+	"github.com/h4x4d/parking_net/pkg/domain"
+)
+
+func TestCheckCapacity(t *testing.T) {
+	ds := testDatabase(t)
+	ctx := context.Background()
+	from := time.Now().UTC().Add(72 * time.Hour).Truncate(time.Hour)
+	at := func(hours int) time.Time { return from.Add(time.Duration(hours) * time.Hour) }
+
+	placeID := testParkingPlace()
+	confirmed := insertTestBooking(t, ds, placeID, at(0), at(2), string(domain.BookingStatusConfirmed))
+	insertTestBooking(t, ds, placeID, at(0), at(2), string(domain.BookingStatusCanceled))
+	insertTestBooking(t, ds, placeID, at(2), at(4), string(domain.BookingStatusWaiting))
+	_, err := ds.pool.Exec(ctx,
+		`INSERT INTO booking_holds (user_id, parking_place_id, date_from, date_to, expires_at)
+		VALUES ('driver-2', $1, $2, $3, CURRENT_TIMESTAMP + INTERVAL '1 hour'),
+			('driver-3', $1, $2, $3, CURRENT_TIMESTAMP - INTERVAL '1 hour')`,
+		placeID, at(1), at(3))
+	if err != nil {
+		t.Fatalf("insert holds: %v", err)
+	}
+	_, err = ds.pool.Exec(ctx,
+		`INSERT INTO waitlist_entries (user_id, parking_place_id, date_from, date_to, status, offer_expires_at)
+		VALUES ('driver-4', $1, $2, $3, 'Offered', CURRENT_TIMESTAMP + INTERVAL '1 hour'),
+			('driver-5', $1, $2, $3, 'Queued', NULL)`,
+		placeID, at(5), at(6))
+	if err != nil {
+		t.Fatalf("insert waitlist entries: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		from, to  time.Time
+		capacity  int64
+		excludeID int64
+		peak      int64
+	}{
+		{name: "booking and hold", from: at(0), to: at(2), capacity: 3, peak: 2},
+		{name: "full", from: at(0), to: at(2), capacity: 2, peak: 2},
+		// Four periods overlap the range, but never more than two at once.
+		{name: "every period", from: at(0), to: at(6), capacity: 3, peak: 2},
+		{name: "next booking and hold", from: at(2), to: at(3), capacity: 2, peak: 2},
+		{name: "moving a booking ignores itself", from: at(0), to: at(1), capacity: 1, excludeID: confirmed},
+		{name: "offer", from: at(5), to: at(6), capacity: 1, peak: 1},
+		{name: "free period", from: at(4), to: at(5), capacity: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			peak, err := peakOverlapping(ctx, ds.pool, placeID, tt.from, tt.to, tt.excludeID)
+			if err != nil {
+				t.Fatalf("peakOverlapping: %v", err)
+			}
+			if peak != tt.peak {
+				t.Errorf("peakOverlapping = %d, want %d", peak, tt.peak)
+			}
+
+			tx, err := ds.pool.Begin(ctx)
+			if err != nil {
+				t.Fatalf("begin: %v", err)
+			}
+			defer tx.Rollback(ctx)
+			err = checkCapacity(ctx, tx, placeID, tt.capacity, tt.from, tt.to, tt.excludeID)
+			if full := tt.peak >= tt.capacity; full != errors.Is(err, utils.ErrNoFreeSpots) {
+				t.Errorf("checkCapacity with %d of %d spots taken: err = %v", tt.peak, tt.capacity, err)
+			}
+		})
+	}
+}
+
+// TestCreateConcurrently races bookings for the last spot: the parking place
+// lock must let exactly one of them take it.
+func TestCreateConcurrently(t *testing.T) {
+	ds := testDatabase(t)
+	placeID := testParkingPlace()
+	from := strfmt.DateTime(time.Now().UTC().Add(72 * time.Hour).Truncate(time.Hour))
+	to := strfmt.DateTime(time.Time(from).Add(2 * time.Hour))
+
+	const drivers = 4
+	errs := make([]error, drivers)
+	var wg sync.WaitGroup
+	for i := range errs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			booking := &models.Booking{DateFrom: &from, DateTo: &to, ParkingPlaceID: &placeID, FullCost: 100,
+				UserID: "driver-1"}
+			_, errs[i] = ds.Create(context.Background(), booking, 1, "owner-1", 0, nil)
+		}()
+	}
+	wg.Wait()
+
+	created := 0
+	for _, err := range errs {
+		switch {
+		case err == nil:
+			created++
+		case !errors.Is(err, utils.ErrNoFreeSpots):
+			t.Errorf("create: %v", err)
+		}
+	}
+	if created != 1 {
+		t.Errorf("created %d bookings for a single spot, want 1", created)
+	}
+}
+
+// TestCreateAtClosedPlace books a place whose closure canceled its bookings:
+// the booking is refused even though it passed the status check of the place
+// before, and taken again once the place is reopened.
+func TestCreateAtClosedPlace(t *testing.T) {
+	ds := testDatabase(t)
+	ctx := context.Background()
+	placeID := testParkingPlace()
+	from := strfmt.DateTime(time.Now().UTC().Add(72 * time.Hour).Truncate(time.Hour))
+	to := strfmt.DateTime(time.Time(from).Add(2 * time.Hour))
+	newBooking := func() *models.Booking {
+		return &models.Booking{DateFrom: &from, DateTo: &to, ParkingPlaceID: &placeID, FullCost: 100,
+			UserID: "driver-1"}
+	}
+
+	if _, err := ds.CancelForPlace(ctx, placeID); err != nil {
+		t.Fatalf("cancel for place: %v", err)
+	}
+	if _, err := ds.Create(ctx, newBooking(), 1, "owner-1", 0, nil); !errors.Is(err, domain.ErrParkingUnavailable) {
+		t.Fatalf("create at closed place: err = %v, want %v", err, domain.ErrParkingUnavailable)
+	}
+
+	if err := ds.ReopenForPlace(ctx, placeID); err != nil {
+		t.Fatalf("reopen place: %v", err)
+	}
+	if _, err := ds.Create(ctx, newBooking(), 1, "owner-1", 0, nil); err != nil {
+		t.Fatalf("create at reopened place: %v", err)
+	}
+}
@@ -0,0 +1,165 @@
+package database_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/h4x4d/parking_net/booking/internal/utils"
+	"github.com/h4x4d/parking_net/pkg/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.opentelemetry.io/otel"
+)
+
+// CheckIn records the arrival of the driver at now and moves the Confirmed
+// booking to Active. It fails with domain.ErrInvalidStatusTransition for any
+// other status and with the domain check-in errors outside the check-in window.
+func (ds *DatabaseService) CheckIn(ctx context.Context, bookingID int64, now time.Time) error {
+	tracer := otel.Tracer("Booking")
+	ctx, span := tracer.Start(ctx, "check in")
+	defer span.End()
+
+	tx, err := ds.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var status string
+	var dateFrom, dateTo pgtype.Timestamp
+	err = tx.QueryRow(ctx, "SELECT status, date_from, date_to FROM bookings WHERE id = $1 FOR UPDATE",
+		bookingID).Scan(&status, &dateFrom, &dateTo)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.ErrBookingNotFound
+	}
+	if err != nil {
+		return err
+	}
+	if domain.BookingStatus(status) != domain.BookingStatusConfirmed {
+		return fmt.Errorf("%w: a %s booking cannot be checked in", domain.ErrInvalidStatusTransition, status)
+	}
+	if err := domain.ValidateCheckIn(dateFrom.Time, dateTo.Time, now); err != nil {
+		return err
+	}
+
+	if err := markCheckedIn(ctx, tx, bookingID, now); err != nil {
+		return err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+func markCheckedIn(ctx context.Context, tx pgx.Tx, bookingID int64, now time.Time) error {
+	var userID string
+	var parkingPlaceID int64
+	err := tx.QueryRow(ctx,
+		"UPDATE bookings SET status = $2, checked_in_at = $3 WHERE id = $1 RETURNING user_id, parking_place_id",
+		bookingID, string(domain.BookingStatusActive), now).Scan(&userID, &parkingPlaceID)
+	if err != nil {
+		return fmt.Errorf("failed to check in booking %d: %w", bookingID, err)
+	}
+	newValue := statusValues(domain.BookingStatusActive)
+	newValue["checked_in_at"] = now
+	return recordEvent(ctx, tx, bookingEvent{
+		bookingID:      bookingID,
+		userID:         userID,
+		parkingPlaceID: parkingPlaceID,
+		eventType:      domain.BookingEventStatusChanged,
+		oldValue:       statusValues(domain.BookingStatusConfirmed),
+		newValue:       newValue,
+	})
+}
+
+// BookingCheckOut is a check-out the caller already priced: the driver left at
+// At and owes OvertimeCost to OwnerID, the owner of the place, for the time
+// spent past ExpectedDateTo, the end the overtime was priced from.
+type BookingCheckOut struct {
+	At             time.Time
+	ExpectedDateTo time.Time
+	OvertimeCost   int64
+	OwnerID        string
+}
+
+// CheckOut records the departure of the driver and completes the Active
+// booking. A positive overtime cost is billed through an adjust command. It
+// fails with utils.ErrBookingChanged when the booking no longer ends at
+// ExpectedDateTo.
+func (ds *DatabaseService) CheckOut(ctx context.Context, bookingID int64, checkOut BookingCheckOut) error {
+	tracer := otel.Tracer("Booking")
+	ctx, span := tracer.Start(ctx, "check out")
+	defer span.End()
+
+	tx, err := ds.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var status, userID string
+	var parkingPlaceID int64
+	var dateTo pgtype.Timestamp
+	err = tx.QueryRow(ctx,
+		"SELECT status, user_id, parking_place_id, date_to FROM bookings WHERE id = $1 FOR UPDATE",
+		bookingID).Scan(&status, &userID, &parkingPlaceID, &dateTo)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.ErrBookingNotFound
+	}
+	if err != nil {
+		return err
+	}
+	if domain.BookingStatus(status) != domain.BookingStatusActive {
+		return fmt.Errorf("%w: a %s booking cannot be checked out", domain.ErrInvalidStatusTransition, status)
+	}
+	if !dateTo.Time.Equal(checkOut.ExpectedDateTo) {
+		return utils.ErrBookingChanged
+	}
+
+	_, err = tx.Exec(ctx,
+		"UPDATE bookings SET status = $2, checked_out_at = $3, overtime_cost = $4 WHERE id = $1",
+		bookingID, string(domain.BookingStatusCompleted), checkOut.At, checkOut.OvertimeCost)
+	if err != nil {
+		return fmt.Errorf("failed to check out booking %d: %w", bookingID, err)
+	}
+	checkedOut := statusValues(domain.BookingStatusCompleted)
+	checkedOut["checked_out_at"] = checkOut.At
+	checkedOut["overtime_cost"] = checkOut.OvertimeCost
+	err = recordEvent(ctx, tx, bookingEvent{
+		bookingID:      bookingID,
+		userID:         userID,
+		parkingPlaceID: parkingPlaceID,
+		eventType:      domain.BookingEventStatusChanged,
+		oldValue:       statusValues(domain.BookingStatusActive),
+		newValue:       checkedOut,
+	})
+	if err != nil {
+		return err
+	}
+	if checkOut.OvertimeCost > 0 {
+		charge := OutboxPayload{
+			DriverID:       userID,
+			OwnerID:        checkOut.OwnerID,
+			ParkingPlaceID: parkingPlaceID,
+			Delta:          checkOut.OvertimeCost,
+		}
+		if _, err := enqueueOutbox(ctx, tx, bookingID, OutboxAdjust, charge); err != nil {
+			return err
+		}
+		notification := OutboxPayload{
+			UserID: userID,
+			Name:   "Overtime",
+			Text: fmt.Sprintf("You left %s after the end of your booking with booking_id %d, the overtime was billed",
+				checkOut.At.Sub(dateTo.Time).Round(time.Minute), bookingID),
+		}
+		if _, err := enqueueOutbox(ctx, tx, bookingID, OutboxNotify, notification); err != nil {
+			return err
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
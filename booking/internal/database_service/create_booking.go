@@ -3,16 +3,18 @@ package database_service
 import (
 	"context"
 	"fmt"
-	"github.com/go-openapi/strfmt"
-	"github.com/h4x4d/parking_net/booking/internal/grpc/client"
 	"github.com/h4x4d/parking_net/booking/internal/models"
-	"github.com/h4x4d/parking_net/booking/internal/utils"
+	"github.com/h4x4d/parking_net/pkg/domain"
 	"go.opentelemetry.io/otel"
 	"strings"
 	"time"
 )
 
 func (ds *DatabaseService) CreateBooking(booking *models.Booking) (*int64, error) {
+	return insertBooking(context.Background(), ds.pool, booking)
+}
+
+func insertBooking(ctx context.Context, q querier, booking *models.Booking) (*int64, error) {
 	query := `INSERT INTO bookings`
 	// maybe fieldNames can be placed in common place cause other methods also need this info
 	var fieldNames []string
@@ -32,6 +34,16 @@ func (ds *DatabaseService) CreateBooking(booking *models.Booking) (*int64, error
 		values = append(values, booking.ParkingPlaceID)
 	}
 
+	if booking.SeriesID != 0 {
+		fieldNames = append(fieldNames, "series_id")
+		values = append(values, booking.SeriesID)
+	}
+
+	if booking.VehicleID != 0 {
+		fieldNames = append(fieldNames, "vehicle_id")
+		values = append(values, booking.VehicleID)
+	}
+
 	if booking.BookingID != 0 {
 		fieldNames = append(fieldNames, "booking_id")
 		values = append(values, booking.BookingID)
@@ -49,56 +61,127 @@ func (ds *DatabaseService) CreateBooking(booking *models.Booking) (*int64, error
 	}
 	query += fmt.Sprintf(" (%s) VALUES (%s) RETURNING id", strings.Join(fieldNames, ", "),
 		strings.Join(fields, ", "))
-	errInsert := ds.pool.QueryRow(context.Background(), query, values...).Scan(&booking.BookingID)
+	errInsert := q.QueryRow(ctx, query, values...).Scan(&booking.BookingID)
 	if errInsert != nil {
 		return nil, errInsert
 	}
 
-	return &booking.BookingID, errInsert
-}
-
-func (ds *DatabaseService) Create(ctx context.Context, dateFrom *strfmt.DateTime, dateTo *strfmt.DateTime, parkingPlaceID *int64, userID string) (*int64, error) {
-	if err := utils.ValidateUserID(userID); err != nil {
-		return nil, fmt.Errorf("invalid user ID")
+	created := eventValues{
+		"date_from":        time.Time(*booking.DateFrom),
+		"date_to":          time.Time(*booking.DateTo),
+		"parking_place_id": *booking.ParkingPlaceID,
+		"full_cost":        booking.FullCost,
+		"status":           booking.Status,
 	}
-
-	if err := utils.ValidateParkingPlaceID(parkingPlaceID); err != nil {
-		return nil, fmt.Errorf("invalid parking place ID")
+	if booking.SeriesID != 0 {
+		created["series_id"] = booking.SeriesID
 	}
-
-	if dateFrom == nil || dateTo == nil {
-		return nil, fmt.Errorf("dates cannot be nil")
+	if booking.VehicleID != 0 {
+		created["vehicle_id"] = booking.VehicleID
+	}
+	err := recordEvent(ctx, q, bookingEvent{
+		bookingID:      booking.BookingID,
+		userID:         booking.UserID,
+		parkingPlaceID: *booking.ParkingPlaceID,
+		eventType:      domain.BookingEventCreated,
+		newValue:       created,
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	dFrom := time.Time(*dateFrom)
-	dTo := time.Time(*dateTo)
-	if err := utils.ValidateDateRange(&dFrom, &dTo); err != nil {
+	return &booking.BookingID, nil
+}
+
+// insertCharged inserts a Waiting booking together with the command that
+// charges its driver for the parking place owner.
+func insertCharged(ctx context.Context, q querier, booking *models.Booking, ownerID string) (*int64, error) {
+	bookingID, err := insertBooking(ctx, q, booking)
+	if err != nil {
 		return nil, err
 	}
+	if _, err := enqueueOutbox(ctx, q, *bookingID, OutboxCharge, chargePayload(booking, ownerID)); err != nil {
+		return nil, err
+	}
+	return bookingID, nil
+}
+
+// chargePayload charges the driver of booking its full cost for ownerID.
+func chargePayload(booking *models.Booking, ownerID string) OutboxPayload {
+	return OutboxPayload{
+		DriverID:       booking.UserID,
+		OwnerID:        ownerID,
+		ParkingPlaceID: *booking.ParkingPlaceID,
+		Amount:         booking.FullCost,
+	}
+}
 
+// Create inserts booking, already validated and priced by the caller, as
+// Waiting together with the command that charges its driver for ownerID. The
+// booking is rejected with utils.ErrNoFreeSpots if capacity spots of its
+// parking place are taken for the period. When an idempotency key is given, a
+// retry of the same request returns the booking created the first time instead
+// of a new one. A non-zero holdID books the spot reserved by that hold of the
+// driver.
+func (ds *DatabaseService) Create(ctx context.Context, booking *models.Booking, capacity int64, ownerID string,
+	holdID int64, idempotencyKey *string) (*int64, error) {
 	tracer := otel.Tracer("Booking")
 	childCtx, span := tracer.Start(ctx, "create booking in database")
 	defer span.End()
 
-	parkingPlace, err := client.GetParkingPlaceById(childCtx, parkingPlaceID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get parking place")
+	dFrom := time.Time(*booking.DateFrom)
+	dTo := time.Time(*booking.DateTo)
+	parkingPlaceID := *booking.ParkingPlaceID
+	userID := booking.UserID
+
+	requestHash := idempotencyHash(dFrom, dTo, parkingPlaceID, booking.VehicleID, holdID)
+	if idempotencyKey != nil {
+		existing, err := findIdempotent(childCtx, ds.pool, userID, *idempotencyKey, requestHash)
+		if err != nil || existing != nil {
+			return existing, err
+		}
 	}
 
-	hours := dTo.Sub(dFrom).Hours()
-	cost := int64(float64(parkingPlace.HourlyRate) * hours)
-	if err := utils.ValidateFullCost(cost); err != nil {
-		return nil, fmt.Errorf("calculated cost exceeds maximum")
+	booking.Status = string(domain.BookingStatusWaiting)
+
+	tx, err := ds.pool.Begin(childCtx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
 	}
+	defer tx.Rollback(childCtx)
 
-	booking := &models.Booking{
-		DateFrom:        dateFrom,
-		DateTo:          dateTo,
-		ParkingPlaceID:  parkingPlaceID,
-		FullCost:        cost,
-		Status:          "Waiting",
-		UserID:          userID,
+	if holdID != 0 {
+		if err := useHold(childCtx, tx, holdID, userID, parkingPlaceID, dFrom, dTo); err != nil {
+			return nil, err
+		}
+	}
+	if err := checkCapacity(childCtx, tx, parkingPlaceID, capacity, dFrom, dTo, 0); err != nil {
+		return nil, err
 	}
 
-	return ds.CreateBooking(booking)
+	bookingID, err := insertCharged(childCtx, tx, booking, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	if holdID != 0 {
+		_, err := tx.Exec(childCtx, "UPDATE booking_holds SET booking_id = $2 WHERE id = $1", holdID, *bookingID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to link booking hold: %w", err)
+		}
+	}
+	if idempotencyKey != nil {
+		stored, err := storeIdempotent(childCtx, tx, userID, *idempotencyKey, requestHash, *bookingID)
+		if err != nil {
+			return nil, err
+		}
+		if !stored {
+			// A concurrent retry committed first; drop this booking and return that one.
+			tx.Rollback(childCtx)
+			return findIdempotent(childCtx, ds.pool, userID, *idempotencyKey, requestHash)
+		}
+	}
+	if err := tx.Commit(childCtx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return bookingID, nil
 }
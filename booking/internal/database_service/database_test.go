@@ -0,0 +1,61 @@
+package database_service
+
+import (
+	"context"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+)
+
+// testDatabaseEnv names the Postgres the tests that need one run against. They
+// are skipped without it.
+const testDatabaseEnv = "BOOKING_TEST_DATABASE_URL"
+
+// schemaPath is init_booking.sql relative to this package.
+const schemaPath = "../../../scripts/init_sql/init_booking.sql"
+
+// testDatabase connects to the test database and applies the booking schema,
+// which only creates what is missing.
+func testDatabase(t *testing.T) *DatabaseService {
+	t.Helper()
+	connStr := os.Getenv(testDatabaseEnv)
+	if connStr == "" {
+		t.Skipf("%s is not set", testDatabaseEnv)
+	}
+	schema, err := os.ReadFile(schemaPath)
+	if err != nil {
+		t.Fatalf("read schema: %v", err)
+	}
+	ds, err := NewDatabaseService(connStr)
+	if err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	t.Cleanup(ds.pool.Close)
+	if _, err := ds.pool.Exec(context.Background(), string(schema)); err != nil {
+		t.Fatalf("apply schema: %v", err)
+	}
+	return ds
+}
+
+// testParkingPlace returns a parking place ID no other test run uses, so tests
+// sharing a database do not see each other's bookings.
+func testParkingPlace() int64 {
+	return 1<<30 + rand.Int63n(1<<30)
+}
+
+// insertTestBooking stores a booking of the place in status, bypassing the
+// capacity check and the payment.
+func insertTestBooking(t *testing.T, ds *DatabaseService, parkingPlaceID int64, from, to time.Time,
+	status string) int64 {
+	t.Helper()
+	var id int64
+	err := ds.pool.QueryRow(context.Background(),
+		`INSERT INTO bookings (date_from, date_to, parking_place_id, full_cost, status, user_id)
+		VALUES ($1, $2, $3, 100, $4, 'driver-1') RETURNING id`,
+		from, to, parkingPlaceID, status).Scan(&id)
+	if err != nil {
+		t.Fatalf("insert booking: %v", err)
+	}
+	return id
+}
@@ -2,21 +2,59 @@ package database_service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+
+	"github.com/h4x4d/parking_net/booking/internal/utils"
+	"github.com/h4x4d/parking_net/pkg/domain"
+	"github.com/jackc/pgx/v5"
 )
 
-func (ds *DatabaseService) Delete(ctx context.Context, bookingID int64) error {
-	query := `DELETE FROM bookings WHERE id = $1`
-	
-	result, err := ds.pool.Exec(ctx, query, bookingID)
+// Delete removes the booking if it is still in expectedStatus and records
+// cancellation, the refund decision for a paid booking, in the same
+// transaction. It fails with utils.ErrBookingChanged if the booking is gone or
+// moved to another status in the meantime.
+func (ds *DatabaseService) Delete(ctx context.Context, bookingID int64, expectedStatus domain.BookingStatus,
+	cancellation *Cancellation) error {
+	tx, err := ds.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var userID string
+	var terms BookingTerms
+	err = tx.QueryRow(ctx,
+		`DELETE FROM bookings WHERE id = $1 AND status = $2
+		RETURNING user_id, date_from, date_to, parking_place_id, full_cost`,
+		bookingID, string(expectedStatus)).Scan(&userID, &terms.DateFrom, &terms.DateTo, &terms.ParkingPlaceID,
+		&terms.FullCost)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return utils.ErrBookingChanged
+	}
 	if err != nil {
 		return fmt.Errorf("failed to delete booking")
 	}
-	
-	if result.RowsAffected() == 0 {
-		return fmt.Errorf("booking not found")
+	parkingPlaceID := terms.ParkingPlaceID
+	err = recordEvent(ctx, tx, bookingEvent{
+		bookingID:      bookingID,
+		userID:         userID,
+		parkingPlaceID: parkingPlaceID,
+		eventType:      domain.BookingEventDeleted,
+		oldValue:       termsValues(terms, expectedStatus),
+	})
+	if err != nil {
+		return err
+	}
+
+	if cancellation != nil {
+		if err := recordCancellation(ctx, tx, bookingID, userID, parkingPlaceID, *cancellation); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
 	}
-	
 	return nil
 }
-
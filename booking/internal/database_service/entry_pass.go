@@ -0,0 +1,119 @@
+package database_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/h4x4d/parking_net/booking/internal/pass"
+	"github.com/h4x4d/parking_net/pkg/domain"
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel"
+)
+
+// PassBooking is a booking as an entry pass sees it.
+type PassBooking struct {
+	pass.Booking
+	UserID string
+	Status domain.BookingStatus
+}
+
+// GetPassBooking returns the booking with its revision, or nil if it does not
+// exist.
+func (ds *DatabaseService) GetPassBooking(ctx context.Context, bookingID int64) (*PassBooking, error) {
+	tracer := otel.Tracer("Booking")
+	ctx, span := tracer.Start(ctx, "get pass booking")
+	defer span.End()
+
+	return scanPassBooking(ds.pool.QueryRow(ctx,
+		"SELECT id, parking_place_id, user_id, date_from, date_to, status, revision FROM bookings WHERE id = $1",
+		bookingID))
+}
+
+// UseEntryPass checks the pass against the current booking: the gate must be
+// at its parking place, the booking must not have changed since the pass was
+// issued and it must be Confirmed within the check-in window. With checkIn the
+// booking is checked in at now and the pass is spent, so showing it again
+// fails with domain.ErrEntryPassUsed.
+func (ds *DatabaseService) UseEntryPass(ctx context.Context, claims *pass.Claims, parkingPlaceID int64,
+	checkIn bool, now time.Time) error {
+	tracer := otel.Tracer("Booking")
+	ctx, span := tracer.Start(ctx, "use entry pass")
+	defer span.End()
+
+	bookingID, err := claims.BookingID()
+	if err != nil {
+		return domain.ErrEntryPassInvalid
+	}
+
+	tx, err := ds.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	booking, err := scanPassBooking(tx.QueryRow(ctx,
+		"SELECT id, parking_place_id, user_id, date_from, date_to, status, revision FROM bookings WHERE id = $1 FOR UPDATE",
+		bookingID))
+	if err != nil {
+		return err
+	}
+	if booking == nil {
+		return domain.ErrBookingNotFound
+	}
+	if booking.ParkingPlaceID != parkingPlaceID || claims.ParkingPlaceID != parkingPlaceID {
+		return domain.ErrEntryPassWrongPlace
+	}
+
+	var used bool
+	err = tx.QueryRow(ctx, "SELECT EXISTS (SELECT 1 FROM entry_pass_uses WHERE pass_id = $1)",
+		claims.ID).Scan(&used)
+	if err != nil {
+		return fmt.Errorf("failed to check entry pass: %w", err)
+	}
+	if used {
+		return domain.ErrEntryPassUsed
+	}
+	if booking.Revision != claims.Revision {
+		return domain.ErrEntryPassOutdated
+	}
+	if booking.Status != domain.BookingStatusConfirmed {
+		return fmt.Errorf("%w: a %s booking cannot be checked in", domain.ErrInvalidStatusTransition, booking.Status)
+	}
+	if err := domain.ValidateCheckIn(booking.DateFrom, booking.DateTo, now); err != nil {
+		return err
+	}
+	if !checkIn {
+		return nil
+	}
+
+	_, err = tx.Exec(ctx,
+		"INSERT INTO entry_pass_uses (pass_id, booking_id, parking_place_id, used_at) VALUES ($1, $2, $3, $4)",
+		claims.ID, bookingID, parkingPlaceID, now)
+	if err != nil {
+		return fmt.Errorf("failed to spend entry pass: %w", err)
+	}
+	if err := markCheckedIn(ctx, tx, bookingID, now); err != nil {
+		return err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+func scanPassBooking(row pgx.Row) (*PassBooking, error) {
+	booking := new(PassBooking)
+	var status string
+	err := row.Scan(&booking.ID, &booking.ParkingPlaceID, &booking.UserID, &booking.DateFrom, &booking.DateTo,
+		&status, &booking.Revision)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get booking: %w", err)
+	}
+	booking.Status = domain.BookingStatus(status)
+	return booking, nil
+}
@@ -0,0 +1,92 @@
+package database_service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/h4x4d/parking_net/booking/internal/pass"
+	"github.com/h4x4d/parking_net/pkg/domain"
+)
+
+// issueTestPass issues a pass for the booking as it is stored now.
+func issueTestPass(t *testing.T, ds *DatabaseService, bookingID int64, now time.Time) *pass.Claims {
+	t.Helper()
+	booking, err := ds.GetPassBooking(context.Background(), bookingID)
+	if err != nil || booking == nil {
+		t.Fatalf("get pass booking %d: %v", bookingID, err)
+	}
+	signer := pass.NewSigner([]byte("secret"))
+	token, _, err := signer.Issue(booking.Booking, now)
+	if err != nil {
+		t.Fatalf("issue pass: %v", err)
+	}
+	claims, err := signer.Verify(token, now)
+	if err != nil {
+		t.Fatalf("verify pass: %v", err)
+	}
+	return claims
+}
+
+func TestUseEntryPassOnce(t *testing.T) {
+	ds := testDatabase(t)
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+	placeID := testParkingPlace()
+	bookingID := insertTestBooking(t, ds, placeID, now.Add(-10*time.Minute), now.Add(2*time.Hour),
+		string(domain.BookingStatusConfirmed))
+	claims := issueTestPass(t, ds, bookingID, now)
+
+	if err := ds.UseEntryPass(ctx, claims, placeID+1, false, now); !errors.Is(err, domain.ErrEntryPassWrongPlace) {
+		t.Fatalf("another gate: err = %v, want %v", err, domain.ErrEntryPassWrongPlace)
+	}
+	// Verifying without checking in leaves the pass unspent.
+	if err := ds.UseEntryPass(ctx, claims, placeID, false, now); err != nil {
+		t.Fatalf("verify: %v", err)
+	}
+	if err := ds.UseEntryPass(ctx, claims, placeID, true, now); err != nil {
+		t.Fatalf("check in: %v", err)
+	}
+	if err := ds.UseEntryPass(ctx, claims, placeID, true, now); !errors.Is(err, domain.ErrEntryPassUsed) {
+		t.Fatalf("replay: err = %v, want %v", err, domain.ErrEntryPassUsed)
+	}
+
+	booking, err := ds.GetPassBooking(ctx, bookingID)
+	if err != nil {
+		t.Fatalf("get pass booking: %v", err)
+	}
+	if booking.Status != domain.BookingStatusActive {
+		t.Errorf("status = %s, want %s", booking.Status, domain.BookingStatusActive)
+	}
+}
+
+func TestDeleteCheckedInBooking(t *testing.T) {
+	ds := testDatabase(t)
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+	placeID := testParkingPlace()
+	bookingID := insertTestBooking(t, ds, placeID, now.Add(-10*time.Minute), now.Add(2*time.Hour),
+		string(domain.BookingStatusConfirmed))
+	claims := issueTestPass(t, ds, bookingID, now)
+	if err := ds.UseEntryPass(ctx, claims, placeID, true, now); err != nil {
+		t.Fatalf("check in: %v", err)
+	}
+
+	// The spent pass must not keep its booking from being deleted.
+	if err := ds.Delete(ctx, bookingID, domain.BookingStatusActive, nil); err != nil {
+		t.Fatalf("delete checked-in booking: %v", err)
+	}
+
+	var uses int
+	err := ds.pool.QueryRow(ctx, "SELECT COUNT(*) FROM entry_pass_uses WHERE pass_id = $1", claims.ID).Scan(&uses)
+	if err != nil {
+		t.Fatalf("count pass uses: %v", err)
+	}
+	if uses != 1 {
+		t.Errorf("pass uses after delete = %d, want 1", uses)
+	}
+	if err := ds.UseEntryPass(ctx, claims, placeID, true, now); !errors.Is(err, domain.ErrBookingNotFound) {
+		t.Errorf("pass of a deleted booking: err = %v, want %v", err, domain.ErrBookingNotFound)
+	}
+}
@@ -0,0 +1,126 @@
+package database_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/h4x4d/parking_net/booking/internal/models"
+	"github.com/h4x4d/parking_net/booking/internal/utils"
+	"github.com/h4x4d/parking_net/pkg/domain"
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel"
+)
+
+// BookingExtension moves the end of a booking later. Like BookingUpdate it only
+// applies while the booking still has the Expected terms and ExpectedStatus.
+type BookingExtension struct {
+	Expected       BookingTerms
+	ExpectedStatus domain.BookingStatus
+	DateTo         time.Time
+	// FullCost is the cost of the extended booking: the cost paid so far plus
+	// the price of the added time.
+	FullCost int64
+	Capacity int64
+	OwnerID  string
+}
+
+// Extend moves the end of the booking to extension.DateTo. Only the added time
+// is checked against the capacity of the place, and its price is charged by an
+// adjust command that rolls the extension back if the payment service declines
+// it. The driver and the owner are notified once the adjust went through.
+func (ds *DatabaseService) Extend(ctx context.Context, bookingID int64, extension BookingExtension) (*models.Booking, error) {
+	tracer := otel.Tracer("Booking")
+	ctx, span := tracer.Start(ctx, "extend")
+	defer span.End()
+
+	tx, err := ds.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var current BookingTerms
+	var status, driverID string
+	err = tx.QueryRow(ctx,
+		"SELECT date_from, date_to, parking_place_id, full_cost, status, user_id FROM bookings WHERE id = $1 FOR UPDATE",
+		bookingID).Scan(&current.DateFrom, &current.DateTo, &current.ParkingPlaceID, &current.FullCost, &status, &driverID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrBookingNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !current.Equal(extension.Expected) || domain.BookingStatus(status) != extension.ExpectedStatus {
+		return nil, utils.ErrBookingChanged
+	}
+
+	// The booking already holds its spot until the current end.
+	if err := checkCapacity(ctx, tx, current.ParkingPlaceID, extension.Capacity, current.DateTo, extension.DateTo,
+		bookingID); err != nil {
+		return nil, err
+	}
+
+	extended := current
+	extended.DateTo = extension.DateTo
+	extended.FullCost = extension.FullCost
+	var charge int64
+	if delta := extended.FullCost - current.FullCost; delta != 0 {
+		charge, err = enqueueOutbox(ctx, tx, bookingID, OutboxAdjust, OutboxPayload{
+			DriverID:       driverID,
+			OwnerID:        extension.OwnerID,
+			ParkingPlaceID: current.ParkingPlaceID,
+			Delta:          delta,
+			Change:         &BookingChange{From: current, To: extended},
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	booking := new(models.Booking)
+	err = scanBooking(tx.QueryRow(ctx,
+		"UPDATE bookings SET date_to = $1, full_cost = $2 WHERE id = $3 RETURNING "+bookingColumns,
+		extended.DateTo, extended.FullCost, bookingID), booking)
+	if err != nil {
+		return nil, err
+	}
+	err = recordEvent(ctx, tx, bookingEvent{
+		bookingID:      bookingID,
+		userID:         driverID,
+		parkingPlaceID: current.ParkingPlaceID,
+		eventType:      domain.BookingEventModified,
+		oldValue:       termsValues(current, domain.BookingStatus(status)),
+		newValue:       termsValues(extended, domain.BookingStatus(status)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	until := extended.DateTo.Format(time.RFC3339)
+	notifications := []OutboxPayload{
+		{
+			UserID:    driverID,
+			Name:      "Booking extended",
+			Text:      fmt.Sprintf("Your booking with booking_id %d was extended until %s", bookingID, until),
+			DependsOn: charge,
+		},
+		{
+			UserID: extension.OwnerID,
+			Name:   "Booking extended",
+			Text: fmt.Sprintf("The booking with booking_id %d at your parking place %d was extended until %s",
+				bookingID, current.ParkingPlaceID, until),
+			DependsOn: charge,
+		},
+	}
+	for _, notification := range notifications {
+		if _, err := enqueueOutbox(ctx, tx, bookingID, OutboxNotify, notification); err != nil {
+			return nil, err
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return booking, nil
+}
@@ -0,0 +1,56 @@
+package database_service
+
+import (
+	"context"
+	"time"
+
+	"github.com/h4x4d/parking_net/booking/internal/utils"
+	"github.com/h4x4d/parking_net/pkg/domain"
+	"go.opentelemetry.io/otel"
+)
+
+// GetAvailability splits [dateFrom, dateTo) into slots of the given length and
+// reports how many spots of the parking place are booked at the busiest moment
+// of each one and how many stay free throughout it. Spots reserved by waitlist
+// offers and booking holds count as booked.
+func (ds *DatabaseService) GetAvailability(ctx context.Context, parkingPlaceID int64, capacity int64,
+	dateFrom time.Time, dateTo time.Time, step time.Duration) ([]*domain.AvailabilitySlot, error) {
+	if err := utils.ValidateAvailabilityWindow(dateFrom, dateTo, step); err != nil {
+		return nil, err
+	}
+
+	tracer := otel.Tracer("Booking")
+	ctx, span := tracer.Start(ctx, "get availability")
+	defer span.End()
+
+	periods, err := occupiedDuring(ctx, ds.pool, parkingPlaceID, dateFrom, &dateTo, 0)
+	if err != nil {
+		return nil, err
+	}
+	return availabilitySlots(periods, capacity, dateFrom, dateTo, step), nil
+}
+
+// availabilitySlots splits [dateFrom, dateTo) into slots of the given length and
+// reports the peak number of spots the periods take in each one.
+func availabilitySlots(periods []Period, capacity int64, dateFrom, dateTo time.Time, step time.Duration) []*domain.AvailabilitySlot {
+	slots := make([]*domain.AvailabilitySlot, 0)
+	for slotFrom := dateFrom; slotFrom.Before(dateTo); slotFrom = slotFrom.Add(step) {
+		slotTo := slotFrom.Add(step)
+		if slotTo.After(dateTo) {
+			slotTo = dateTo
+		}
+
+		booked := PeakOccupancy(periods, slotFrom, slotTo)
+		free := capacity - booked
+		if free < 0 {
+			free = 0
+		}
+		slots = append(slots, &domain.AvailabilitySlot{
+			DateFrom: slotFrom,
+			DateTo:   slotTo,
+			Booked:   booked,
+			Free:     free,
+		})
+	}
+	return slots
+}
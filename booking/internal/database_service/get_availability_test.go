@@ -0,0 +1,56 @@
+package database_service
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPeakOccupancy(t *testing.T) {
+	at := func(hour int) time.Time { return time.Date(2030, 1, 1, hour, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		name    string
+		periods []Period
+		want    int64
+	}{
+		{"no periods", nil, 0},
+		{"one period", []Period{{at(10), at(11)}}, 1},
+		{"adjacent periods", []Period{{at(10), at(11)}, {at(11), at(12)}}, 1},
+		{"overlapping periods", []Period{{at(10), at(12)}, {at(11), at(13)}}, 2},
+		{"disjoint pairs", []Period{{at(8), at(10)}, {at(9), at(10)}, {at(12), at(14)}, {at(13), at(15)}}, 2},
+		{"nested periods", []Period{{at(8), at(16)}, {at(9), at(10)}, {at(11), at(12)}}, 2},
+		{"overlap outside the window", []Period{{at(6), at(9)}, {at(7), at(8)}}, 1},
+		{"periods outside the window", []Period{{at(2), at(8)}, {at(16), at(20)}}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PeakOccupancy(tt.periods, at(8), at(16)); got != tt.want {
+				t.Errorf("PeakOccupancy() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAvailabilitySlotsAdjacentBookings(t *testing.T) {
+	at := func(hour int) time.Time { return time.Date(2030, 1, 1, hour, 0, 0, 0, time.UTC) }
+	periods := []Period{{at(10), at(11)}, {at(11), at(12)}}
+
+	slots := availabilitySlots(periods, 1, at(0), at(24), 24*time.Hour)
+	if len(slots) != 1 {
+		t.Fatalf("got %d slots, want 1", len(slots))
+	}
+	if slots[0].Booked != 1 || slots[0].Free != 0 {
+		t.Errorf("slot booked = %d, free = %d, want 1 and 0", slots[0].Booked, slots[0].Free)
+	}
+
+	hourly := availabilitySlots(periods, 1, at(9), at(13), time.Hour)
+	wantBooked := []int64{0, 1, 1, 0}
+	if len(hourly) != len(wantBooked) {
+		t.Fatalf("got %d slots, want %d", len(hourly), len(wantBooked))
+	}
+	for i, slot := range hourly {
+		if slot.Booked != wantBooked[i] || slot.Free != 1-wantBooked[i] {
+			t.Errorf("slot %d booked = %d, free = %d, want %d and %d", i, slot.Booked, slot.Free, wantBooked[i], 1-wantBooked[i])
+		}
+	}
+}
@@ -2,35 +2,29 @@ package database_service
 
 import (
 	"context"
-	"github.com/go-openapi/strfmt"
+
 	"github.com/h4x4d/parking_net/booking/internal/models"
-	"github.com/jackc/pgx/v5/pgtype"
+	"go.opentelemetry.io/otel"
 )
 
-func (ds *DatabaseService) GetByID(BookingID int64) (*models.Booking, error) {
-	bookingRow, errGet := ds.pool.Query(context.Background(),
-		"SELECT * FROM bookings WHERE id = $1", BookingID)
+// GetByID returns the booking, or nil if it does not exist.
+func (ds *DatabaseService) GetByID(ctx context.Context, bookingID int64) (*models.Booking, error) {
+	tracer := otel.Tracer("Booking")
+	ctx, span := tracer.Start(ctx, "get booking by id")
+	defer span.End()
+
+	bookingRow, errGet := ds.pool.Query(ctx,
+		"SELECT "+bookingColumns+" FROM bookings WHERE id = $1", bookingID)
 	if errGet != nil {
 		return nil, errGet
 	}
 	defer bookingRow.Close()
 
 	if !bookingRow.Next() {
-		return nil, nil
+		return nil, bookingRow.Err()
 	}
 
 	booking := new(models.Booking)
-	booking.ParkingPlaceID = new(int64)
-
-	from := new(pgtype.Timestamp)
-	to := new(pgtype.Timestamp)
-
-	errBooking := bookingRow.Scan(&booking.BookingID, from,
-		to, booking.ParkingPlaceID, &booking.FullCost, &booking.Status, &booking.UserID)
-
-	fromDT := strfmt.DateTime(from.Time)
-	toDT := strfmt.DateTime(to.Time)
-	booking.DateFrom = &fromDT
-	booking.DateTo = &toDT
+	errBooking := scanBooking(bookingRow, booking)
 	return booking, errBooking
 }
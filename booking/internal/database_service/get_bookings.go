@@ -2,46 +2,176 @@ package database_service
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"strings"
+	"time"
+
 	"github.com/h4x4d/parking_net/booking/internal/models"
+	"github.com/h4x4d/parking_net/booking/internal/utils"
+	"github.com/h4x4d/parking_net/pkg/domain"
 )
 
-func (ds *DatabaseService) GetAll(parkingPlaceID *int64, userID *string) ([]*models.Booking, error) {
-	query := "SELECT id FROM bookings WHERE 1=1"
-	var args []interface{}
-	argIndex := 1
+// Sort keys of ListBookings. The created_at keys follow the booking IDs, which
+// are assigned in creation order.
+const (
+	SortDateFrom      = "date_from"
+	SortDateFromDesc  = "-date_from"
+	SortCreatedAt     = "created_at"
+	SortCreatedAtDesc = "-created_at"
+)
+
+// BookingFilter selects a page of bookings. Nil and empty fields do not filter;
+// an empty non-nil ParkingPlaceIDs or VehicleIDs matches nothing.
+type BookingFilter struct {
+	ParkingPlaceIDs []int64
+	VehicleIDs      []int64
+	UserID          *string
+	Statuses        []string
+	// DateFrom and DateTo keep the bookings that overlap [DateFrom, DateTo).
+	DateFrom *time.Time
+	DateTo   *time.Time
+	Sort     string
+	Limit    int64
+	Cursor   string
+}
+
+// listCursor is the position after the last booking of a page. Sort is kept so
+// a cursor cannot be replayed with another order.
+type listCursor struct {
+	Sort     string    `json:"s"`
+	DateFrom time.Time `json:"d,omitempty"`
+	ID       int64     `json:"i"`
+}
+
+func encodeCursor(cursor listCursor) string {
+	raw, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
 
-	if parkingPlaceID != nil {
-		query += fmt.Sprintf(" AND parking_place_id=$%d", argIndex)
-		args = append(args, *parkingPlaceID)
-		argIndex++
+func decodeCursor(token string, sort string) (*listCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, utils.ErrInvalidCursor
 	}
+	cursor := new(listCursor)
+	if err := json.Unmarshal(raw, cursor); err != nil || cursor.Sort != sort {
+		return nil, utils.ErrInvalidCursor
+	}
+	return cursor, nil
+}
 
-	if userID != nil {
-		query += fmt.Sprintf(" AND user_id=$%d", argIndex)
-		args = append(args, *userID)
-		argIndex++
+// ListBookings returns one page of the bookings matching filter in a single
+// query, along with the cursor of the next page or "" on the last page.
+func (ds *DatabaseService) ListBookings(ctx context.Context, filter BookingFilter) ([]*models.Booking, string, error) {
+	if filter.Limit <= 0 || filter.Limit > utils.MaxBookingsPage {
+		filter.Limit = utils.DefaultBookingsPage
+	}
+	if (filter.ParkingPlaceIDs != nil && len(filter.ParkingPlaceIDs) == 0) ||
+		(filter.VehicleIDs != nil && len(filter.VehicleIDs) == 0) {
+		return make([]*models.Booking, 0), "", nil
+	}
+	for _, status := range filter.Statuses {
+		if !domain.BookingStatus(status).IsValid() {
+			return nil, "", fmt.Errorf("%w: %s", utils.ErrInvalidStatus, status)
+		}
+	}
+	if filter.DateFrom != nil && filter.DateTo != nil && !filter.DateFrom.Before(*filter.DateTo) {
+		return nil, "", fmt.Errorf("%w: date_from must be before date_to", utils.ErrInvalidDateRange)
 	}
 
-	bookingIdRow, errGetId := ds.pool.Query(context.Background(), query, args...)
-	if errGetId != nil {
-		return nil, errGetId
+	var clauses []string
+	var args []interface{}
+	arg := func(value interface{}) string {
+		args = append(args, value)
+		return fmt.Sprintf("$%d", len(args))
 	}
-	defer bookingIdRow.Close()
 
-	bookings := make([]*models.Booking, 0)
+	if filter.ParkingPlaceIDs != nil {
+		clauses = append(clauses, "parking_place_id = ANY("+arg(filter.ParkingPlaceIDs)+")")
+	}
+	if filter.VehicleIDs != nil {
+		clauses = append(clauses, "vehicle_id = ANY("+arg(filter.VehicleIDs)+")")
+	}
+	if filter.UserID != nil {
+		clauses = append(clauses, "user_id = "+arg(*filter.UserID))
+	}
+	if len(filter.Statuses) > 0 {
+		clauses = append(clauses, "status = ANY("+arg(filter.Statuses)+")")
+	}
+	if filter.DateFrom != nil {
+		clauses = append(clauses, "date_to > "+arg(filter.DateFrom.UTC()))
+	}
+	if filter.DateTo != nil {
+		clauses = append(clauses, "date_from < "+arg(filter.DateTo.UTC()))
+	}
 
-	for bookingIdRow.Next() {
-		var bookingId int64
-		errScanId := bookingIdRow.Scan(&bookingId)
-		if errScanId != nil {
-			return nil, errScanId
+	var order string
+	switch filter.Sort {
+	case SortDateFrom:
+		order = "date_from, id"
+	case SortDateFromDesc:
+		order = "date_from DESC, id DESC"
+	case SortCreatedAt:
+		order = "id"
+	case SortCreatedAtDesc, "":
+		filter.Sort = SortCreatedAtDesc
+		order = "id DESC"
+	default:
+		return nil, "", fmt.Errorf("%w: %q", utils.ErrInvalidSort, filter.Sort)
+	}
+
+	if filter.Cursor != "" {
+		cursor, err := decodeCursor(filter.Cursor, filter.Sort)
+		if err != nil {
+			return nil, "", err
 		}
-		booking, errGetBooking := ds.GetByID(bookingId)
-		if errGetBooking != nil {
-			return nil, errGetBooking
+		switch filter.Sort {
+		case SortDateFrom:
+			clauses = append(clauses, "(date_from, id) > ("+arg(cursor.DateFrom)+", "+arg(cursor.ID)+")")
+		case SortDateFromDesc:
+			clauses = append(clauses, "(date_from, id) < ("+arg(cursor.DateFrom)+", "+arg(cursor.ID)+")")
+		case SortCreatedAt:
+			clauses = append(clauses, "id > "+arg(cursor.ID))
+		case SortCreatedAtDesc:
+			clauses = append(clauses, "id < "+arg(cursor.ID))
+		}
+	}
+
+	query := "SELECT " + bookingColumns + " FROM bookings"
+	if len(clauses) > 0 {
+		query += " WHERE " + strings.Join(clauses, " AND ")
+	}
+	// One extra row tells whether there is a next page.
+	query += " ORDER BY " + order + " LIMIT " + arg(filter.Limit+1)
+
+	rows, err := ds.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	bookings := make([]*models.Booking, 0, filter.Limit)
+	for rows.Next() {
+		booking := new(models.Booking)
+		if err := scanBooking(rows, booking); err != nil {
+			return nil, "", err
 		}
 		bookings = append(bookings, booking)
 	}
-	return bookings, nil
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	if int64(len(bookings)) <= filter.Limit {
+		return bookings, "", nil
+	}
+	bookings = bookings[:filter.Limit]
+	last := bookings[len(bookings)-1]
+	next := listCursor{Sort: filter.Sort, ID: last.BookingID}
+	if filter.Sort == SortDateFrom || filter.Sort == SortDateFromDesc {
+		next.DateFrom = time.Time(*last.DateFrom)
+	}
+	return bookings, encodeCursor(next), nil
 }
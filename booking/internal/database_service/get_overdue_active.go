@@ -0,0 +1,41 @@
+package database_service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/h4x4d/parking_net/pkg/domain"
+	"go.opentelemetry.io/otel"
+)
+
+// GetOverdueActive returns up to limit Active bookings whose date_to is not
+// after dueBefore, earliest date_to first.
+func (ds *DatabaseService) GetOverdueActive(ctx context.Context, dueBefore time.Time, limit int) ([]StatusChange, error) {
+	tracer := otel.Tracer("Booking")
+	ctx, span := tracer.Start(ctx, "get overdue active")
+	defer span.End()
+
+	rows, err := ds.pool.Query(ctx,
+		`SELECT id, parking_place_id, user_id FROM bookings
+		WHERE status = $1 AND date_to <= $2
+		ORDER BY date_to LIMIT $3`,
+		string(domain.BookingStatusActive), dueBefore, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get overdue bookings: %w", err)
+	}
+	defer rows.Close()
+
+	overdue := make([]StatusChange, 0)
+	for rows.Next() {
+		booking := StatusChange{From: domain.BookingStatusActive}
+		if err := rows.Scan(&booking.BookingID, &booking.ParkingPlaceID, &booking.UserID); err != nil {
+			return nil, err
+		}
+		overdue = append(overdue, booking)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return overdue, nil
+}
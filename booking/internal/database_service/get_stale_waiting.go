@@ -0,0 +1,48 @@
+package database_service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/h4x4d/parking_net/pkg/domain"
+	"go.opentelemetry.io/otel"
+)
+
+// GetStaleWaiting returns up to limit bookings that have been Waiting for
+// longer than ttl, oldest first. A booking whose charge is scheduled for later,
+// like a later occurrence of a series billed per occurrence, only goes stale
+// ttl after its charge came due.
+func (ds *DatabaseService) GetStaleWaiting(ctx context.Context, ttl time.Duration, limit int) ([]StatusChange, error) {
+	tracer := otel.Tracer("Booking")
+	ctx, span := tracer.Start(ctx, "get stale waiting")
+	defer span.End()
+
+	rows, err := ds.pool.Query(ctx,
+		`SELECT id, parking_place_id, user_id FROM bookings
+		WHERE status = $1 AND created_at <= CURRENT_TIMESTAMP - make_interval(secs => $2)
+		AND NOT EXISTS (
+			SELECT 1 FROM outbox
+			WHERE outbox.booking_id = bookings.id AND outbox.command = 'charge' AND outbox.status = 'pending'
+			AND outbox.attempts = 0 AND outbox.next_attempt_at > CURRENT_TIMESTAMP - make_interval(secs => $2)
+		)
+		ORDER BY created_at LIMIT $3`,
+		string(domain.BookingStatusWaiting), ttl.Seconds(), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stale bookings: %w", err)
+	}
+	defer rows.Close()
+
+	stale := make([]StatusChange, 0)
+	for rows.Next() {
+		booking := StatusChange{From: domain.BookingStatusWaiting}
+		if err := rows.Scan(&booking.BookingID, &booking.ParkingPlaceID, &booking.UserID); err != nil {
+			return nil, err
+		}
+		stale = append(stale, booking)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return stale, nil
+}
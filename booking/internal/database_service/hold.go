@@ -0,0 +1,111 @@
+package database_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/h4x4d/parking_net/booking/internal/utils"
+	"github.com/h4x4d/parking_net/pkg/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.opentelemetry.io/otel"
+)
+
+// CreateHold reserves a spot of a parking place with the given capacity for
+// the driver of hold during its window, until ttl from now. It fails with
+// utils.ErrNoFreeSpots when the window is fully booked and with
+// utils.ErrTooManyHolds when the driver already holds utils.MaxActiveHolds
+// spots.
+func (ds *DatabaseService) CreateHold(ctx context.Context, hold *domain.BookingHold, capacity int64,
+	ttl time.Duration) (*domain.BookingHold, error) {
+	if err := utils.ValidateUserID(hold.UserID); err != nil {
+		return nil, fmt.Errorf("invalid user ID")
+	}
+	dateFrom := hold.DateFrom.UTC()
+	dateTo := hold.DateTo.UTC()
+
+	tracer := otel.Tracer("Booking")
+	ctx, span := tracer.Start(ctx, "create booking hold")
+	defer span.End()
+
+	tx, err := ds.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Serialize holds of the same driver so concurrent requests can't all pass
+	// the limit below.
+	if err := lockUser(ctx, tx, lockClassHolds, hold.UserID); err != nil {
+		return nil, err
+	}
+	var active int64
+	err = tx.QueryRow(ctx,
+		"SELECT COUNT(*) FROM booking_holds WHERE user_id = $1 AND status = 'Held' AND expires_at > $2",
+		hold.UserID, time.Now().UTC()).Scan(&active)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count booking holds: %w", err)
+	}
+	if active >= utils.MaxActiveHolds {
+		return nil, fmt.Errorf("%w: at most %d", utils.ErrTooManyHolds, utils.MaxActiveHolds)
+	}
+
+	if err := checkCapacity(ctx, tx, hold.ParkingPlaceID, capacity, dateFrom, dateTo, 0); err != nil {
+		return nil, err
+	}
+
+	created := &domain.BookingHold{
+		UserID:         hold.UserID,
+		ParkingPlaceID: hold.ParkingPlaceID,
+		DateFrom:       dateFrom,
+		DateTo:         dateTo,
+	}
+	var expiresAt pgtype.Timestamp
+	err = tx.QueryRow(ctx,
+		`INSERT INTO booking_holds (user_id, parking_place_id, date_from, date_to, expires_at)
+		VALUES ($1, $2, $3, $4, $5) RETURNING id, expires_at`,
+		hold.UserID, hold.ParkingPlaceID, dateFrom, dateTo, time.Now().UTC().Add(ttl)).Scan(&created.ID, &expiresAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create booking hold: %w", err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	created.ExpiresAt = expiresAt.Time
+	return created, nil
+}
+
+// useHold marks the hold of the driver as used by a booking of [dateFrom,
+// dateTo), releasing its spot to the capacity check of that booking. It fails
+// with utils.ErrInvalidHold unless the hold is live, belongs to the driver and
+// covers the period.
+func useHold(ctx context.Context, tx pgx.Tx, holdID int64, userID string, parkingPlaceID int64, dateFrom, dateTo time.Time) error {
+	var id int64
+	err := tx.QueryRow(ctx,
+		`UPDATE booking_holds SET status = 'Used'
+		WHERE id = $1 AND user_id = $2 AND parking_place_id = $3 AND date_from <= $4 AND date_to >= $5
+		AND status = 'Held' AND expires_at > $6
+		RETURNING id`,
+		holdID, userID, parkingPlaceID, dateFrom, dateTo, time.Now().UTC()).Scan(&id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return utils.ErrInvalidHold
+	}
+	if err != nil {
+		return fmt.Errorf("failed to use booking hold: %w", err)
+	}
+	return nil
+}
+
+// ExpireHolds closes the holds that were not used before they expired and
+// returns how many there were. Expired holds already stop counting against
+// capacity on their own; this only keeps their status accurate.
+func (ds *DatabaseService) ExpireHolds(ctx context.Context, now time.Time) (int64, error) {
+	tag, err := ds.pool.Exec(ctx,
+		"UPDATE booking_holds SET status = 'Expired' WHERE status = 'Held' AND expires_at <= $1", now)
+	if err != nil {
+		return 0, fmt.Errorf("failed to expire booking holds: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
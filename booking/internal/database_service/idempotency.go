@@ -0,0 +1,64 @@
+package database_service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/h4x4d/parking_net/booking/internal/utils"
+	"github.com/jackc/pgx/v5"
+)
+
+// idempotencyHash fingerprints every field of a create booking request, so a
+// key reused with different data can be told apart from a retry. vehicleID is
+// the vehicle the booking was resolved to, so leaving out the only vehicle of
+// the driver and naming it are the same request.
+func idempotencyHash(dateFrom time.Time, dateTo time.Time, parkingPlaceID int64, vehicleID int64,
+	holdID int64) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s|%s|%d|%d|%d",
+		dateFrom.UTC().Format(time.RFC3339Nano), dateTo.UTC().Format(time.RFC3339Nano), parkingPlaceID,
+		vehicleID, holdID)))
+	return hex.EncodeToString(sum[:])
+}
+
+// findIdempotent returns the booking created earlier with the key, or nil if the
+// key is new. It fails with utils.ErrIdempotencyKeyReused when the key was used
+// for a different request.
+func findIdempotent(ctx context.Context, q querier, userID string, key string, requestHash string) (*int64, error) {
+	var storedHash string
+	var bookingID int64
+	err := q.QueryRow(ctx,
+		"SELECT request_hash, booking_id FROM booking_idempotency WHERE user_id = $1 AND key = $2",
+		userID, key).Scan(&storedHash, &bookingID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get idempotency key: %w", err)
+	}
+	if storedHash != requestHash {
+		return nil, utils.ErrIdempotencyKeyReused
+	}
+	return &bookingID, nil
+}
+
+// storeIdempotent records the key for the booking. It reports false when a
+// concurrent request with the same key got there first.
+func storeIdempotent(ctx context.Context, q querier, userID string, key string, requestHash string,
+	bookingID int64) (bool, error) {
+	var stored int64
+	err := q.QueryRow(ctx,
+		`INSERT INTO booking_idempotency (user_id, key, request_hash, booking_id) VALUES ($1, $2, $3, $4)
+		ON CONFLICT DO NOTHING RETURNING booking_id`,
+		userID, key, requestHash, bookingID).Scan(&stored)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to store idempotency key: %w", err)
+	}
+	return true, nil
+}
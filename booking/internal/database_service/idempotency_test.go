@@ -0,0 +1,33 @@
+package database_service
+
+import (
+	"testing"
+	"time"
+)
+
+func TestIdempotencyHash(t *testing.T) {
+	from := time.Date(2030, 1, 1, 10, 0, 0, 0, time.UTC)
+	to := from.Add(2 * time.Hour)
+	base := idempotencyHash(from, to, 7, 3, 0)
+
+	tests := []struct {
+		name string
+		hash string
+		same bool
+	}{
+		{"same request", idempotencyHash(from, to, 7, 3, 0), true},
+		{"same instants in another zone", idempotencyHash(from.In(time.FixedZone("UTC+3", 3*3600)), to, 7, 3, 0), true},
+		{"other date_from", idempotencyHash(from.Add(time.Minute), to, 7, 3, 0), false},
+		{"other date_to", idempotencyHash(from, to.Add(time.Minute), 7, 3, 0), false},
+		{"other parking place", idempotencyHash(from, to, 8, 3, 0), false},
+		{"other vehicle", idempotencyHash(from, to, 7, 4, 0), false},
+		{"with a hold", idempotencyHash(from, to, 7, 3, 5), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if (tt.hash == base) != tt.same {
+				t.Errorf("hash equal to the original request = %v, want %v", tt.hash == base, tt.same)
+			}
+		})
+	}
+}
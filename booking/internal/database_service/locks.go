@@ -0,0 +1,33 @@
+package database_service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// lockClass tells apart the advisory locks taken with the two-key
+// pg_advisory_xact_lock(class, key). Postgres keeps that keyspace apart from
+// the single bigint one lockParkingPlace uses, so these locks never collide
+// with a parking place ID, and the class keeps different kinds of locks apart
+// from each other.
+type lockClass int32
+
+const (
+	lockClassWaitlist lockClass = iota + 1
+	lockClassVehicles
+	lockClassHolds
+	// lockClassPublisher with key 0 serializes PublishBookingEvents runs.
+	lockClassPublisher
+)
+
+// lockUser serializes the transactions that take the lock of the class for the
+// user until the surrounding transaction ends. Users whose IDs hash alike share
+// the lock, which only costs some concurrency.
+func lockUser(ctx context.Context, tx pgx.Tx, class lockClass, userID string) error {
+	if _, err := tx.Exec(ctx, "SELECT pg_advisory_xact_lock($1::INTEGER, hashtext($2))", int32(class), userID); err != nil {
+		return fmt.Errorf("failed to lock user: %w", err)
+	}
+	return nil
+}
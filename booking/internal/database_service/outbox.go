@@ -0,0 +1,483 @@
+package database_service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/h4x4d/parking_net/pkg/domain"
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel"
+)
+
+type OutboxCommand string
+
+const (
+	OutboxCharge OutboxCommand = "charge"
+	OutboxRefund OutboxCommand = "refund"
+	OutboxNotify OutboxCommand = "notify"
+	OutboxAdjust OutboxCommand = "adjust"
+)
+
+// OutboxPayload carries the arguments of an outbox command. Charge uses
+// DriverID, OwnerID, ParkingPlaceID and Amount; with Occurrences it charges
+// every listed booking of an upfront series in a single payment, Amount being
+// their total. Refund uses DriverID and OwnerID, falling back
+// to the owner of ParkingPlaceID, and returns whatever was actually charged
+// less FeePercent, recording Reason with the refund.
+// Notify uses UserID, Name and Text, and is dropped if the message DependsOn
+// was not delivered. Adjust moves Delta from DriverID to OwnerID
+// (or back when negative) once the message DependsOn has been delivered; Change
+// is the booking modification to roll back if the payment service declines it.
+type OutboxPayload struct {
+	DriverID       string             `json:"driver_id,omitempty"`
+	OwnerID        string             `json:"owner_id,omitempty"`
+	ParkingPlaceID int64              `json:"parking_place_id,omitempty"`
+	Amount         int64              `json:"amount,omitempty"`
+	UserID         string             `json:"user_id,omitempty"`
+	Name           string             `json:"name,omitempty"`
+	Text           string             `json:"text,omitempty"`
+	FeePercent     int64              `json:"fee_percent,omitempty"`
+	Reason         string             `json:"reason,omitempty"`
+	Delta          int64              `json:"delta,omitempty"`
+	DependsOn      int64              `json:"depends_on,omitempty"`
+	Change         *BookingChange     `json:"change,omitempty"`
+	Occurrences    []OccurrenceCharge `json:"occurrences,omitempty"`
+}
+
+// OccurrenceCharge is the price of one booking of a series charged up front.
+type OccurrenceCharge struct {
+	BookingID int64 `json:"booking_id"`
+	Amount    int64 `json:"amount"`
+}
+
+// BookingTerms are the priced parts of a booking.
+type BookingTerms struct {
+	DateFrom       time.Time `json:"date_from"`
+	DateTo         time.Time `json:"date_to"`
+	ParkingPlaceID int64     `json:"parking_place_id"`
+	FullCost       int64     `json:"full_cost"`
+}
+
+func (t BookingTerms) Equal(other BookingTerms) bool {
+	return t.DateFrom.Equal(other.DateFrom) && t.DateTo.Equal(other.DateTo) &&
+		t.ParkingPlaceID == other.ParkingPlaceID && t.FullCost == other.FullCost
+}
+
+// BookingChange records the terms of a booking before and after a modification.
+type BookingChange struct {
+	From BookingTerms `json:"from"`
+	To   BookingTerms `json:"to"`
+}
+
+type OutboxMessage struct {
+	ID        int64
+	BookingID int64
+	Command   OutboxCommand
+	Payload   OutboxPayload
+	Attempts  int
+}
+
+func enqueueOutbox(ctx context.Context, q querier, bookingID int64, command OutboxCommand, payload OutboxPayload) (int64, error) {
+	return enqueueOutboxAt(ctx, q, bookingID, command, payload, nil)
+}
+
+// enqueueOutboxAt enqueues a command that is not delivered before due, or
+// right away when due is nil.
+func enqueueOutboxAt(ctx context.Context, q querier, bookingID int64, command OutboxCommand, payload OutboxPayload,
+	due *time.Time) (int64, error) {
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return 0, fmt.Errorf("failed to encode %s command: %w", command, err)
+	}
+	var id int64
+	err = q.QueryRow(ctx,
+		`INSERT INTO outbox (booking_id, command, payload, next_attempt_at)
+		VALUES ($1, $2, $3, COALESCE($4::timestamp, CURRENT_TIMESTAMP)) RETURNING id`,
+		bookingID, string(command), raw, due).Scan(&id)
+	if err != nil {
+		return 0, fmt.Errorf("failed to enqueue %s command: %w", command, err)
+	}
+	return id, nil
+}
+
+// enqueueAdjustment settles the price difference of a modified booking. When
+// the owner stays the same a single adjust moves the delta; when the booking
+// moves to another owner's place, the new owner is paid the new cost first and
+// the previous owner returns the old cost only after that succeeded. Only the
+// first adjust carries the change, since the booking must be rolled back if the
+// driver cannot pay, while the second one cannot fail for lack of the driver's
+// funds.
+func enqueueAdjustment(ctx context.Context, q querier, bookingID int64, driverID string, change BookingChange,
+	fromOwner, toOwner string) error {
+	if fromOwner == toOwner {
+		delta := change.To.FullCost - change.From.FullCost
+		if delta == 0 {
+			return nil
+		}
+		_, err := enqueueOutbox(ctx, q, bookingID, OutboxAdjust, OutboxPayload{
+			DriverID:       driverID,
+			OwnerID:        toOwner,
+			ParkingPlaceID: change.To.ParkingPlaceID,
+			Delta:          delta,
+			Change:         &change,
+		})
+		return err
+	}
+
+	id, err := enqueueOutbox(ctx, q, bookingID, OutboxAdjust, OutboxPayload{
+		DriverID:       driverID,
+		OwnerID:        toOwner,
+		ParkingPlaceID: change.To.ParkingPlaceID,
+		Delta:          change.To.FullCost,
+		Change:         &change,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = enqueueOutbox(ctx, q, bookingID, OutboxAdjust, OutboxPayload{
+		DriverID:       driverID,
+		OwnerID:        fromOwner,
+		ParkingPlaceID: change.From.ParkingPlaceID,
+		Delta:          -change.From.FullCost,
+		DependsOn:      id,
+	})
+	return err
+}
+
+// ClaimOutbox leases up to limit pending messages that are due, optionally only
+// those of a single booking. A claimed message is hidden from other claims for
+// the lease duration, so a crashed relay's work is picked up again afterwards.
+func (ds *DatabaseService) ClaimOutbox(ctx context.Context, bookingID *int64, limit int, lease time.Duration) ([]OutboxMessage, error) {
+	tracer := otel.Tracer("Booking")
+	ctx, span := tracer.Start(ctx, "claim outbox")
+	defer span.End()
+
+	rows, err := ds.pool.Query(ctx,
+		`UPDATE outbox SET next_attempt_at = CURRENT_TIMESTAMP + make_interval(secs => $1)
+		WHERE id IN (
+			SELECT id FROM outbox
+			WHERE status = 'pending' AND next_attempt_at <= CURRENT_TIMESTAMP
+			AND ($2::BIGINT IS NULL OR booking_id = $2)
+			ORDER BY id LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, booking_id, command, payload, attempts`,
+		lease.Seconds(), bookingID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim outbox: %w", err)
+	}
+	defer rows.Close()
+
+	messages := make([]OutboxMessage, 0)
+	for rows.Next() {
+		var message OutboxMessage
+		var raw []byte
+		if err := rows.Scan(&message.ID, &message.BookingID, &message.Command, &raw, &message.Attempts); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(raw, &message.Payload); err != nil {
+			return nil, fmt.Errorf("failed to decode outbox message %d: %w", message.ID, err)
+		}
+		messages = append(messages, message)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return messages, nil
+}
+
+func completeOutbox(ctx context.Context, q querier, id int64) error {
+	var done int64
+	err := q.QueryRow(ctx,
+		"UPDATE outbox SET status = 'done', updated_at = CURRENT_TIMESTAMP WHERE id = $1 RETURNING id",
+		id).Scan(&done)
+	if err != nil {
+		return fmt.Errorf("failed to complete outbox message %d: %w", id, err)
+	}
+	return nil
+}
+
+func (ds *DatabaseService) CompleteOutbox(ctx context.Context, id int64) error {
+	return completeOutbox(ctx, ds.pool, id)
+}
+
+// CompleteRefund completes a delivered refund command and records the amount
+// returned to the driver in the history of the booking.
+func (ds *DatabaseService) CompleteRefund(ctx context.Context, message OutboxMessage, amount int64) error {
+	tx, err := ds.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	err = recordEvent(ctx, tx, bookingEvent{
+		bookingID:      message.BookingID,
+		userID:         message.Payload.DriverID,
+		parkingPlaceID: message.Payload.ParkingPlaceID,
+		eventType:      domain.BookingEventRefunded,
+		newValue: eventValues{
+			"amount":      amount,
+			"fee_percent": message.Payload.FeePercent,
+			"reason":      message.Payload.Reason,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if err := completeOutbox(ctx, tx, message.ID); err != nil {
+		return err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// GetOutboxStatus returns the delivery status of a message: pending, done,
+// failed or compensated.
+func (ds *DatabaseService) GetOutboxStatus(ctx context.Context, id int64) (string, error) {
+	var status string
+	err := ds.pool.QueryRow(ctx, "SELECT status FROM outbox WHERE id = $1", id).Scan(&status)
+	if err != nil {
+		return "", fmt.Errorf("failed to get outbox message %d: %w", id, err)
+	}
+	return status, nil
+}
+
+// SkipOutbox marks a message as compensated without delivering it.
+func (ds *DatabaseService) SkipOutbox(ctx context.Context, id int64) error {
+	return compensateOutbox(ctx, ds.pool, id)
+}
+
+func compensateOutbox(ctx context.Context, q querier, id int64) error {
+	var done int64
+	err := q.QueryRow(ctx,
+		"UPDATE outbox SET status = 'compensated', updated_at = CURRENT_TIMESTAMP WHERE id = $1 RETURNING id",
+		id).Scan(&done)
+	if err != nil {
+		return fmt.Errorf("failed to compensate outbox message %d: %w", id, err)
+	}
+	return nil
+}
+
+// RetryOutbox records a failed attempt and schedules the next one after delay.
+func (ds *DatabaseService) RetryOutbox(ctx context.Context, id int64, cause error, delay time.Duration) error {
+	_, err := ds.pool.Exec(ctx,
+		`UPDATE outbox SET attempts = attempts + 1, last_error = $2,
+		next_attempt_at = CURRENT_TIMESTAMP + make_interval(secs => $3), updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1`,
+		id, cause.Error(), delay.Seconds())
+	if err != nil {
+		return fmt.Errorf("failed to reschedule outbox message %d: %w", id, err)
+	}
+	return nil
+}
+
+// FailOutbox gives up on a message; it stays in the table for manual follow-up.
+func (ds *DatabaseService) FailOutbox(ctx context.Context, id int64, cause error) error {
+	_, err := ds.pool.Exec(ctx,
+		`UPDATE outbox SET status = 'failed', attempts = attempts + 1, last_error = $2,
+		updated_at = CURRENT_TIMESTAMP WHERE id = $1`,
+		id, cause.Error())
+	if err != nil {
+		return fmt.Errorf("failed to fail outbox message %d: %w", id, err)
+	}
+	return nil
+}
+
+// CompleteCharge settles a charge command by moving its Waiting booking to next,
+// which is Confirmed after a successful charge and Canceled otherwise. The
+// notifications are enqueued only when the booking actually changed. A refund is
+// enqueued whenever the booking ends up deleted, Canceled or Expired, so a charge
+// that did go through (or whose outcome is unknown) is always compensated; the
+// relay skips refunds of bookings that were never charged. A charge of an
+// upfront series settles every occurrence it lists the same way, and when it
+// fails the rest of the series is canceled as well.
+func (ds *DatabaseService) CompleteCharge(ctx context.Context, message OutboxMessage, next domain.BookingStatus,
+	notifications []OutboxPayload) (bool, error) {
+	tracer := otel.Tracer("Booking")
+	ctx, span := tracer.Start(ctx, "complete charge")
+	defer span.End()
+
+	tx, err := ds.pool.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	bookingIDs := []int64{message.BookingID}
+	if len(message.Payload.Occurrences) > 0 {
+		bookingIDs = bookingIDs[:0]
+		for _, occurrence := range message.Payload.Occurrences {
+			bookingIDs = append(bookingIDs, occurrence.BookingID)
+		}
+	}
+	changed := false
+	for _, bookingID := range bookingIDs {
+		settled, err := settleCharged(ctx, tx, bookingID, message.Payload, next)
+		if err != nil {
+			return false, err
+		}
+		changed = changed || settled
+	}
+	if changed {
+		for _, notification := range notifications {
+			if _, err := enqueueOutbox(ctx, tx, message.BookingID, OutboxNotify, notification); err != nil {
+				return false, err
+			}
+		}
+		if next == domain.BookingStatusCanceled {
+			if _, err := cancelUpfrontSeries(ctx, tx, message.BookingID); err != nil {
+				return false, err
+			}
+		}
+	}
+	if err := completeOutbox(ctx, tx, message.ID); err != nil {
+		return false, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return changed, nil
+}
+
+// settleCharged moves the Waiting booking to next and enqueues a refund when it
+// ended up deleted, Canceled or Expired. It reports whether the booking changed.
+func settleCharged(ctx context.Context, tx pgx.Tx, bookingID int64, charge OutboxPayload,
+	next domain.BookingStatus) (bool, error) {
+	changed, err := transitionStatus(ctx, tx, bookingID, domain.BookingStatusWaiting, next)
+	if err != nil {
+		return false, err
+	}
+
+	var current string
+	errCurrent := tx.QueryRow(ctx, "SELECT status FROM bookings WHERE id = $1", bookingID).Scan(&current)
+	if errCurrent != nil && !errors.Is(errCurrent, pgx.ErrNoRows) {
+		return false, errCurrent
+	}
+	status := domain.BookingStatus(current)
+	if current == "" || status == domain.BookingStatusCanceled || status == domain.BookingStatusExpired {
+		refund := OutboxPayload{
+			DriverID:       charge.DriverID,
+			OwnerID:        charge.OwnerID,
+			ParkingPlaceID: charge.ParkingPlaceID,
+			Reason:         "booking canceled before the payment was settled",
+		}
+		if _, err := enqueueOutbox(ctx, tx, bookingID, OutboxRefund, refund); err != nil {
+			return false, err
+		}
+	}
+	return changed, nil
+}
+
+// deferredChargeMargin keeps charges that are due soon out of reach of
+// dropDeferredCharge and repriceDeferredCharge, since the relay may already be
+// delivering them; those bookings are settled like any other Waiting booking.
+const deferredChargeMargin = time.Hour
+
+// dropDeferredCharge compensates the charge of a booking that is not due yet,
+// so a canceled occurrence of a series billed per occurrence is never charged.
+func dropDeferredCharge(ctx context.Context, tx pgx.Tx, bookingID int64) error {
+	_, err := tx.Exec(ctx,
+		`UPDATE outbox SET status = 'compensated', updated_at = CURRENT_TIMESTAMP
+		WHERE booking_id = $1 AND command = 'charge' AND status = 'pending' AND attempts = 0
+		AND next_attempt_at > CURRENT_TIMESTAMP + make_interval(secs => $2)`,
+		bookingID, deferredChargeMargin.Seconds())
+	if err != nil {
+		return fmt.Errorf("failed to drop charge of booking %d: %w", bookingID, err)
+	}
+	return nil
+}
+
+// repriceDeferredCharge makes the charge of a booking that is not due yet take
+// the new terms of the booking instead of adjusting a payment that was never
+// made. It reports false when the booking has no such charge.
+func repriceDeferredCharge(ctx context.Context, tx pgx.Tx, bookingID int64, terms BookingTerms,
+	ownerID string) (bool, error) {
+	repriced, err := json.Marshal(OutboxPayload{
+		OwnerID:        ownerID,
+		ParkingPlaceID: terms.ParkingPlaceID,
+		Amount:         terms.FullCost,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to encode charge: %w", err)
+	}
+	tag, err := tx.Exec(ctx,
+		`UPDATE outbox SET payload = payload || $2::jsonb, next_attempt_at = $3, updated_at = CURRENT_TIMESTAMP
+		WHERE booking_id = $1 AND command = 'charge' AND status = 'pending' AND attempts = 0
+		AND next_attempt_at > CURRENT_TIMESTAMP + make_interval(secs => $4)`,
+		bookingID, repriced, terms.DateFrom.Add(-domain.OccurrenceChargeLead), deferredChargeMargin.Seconds())
+	if err != nil {
+		return false, fmt.Errorf("failed to reprice charge of booking %d: %w", bookingID, err)
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// CompensateAdjustment rolls back the booking change of an adjust command the
+// payment service declined. The booking keeps its new terms if it was modified
+// again in the meantime, and the driver is notified only when it was restored.
+func (ds *DatabaseService) CompensateAdjustment(ctx context.Context, message OutboxMessage,
+	notification OutboxPayload) (bool, error) {
+	tracer := otel.Tracer("Booking")
+	ctx, span := tracer.Start(ctx, "compensate adjustment")
+	defer span.End()
+
+	change := message.Payload.Change
+	if change == nil {
+		return false, fmt.Errorf("outbox message %d has no booking change", message.ID)
+	}
+
+	tx, err := ds.pool.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var current BookingTerms
+	var status, userID string
+	errCurrent := tx.QueryRow(ctx,
+		"SELECT date_from, date_to, parking_place_id, full_cost, status, user_id FROM bookings WHERE id = $1 FOR UPDATE",
+		message.BookingID).Scan(&current.DateFrom, &current.DateTo, &current.ParkingPlaceID, &current.FullCost,
+		&status, &userID)
+	if errCurrent != nil && !errors.Is(errCurrent, pgx.ErrNoRows) {
+		return false, errCurrent
+	}
+
+	restored := false
+	if errCurrent == nil && current.Equal(change.To) {
+		_, err := tx.Exec(ctx,
+			"UPDATE bookings SET date_from = $1, date_to = $2, parking_place_id = $3, full_cost = $4 WHERE id = $5",
+			change.From.DateFrom, change.From.DateTo, change.From.ParkingPlaceID, change.From.FullCost,
+			message.BookingID)
+		if err != nil {
+			return false, fmt.Errorf("failed to restore booking: %w", err)
+		}
+		err = recordEvent(ctx, tx, bookingEvent{
+			bookingID:      message.BookingID,
+			userID:         userID,
+			parkingPlaceID: change.From.ParkingPlaceID,
+			eventType:      domain.BookingEventModified,
+			oldValue:       termsValues(change.To, domain.BookingStatus(status)),
+			newValue:       termsValues(change.From, domain.BookingStatus(status)),
+		})
+		if err != nil {
+			return false, err
+		}
+		restored = true
+	}
+	if restored {
+		if _, err := enqueueOutbox(ctx, tx, message.BookingID, OutboxNotify, notification); err != nil {
+			return false, err
+		}
+	}
+	if err := compensateOutbox(ctx, tx, message.ID); err != nil {
+		return false, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return restored, nil
+}
@@ -0,0 +1,174 @@
+package database_service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/h4x4d/parking_net/booking/internal/models"
+	"github.com/h4x4d/parking_net/pkg/domain"
+	"go.opentelemetry.io/otel"
+)
+
+// ListActiveForPlace returns the bookings of the parking place that take a spot
+// and have not ended yet, ordered by start. A checked-in booking has not ended
+// before its driver checks out, even past its end.
+func (ds *DatabaseService) ListActiveForPlace(ctx context.Context, parkingPlaceID int64) ([]*models.Booking, error) {
+	tracer := otel.Tracer("Booking")
+	ctx, span := tracer.Start(ctx, "list active bookings for place")
+	defer span.End()
+
+	rows, err := ds.pool.Query(ctx,
+		"SELECT "+bookingColumns+` FROM bookings
+		WHERE parking_place_id = $1 AND status = ANY($2) AND (date_to > $3 OR status = $4)
+		ORDER BY date_from, id`,
+		parkingPlaceID, activeStatuses, time.Now().UTC(), string(domain.BookingStatusActive))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bookings: %w", err)
+	}
+	defer rows.Close()
+
+	bookings := make([]*models.Booking, 0)
+	for rows.Next() {
+		booking := new(models.Booking)
+		if err := scanBooking(rows, booking); err != nil {
+			return nil, err
+		}
+		bookings = append(bookings, booking)
+	}
+	return bookings, rows.Err()
+}
+
+// Occupancy describes how the spots of a parking place are taken during a
+// period: Count periods overlap it and at most Peak of them at the same time.
+type Occupancy struct {
+	Count int64
+	Peak  int64
+}
+
+// CountOverlapping reports the occupancy of the parking place during
+// [dateFrom, dateTo), or from dateFrom on when dateTo is nil. Waitlist offers
+// and booking holds take a spot just like active bookings.
+func (ds *DatabaseService) CountOverlapping(ctx context.Context, parkingPlaceID int64, dateFrom time.Time,
+	dateTo *time.Time) (*Occupancy, error) {
+	tracer := otel.Tracer("Booking")
+	ctx, span := tracer.Start(ctx, "count overlapping bookings")
+	defer span.End()
+
+	periods, err := occupiedDuring(ctx, ds.pool, parkingPlaceID, dateFrom, dateTo, 0)
+	if err != nil {
+		return nil, err
+	}
+	until := dateFrom
+	if dateTo != nil {
+		until = *dateTo
+	} else {
+		for _, p := range periods {
+			if p.To.After(until) {
+				until = p.To
+			}
+		}
+	}
+	return &Occupancy{Count: int64(len(periods)), Peak: PeakOccupancy(periods, dateFrom, until)}, nil
+}
+
+// CancelForPlace cancels every Waiting and Confirmed booking of the parking
+// place that has not ended yet, because the place no longer takes them;
+// bookings already checked in are kept. Holds, waitlist entries and booking
+// series of the place end too, and the place is marked closed, so nothing books
+// it again until ReopenForPlace, even a booking that passed the status check of
+// the place just before. Refunding and notifying the drivers of the returned
+// bookings is left to the caller, except for charges still in progress, which
+// are compensated once they complete.
+func (ds *DatabaseService) CancelForPlace(ctx context.Context, parkingPlaceID int64) ([]*models.Booking, error) {
+	tracer := otel.Tracer("Booking")
+	ctx, span := tracer.Start(ctx, "cancel bookings for place")
+	defer span.End()
+
+	tx, err := ds.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := lockParkingPlace(ctx, tx, parkingPlaceID); err != nil {
+		return nil, err
+	}
+	_, err = tx.Exec(ctx,
+		"INSERT INTO closed_parking_places (parking_place_id) VALUES ($1) ON CONFLICT DO NOTHING",
+		parkingPlaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to close parking place: %w", err)
+	}
+
+	rows, err := tx.Query(ctx,
+		"SELECT "+bookingColumns+` FROM bookings
+		WHERE parking_place_id = $1 AND status = ANY($2) AND date_to > $3
+		ORDER BY date_from, id FOR UPDATE`,
+		parkingPlaceID,
+		[]string{string(domain.BookingStatusWaiting), string(domain.BookingStatusConfirmed)},
+		time.Now().UTC())
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock bookings: %w", err)
+	}
+	bookings := make([]*models.Booking, 0)
+	for rows.Next() {
+		booking := new(models.Booking)
+		if err := scanBooking(rows, booking); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		bookings = append(bookings, booking)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, booking := range bookings {
+		status := domain.BookingStatus(booking.Status)
+		if _, err := transitionStatus(ctx, tx, booking.BookingID, status, domain.BookingStatusCanceled); err != nil {
+			return nil, err
+		}
+		booking.Status = string(domain.BookingStatusCanceled)
+	}
+
+	_, err = tx.Exec(ctx,
+		"UPDATE booking_holds SET status = 'Expired' WHERE parking_place_id = $1 AND status = 'Held'",
+		parkingPlaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to expire holds: %w", err)
+	}
+	_, err = tx.Exec(ctx,
+		`UPDATE waitlist_entries SET status = 'Expired', offer_expires_at = NULL
+		WHERE parking_place_id = $1 AND status IN ('Queued', 'Offered')`,
+		parkingPlaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to expire waitlist entries: %w", err)
+	}
+	_, err = tx.Exec(ctx,
+		"UPDATE booking_series SET status = 'Canceled' WHERE parking_place_id = $1 AND status = 'Active'",
+		parkingPlaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to cancel booking series: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return bookings, nil
+}
+
+// ReopenForPlace lets a parking place closed by CancelForPlace take bookings
+// again. Reopening a place that is not closed does nothing.
+func (ds *DatabaseService) ReopenForPlace(ctx context.Context, parkingPlaceID int64) error {
+	tracer := otel.Tracer("Booking")
+	ctx, span := tracer.Start(ctx, "reopen place")
+	defer span.End()
+
+	_, err := ds.pool.Exec(ctx, "DELETE FROM closed_parking_places WHERE parking_place_id = $1", parkingPlaceID)
+	if err != nil {
+		return fmt.Errorf("failed to reopen parking place: %w", err)
+	}
+	return nil
+}
@@ -2,9 +2,17 @@ package database_service
 
 import (
 	"context"
+	"github.com/go-openapi/strfmt"
+	"github.com/h4x4d/parking_net/booking/internal/models"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// bookingColumns lists the bookings columns in the order scanBooking expects.
+const bookingColumns = "id, date_from, date_to, parking_place_id, full_cost, status, user_id, COALESCE(series_id, 0), " +
+	"checked_in_at, checked_out_at, overtime_cost, COALESCE(vehicle_id, 0)"
+
 type DatabaseService struct {
 	pool *pgxpool.Pool
 }
@@ -18,3 +26,32 @@ func NewDatabaseService(connStr string) (*DatabaseService, error) {
 	result.pool = newPool
 	return result, nil
 }
+
+// scanBooking reads a row selected with bookingColumns into booking.
+func scanBooking(row pgx.Row, booking *models.Booking) error {
+	if booking.ParkingPlaceID == nil {
+		booking.ParkingPlaceID = new(int64)
+	}
+	var from, to, checkedIn, checkedOut pgtype.Timestamp
+	err := row.Scan(&booking.BookingID, &from, &to, booking.ParkingPlaceID, &booking.FullCost, &booking.Status,
+		&booking.UserID, &booking.SeriesID, &checkedIn, &checkedOut, &booking.OvertimeCost, &booking.VehicleID)
+	if err != nil {
+		return err
+	}
+
+	fromDT := strfmt.DateTime(from.Time)
+	toDT := strfmt.DateTime(to.Time)
+	booking.DateFrom = &fromDT
+	booking.DateTo = &toDT
+	booking.CheckedInAt = nil
+	if checkedIn.Valid {
+		checkedInDT := strfmt.DateTime(checkedIn.Time)
+		booking.CheckedInAt = &checkedInDT
+	}
+	booking.CheckedOutAt = nil
+	if checkedOut.Valid {
+		checkedOutDT := strfmt.DateTime(checkedOut.Time)
+		booking.CheckedOutAt = &checkedOutDT
+	}
+	return nil
+}
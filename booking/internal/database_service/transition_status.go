@@ -0,0 +1,67 @@
+package database_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/h4x4d/parking_net/pkg/domain"
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel"
+)
+
+// TransitionStatus moves a single booking from one status to another. It
+// reports false without an error when the booking is no longer in status from,
+// e.g. because a concurrent request already changed it.
+func (ds *DatabaseService) TransitionStatus(ctx context.Context, bookingID int64, from domain.BookingStatus,
+	to domain.BookingStatus) (bool, error) {
+	tracer := otel.Tracer("Booking")
+	ctx, span := tracer.Start(ctx, "transition status")
+	defer span.End()
+
+	tx, err := ds.pool.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	changed, err := transitionStatus(ctx, tx, bookingID, from, to)
+	if err != nil || !changed {
+		return false, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return true, nil
+}
+
+func transitionStatus(ctx context.Context, q querier, bookingID int64, from domain.BookingStatus,
+	to domain.BookingStatus) (bool, error) {
+	if err := domain.ValidateTransition(from, to); err != nil {
+		return false, err
+	}
+
+	var userID string
+	var parkingPlaceID int64
+	err := q.QueryRow(ctx,
+		"UPDATE bookings SET status = $3 WHERE id = $1 AND status = $2 RETURNING user_id, parking_place_id",
+		bookingID, string(from), string(to)).Scan(&userID, &parkingPlaceID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to move booking %d from %s to %s: %w", bookingID, from, to, err)
+	}
+	err = recordEvent(ctx, q, bookingEvent{
+		bookingID:      bookingID,
+		userID:         userID,
+		parkingPlaceID: parkingPlaceID,
+		eventType:      domain.BookingEventStatusChanged,
+		oldValue:       statusValues(from),
+		newValue:       statusValues(to),
+	})
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
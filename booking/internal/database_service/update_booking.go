@@ -2,101 +2,132 @@ package database_service
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"strings"
-	"time"
 
-	"github.com/go-openapi/strfmt"
-	"github.com/h4x4d/parking_net/booking/internal/grpc/client"
 	"github.com/h4x4d/parking_net/booking/internal/models"
 	"github.com/h4x4d/parking_net/booking/internal/utils"
-	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/h4x4d/parking_net/pkg/domain"
+	"github.com/jackc/pgx/v5"
 	"go.opentelemetry.io/otel"
 )
 
-func (ds *DatabaseService) Update(ctx context.Context, bookingId int64, booking *models.Booking) (*models.Booking, error) {
-	query := `UPDATE bookings SET`
-	var settings []string
-	var values []interface{}
+// BookingUpdate is a change of a booking that the caller has already validated
+// and priced. It only applies while the booking still has the Expected terms
+// and ExpectedStatus the caller decided on.
+type BookingUpdate struct {
+	Expected       BookingTerms
+	ExpectedStatus domain.BookingStatus
+	Terms          BookingTerms
+	Status         domain.BookingStatus
+	UserID         string
+	// Capacity of the parking place of Terms, checked when a booking that
+	// occupies a spot is moved.
+	Capacity int64
+	// FromOwner and ToOwner own the parking places of Expected and Terms.
+	FromOwner string
+	ToOwner   string
+	// Cancellation is set when the update cancels a paid booking.
+	Cancellation *Cancellation
+}
 
+// Update applies update to the booking. It fails with utils.ErrBookingChanged
+// if the booking was modified since the caller read it. The price difference
+// of a moved booking that is paid or being paid is settled by adjust commands
+// in the outbox, and the relay rolls the change back if the payment service
+// declines it. A booking whose charge is not due yet is charged the new price
+// when it is.
+func (ds *DatabaseService) Update(ctx context.Context, bookingID int64, update BookingUpdate) (*models.Booking, error) {
 	tracer := otel.Tracer("Booking")
 	ctx, span := tracer.Start(ctx, "update")
 	defer span.End()
 
-	if booking.DateFrom != nil {
-		settings = append(settings, fmt.Sprintf("date_from = $%d", len(values)+1))
-		values = append(values, time.Time(*booking.DateFrom))
+	tx, err := ds.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
 	}
-
-	if booking.DateTo != nil {
-		settings = append(settings, fmt.Sprintf("date_to = $%d", len(values)+1))
-		values = append(values, time.Time(*booking.DateTo))
+	defer tx.Rollback(ctx)
+
+	var current BookingTerms
+	var status, driverID string
+	err = tx.QueryRow(ctx,
+		"SELECT date_from, date_to, parking_place_id, full_cost, status, user_id FROM bookings WHERE id = $1 FOR UPDATE",
+		bookingID).Scan(&current.DateFrom, &current.DateTo, &current.ParkingPlaceID, &current.FullCost, &status, &driverID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrBookingNotFound
 	}
-
-	if booking.ParkingPlaceID != nil {
-		settings = append(settings, fmt.Sprintf("parking_place_id = $%d", len(values)+1))
-		values = append(values, *booking.ParkingPlaceID)
+	if err != nil {
+		return nil, err
+	}
+	if !current.Equal(update.Expected) || domain.BookingStatus(status) != update.ExpectedStatus {
+		return nil, utils.ErrBookingChanged
 	}
-	if booking.FullCost == 0 {
-		if booking.ParkingPlaceID == nil {
-			return nil, fmt.Errorf("parking place ID is required")
-		}
-		if err := utils.ValidateParkingPlaceID(booking.ParkingPlaceID); err != nil {
-			return nil, fmt.Errorf("invalid parking place ID")
-		}
-
-		parkingPlace, err := client.GetParkingPlaceById(ctx, booking.ParkingPlaceID)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get parking place")
-		}
-
-		if booking.DateFrom == nil || booking.DateTo == nil {
-			return nil, fmt.Errorf("dates cannot be nil")
-		}
 
-		dFrom := time.Time(*booking.DateFrom)
-		dTo := time.Time(*booking.DateTo)
-		if err := utils.ValidateDateRange(&dFrom, &dTo); err != nil {
+	if !current.Equal(update.Terms) && update.Status.OccupiesSpot() {
+		// Moving a booking must not overbook the target place, so re-check
+		// capacity excluding the booking itself.
+		if err := checkCapacity(ctx, tx, update.Terms.ParkingPlaceID, update.Capacity,
+			update.Terms.DateFrom, update.Terms.DateTo, bookingID); err != nil {
 			return nil, err
 		}
-
-		hours := dTo.Sub(dFrom).Hours()
-		booking.FullCost = int64(float64(parkingPlace.HourlyRate) * hours)
-		if err := utils.ValidateFullCost(booking.FullCost); err != nil {
-			return nil, fmt.Errorf("calculated cost exceeds maximum")
+		repriced := false
+		if domain.BookingStatus(status) == domain.BookingStatusWaiting {
+			repriced, err = repriceDeferredCharge(ctx, tx, bookingID, update.Terms, update.ToOwner)
+			if err != nil {
+				return nil, err
+			}
 		}
-	} else {
-		if err := utils.ValidateFullCost(booking.FullCost); err != nil {
-			return nil, fmt.Errorf("invalid full cost")
+		change := BookingChange{From: current, To: update.Terms}
+		if !repriced {
+			err := enqueueAdjustment(ctx, tx, bookingID, driverID, change, update.FromOwner, update.ToOwner)
+			if err != nil {
+				return nil, err
+			}
 		}
 	}
 
-	settings = append(settings, fmt.Sprintf("full_cost = $%d", len(values)+1))
-	values = append(values, booking.FullCost)
-
-	if booking.Status != "" {
-		settings = append(settings, fmt.Sprintf("status = $%d", len(values)+1))
-		values = append(values, booking.Status)
+	booking := new(models.Booking)
+	err = scanBooking(tx.QueryRow(ctx,
+		`UPDATE bookings SET date_from = $1, date_to = $2, parking_place_id = $3, full_cost = $4, status = $5,
+		user_id = $6 WHERE id = $7 RETURNING `+bookingColumns,
+		update.Terms.DateFrom, update.Terms.DateTo, update.Terms.ParkingPlaceID, update.Terms.FullCost,
+		string(update.Status), update.UserID, bookingID), booking)
+	if err != nil {
+		return nil, err
 	}
-
-	if booking.UserID != "" {
-		settings = append(settings, fmt.Sprintf("user_id = $%d", len(values)+1))
-		values = append(values, booking.UserID)
+	// A plain cancellation only changes the status, so it is recorded as one.
+	eventType := domain.BookingEventModified
+	oldValue := termsValues(current, domain.BookingStatus(status))
+	newValue := termsValues(update.Terms, update.Status)
+	switch {
+	case update.UserID != driverID:
+		oldValue["user_id"] = driverID
+		newValue["user_id"] = update.UserID
+	case current.Equal(update.Terms) && domain.BookingStatus(status) != update.Status:
+		eventType = domain.BookingEventStatusChanged
+		oldValue = statusValues(domain.BookingStatus(status))
+		newValue = statusValues(update.Status)
 	}
-
-	query += fmt.Sprintf(" %s WHERE %s RETURNING *", strings.Join(settings, ", "),
-		fmt.Sprintf("id = $%d", len(values)+1))
-	values = append(values, bookingId)
-
-	from := new(pgtype.Timestamp)
-	to := new(pgtype.Timestamp)
-
-	errUpdate := ds.pool.QueryRow(context.Background(), query, values...).Scan(&booking.BookingID, from,
-		to, booking.ParkingPlaceID, &booking.FullCost, &booking.Status, &booking.UserID)
-
-	fromDT := strfmt.DateTime(from.Time)
-	toDT := strfmt.DateTime(to.Time)
-	booking.DateFrom = &fromDT
-	booking.DateTo = &toDT
-	return booking, errUpdate
+	err = recordEvent(ctx, tx, bookingEvent{
+		bookingID:      bookingID,
+		userID:         update.UserID,
+		parkingPlaceID: update.Terms.ParkingPlaceID,
+		eventType:      eventType,
+		oldValue:       oldValue,
+		newValue:       newValue,
+	})
+	if err != nil {
+		return nil, err
+	}
+	// The refund is based on the booking as it was paid.
+	if update.Cancellation != nil {
+		if err := recordCancellation(ctx, tx, bookingID, driverID, current.ParkingPlaceID,
+			*update.Cancellation); err != nil {
+			return nil, err
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return booking, nil
 }
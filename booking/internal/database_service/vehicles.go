@@ -0,0 +1,194 @@
+package database_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/h4x4d/parking_net/booking/internal/utils"
+	"github.com/h4x4d/parking_net/pkg/domain"
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel"
+)
+
+const vehicleColumns = "id, user_id, plate, country, size, electric, created_at"
+
+func scanVehicle(row pgx.Row) (*domain.Vehicle, error) {
+	vehicle := new(domain.Vehicle)
+	var size string
+	err := row.Scan(&vehicle.ID, &vehicle.UserID, &vehicle.Plate, &vehicle.Country, &size, &vehicle.Electric,
+		&vehicle.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	vehicle.Size = domain.VehicleSize(size)
+	return vehicle, nil
+}
+
+// CreateVehicle registers a validated vehicle for its driver. It fails with
+// utils.ErrVehicleExists when the driver already has the plate and with
+// utils.ErrTooManyVehicles past domain.MaxVehiclesPerDriver.
+func (ds *DatabaseService) CreateVehicle(ctx context.Context, vehicle *domain.Vehicle) (*domain.Vehicle, error) {
+	tracer := otel.Tracer("Booking")
+	ctx, span := tracer.Start(ctx, "create vehicle")
+	defer span.End()
+
+	tx, err := ds.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Serialize registrations of the same driver so the checks below hold.
+	if err := lockUser(ctx, tx, lockClassVehicles, vehicle.UserID); err != nil {
+		return nil, err
+	}
+	var count int64
+	var exists bool
+	err = tx.QueryRow(ctx,
+		`SELECT COUNT(*), COALESCE(bool_or(country = $2 AND plate = $3), false) FROM vehicles
+		WHERE user_id = $1 AND deleted_at IS NULL`,
+		vehicle.UserID, vehicle.Country, vehicle.Plate).Scan(&count, &exists)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count vehicles: %w", err)
+	}
+	if exists {
+		return nil, fmt.Errorf("%w: %s %s", utils.ErrVehicleExists, vehicle.Country, vehicle.Plate)
+	}
+	if count >= domain.MaxVehiclesPerDriver {
+		return nil, fmt.Errorf("%w: at most %d", utils.ErrTooManyVehicles, domain.MaxVehiclesPerDriver)
+	}
+
+	created, err := scanVehicle(tx.QueryRow(ctx,
+		`INSERT INTO vehicles (user_id, plate, country, size, electric) VALUES ($1, $2, $3, $4, $5)
+		RETURNING `+vehicleColumns,
+		vehicle.UserID, vehicle.Plate, vehicle.Country, string(vehicle.Size), vehicle.Electric))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create vehicle: %w", err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return created, nil
+}
+
+// ListVehicles returns the vehicles of the driver in the order they were added.
+func (ds *DatabaseService) ListVehicles(ctx context.Context, userID string) ([]*domain.Vehicle, error) {
+	rows, err := ds.pool.Query(ctx,
+		"SELECT "+vehicleColumns+" FROM vehicles WHERE user_id = $1 AND deleted_at IS NULL ORDER BY id", userID)
+	if err != nil {
+		return nil, err
+	}
+	return collectVehicles(rows)
+}
+
+// GetVehicle returns the vehicle, or nil if it does not exist or was removed.
+func (ds *DatabaseService) GetVehicle(ctx context.Context, id int64) (*domain.Vehicle, error) {
+	vehicle, err := scanVehicle(ds.pool.QueryRow(ctx,
+		"SELECT "+vehicleColumns+" FROM vehicles WHERE id = $1 AND deleted_at IS NULL", id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	return vehicle, err
+}
+
+// FindVehiclesByPlate returns every vehicle registered with the normalized
+// plate, whatever the driver or country.
+func (ds *DatabaseService) FindVehiclesByPlate(ctx context.Context, plate string) ([]*domain.Vehicle, error) {
+	rows, err := ds.pool.Query(ctx, "SELECT "+vehicleColumns+" FROM vehicles WHERE plate = $1 ORDER BY id", plate)
+	if err != nil {
+		return nil, err
+	}
+	return collectVehicles(rows)
+}
+
+// DeleteVehicle removes a vehicle. Bookings keep referring to it, so it is
+// only marked deleted, and a vehicle that still has bookings holding a spot or
+// open waitlist entries fails with utils.ErrVehicleInUse.
+func (ds *DatabaseService) DeleteVehicle(ctx context.Context, id int64) error {
+	tracer := otel.Tracer("Booking")
+	ctx, span := tracer.Start(ctx, "delete vehicle")
+	defer span.End()
+
+	tx, err := ds.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var userID string
+	err = tx.QueryRow(ctx, "SELECT user_id FROM vehicles WHERE id = $1 AND deleted_at IS NULL FOR UPDATE",
+		id).Scan(&userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.ErrVehicleNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to lock vehicle: %w", err)
+	}
+
+	var inUse bool
+	err = tx.QueryRow(ctx,
+		`SELECT EXISTS (SELECT 1 FROM bookings WHERE vehicle_id = $1 AND status IN ('Waiting', 'Confirmed', 'Active'))
+		OR EXISTS (SELECT 1 FROM waitlist_entries WHERE vehicle_id = $1 AND status IN ('Queued', 'Offered'))`,
+		id).Scan(&inUse)
+	if err != nil {
+		return fmt.Errorf("failed to check vehicle use: %w", err)
+	}
+	if inUse {
+		return utils.ErrVehicleInUse
+	}
+
+	if _, err := tx.Exec(ctx, "UPDATE vehicles SET deleted_at = $2 WHERE id = $1", id, time.Now().UTC()); err != nil {
+		return fmt.Errorf("failed to delete vehicle: %w", err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// resolveVehicle returns the vehicle of the driver a booking is made for. A
+// zero vehicleID picks the driver's only vehicle; a driver with none or
+// several must name one and gets domain.ErrVehicleRequired.
+func resolveVehicle(ctx context.Context, q querier, userID string, vehicleID int64) (*domain.Vehicle, error) {
+	var row pgx.Row
+	if vehicleID != 0 {
+		row = q.QueryRow(ctx,
+			"SELECT "+vehicleColumns+" FROM vehicles WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL",
+			vehicleID, userID)
+	} else {
+		row = q.QueryRow(ctx,
+			`SELECT `+vehicleColumns+` FROM vehicles v WHERE user_id = $1 AND deleted_at IS NULL
+			AND NOT EXISTS (SELECT 1 FROM vehicles o WHERE o.user_id = $1 AND o.deleted_at IS NULL AND o.id <> v.id)`,
+			userID)
+	}
+	vehicle, err := scanVehicle(row)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows) && vehicleID != 0:
+		return nil, domain.ErrVehicleNotFound
+	case errors.Is(err, pgx.ErrNoRows):
+		return nil, domain.ErrVehicleRequired
+	case err != nil:
+		return nil, fmt.Errorf("failed to get vehicle: %w", err)
+	}
+	return vehicle, nil
+}
+
+// ResolveVehicle is resolveVehicle outside of a transaction.
+func (ds *DatabaseService) ResolveVehicle(ctx context.Context, userID string, vehicleID int64) (*domain.Vehicle, error) {
+	return resolveVehicle(ctx, ds.pool, userID, vehicleID)
+}
+
+func collectVehicles(rows pgx.Rows) ([]*domain.Vehicle, error) {
+	defer rows.Close()
+	vehicles := make([]*domain.Vehicle, 0)
+	for rows.Next() {
+		vehicle, err := scanVehicle(rows)
+		if err != nil {
+			return nil, err
+		}
+		vehicles = append(vehicles, vehicle)
+	}
+	return vehicles, rows.Err()
+}
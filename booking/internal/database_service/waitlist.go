@@ -0,0 +1,330 @@
+package database_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/h4x4d/parking_net/booking/internal/models"
+	"github.com/h4x4d/parking_net/booking/internal/utils"
+	"github.com/h4x4d/parking_net/pkg/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.opentelemetry.io/otel"
+)
+
+const waitlistColumns = "id, user_id, parking_place_id, date_from, date_to, auto_accept, status, offer_expires_at, " +
+	"COALESCE(booking_id, 0), COALESCE(vehicle_id, 0)"
+
+func scanWaitlistEntry(row pgx.Row) (*models.WaitlistEntry, error) {
+	entry := new(models.WaitlistEntry)
+	entry.ParkingPlaceID = new(int64)
+	var from, to, offerExpiresAt pgtype.Timestamp
+	err := row.Scan(&entry.EntryID, &entry.UserID, entry.ParkingPlaceID, &from, &to, &entry.AutoAccept,
+		&entry.Status, &offerExpiresAt, &entry.BookingID, &entry.VehicleID)
+	if err != nil {
+		return nil, err
+	}
+	fromDT := strfmt.DateTime(from.Time)
+	toDT := strfmt.DateTime(to.Time)
+	entry.DateFrom = &fromDT
+	entry.DateTo = &toDT
+	if offerExpiresAt.Valid {
+		offerDT := strfmt.DateTime(offerExpiresAt.Time)
+		entry.OfferExpiresAt = &offerDT
+	}
+	return entry, nil
+}
+
+// JoinWaitlist queues the driver of entry for a spot of its parking place
+// during the window of entry. The window and the vehicle are expected to be
+// validated already. A driver may have at most utils.MaxWaitlistEntries open
+// entries at a time.
+func (ds *DatabaseService) JoinWaitlist(ctx context.Context, entry *models.WaitlistEntry) (*models.WaitlistEntry, error) {
+	if err := utils.ValidateUserID(entry.UserID); err != nil {
+		return nil, fmt.Errorf("invalid user ID")
+	}
+
+	tracer := otel.Tracer("Booking")
+	ctx, span := tracer.Start(ctx, "join waitlist")
+	defer span.End()
+
+	tx, err := ds.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Serialize joins of the same driver so the limit holds under concurrency.
+	if err := lockUser(ctx, tx, lockClassWaitlist, entry.UserID); err != nil {
+		return nil, err
+	}
+	var open int64
+	err = tx.QueryRow(ctx,
+		"SELECT COUNT(*) FROM waitlist_entries WHERE user_id = $1 AND status IN ('Queued', 'Offered')",
+		entry.UserID).Scan(&open)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count waitlist entries: %w", err)
+	}
+	if open >= utils.MaxWaitlistEntries {
+		return nil, fmt.Errorf("%w: at most %d", utils.ErrTooManyWaitlistEntries, utils.MaxWaitlistEntries)
+	}
+
+	created, err := scanWaitlistEntry(tx.QueryRow(ctx,
+		`INSERT INTO waitlist_entries (user_id, parking_place_id, date_from, date_to, auto_accept, vehicle_id)
+		VALUES ($1, $2, $3, $4, $5, $6) RETURNING `+waitlistColumns,
+		entry.UserID, *entry.ParkingPlaceID, time.Time(*entry.DateFrom), time.Time(*entry.DateTo),
+		entry.AutoAccept, entry.VehicleID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to join waitlist: %w", err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return created, nil
+}
+
+// GetWaitlist returns the waitlist entries of the driver, newest first.
+func (ds *DatabaseService) GetWaitlist(ctx context.Context, userID string) ([]*models.WaitlistEntry, error) {
+	rows, err := ds.pool.Query(ctx,
+		"SELECT "+waitlistColumns+" FROM waitlist_entries WHERE user_id = $1 ORDER BY created_at DESC", userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := make([]*models.WaitlistEntry, 0)
+	for rows.Next() {
+		entry, err := scanWaitlistEntry(rows)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, rows.Err()
+}
+
+// GetWaitlistEntry returns the entry, or nil if there is no such entry.
+func (ds *DatabaseService) GetWaitlistEntry(ctx context.Context, entryID int64) (*models.WaitlistEntry, error) {
+	entry, err := scanWaitlistEntry(ds.pool.QueryRow(ctx,
+		"SELECT "+waitlistColumns+" FROM waitlist_entries WHERE id = $1", entryID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	return entry, err
+}
+
+// LeaveWaitlist closes a Queued or Offered entry, releasing the spot of an open
+// offer. It fails with utils.ErrWaitlistEntryClosed for any other status.
+func (ds *DatabaseService) LeaveWaitlist(ctx context.Context, entryID int64) (*models.WaitlistEntry, error) {
+	entry, err := scanWaitlistEntry(ds.pool.QueryRow(ctx,
+		`UPDATE waitlist_entries SET status = 'Left', offer_expires_at = NULL
+		WHERE id = $1 AND status IN ('Queued', 'Offered') RETURNING `+waitlistColumns,
+		entryID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, utils.ErrWaitlistEntryClosed
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to leave waitlist: %w", err)
+	}
+	return entry, nil
+}
+
+// AcceptOffer books the spot an open offer reserved for the driver. booking is
+// the priced booking of the entry at the parking place of capacity spots owned
+// by ownerID. It fails with utils.ErrNoWaitlistOffer when the entry has no offer
+// or it expired.
+func (ds *DatabaseService) AcceptOffer(ctx context.Context, entryID int64, booking *models.Booking, capacity int64,
+	ownerID string) (*models.WaitlistEntry, error) {
+	tracer := otel.Tracer("Booking")
+	ctx, span := tracer.Start(ctx, "accept waitlist offer")
+	defer span.End()
+
+	tx, err := ds.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Marking the entry Booked first releases its offer, so the capacity check
+	// below does not count the spot the offer reserved.
+	entry, err := scanWaitlistEntry(tx.QueryRow(ctx,
+		`UPDATE waitlist_entries SET status = 'Booked'
+		WHERE id = $1 AND status = 'Offered' AND offer_expires_at > CURRENT_TIMESTAMP
+		RETURNING `+waitlistColumns,
+		entryID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, utils.ErrNoWaitlistOffer
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to accept waitlist offer: %w", err)
+	}
+
+	if err := bookWaitlistEntry(ctx, tx, entry, booking, capacity, ownerID); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return entry, nil
+}
+
+// bookWaitlistEntry checks the capacity for the window of entry and creates
+// booking for it together with the charge command.
+func bookWaitlistEntry(ctx context.Context, tx pgx.Tx, entry *models.WaitlistEntry, booking *models.Booking,
+	capacity int64, ownerID string) error {
+	err := checkCapacity(ctx, tx, *entry.ParkingPlaceID, capacity, time.Time(*entry.DateFrom),
+		time.Time(*entry.DateTo), 0)
+	if err != nil {
+		return err
+	}
+
+	booking.DateFrom = entry.DateFrom
+	booking.DateTo = entry.DateTo
+	booking.ParkingPlaceID = entry.ParkingPlaceID
+	booking.Status = string(domain.BookingStatusWaiting)
+	booking.UserID = entry.UserID
+	booking.VehicleID = entry.VehicleID
+	bookingID, err := insertCharged(ctx, tx, booking, ownerID)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx, "UPDATE waitlist_entries SET status = 'Booked', booking_id = $2 WHERE id = $1",
+		entry.EntryID, *bookingID)
+	if err != nil {
+		return fmt.Errorf("failed to link waitlist entry to booking: %w", err)
+	}
+	entry.Status = models.WaitlistEntryStatusBooked
+	entry.BookingID = *bookingID
+	return nil
+}
+
+// ExpireWaitlist closes the entries whose window has started and the offers
+// that were not accepted in time. The expired offers are returned so their
+// drivers can be told; their spots become available to the next entries.
+func (ds *DatabaseService) ExpireWaitlist(ctx context.Context, now time.Time) ([]*models.WaitlistEntry, error) {
+	tracer := otel.Tracer("Booking")
+	ctx, span := tracer.Start(ctx, "expire waitlist")
+	defer span.End()
+
+	_, err := ds.pool.Exec(ctx,
+		"UPDATE waitlist_entries SET status = 'Expired' WHERE status = 'Queued' AND date_from <= $1", now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to expire waitlist entries: %w", err)
+	}
+
+	rows, err := ds.pool.Query(ctx,
+		`UPDATE waitlist_entries SET status = 'Expired'
+		WHERE status = 'Offered' AND (offer_expires_at <= $1 OR date_from <= $1)
+		RETURNING `+waitlistColumns,
+		now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to expire waitlist offers: %w", err)
+	}
+	defer rows.Close()
+
+	expired := make([]*models.WaitlistEntry, 0)
+	for rows.Next() {
+		entry, err := scanWaitlistEntry(rows)
+		if err != nil {
+			return nil, err
+		}
+		expired = append(expired, entry)
+	}
+	return expired, rows.Err()
+}
+
+// GetQueuedWaitlist returns up to limit Queued entries in the order they
+// joined.
+func (ds *DatabaseService) GetQueuedWaitlist(ctx context.Context, limit int) ([]*models.WaitlistEntry, error) {
+	tracer := otel.Tracer("Booking")
+	ctx, span := tracer.Start(ctx, "get queued waitlist")
+	defer span.End()
+
+	rows, err := ds.pool.Query(ctx,
+		"SELECT "+waitlistColumns+" FROM waitlist_entries WHERE status = 'Queued' ORDER BY created_at, id LIMIT $1",
+		limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get queued waitlist entries: %w", err)
+	}
+	defer rows.Close()
+
+	queued := make([]*models.WaitlistEntry, 0)
+	for rows.Next() {
+		entry, err := scanWaitlistEntry(rows)
+		if err != nil {
+			return nil, err
+		}
+		queued = append(queued, entry)
+	}
+	return queued, rows.Err()
+}
+
+// MatchWaitlistEntry hands a free spot at the parking place of the given
+// capacity to the Queued entry: with booking, already priced, the spot is
+// booked and charged right away; without, the entry gets an offer that
+// reserves the spot until offerExpiresAt. It reports false when the entry is no
+// longer Queued or its window has no free spot; otherwise entry is updated to
+// its new state.
+func (ds *DatabaseService) MatchWaitlistEntry(ctx context.Context, entry *models.WaitlistEntry,
+	booking *models.Booking, capacity int64, ownerID string, offerExpiresAt time.Time) (bool, error) {
+	tracer := otel.Tracer("Booking")
+	ctx, span := tracer.Start(ctx, "match waitlist entry")
+	defer span.End()
+
+	tx, err := ds.pool.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := lockParkingPlace(ctx, tx, *entry.ParkingPlaceID); err != nil {
+		return false, err
+	}
+	var status string
+	err = tx.QueryRow(ctx, "SELECT status FROM waitlist_entries WHERE id = $1 FOR UPDATE", entry.EntryID).Scan(&status)
+	if err != nil {
+		return false, fmt.Errorf("failed to lock waitlist entry: %w", err)
+	}
+	if status != models.WaitlistEntryStatusQueued {
+		// The driver left or the entry expired since it was read.
+		return false, nil
+	}
+
+	if booking != nil {
+		err := bookWaitlistEntry(ctx, tx, entry, booking, capacity, ownerID)
+		if errors.Is(err, utils.ErrNoFreeSpots) {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+	} else {
+		peak, err := peakOverlapping(ctx, tx, *entry.ParkingPlaceID, time.Time(*entry.DateFrom),
+			time.Time(*entry.DateTo), 0)
+		if err != nil {
+			return false, err
+		}
+		if peak >= capacity {
+			return false, nil
+		}
+		var expiresAt time.Time
+		err = tx.QueryRow(ctx,
+			`UPDATE waitlist_entries SET status = 'Offered', offer_expires_at = $2 WHERE id = $1
+			RETURNING offer_expires_at`,
+			entry.EntryID, offerExpiresAt).Scan(&expiresAt)
+		if err != nil {
+			return false, fmt.Errorf("failed to offer waitlist entry: %w", err)
+		}
+		offerDT := strfmt.DateTime(expiresAt)
+		entry.Status = models.WaitlistEntryStatusOffered
+		entry.OfferExpiresAt = &offerDT
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return true, nil
+}
@@ -0,0 +1,69 @@
+package di
+
+import (
+	"time"
+
+	"github.com/h4x4d/parking_net/booking/internal/database_service"
+	"github.com/h4x4d/parking_net/booking/internal/grpc/client"
+	"github.com/h4x4d/parking_net/booking/internal/handlers"
+	"github.com/h4x4d/parking_net/booking/internal/pass"
+	"github.com/h4x4d/parking_net/booking/internal/repository"
+	"github.com/h4x4d/parking_net/booking/internal/scheduler"
+	"github.com/h4x4d/parking_net/booking/internal/service"
+	keycloak "github.com/h4x4d/parking_net/pkg/client"
+	"github.com/h4x4d/parking_net/pkg/notification"
+)
+
+type Container struct {
+	BookingHandler      *handlers.BookingHandler
+	VehicleHandler      *handlers.VehicleHandler
+	AvailabilityHandler *handlers.AvailabilityHandler
+	SeriesHandler       *handlers.SeriesHandler
+	AttendanceHandler   *handlers.AttendanceHandler
+	WaitlistHandler     *handlers.WaitlistHandler
+	CalendarHandler     *handlers.CalendarHandler
+	// Attendance and Waitlist also serve the background jobs: the scheduler
+	// checks out the bookings nobody checked out and the waitlist worker hands
+	// out freed spots through them.
+	Attendance *service.AttendanceService
+	Waitlist   *service.WaitlistService
+}
+
+// Settings are the tunables the legacy handler reads from the environment.
+type Settings struct {
+	// HoldTTL is how long POST /booking/hold keeps a spot reserved.
+	HoldTTL time.Duration
+	// OvertimePenalty multiplies the hourly rate for time spent past date_to.
+	OvertimePenalty float64
+	// Passes signs and verifies entry passes.
+	Passes *pass.Signer
+}
+
+// NewContainer builds the booking service on the database and the outbox relay
+// the legacy handlers already use, so both share one pool and one relay.
+func NewContainer(db *database_service.DatabaseService, relay *scheduler.Relay,
+	kafkaConn *notification.KafkaConnection, keyCloak *keycloak.Client, settings Settings) *Container {
+	repo := repository.NewPostgresBookingRepository(db)
+	vehicles := repository.NewPostgresVehicleRepository(db)
+	parkingClient := client.NewParkingClient()
+	svc := service.NewBookingService(repo, vehicles, parkingClient, relay)
+	attendance := service.NewAttendanceService(repository.NewPostgresAttendanceRepository(db), repo, parkingClient,
+		relay, settings.Passes, settings.OvertimePenalty)
+	waitlist := service.NewWaitlistService(repository.NewPostgresWaitlistRepository(db), vehicles, parkingClient,
+		relay)
+
+	return &Container{
+		BookingHandler: handlers.NewBookingHandler(svc, parkingClient, kafkaConn, keyCloak),
+		VehicleHandler: handlers.NewVehicleHandler(service.NewVehicleService(vehicles, repo, parkingClient)),
+		AvailabilityHandler: handlers.NewAvailabilityHandler(service.NewAvailabilityService(
+			repository.NewPostgresHoldRepository(db), parkingClient, settings.HoldTTL)),
+		SeriesHandler: handlers.NewSeriesHandler(service.NewSeriesService(
+			repository.NewPostgresSeriesRepository(db), repo, vehicles, parkingClient, relay)),
+		AttendanceHandler: handlers.NewAttendanceHandler(attendance),
+		WaitlistHandler:   handlers.NewWaitlistHandler(waitlist),
+		CalendarHandler: handlers.NewCalendarHandler(service.NewCalendarService(
+			repository.NewPostgresCalendarRepository(db), parkingClient)),
+		Attendance: attendance,
+		Waitlist:   waitlist,
+	}
+}
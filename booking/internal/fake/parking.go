@@ -0,0 +1,66 @@
+package fake
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/h4x4d/parking_net/pkg/domain"
+)
+
+// ParkingClient serves parking places from memory in place of the parking
+// service.
+type ParkingClient struct {
+	mu     sync.Mutex
+	places map[int64]*domain.ParkingPlace
+}
+
+func NewParkingClient(places ...*domain.ParkingPlace) *ParkingClient {
+	client := &ParkingClient{places: make(map[int64]*domain.ParkingPlace)}
+	for _, place := range places {
+		client.Put(place)
+	}
+	return client
+}
+
+// Put adds the parking place or replaces the one with the same ID.
+func (c *ParkingClient) Put(place *domain.ParkingPlace) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	copied := *place
+	c.places[place.ID] = &copied
+}
+
+func (c *ParkingClient) Remove(id int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.places, id)
+}
+
+func (c *ParkingClient) GetParkingPlace(ctx context.Context, id int64) (*domain.ParkingPlace, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	place, ok := c.places[id]
+	if !ok {
+		return nil, domain.ErrParkingNotFound
+	}
+	copied := *place
+	return &copied, nil
+}
+
+func (c *ParkingClient) GetOwnerParkingIDs(ctx context.Context, ownerID string) ([]int64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ids := make([]int64, 0)
+	for id, place := range c.places {
+		if place.OwnerID == ownerID {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids, nil
+}
@@ -0,0 +1,138 @@
+package fake
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/h4x4d/parking_net/booking/internal/database_service"
+	"github.com/h4x4d/parking_net/booking/internal/repository"
+	"github.com/h4x4d/parking_net/pkg/domain"
+)
+
+// Payments settles the payment commands recorded by a MemoryBookingRepository
+// against in-memory balances, the way the outbox relay and the payment service
+// would. A charge the driver cannot cover cancels the booking, and an
+// adjustment the driver cannot cover rolls the booking change back. Charges
+// that are not due yet are left for a later call.
+type Payments struct {
+	repo     *repository.MemoryBookingRepository
+	mu       sync.Mutex
+	balances map[string]int64
+	// paid is what the driver of a booking has paid and to whom.
+	paid     map[int64]payment
+	declined map[int64]bool
+}
+
+type payment struct {
+	driverID string
+	ownerID  string
+	amount   int64
+}
+
+func NewPayments(repo *repository.MemoryBookingRepository) *Payments {
+	return &Payments{
+		repo:     repo,
+		balances: make(map[string]int64),
+		paid:     make(map[int64]payment),
+		declined: make(map[int64]bool),
+	}
+}
+
+func (p *Payments) SetBalance(userID string, amount int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.balances[userID] = amount
+}
+
+func (p *Payments) Balance(userID string) int64 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.balances[userID]
+}
+
+func (p *Payments) ProcessBooking(ctx context.Context, bookingID int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, command := range p.repo.Payments(bookingID) {
+		if command.Done || command.DueAt.After(time.Now()) {
+			continue
+		}
+		switch command.Command {
+		case database_service.OutboxCharge:
+			if len(command.Occurrences) > 0 {
+				p.chargeSeries(ctx, command)
+				break
+			}
+			next := domain.BookingStatusCanceled
+			if p.transfer(command.DriverID, command.OwnerID, command.Amount) {
+				p.paid[bookingID] = payment{driverID: command.DriverID, ownerID: command.OwnerID, amount: command.Amount}
+				next = domain.BookingStatusConfirmed
+			}
+			p.repo.SettleCharge(bookingID, next)
+		case database_service.OutboxRefund:
+			if paid, ok := p.paid[bookingID]; ok {
+				p.transfer(paid.ownerID, paid.driverID, domain.RefundAmount(paid.amount, command.FeePercent))
+				delete(p.paid, bookingID)
+			}
+		case database_service.OutboxAdjust:
+			if p.declined[command.DependsOn] {
+				p.declined[command.ID] = true
+				break
+			}
+			from, to, amount := command.DriverID, command.OwnerID, command.Amount
+			if amount < 0 {
+				from, to, amount = to, from, -amount
+			}
+			if !p.transfer(from, to, amount) {
+				p.declined[command.ID] = true
+				if command.Change != nil {
+					p.repo.RollBack(bookingID, *command.Change)
+				}
+				break
+			}
+			if command.Change != nil {
+				p.paid[bookingID] = payment{driverID: command.DriverID, ownerID: command.OwnerID,
+					amount: command.Change.To.FullCost}
+			}
+		}
+		p.repo.CompletePayment(command.ID)
+	}
+}
+
+// chargeSeries charges the occurrences of an upfront series that are still
+// Waiting all at once, and cancels all of them when the driver cannot pay.
+func (p *Payments) chargeSeries(ctx context.Context, command repository.Payment) {
+	var waiting []database_service.OccurrenceCharge
+	var total int64
+	for _, occurrence := range command.Occurrences {
+		booking, _ := p.repo.GetByID(ctx, occurrence.BookingID)
+		if booking != nil && booking.Status == domain.BookingStatusWaiting {
+			waiting = append(waiting, occurrence)
+			total += occurrence.Amount
+		}
+	}
+	next := domain.BookingStatusCanceled
+	if len(waiting) > 0 && p.transfer(command.DriverID, command.OwnerID, total) {
+		next = domain.BookingStatusConfirmed
+		for _, occurrence := range waiting {
+			p.paid[occurrence.BookingID] = payment{driverID: command.DriverID, ownerID: command.OwnerID,
+				amount: occurrence.Amount}
+		}
+	}
+	for _, occurrence := range waiting {
+		p.repo.SettleCharge(occurrence.BookingID, next)
+	}
+}
+
+func (p *Payments) transfer(from, to string, amount int64) bool {
+	if p.balances[from] < amount {
+		return false
+	}
+	p.balances[from] -= amount
+	p.balances[to] += amount
+	return true
+}
@@ -7,8 +7,11 @@ import (
 	"github.com/h4x4d/parking_net/booking/internal/grpc/gen"
 	"github.com/h4x4d/parking_net/booking/internal/grpc/utils"
 	"github.com/h4x4d/parking_net/booking/internal/models"
+	"github.com/h4x4d/parking_net/pkg/domain"
 	"go.opentelemetry.io/otel"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 func GetParkingPlaceById(ctx context.Context, parkingPlaceId *int64) (*models.ParkingPlace, error) {
@@ -42,6 +45,132 @@ func GetParkingPlaceById(ctx context.Context, parkingPlaceId *int64) (*models.Pa
 		Capacity:   parkingResp.Capacity,
 		ParkingType: parkingResp.ParkingType,
 		OwnerID:    parkingResp.OwnerId,
+		Status:     parkingResp.Status,
+		AllowedVehicleSizes: parkingResp.AllowedVehicleSizes,
 	}
 	return &parkingPlace, err
 }
+
+// GetCancellationPolicy returns the cancellation policy currently in effect for
+// the parking place.
+func GetCancellationPolicy(ctx context.Context, parkingPlaceId *int64) (*domain.CancellationPolicy, error) {
+	conn, err := utils.ConnectToParking()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	tracer := otel.Tracer("Booking")
+	childCtx, span := tracer.Start(ctx, "booking request get cancellation policy")
+	defer span.End()
+
+	internalToken := os.Getenv("INTERNAL_SERVICE_TOKEN")
+	if internalToken != "" {
+		childCtx = metadata.AppendToOutgoingContext(childCtx, "authorization", "Bearer "+internalToken)
+	}
+
+	client := gen.NewParkingClient(conn)
+
+	parkingResp, err := client.GetParkingPlace(childCtx, &gen.ParkingPlaceRequest{Id: *parkingPlaceId})
+	if err != nil {
+		return nil, err
+	}
+	policy := domain.DefaultCancellationPolicy
+	if parkingResp.CancellationPolicy != nil {
+		policy = domain.CancellationPolicy{
+			Version:                    parkingResp.CancellationPolicy.Version,
+			FreeCancellationHours:      parkingResp.CancellationPolicy.FreeCancellationHours,
+			LateCancellationFeePercent: parkingResp.CancellationPolicy.LateCancellationFeePercent,
+		}
+	}
+	return &policy, nil
+}
+
+// GetOwnerParkingIDs returns the IDs of every parking place of the owner.
+func GetOwnerParkingIDs(ctx context.Context, ownerID string) ([]int64, error) {
+	conn, err := utils.ConnectToParking()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	tracer := otel.Tracer("Booking")
+	childCtx, span := tracer.Start(ctx, "booking request get owner parking places")
+	defer span.End()
+
+	internalToken := os.Getenv("INTERNAL_SERVICE_TOKEN")
+	if internalToken != "" {
+		childCtx = metadata.AppendToOutgoingContext(childCtx, "authorization", "Bearer "+internalToken)
+	}
+
+	client := gen.NewParkingClient(conn)
+
+	resp, err := client.GetOwnerParkingPlaces(childCtx, &gen.OwnerParkingPlacesRequest{OwnerId: ownerID})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Ids, nil
+}
+
+// ParkingClient looks up parking places in the parking service for the booking
+// service layer.
+type ParkingClient struct{}
+
+func NewParkingClient() *ParkingClient {
+	return &ParkingClient{}
+}
+
+// GetParkingPlace returns the parking place with its cancellation policy, or
+// domain.ErrParkingNotFound if the parking service does not know it.
+func (pc *ParkingClient) GetParkingPlace(ctx context.Context, id int64) (*domain.ParkingPlace, error) {
+	conn, err := utils.ConnectToParking()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	tracer := otel.Tracer("Booking")
+	childCtx, span := tracer.Start(ctx, "booking request get parking place")
+	defer span.End()
+
+	internalToken := os.Getenv("INTERNAL_SERVICE_TOKEN")
+	if internalToken != "" {
+		childCtx = metadata.AppendToOutgoingContext(childCtx, "authorization", "Bearer "+internalToken)
+	}
+
+	client := gen.NewParkingClient(conn)
+
+	parkingResp, err := client.GetParkingPlace(childCtx, &gen.ParkingPlaceRequest{Id: id})
+	if status.Code(err) == codes.NotFound {
+		return nil, domain.ErrParkingNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	parkingPlace := &domain.ParkingPlace{
+		ID:         parkingResp.Id,
+		Name:       parkingResp.Name,
+		City:       parkingResp.City,
+		Address:    parkingResp.Address,
+		Type:       domain.ParkingType(parkingResp.ParkingType),
+		HourlyRate: float64(parkingResp.HourlyRate),
+		Capacity:   int(parkingResp.Capacity),
+		OwnerID:    parkingResp.OwnerId,
+		Status:     domain.ParkingStatus(parkingResp.Status),
+	}
+	for _, size := range parkingResp.AllowedVehicleSizes {
+		parkingPlace.AllowedVehicleSizes = append(parkingPlace.AllowedVehicleSizes, domain.VehicleSize(size))
+	}
+	if parkingResp.CancellationPolicy != nil {
+		parkingPlace.CancellationPolicy = &domain.CancellationPolicy{
+			Version:                    parkingResp.CancellationPolicy.Version,
+			FreeCancellationHours:      parkingResp.CancellationPolicy.FreeCancellationHours,
+			LateCancellationFeePercent: parkingResp.CancellationPolicy.LateCancellationFeePercent,
+		}
+	}
+	return parkingPlace, nil
+}
+
+func (pc *ParkingClient) GetOwnerParkingIDs(ctx context.Context, ownerID string) ([]int64, error) {
+	return GetOwnerParkingIDs(ctx, ownerID)
+}
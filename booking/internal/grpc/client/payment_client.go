@@ -54,7 +54,8 @@ func (pc *PaymentClient) ProcessTransaction(ctx context.Context, bookingID int64
 	}, nil
 }
 
-func (pc *PaymentClient) ProcessRefund(ctx context.Context, bookingID int64, driverID string, ownerID string, amount int64) (*TransactionResponse, error) {
+// ProcessRefund returns amount to the driver; reason is recorded with the refund.
+func (pc *PaymentClient) ProcessRefund(ctx context.Context, bookingID int64, driverID string, ownerID string, amount int64, reason string) (*TransactionResponse, error) {
 	conn, err := utils.ConnectToPayment()
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to payment service: %w", err)
@@ -77,6 +78,7 @@ func (pc *PaymentClient) ProcessRefund(ctx context.Context, bookingID int64, dri
 		DriverId:  driverID,
 		OwnerId:   ownerID,
 		Amount:    amount,
+		Reason:    reason,
 	}
 
 	resp, err := client.ProcessRefund(childCtx, req)
@@ -91,8 +93,132 @@ func (pc *PaymentClient) ProcessRefund(ctx context.Context, bookingID int64, dri
 	}, nil
 }
 
+// AdjustCharge moves delta from the driver to the owner, or back when delta is
+// negative. adjustmentID identifies the adjustment for retries.
+func (pc *PaymentClient) AdjustCharge(ctx context.Context, bookingID int64, driverID string, ownerID string, delta int64, adjustmentID int64) (*TransactionResponse, error) {
+	conn, err := utils.ConnectToPayment()
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to payment service: %w", err)
+	}
+	defer conn.Close()
+
+	tracer := otel.Tracer("Booking")
+	childCtx, span := tracer.Start(ctx, "booking request adjust charge")
+	defer span.End()
+
+	internalToken := os.Getenv("INTERNAL_SERVICE_TOKEN")
+	if internalToken != "" {
+		childCtx = metadata.AppendToOutgoingContext(childCtx, "authorization", "Bearer "+internalToken)
+	}
+
+	client := gen.NewPaymentClient(conn)
+
+	req := &gen.AdjustChargeRequest{
+		BookingId:    bookingID,
+		DriverId:     driverID,
+		OwnerId:      ownerID,
+		Delta:        delta,
+		AdjustmentId: adjustmentID,
+	}
+
+	resp, err := client.AdjustCharge(childCtx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to adjust charge: %w", err)
+	}
+
+	return &TransactionResponse{
+		TransactionID: resp.TransactionId,
+		Status:        resp.Status,
+		Message:       resp.Message,
+	}, nil
+}
+
+// ProcessSeriesTransaction charges the driver for all the given bookings at
+// once; the payment service charges either every one of them or none.
+func (pc *PaymentClient) ProcessSeriesTransaction(ctx context.Context, driverID string, ownerID string, charges []BookingAmount) (*TransactionResponse, error) {
+	conn, err := utils.ConnectToPayment()
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to payment service: %w", err)
+	}
+	defer conn.Close()
+
+	tracer := otel.Tracer("Booking")
+	childCtx, span := tracer.Start(ctx, "booking request process series transaction")
+	defer span.End()
+
+	internalToken := os.Getenv("INTERNAL_SERVICE_TOKEN")
+	if internalToken != "" {
+		childCtx = metadata.AppendToOutgoingContext(childCtx, "authorization", "Bearer "+internalToken)
+	}
+
+	client := gen.NewPaymentClient(conn)
+
+	req := &gen.SeriesTransactionRequest{
+		DriverId: driverID,
+		OwnerId:  ownerID,
+		Charges:  make([]*gen.BookingAmount, 0, len(charges)),
+	}
+	for _, charge := range charges {
+		req.Charges = append(req.Charges, &gen.BookingAmount{BookingId: charge.BookingID, Amount: charge.Amount})
+	}
+
+	resp, err := client.ProcessSeriesTransaction(childCtx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to process series transaction: %w", err)
+	}
+
+	return &TransactionResponse{
+		TransactionID: resp.TransactionId,
+		Status:        resp.Status,
+		Message:       resp.Message,
+	}, nil
+}
+
+// GetBookingCharge asks the payment service whether the driver has already been
+// charged for the booking.
+func (pc *PaymentClient) GetBookingCharge(ctx context.Context, bookingID int64) (*BookingCharge, error) {
+	conn, err := utils.ConnectToPayment()
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to payment service: %w", err)
+	}
+	defer conn.Close()
+
+	tracer := otel.Tracer("Booking")
+	childCtx, span := tracer.Start(ctx, "booking request get booking charge")
+	defer span.End()
+
+	internalToken := os.Getenv("INTERNAL_SERVICE_TOKEN")
+	if internalToken != "" {
+		childCtx = metadata.AppendToOutgoingContext(childCtx, "authorization", "Bearer "+internalToken)
+	}
+
+	client := gen.NewPaymentClient(conn)
+
+	resp, err := client.GetBookingCharge(childCtx, &gen.BookingChargeRequest{BookingId: bookingID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get booking charge: %w", err)
+	}
+
+	return &BookingCharge{
+		Charged:       resp.Charged,
+		TransactionID: resp.TransactionId,
+		Amount:        resp.Amount,
+	}, nil
+}
+
 type TransactionResponse struct {
 	TransactionID int64
 	Status        string
 	Message       string
 }
+
+type BookingCharge struct {
+	Charged       bool
+	TransactionID int64
+	Amount        int64
+}
+
+type BookingAmount struct {
+	BookingID int64
+	Amount    int64
+}
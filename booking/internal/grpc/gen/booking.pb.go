@@ -0,0 +1,569 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.33.1
+// source: booking.proto
+
+package gen
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type BookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookingRequest) Reset() {
+	*x = BookingRequest{}
+	mi := &file_booking_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingRequest) ProtoMessage() {}
+
+func (x *BookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingRequest.ProtoReflect.Descriptor instead.
+func (*BookingRequest) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BookingRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type BookingResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ParkingPlaceId int64                  `protobuf:"varint,2,opt,name=parking_place_id,json=parkingPlaceId,proto3" json:"parking_place_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DateFrom       int64                  `protobuf:"varint,4,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"`
+	DateTo         int64                  `protobuf:"varint,5,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`
+	FullCost       int64                  `protobuf:"varint,6,opt,name=full_cost,json=fullCost,proto3" json:"full_cost,omitempty"`
+	Status         string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	SeriesId       int64                  `protobuf:"varint,8,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BookingResponse) Reset() {
+	*x = BookingResponse{}
+	mi := &file_booking_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingResponse) ProtoMessage() {}
+
+func (x *BookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingResponse.ProtoReflect.Descriptor instead.
+func (*BookingResponse) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BookingResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BookingResponse) GetParkingPlaceId() int64 {
+	if x != nil {
+		return x.ParkingPlaceId
+	}
+	return 0
+}
+
+func (x *BookingResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BookingResponse) GetDateFrom() int64 {
+	if x != nil {
+		return x.DateFrom
+	}
+	return 0
+}
+
+func (x *BookingResponse) GetDateTo() int64 {
+	if x != nil {
+		return x.DateTo
+	}
+	return 0
+}
+
+func (x *BookingResponse) GetFullCost() int64 {
+	if x != nil {
+		return x.FullCost
+	}
+	return 0
+}
+
+func (x *BookingResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *BookingResponse) GetSeriesId() int64 {
+	if x != nil {
+		return x.SeriesId
+	}
+	return 0
+}
+
+// PlaceBookingsRequest selects the bookings of a parking place that take a spot
+// and have not ended yet; checked-in bookings end when their driver checks out.
+type PlaceBookingsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ParkingPlaceId int64                  `protobuf:"varint,1,opt,name=parking_place_id,json=parkingPlaceId,proto3" json:"parking_place_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PlaceBookingsRequest) Reset() {
+	*x = PlaceBookingsRequest{}
+	mi := &file_booking_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaceBookingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceBookingsRequest) ProtoMessage() {}
+
+func (x *PlaceBookingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceBookingsRequest.ProtoReflect.Descriptor instead.
+func (*PlaceBookingsRequest) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PlaceBookingsRequest) GetParkingPlaceId() int64 {
+	if x != nil {
+		return x.ParkingPlaceId
+	}
+	return 0
+}
+
+type BookingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bookings      []*BookingResponse     `protobuf:"bytes,1,rep,name=bookings,proto3" json:"bookings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookingsResponse) Reset() {
+	*x = BookingsResponse{}
+	mi := &file_booking_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingsResponse) ProtoMessage() {}
+
+func (x *BookingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingsResponse.ProtoReflect.Descriptor instead.
+func (*BookingsResponse) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BookingsResponse) GetBookings() []*BookingResponse {
+	if x != nil {
+		return x.Bookings
+	}
+	return nil
+}
+
+// CountOverlappingRequest leaves date_to at 0 to count everything from date_from on.
+type CountOverlappingRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ParkingPlaceId int64                  `protobuf:"varint,1,opt,name=parking_place_id,json=parkingPlaceId,proto3" json:"parking_place_id,omitempty"`
+	DateFrom       int64                  `protobuf:"varint,2,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"`
+	DateTo         int64                  `protobuf:"varint,3,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CountOverlappingRequest) Reset() {
+	*x = CountOverlappingRequest{}
+	mi := &file_booking_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CountOverlappingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountOverlappingRequest) ProtoMessage() {}
+
+func (x *CountOverlappingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountOverlappingRequest.ProtoReflect.Descriptor instead.
+func (*CountOverlappingRequest) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CountOverlappingRequest) GetParkingPlaceId() int64 {
+	if x != nil {
+		return x.ParkingPlaceId
+	}
+	return 0
+}
+
+func (x *CountOverlappingRequest) GetDateFrom() int64 {
+	if x != nil {
+		return x.DateFrom
+	}
+	return 0
+}
+
+func (x *CountOverlappingRequest) GetDateTo() int64 {
+	if x != nil {
+		return x.DateTo
+	}
+	return 0
+}
+
+// CountOverlappingResponse counts the bookings, holds and waitlist offers
+// overlapping the period; peak is the most of them at the same time.
+type CountOverlappingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int64                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Peak          int64                  `protobuf:"varint,2,opt,name=peak,proto3" json:"peak,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CountOverlappingResponse) Reset() {
+	*x = CountOverlappingResponse{}
+	mi := &file_booking_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CountOverlappingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountOverlappingResponse) ProtoMessage() {}
+
+func (x *CountOverlappingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountOverlappingResponse.ProtoReflect.Descriptor instead.
+func (*CountOverlappingResponse) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CountOverlappingResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *CountOverlappingResponse) GetPeak() int64 {
+	if x != nil {
+		return x.Peak
+	}
+	return 0
+}
+
+// CancelPlaceBookingsRequest cancels the Waiting and Confirmed bookings of the
+// parking place that have not ended. The caller refunds and notifies the
+// drivers of the returned bookings; reason is only logged.
+type CancelPlaceBookingsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ParkingPlaceId int64                  `protobuf:"varint,1,opt,name=parking_place_id,json=parkingPlaceId,proto3" json:"parking_place_id,omitempty"`
+	Reason         string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CancelPlaceBookingsRequest) Reset() {
+	*x = CancelPlaceBookingsRequest{}
+	mi := &file_booking_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelPlaceBookingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelPlaceBookingsRequest) ProtoMessage() {}
+
+func (x *CancelPlaceBookingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelPlaceBookingsRequest.ProtoReflect.Descriptor instead.
+func (*CancelPlaceBookingsRequest) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CancelPlaceBookingsRequest) GetParkingPlaceId() int64 {
+	if x != nil {
+		return x.ParkingPlaceId
+	}
+	return 0
+}
+
+func (x *CancelPlaceBookingsRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// ReopenPlaceResponse answers ReopenPlace, which lets a parking place whose
+// bookings CancelBookingsForPlace canceled take bookings again.
+type ReopenPlaceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReopenPlaceResponse) Reset() {
+	*x = ReopenPlaceResponse{}
+	mi := &file_booking_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReopenPlaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReopenPlaceResponse) ProtoMessage() {}
+
+func (x *ReopenPlaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReopenPlaceResponse.ProtoReflect.Descriptor instead.
+func (*ReopenPlaceResponse) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{7}
+}
+
+var File_booking_proto protoreflect.FileDescriptor
+
+const file_booking_proto_rawDesc = "" +
+	"\n" +
+	"\rbooking.proto\x12\x03gen\" \n" +
+	"\x0eBookingRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\xec\x01\n" +
+	"\x0fBookingResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12(\n" +
+	"\x10parking_place_id\x18\x02 \x01(\x03R\x0eparkingPlaceId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tdate_from\x18\x04 \x01(\x03R\bdateFrom\x12\x17\n" +
+	"\adate_to\x18\x05 \x01(\x03R\x06dateTo\x12\x1b\n" +
+	"\tfull_cost\x18\x06 \x01(\x03R\bfullCost\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12\x1b\n" +
+	"\tseries_id\x18\b \x01(\x03R\bseriesId\"@\n" +
+	"\x14PlaceBookingsRequest\x12(\n" +
+	"\x10parking_place_id\x18\x01 \x01(\x03R\x0eparkingPlaceId\"D\n" +
+	"\x10BookingsResponse\x120\n" +
+	"\bbookings\x18\x01 \x03(\v2\x14.gen.BookingResponseR\bbookings\"y\n" +
+	"\x17CountOverlappingRequest\x12(\n" +
+	"\x10parking_place_id\x18\x01 \x01(\x03R\x0eparkingPlaceId\x12\x1b\n" +
+	"\tdate_from\x18\x02 \x01(\x03R\bdateFrom\x12\x17\n" +
+	"\adate_to\x18\x03 \x01(\x03R\x06dateTo\"D\n" +
+	"\x18CountOverlappingResponse\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x03R\x05count\x12\x12\n" +
+	"\x04peak\x18\x02 \x01(\x03R\x04peak\"^\n" +
+	"\x1aCancelPlaceBookingsRequest\x12(\n" +
+	"\x10parking_place_id\x18\x01 \x01(\x03R\x0eparkingPlaceId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\x15\n" +
+	"\x13ReopenPlaceResponse2\xf9\x02\n" +
+	"\aBooking\x127\n" +
+	"\n" +
+	"GetBooking\x12\x13.gen.BookingRequest\x1a\x14.gen.BookingResponse\x12N\n" +
+	"\x1aListActiveBookingsForPlace\x12\x19.gen.PlaceBookingsRequest\x1a\x15.gen.BookingsResponse\x12O\n" +
+	"\x10CountOverlapping\x12\x1c.gen.CountOverlappingRequest\x1a\x1d.gen.CountOverlappingResponse\x12P\n" +
+	"\x16CancelBookingsForPlace\x12\x1f.gen.CancelPlaceBookingsRequest\x1a\x15.gen.BookingsResponse\x12B\n" +
+	"\vReopenPlace\x12\x19.gen.PlaceBookingsRequest\x1a\x18.gen.ReopenPlaceResponseB8Z6github.com/h4x4d/parking_net/booking/internal/grpc/genb\x06proto3"
+
+var (
+	file_booking_proto_rawDescOnce sync.Once
+	file_booking_proto_rawDescData []byte
+)
+
+func file_booking_proto_rawDescGZIP() []byte {
+	file_booking_proto_rawDescOnce.Do(func() {
+		file_booking_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_booking_proto_rawDesc), len(file_booking_proto_rawDesc)))
+	})
+	return file_booking_proto_rawDescData
+}
+
+var file_booking_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_booking_proto_goTypes = []any{
+	(*BookingRequest)(nil),             // 0: gen.BookingRequest
+	(*BookingResponse)(nil),            // 1: gen.BookingResponse
+	(*PlaceBookingsRequest)(nil),       // 2: gen.PlaceBookingsRequest
+	(*BookingsResponse)(nil),           // 3: gen.BookingsResponse
+	(*CountOverlappingRequest)(nil),    // 4: gen.CountOverlappingRequest
+	(*CountOverlappingResponse)(nil),   // 5: gen.CountOverlappingResponse
+	(*CancelPlaceBookingsRequest)(nil), // 6: gen.CancelPlaceBookingsRequest
+	(*ReopenPlaceResponse)(nil),        // 7: gen.ReopenPlaceResponse
+}
+var file_booking_proto_depIdxs = []int32{
+	1, // 0: gen.BookingsResponse.bookings:type_name -> gen.BookingResponse
+	0, // 1: gen.Booking.GetBooking:input_type -> gen.BookingRequest
+	2, // 2: gen.Booking.ListActiveBookingsForPlace:input_type -> gen.PlaceBookingsRequest
+	4, // 3: gen.Booking.CountOverlapping:input_type -> gen.CountOverlappingRequest
+	6, // 4: gen.Booking.CancelBookingsForPlace:input_type -> gen.CancelPlaceBookingsRequest
+	2, // 5: gen.Booking.ReopenPlace:input_type -> gen.PlaceBookingsRequest
+	1, // 6: gen.Booking.GetBooking:output_type -> gen.BookingResponse
+	3, // 7: gen.Booking.ListActiveBookingsForPlace:output_type -> gen.BookingsResponse
+	5, // 8: gen.Booking.CountOverlapping:output_type -> gen.CountOverlappingResponse
+	3, // 9: gen.Booking.CancelBookingsForPlace:output_type -> gen.BookingsResponse
+	7, // 10: gen.Booking.ReopenPlace:output_type -> gen.ReopenPlaceResponse
+	6, // [6:11] is the sub-list for method output_type
+	1, // [1:6] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_booking_proto_init() }
+func file_booking_proto_init() {
+	if File_booking_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_booking_proto_rawDesc), len(file_booking_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_booking_proto_goTypes,
+		DependencyIndexes: file_booking_proto_depIdxs,
+		MessageInfos:      file_booking_proto_msgTypes,
+	}.Build()
+	File_booking_proto = out.File
+	file_booking_proto_goTypes = nil
+	file_booking_proto_depIdxs = nil
+}
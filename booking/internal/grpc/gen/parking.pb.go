@@ -66,17 +66,23 @@ func (x *ParkingPlaceRequest) GetId() int64 {
 }
 
 type ParkingPlaceResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	City          string                 `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`
-	Address       string                 `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
-	ParkingType   string                 `protobuf:"bytes,5,opt,name=parking_type,json=parkingType,proto3" json:"parking_type,omitempty"`
-	HourlyRate    int64                  `protobuf:"varint,6,opt,name=hourly_rate,json=hourlyRate,proto3" json:"hourly_rate,omitempty"`
-	Capacity      int64                  `protobuf:"varint,7,opt,name=capacity,proto3" json:"capacity,omitempty"`
-	OwnerId       string                 `protobuf:"bytes,8,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name               string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	City               string                 `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`
+	Address            string                 `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	ParkingType        string                 `protobuf:"bytes,5,opt,name=parking_type,json=parkingType,proto3" json:"parking_type,omitempty"`
+	HourlyRate         int64                  `protobuf:"varint,6,opt,name=hourly_rate,json=hourlyRate,proto3" json:"hourly_rate,omitempty"`
+	Capacity           int64                  `protobuf:"varint,7,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	OwnerId            string                 `protobuf:"bytes,8,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	CancellationPolicy *CancellationPolicy    `protobuf:"bytes,9,opt,name=cancellation_policy,json=cancellationPolicy,proto3" json:"cancellation_policy,omitempty"`
+	// status is Active, Suspended or Closing; only Active places take bookings.
+	Status string `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`
+	// allowed_vehicle_sizes restricts the vehicles the place takes; empty takes
+	// every size.
+	AllowedVehicleSizes []string `protobuf:"bytes,11,rep,name=allowed_vehicle_sizes,json=allowedVehicleSizes,proto3" json:"allowed_vehicle_sizes,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *ParkingPlaceResponse) Reset() {
@@ -165,13 +171,184 @@ func (x *ParkingPlaceResponse) GetOwnerId() string {
 	return ""
 }
 
+func (x *ParkingPlaceResponse) GetCancellationPolicy() *CancellationPolicy {
+	if x != nil {
+		return x.CancellationPolicy
+	}
+	return nil
+}
+
+func (x *ParkingPlaceResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ParkingPlaceResponse) GetAllowedVehicleSizes() []string {
+	if x != nil {
+		return x.AllowedVehicleSizes
+	}
+	return nil
+}
+
+// CancellationPolicy has version 0 when the owner has not set a policy, in which
+// case every cancellation before the start is refunded in full.
+type CancellationPolicy struct {
+	state                      protoimpl.MessageState `protogen:"open.v1"`
+	Version                    int64                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	FreeCancellationHours      int64                  `protobuf:"varint,2,opt,name=free_cancellation_hours,json=freeCancellationHours,proto3" json:"free_cancellation_hours,omitempty"`
+	LateCancellationFeePercent int64                  `protobuf:"varint,3,opt,name=late_cancellation_fee_percent,json=lateCancellationFeePercent,proto3" json:"late_cancellation_fee_percent,omitempty"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *CancellationPolicy) Reset() {
+	*x = CancellationPolicy{}
+	mi := &file_parking_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancellationPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancellationPolicy) ProtoMessage() {}
+
+func (x *CancellationPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_parking_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancellationPolicy.ProtoReflect.Descriptor instead.
+func (*CancellationPolicy) Descriptor() ([]byte, []int) {
+	return file_parking_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CancellationPolicy) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *CancellationPolicy) GetFreeCancellationHours() int64 {
+	if x != nil {
+		return x.FreeCancellationHours
+	}
+	return 0
+}
+
+func (x *CancellationPolicy) GetLateCancellationFeePercent() int64 {
+	if x != nil {
+		return x.LateCancellationFeePercent
+	}
+	return 0
+}
+
+type OwnerParkingPlacesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId       string                 `protobuf:"bytes,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OwnerParkingPlacesRequest) Reset() {
+	*x = OwnerParkingPlacesRequest{}
+	mi := &file_parking_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OwnerParkingPlacesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OwnerParkingPlacesRequest) ProtoMessage() {}
+
+func (x *OwnerParkingPlacesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_parking_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OwnerParkingPlacesRequest.ProtoReflect.Descriptor instead.
+func (*OwnerParkingPlacesRequest) Descriptor() ([]byte, []int) {
+	return file_parking_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *OwnerParkingPlacesRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+type OwnerParkingPlacesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []int64                `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OwnerParkingPlacesResponse) Reset() {
+	*x = OwnerParkingPlacesResponse{}
+	mi := &file_parking_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OwnerParkingPlacesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OwnerParkingPlacesResponse) ProtoMessage() {}
+
+func (x *OwnerParkingPlacesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_parking_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OwnerParkingPlacesResponse.ProtoReflect.Descriptor instead.
+func (*OwnerParkingPlacesResponse) Descriptor() ([]byte, []int) {
+	return file_parking_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *OwnerParkingPlacesResponse) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
 var File_parking_proto protoreflect.FileDescriptor
 
 const file_parking_proto_rawDesc = "" +
 	"\n" +
 	"\rparking.proto\x12\x03gen\"%\n" +
 	"\x13ParkingPlaceRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"\xe3\x01\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\xf9\x02\n" +
 	"\x14ParkingPlaceResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
@@ -181,9 +358,22 @@ const file_parking_proto_rawDesc = "" +
 	"\vhourly_rate\x18\x06 \x01(\x03R\n" +
 	"hourlyRate\x12\x1a\n" +
 	"\bcapacity\x18\a \x01(\x03R\bcapacity\x12\x19\n" +
-	"\bowner_id\x18\b \x01(\tR\aownerId2Q\n" +
+	"\bowner_id\x18\b \x01(\tR\aownerId\x12H\n" +
+	"\x13cancellation_policy\x18\t \x01(\v2\x17.gen.CancellationPolicyR\x12cancellationPolicy\x12\x16\n" +
+	"\x06status\x18\n" +
+	" \x01(\tR\x06status\x122\n" +
+	"\x15allowed_vehicle_sizes\x18\v \x03(\tR\x13allowedVehicleSizes\"\xa9\x01\n" +
+	"\x12CancellationPolicy\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x03R\aversion\x126\n" +
+	"\x17free_cancellation_hours\x18\x02 \x01(\x03R\x15freeCancellationHours\x12A\n" +
+	"\x1dlate_cancellation_fee_percent\x18\x03 \x01(\x03R\x1alateCancellationFeePercent\"6\n" +
+	"\x19OwnerParkingPlacesRequest\x12\x19\n" +
+	"\bowner_id\x18\x01 \x01(\tR\aownerId\".\n" +
+	"\x1aOwnerParkingPlacesResponse\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\x03R\x03ids2\xab\x01\n" +
 	"\aParking\x12F\n" +
-	"\x0fGetParkingPlace\x12\x18.gen.ParkingPlaceRequest\x1a\x19.gen.ParkingPlaceResponseB8Z6github.com/h4x4d/parking_net/parking/internal/grpc/genb\x06proto3"
+	"\x0fGetParkingPlace\x12\x18.gen.ParkingPlaceRequest\x1a\x19.gen.ParkingPlaceResponse\x12X\n" +
+	"\x15GetOwnerParkingPlaces\x12\x1e.gen.OwnerParkingPlacesRequest\x1a\x1f.gen.OwnerParkingPlacesResponseB8Z6github.com/h4x4d/parking_net/parking/internal/grpc/genb\x06proto3"
 
 var (
 	file_parking_proto_rawDescOnce sync.Once
@@ -197,19 +387,25 @@ func file_parking_proto_rawDescGZIP() []byte {
 	return file_parking_proto_rawDescData
 }
 
-var file_parking_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_parking_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_parking_proto_goTypes = []any{
-	(*ParkingPlaceRequest)(nil),  // 0: gen.ParkingPlaceRequest
-	(*ParkingPlaceResponse)(nil), // 1: gen.ParkingPlaceResponse
+	(*ParkingPlaceRequest)(nil),        // 0: gen.ParkingPlaceRequest
+	(*ParkingPlaceResponse)(nil),       // 1: gen.ParkingPlaceResponse
+	(*CancellationPolicy)(nil),         // 2: gen.CancellationPolicy
+	(*OwnerParkingPlacesRequest)(nil),  // 3: gen.OwnerParkingPlacesRequest
+	(*OwnerParkingPlacesResponse)(nil), // 4: gen.OwnerParkingPlacesResponse
 }
 var file_parking_proto_depIdxs = []int32{
-	0, // 0: gen.Parking.GetParkingPlace:input_type -> gen.ParkingPlaceRequest
-	1, // 1: gen.Parking.GetParkingPlace:output_type -> gen.ParkingPlaceResponse
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	2, // 0: gen.ParkingPlaceResponse.cancellation_policy:type_name -> gen.CancellationPolicy
+	0, // 1: gen.Parking.GetParkingPlace:input_type -> gen.ParkingPlaceRequest
+	3, // 2: gen.Parking.GetOwnerParkingPlaces:input_type -> gen.OwnerParkingPlacesRequest
+	1, // 3: gen.Parking.GetParkingPlace:output_type -> gen.ParkingPlaceResponse
+	4, // 4: gen.Parking.GetOwnerParkingPlaces:output_type -> gen.OwnerParkingPlacesResponse
+	3, // [3:5] is the sub-list for method output_type
+	1, // [1:3] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_parking_proto_init() }
@@ -223,7 +419,7 @@ func file_parking_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_parking_proto_rawDesc), len(file_parking_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
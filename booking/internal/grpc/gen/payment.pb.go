@@ -95,6 +95,7 @@ type RefundRequest struct {
 	DriverId      string                 `protobuf:"bytes,2,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
 	OwnerId       string                 `protobuf:"bytes,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
 	Amount        int64                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -157,6 +158,203 @@ func (x *RefundRequest) GetAmount() int64 {
 	return 0
 }
 
+func (x *RefundRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type AdjustChargeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     int64                  `protobuf:"varint,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	DriverId      string                 `protobuf:"bytes,2,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Delta         int64                  `protobuf:"varint,4,opt,name=delta,proto3" json:"delta,omitempty"`
+	AdjustmentId  int64                  `protobuf:"varint,5,opt,name=adjustment_id,json=adjustmentId,proto3" json:"adjustment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdjustChargeRequest) Reset() {
+	*x = AdjustChargeRequest{}
+	mi := &file_payment_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjustChargeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustChargeRequest) ProtoMessage() {}
+
+func (x *AdjustChargeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustChargeRequest.ProtoReflect.Descriptor instead.
+func (*AdjustChargeRequest) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AdjustChargeRequest) GetBookingId() int64 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+func (x *AdjustChargeRequest) GetDriverId() string {
+	if x != nil {
+		return x.DriverId
+	}
+	return ""
+}
+
+func (x *AdjustChargeRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *AdjustChargeRequest) GetDelta() int64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *AdjustChargeRequest) GetAdjustmentId() int64 {
+	if x != nil {
+		return x.AdjustmentId
+	}
+	return 0
+}
+
+// SeriesTransactionRequest charges several bookings of a driver in a single
+// payment: either every booking is charged or none.
+type SeriesTransactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DriverId      string                 `protobuf:"bytes,1,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Charges       []*BookingAmount       `protobuf:"bytes,3,rep,name=charges,proto3" json:"charges,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeriesTransactionRequest) Reset() {
+	*x = SeriesTransactionRequest{}
+	mi := &file_payment_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeriesTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeriesTransactionRequest) ProtoMessage() {}
+
+func (x *SeriesTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeriesTransactionRequest.ProtoReflect.Descriptor instead.
+func (*SeriesTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SeriesTransactionRequest) GetDriverId() string {
+	if x != nil {
+		return x.DriverId
+	}
+	return ""
+}
+
+func (x *SeriesTransactionRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *SeriesTransactionRequest) GetCharges() []*BookingAmount {
+	if x != nil {
+		return x.Charges
+	}
+	return nil
+}
+
+type BookingAmount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     int64                  `protobuf:"varint,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Amount        int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookingAmount) Reset() {
+	*x = BookingAmount{}
+	mi := &file_payment_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingAmount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingAmount) ProtoMessage() {}
+
+func (x *BookingAmount) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingAmount.ProtoReflect.Descriptor instead.
+func (*BookingAmount) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BookingAmount) GetBookingId() int64 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+func (x *BookingAmount) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
 type TransactionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TransactionId int64                  `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
@@ -168,7 +366,7 @@ type TransactionResponse struct {
 
 func (x *TransactionResponse) Reset() {
 	*x = TransactionResponse{}
-	mi := &file_payment_proto_msgTypes[2]
+	mi := &file_payment_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -180,7 +378,7 @@ func (x *TransactionResponse) String() string {
 func (*TransactionResponse) ProtoMessage() {}
 
 func (x *TransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[2]
+	mi := &file_payment_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -193,7 +391,7 @@ func (x *TransactionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransactionResponse.ProtoReflect.Descriptor instead.
 func (*TransactionResponse) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{2}
+	return file_payment_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *TransactionResponse) GetTransactionId() int64 {
@@ -217,6 +415,110 @@ func (x *TransactionResponse) GetMessage() string {
 	return ""
 }
 
+type BookingChargeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     int64                  `protobuf:"varint,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookingChargeRequest) Reset() {
+	*x = BookingChargeRequest{}
+	mi := &file_payment_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingChargeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingChargeRequest) ProtoMessage() {}
+
+func (x *BookingChargeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingChargeRequest.ProtoReflect.Descriptor instead.
+func (*BookingChargeRequest) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BookingChargeRequest) GetBookingId() int64 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+type BookingChargeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Charged       bool                   `protobuf:"varint,1,opt,name=charged,proto3" json:"charged,omitempty"`
+	TransactionId int64                  `protobuf:"varint,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Amount        int64                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookingChargeResponse) Reset() {
+	*x = BookingChargeResponse{}
+	mi := &file_payment_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingChargeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingChargeResponse) ProtoMessage() {}
+
+func (x *BookingChargeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingChargeResponse.ProtoReflect.Descriptor instead.
+func (*BookingChargeResponse) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BookingChargeResponse) GetCharged() bool {
+	if x != nil {
+		return x.Charged
+	}
+	return false
+}
+
+func (x *BookingChargeResponse) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *BookingChargeResponse) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
 var File_payment_proto protoreflect.FileDescriptor
 
 const file_payment_proto_rawDesc = "" +
@@ -227,20 +529,46 @@ const file_payment_proto_rawDesc = "" +
 	"booking_id\x18\x01 \x01(\x03R\tbookingId\x12\x1b\n" +
 	"\tdriver_id\x18\x02 \x01(\tR\bdriverId\x12\x19\n" +
 	"\bowner_id\x18\x03 \x01(\tR\aownerId\x12\x16\n" +
-	"\x06amount\x18\x04 \x01(\x03R\x06amount\"~\n" +
+	"\x06amount\x18\x04 \x01(\x03R\x06amount\"\x96\x01\n" +
 	"\rRefundRequest\x12\x1d\n" +
 	"\n" +
 	"booking_id\x18\x01 \x01(\x03R\tbookingId\x12\x1b\n" +
 	"\tdriver_id\x18\x02 \x01(\tR\bdriverId\x12\x19\n" +
 	"\bowner_id\x18\x03 \x01(\tR\aownerId\x12\x16\n" +
-	"\x06amount\x18\x04 \x01(\x03R\x06amount\"n\n" +
+	"\x06amount\x18\x04 \x01(\x03R\x06amount\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\"\xa7\x01\n" +
+	"\x13AdjustChargeRequest\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\x03R\tbookingId\x12\x1b\n" +
+	"\tdriver_id\x18\x02 \x01(\tR\bdriverId\x12\x19\n" +
+	"\bowner_id\x18\x03 \x01(\tR\aownerId\x12\x14\n" +
+	"\x05delta\x18\x04 \x01(\x03R\x05delta\x12#\n" +
+	"\radjustment_id\x18\x05 \x01(\x03R\fadjustmentId\"\x80\x01\n" +
+	"\x18SeriesTransactionRequest\x12\x1b\n" +
+	"\tdriver_id\x18\x01 \x01(\tR\bdriverId\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\x12,\n" +
+	"\acharges\x18\x03 \x03(\v2\x12.gen.BookingAmountR\acharges\"F\n" +
+	"\rBookingAmount\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\x03R\tbookingId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x03R\x06amount\"n\n" +
 	"\x13TransactionResponse\x12%\n" +
 	"\x0etransaction_id\x18\x01 \x01(\x03R\rtransactionId\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\tR\x06status\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage2\x91\x01\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"5\n" +
+	"\x14BookingChargeRequest\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\x03R\tbookingId\"p\n" +
+	"\x15BookingChargeResponse\x12\x18\n" +
+	"\acharged\x18\x01 \x01(\bR\acharged\x12%\n" +
+	"\x0etransaction_id\x18\x02 \x01(\x03R\rtransactionId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x03R\x06amount2\xf5\x02\n" +
 	"\aPayment\x12G\n" +
 	"\x12ProcessTransaction\x12\x17.gen.TransactionRequest\x1a\x18.gen.TransactionResponse\x12=\n" +
-	"\rProcessRefund\x12\x12.gen.RefundRequest\x1a\x18.gen.TransactionResponseB8Z6github.com/h4x4d/parking_net/payment/internal/grpc/genb\x06proto3"
+	"\rProcessRefund\x12\x12.gen.RefundRequest\x1a\x18.gen.TransactionResponse\x12I\n" +
+	"\x10GetBookingCharge\x12\x19.gen.BookingChargeRequest\x1a\x1a.gen.BookingChargeResponse\x12B\n" +
+	"\fAdjustCharge\x12\x18.gen.AdjustChargeRequest\x1a\x18.gen.TransactionResponse\x12S\n" +
+	"\x18ProcessSeriesTransaction\x12\x1d.gen.SeriesTransactionRequest\x1a\x18.gen.TransactionResponseB8Z6github.com/h4x4d/parking_net/payment/internal/grpc/genb\x06proto3"
 
 var (
 	file_payment_proto_rawDescOnce sync.Once
@@ -254,22 +582,34 @@ func file_payment_proto_rawDescGZIP() []byte {
 	return file_payment_proto_rawDescData
 }
 
-var file_payment_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_payment_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_payment_proto_goTypes = []any{
-	(*TransactionRequest)(nil),  // 0: gen.TransactionRequest
-	(*RefundRequest)(nil),       // 1: gen.RefundRequest
-	(*TransactionResponse)(nil), // 2: gen.TransactionResponse
+	(*TransactionRequest)(nil),       // 0: gen.TransactionRequest
+	(*RefundRequest)(nil),            // 1: gen.RefundRequest
+	(*AdjustChargeRequest)(nil),      // 2: gen.AdjustChargeRequest
+	(*SeriesTransactionRequest)(nil), // 3: gen.SeriesTransactionRequest
+	(*BookingAmount)(nil),            // 4: gen.BookingAmount
+	(*TransactionResponse)(nil),      // 5: gen.TransactionResponse
+	(*BookingChargeRequest)(nil),     // 6: gen.BookingChargeRequest
+	(*BookingChargeResponse)(nil),    // 7: gen.BookingChargeResponse
 }
 var file_payment_proto_depIdxs = []int32{
-	0, // 0: gen.Payment.ProcessTransaction:input_type -> gen.TransactionRequest
-	1, // 1: gen.Payment.ProcessRefund:input_type -> gen.RefundRequest
-	2, // 2: gen.Payment.ProcessTransaction:output_type -> gen.TransactionResponse
-	2, // 3: gen.Payment.ProcessRefund:output_type -> gen.TransactionResponse
-	2, // [2:4] is the sub-list for method output_type
-	0, // [0:2] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	4, // 0: gen.SeriesTransactionRequest.charges:type_name -> gen.BookingAmount
+	0, // 1: gen.Payment.ProcessTransaction:input_type -> gen.TransactionRequest
+	1, // 2: gen.Payment.ProcessRefund:input_type -> gen.RefundRequest
+	6, // 3: gen.Payment.GetBookingCharge:input_type -> gen.BookingChargeRequest
+	2, // 4: gen.Payment.AdjustCharge:input_type -> gen.AdjustChargeRequest
+	3, // 5: gen.Payment.ProcessSeriesTransaction:input_type -> gen.SeriesTransactionRequest
+	5, // 6: gen.Payment.ProcessTransaction:output_type -> gen.TransactionResponse
+	5, // 7: gen.Payment.ProcessRefund:output_type -> gen.TransactionResponse
+	7, // 8: gen.Payment.GetBookingCharge:output_type -> gen.BookingChargeResponse
+	5, // 9: gen.Payment.AdjustCharge:output_type -> gen.TransactionResponse
+	5, // 10: gen.Payment.ProcessSeriesTransaction:output_type -> gen.TransactionResponse
+	6, // [6:11] is the sub-list for method output_type
+	1, // [1:6] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_payment_proto_init() }
@@ -283,7 +623,7 @@ func file_payment_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_payment_proto_rawDesc), len(file_payment_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,8 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Payment_ProcessTransaction_FullMethodName = "/gen.Payment/ProcessTransaction"
-	Payment_ProcessRefund_FullMethodName      = "/gen.Payment/ProcessRefund"
+	Payment_ProcessTransaction_FullMethodName       = "/gen.Payment/ProcessTransaction"
+	Payment_ProcessRefund_FullMethodName            = "/gen.Payment/ProcessRefund"
+	Payment_GetBookingCharge_FullMethodName         = "/gen.Payment/GetBookingCharge"
+	Payment_AdjustCharge_FullMethodName             = "/gen.Payment/AdjustCharge"
+	Payment_ProcessSeriesTransaction_FullMethodName = "/gen.Payment/ProcessSeriesTransaction"
 )
 
 // PaymentClient is the client API for Payment service.
@@ -29,6 +32,9 @@ const (
 type PaymentClient interface {
 	ProcessTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*TransactionResponse, error)
 	ProcessRefund(ctx context.Context, in *RefundRequest, opts ...grpc.CallOption) (*TransactionResponse, error)
+	GetBookingCharge(ctx context.Context, in *BookingChargeRequest, opts ...grpc.CallOption) (*BookingChargeResponse, error)
+	AdjustCharge(ctx context.Context, in *AdjustChargeRequest, opts ...grpc.CallOption) (*TransactionResponse, error)
+	ProcessSeriesTransaction(ctx context.Context, in *SeriesTransactionRequest, opts ...grpc.CallOption) (*TransactionResponse, error)
 }
 
 type paymentClient struct {
@@ -59,12 +65,45 @@ func (c *paymentClient) ProcessRefund(ctx context.Context, in *RefundRequest, op
 	return out, nil
 }
 
+func (c *paymentClient) GetBookingCharge(ctx context.Context, in *BookingChargeRequest, opts ...grpc.CallOption) (*BookingChargeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BookingChargeResponse)
+	err := c.cc.Invoke(ctx, Payment_GetBookingCharge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentClient) AdjustCharge(ctx context.Context, in *AdjustChargeRequest, opts ...grpc.CallOption) (*TransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransactionResponse)
+	err := c.cc.Invoke(ctx, Payment_AdjustCharge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentClient) ProcessSeriesTransaction(ctx context.Context, in *SeriesTransactionRequest, opts ...grpc.CallOption) (*TransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransactionResponse)
+	err := c.cc.Invoke(ctx, Payment_ProcessSeriesTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PaymentServer is the server API for Payment service.
 // All implementations must embed UnimplementedPaymentServer
 // for forward compatibility.
 type PaymentServer interface {
 	ProcessTransaction(context.Context, *TransactionRequest) (*TransactionResponse, error)
 	ProcessRefund(context.Context, *RefundRequest) (*TransactionResponse, error)
+	GetBookingCharge(context.Context, *BookingChargeRequest) (*BookingChargeResponse, error)
+	AdjustCharge(context.Context, *AdjustChargeRequest) (*TransactionResponse, error)
+	ProcessSeriesTransaction(context.Context, *SeriesTransactionRequest) (*TransactionResponse, error)
 	mustEmbedUnimplementedPaymentServer()
 }
 
@@ -81,6 +120,15 @@ func (UnimplementedPaymentServer) ProcessTransaction(context.Context, *Transacti
 func (UnimplementedPaymentServer) ProcessRefund(context.Context, *RefundRequest) (*TransactionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProcessRefund not implemented")
 }
+func (UnimplementedPaymentServer) GetBookingCharge(context.Context, *BookingChargeRequest) (*BookingChargeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBookingCharge not implemented")
+}
+func (UnimplementedPaymentServer) AdjustCharge(context.Context, *AdjustChargeRequest) (*TransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdjustCharge not implemented")
+}
+func (UnimplementedPaymentServer) ProcessSeriesTransaction(context.Context, *SeriesTransactionRequest) (*TransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessSeriesTransaction not implemented")
+}
 func (UnimplementedPaymentServer) mustEmbedUnimplementedPaymentServer() {}
 func (UnimplementedPaymentServer) testEmbeddedByValue()                 {}
 
@@ -138,6 +186,60 @@ func _Payment_ProcessRefund_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Payment_GetBookingCharge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BookingChargeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServer).GetBookingCharge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payment_GetBookingCharge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServer).GetBookingCharge(ctx, req.(*BookingChargeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Payment_AdjustCharge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdjustChargeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServer).AdjustCharge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payment_AdjustCharge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServer).AdjustCharge(ctx, req.(*AdjustChargeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Payment_ProcessSeriesTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SeriesTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServer).ProcessSeriesTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payment_ProcessSeriesTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServer).ProcessSeriesTransaction(ctx, req.(*SeriesTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Payment_ServiceDesc is the grpc.ServiceDesc for Payment service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -153,6 +255,18 @@ var Payment_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ProcessRefund",
 			Handler:    _Payment_ProcessRefund_Handler,
 		},
+		{
+			MethodName: "GetBookingCharge",
+			Handler:    _Payment_GetBookingCharge_Handler,
+		},
+		{
+			MethodName: "AdjustCharge",
+			Handler:    _Payment_AdjustCharge_Handler,
+		},
+		{
+			MethodName: "ProcessSeriesTransaction",
+			Handler:    _Payment_ProcessSeriesTransaction_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "payment.proto",
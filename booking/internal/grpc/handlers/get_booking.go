@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/h4x4d/parking_net/booking/internal/grpc/gen"
+	"go.opentelemetry.io/otel"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (serverApi *GRPCServer) GetBooking(ctx context.Context, in *gen.BookingRequest) (*gen.BookingResponse, error) {
+	if err := serverApi.validateInternalRequest(ctx); err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "authentication failed")
+	}
+
+	if in.Id <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid booking ID")
+	}
+
+	ctx, err := traceContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ctx, span := otel.Tracer("Booking").Start(ctx, "get booking")
+	defer span.End()
+
+	booking, err := serverApi.Database.GetByID(ctx, in.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get booking")
+	}
+	if booking == nil {
+		return nil, status.Errorf(codes.NotFound, "booking not found")
+	}
+	return toResponse(booking), nil
+}
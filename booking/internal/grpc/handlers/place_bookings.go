@@ -0,0 +1,129 @@
+package handlers
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/h4x4d/parking_net/booking/internal/audit"
+	"github.com/h4x4d/parking_net/booking/internal/grpc/gen"
+	"github.com/h4x4d/parking_net/pkg/domain"
+	"go.opentelemetry.io/otel"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (serverApi *GRPCServer) ListActiveBookingsForPlace(
+	ctx context.Context, in *gen.PlaceBookingsRequest) (*gen.BookingsResponse, error) {
+
+	if err := serverApi.validateInternalRequest(ctx); err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "authentication failed")
+	}
+
+	if in.ParkingPlaceId <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parking place ID")
+	}
+
+	ctx, err := traceContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ctx, span := otel.Tracer("Booking").Start(ctx, "list active bookings for place")
+	defer span.End()
+
+	bookings, err := serverApi.Database.ListActiveForPlace(ctx, in.ParkingPlaceId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get bookings")
+	}
+	return toBookingsResponse(bookings), nil
+}
+
+func (serverApi *GRPCServer) CountOverlapping(
+	ctx context.Context, in *gen.CountOverlappingRequest) (*gen.CountOverlappingResponse, error) {
+
+	if err := serverApi.validateInternalRequest(ctx); err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "authentication failed")
+	}
+
+	if in.ParkingPlaceId <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parking place ID")
+	}
+	if in.DateTo != 0 && in.DateTo <= in.DateFrom {
+		return nil, status.Errorf(codes.InvalidArgument, "date_to must be after date_from")
+	}
+
+	ctx, err := traceContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ctx, span := otel.Tracer("Booking").Start(ctx, "count overlapping bookings")
+	defer span.End()
+
+	var dateTo *time.Time
+	if in.DateTo != 0 {
+		to := time.Unix(in.DateTo, 0).UTC()
+		dateTo = &to
+	}
+	occupancy, err := serverApi.Database.CountOverlapping(ctx, in.ParkingPlaceId, time.Unix(in.DateFrom, 0).UTC(), dateTo)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count bookings")
+	}
+	return &gen.CountOverlappingResponse{Count: occupancy.Count, Peak: occupancy.Peak}, nil
+}
+
+func (serverApi *GRPCServer) CancelBookingsForPlace(
+	ctx context.Context, in *gen.CancelPlaceBookingsRequest) (*gen.BookingsResponse, error) {
+
+	if err := serverApi.validateInternalRequest(ctx); err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "authentication failed")
+	}
+
+	if in.ParkingPlaceId <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parking place ID")
+	}
+
+	ctx, err := traceContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ctx, span := otel.Tracer("Booking").Start(ctx, "cancel bookings for place")
+	defer span.End()
+	// The parking service cancels on behalf of the owner or admin who deleted
+	// or suspended the place; it does not pass them on.
+	ctx = audit.WithActor(ctx, domain.SystemActor("parking"))
+
+	bookings, err := serverApi.Database.CancelForPlace(ctx, in.ParkingPlaceId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to cancel bookings")
+	}
+	slog.Info("bookings canceled for parking place",
+		slog.Int64("parking_place_id", in.ParkingPlaceId),
+		slog.Int("count", len(bookings)),
+		slog.String("reason", in.Reason),
+	)
+	return toBookingsResponse(bookings), nil
+}
+
+func (serverApi *GRPCServer) ReopenPlace(
+	ctx context.Context, in *gen.PlaceBookingsRequest) (*gen.ReopenPlaceResponse, error) {
+
+	if err := serverApi.validateInternalRequest(ctx); err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "authentication failed")
+	}
+
+	if in.ParkingPlaceId <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parking place ID")
+	}
+
+	ctx, err := traceContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ctx, span := otel.Tracer("Booking").Start(ctx, "reopen place")
+	defer span.End()
+
+	if err := serverApi.Database.ReopenForPlace(ctx, in.ParkingPlaceId); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to reopen parking place")
+	}
+	return &gen.ReopenPlaceResponse{}, nil
+}
@@ -0,0 +1,111 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/h4x4d/parking_net/booking/internal/database_service"
+	"github.com/h4x4d/parking_net/booking/internal/grpc/gen"
+	"github.com/h4x4d/parking_net/booking/internal/models"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type GRPCServer struct {
+	Database *database_service.DatabaseService
+	gen.UnimplementedBookingServer
+}
+
+func NewGRPCServer() (*GRPCServer, error) {
+	db, err := database_service.NewDatabaseService(fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=disable",
+		os.Getenv("POSTGRES_USER"), os.Getenv("POSTGRES_PASSWORD"), "db", os.Getenv("POSTGRES_PORT"),
+		os.Getenv("BOOKING_DB_NAME")))
+	if err != nil {
+		return nil, err
+	}
+	return &GRPCServer{Database: db}, nil
+}
+
+func Register(gRPCServer *grpc.Server) {
+	server, err := NewGRPCServer()
+	if err != nil {
+		log.Printf("failed to create booking gRPC server: %v", err)
+		os.Exit(1)
+	}
+	gen.RegisterBookingServer(gRPCServer, server)
+}
+
+// traceContext continues the trace of the calling service when it sent one.
+// The context of the call is kept either way, so its deadline and
+// cancellation reach the database.
+func traceContext(ctx context.Context) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if len(md.Get("x-trace-id")) == 0 {
+		return ctx, nil
+	}
+	traceId, err := trace.TraceIDFromHex(md.Get("x-trace-id")[0])
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid trace ID")
+	}
+	spanContext := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: traceId,
+	})
+	return trace.ContextWithSpanContext(ctx, spanContext), nil
+}
+
+func (s *GRPCServer) validateInternalRequest(ctx context.Context) error {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return fmt.Errorf("no metadata provided")
+	}
+
+	internalToken := os.Getenv("INTERNAL_SERVICE_TOKEN")
+	if internalToken == "" {
+		return nil
+	}
+
+	authHeaders := md.Get("authorization")
+	if len(authHeaders) == 0 {
+		return fmt.Errorf("no authorization header")
+	}
+
+	authHeader := strings.TrimSpace(authHeaders[0])
+	if !strings.HasPrefix(authHeader, "Bearer ") {
+		return fmt.Errorf("invalid authorization format")
+	}
+
+	token := strings.TrimPrefix(authHeader, "Bearer ")
+	if token != internalToken {
+		return fmt.Errorf("invalid token")
+	}
+
+	return nil
+}
+
+func toResponse(booking *models.Booking) *gen.BookingResponse {
+	return &gen.BookingResponse{
+		Id:             booking.BookingID,
+		ParkingPlaceId: *booking.ParkingPlaceID,
+		UserId:         booking.UserID,
+		DateFrom:       time.Time(*booking.DateFrom).Unix(),
+		DateTo:         time.Time(*booking.DateTo).Unix(),
+		FullCost:       booking.FullCost,
+		Status:         booking.Status,
+		SeriesId:       booking.SeriesID,
+	}
+}
+
+func toBookingsResponse(bookings []*models.Booking) *gen.BookingsResponse {
+	response := &gen.BookingsResponse{Bookings: make([]*gen.BookingResponse, 0, len(bookings))}
+	for _, booking := range bookings {
+		response.Bookings = append(response.Bookings, toResponse(booking))
+	}
+	return response
+}
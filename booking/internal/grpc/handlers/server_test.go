@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestTraceContextKeepsCallerContext(t *testing.T) {
+	const traceID = "0102030405060708090a0b0c0d0e0f10"
+	tests := []struct {
+		name     string
+		metadata metadata.MD
+		traceID  string
+	}{
+		{name: "without trace", metadata: metadata.MD{}},
+		{name: "with trace", metadata: metadata.Pairs("x-trace-id", traceID), traceID: traceID},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deadline := time.Now().Add(time.Minute)
+			caller, cancel := context.WithDeadline(metadata.NewIncomingContext(context.Background(), tt.metadata),
+				deadline)
+			ctx, err := traceContext(caller)
+			if err != nil {
+				t.Fatalf("traceContext: %v", err)
+			}
+			if got, ok := ctx.Deadline(); !ok || !got.Equal(deadline) {
+				t.Errorf("deadline = %v, %v; want %v", got, ok, deadline)
+			}
+			cancel()
+			if ctx.Err() == nil {
+				t.Error("canceling the call did not cancel the context")
+			}
+			if tt.traceID != "" {
+				if got := trace.SpanContextFromContext(ctx).TraceID().String(); got != tt.traceID {
+					t.Errorf("trace ID = %s, want %s", got, tt.traceID)
+				}
+			}
+		})
+	}
+}
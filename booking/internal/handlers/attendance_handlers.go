@@ -0,0 +1,193 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/h4x4d/parking_net/booking/internal/models"
+	"github.com/h4x4d/parking_net/booking/internal/restapi/operations/driver"
+	"github.com/h4x4d/parking_net/booking/internal/restapi/operations/owner"
+	"github.com/h4x4d/parking_net/booking/internal/service"
+	"github.com/h4x4d/parking_net/booking/internal/utils"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/metadata"
+)
+
+type AttendanceHandler struct {
+	service *service.AttendanceService
+	tracer  trace.Tracer
+}
+
+// NewAttendanceHandler uses the global "Booking" tracer, like NewBookingHandler.
+func NewAttendanceHandler(svc *service.AttendanceService) *AttendanceHandler {
+	return &AttendanceHandler{
+		service: svc,
+		tracer:  otel.Tracer("Booking"),
+	}
+}
+
+func (h *AttendanceHandler) CheckInBooking(params driver.CheckInBookingParams, user *models.User) (responder middleware.Responder) {
+	defer utils.CatchPanic(&responder)
+
+	ctx, span := h.tracer.Start(context.Background(), "check in booking")
+	defer span.End()
+	traceId := fmt.Sprintf("%s", span.SpanContext().TraceID())
+	ctx = metadata.AppendToOutgoingContext(ctx, "x-trace-id", traceId)
+
+	booking, appErr := h.service.CheckIn(ctx, params.BookingID, ToDomainUser(user))
+	if appErr != nil {
+		logFailure("failed check in booking", "POST", traceId, user, appErr.Code, appErr.Error(),
+			slog.Int64("booking-id", params.BookingID))
+		payload := errorPayload(appErr)
+		switch appErr.Code {
+		case driver.CheckInBookingBadRequestCode:
+			return &driver.CheckInBookingBadRequest{Payload: payload}
+		case driver.CheckInBookingForbiddenCode:
+			return &driver.CheckInBookingForbidden{Payload: payload}
+		case driver.CheckInBookingNotFoundCode:
+			return &driver.CheckInBookingNotFound{Payload: payload}
+		}
+		return errorResponder(appErr)
+	}
+
+	slog.Info(
+		"check in booking",
+		slog.String("method", "POST"),
+		slog.String("trace_id", traceId),
+		userProperties(user),
+		slog.Group("booking-properties",
+			slog.Int64("booking-id", booking.ID),
+			slog.Int64("parking-place-id", booking.ParkingPlaceID),
+		),
+		slog.Int("status_code", driver.CheckInBookingOKCode),
+	)
+
+	result := new(driver.CheckInBookingOK)
+	result.SetPayload(ToAPIBooking(booking))
+	return result
+}
+
+func (h *AttendanceHandler) CheckOutBooking(params driver.CheckOutBookingParams, user *models.User) (responder middleware.Responder) {
+	defer utils.CatchPanic(&responder)
+
+	ctx, span := h.tracer.Start(context.Background(), "check out booking")
+	defer span.End()
+	traceId := fmt.Sprintf("%s", span.SpanContext().TraceID())
+	ctx = metadata.AppendToOutgoingContext(ctx, "x-trace-id", traceId)
+
+	booking, appErr := h.service.CheckOut(ctx, params.BookingID, ToDomainUser(user))
+	if appErr != nil {
+		logFailure("failed check out booking", "POST", traceId, user, appErr.Code, appErr.Error(),
+			slog.Int64("booking-id", params.BookingID))
+		payload := errorPayload(appErr)
+		switch appErr.Code {
+		case driver.CheckOutBookingBadRequestCode:
+			return &driver.CheckOutBookingBadRequest{Payload: payload}
+		case driver.CheckOutBookingForbiddenCode:
+			return &driver.CheckOutBookingForbidden{Payload: payload}
+		case driver.CheckOutBookingNotFoundCode:
+			return &driver.CheckOutBookingNotFound{Payload: payload}
+		}
+		return errorResponder(appErr)
+	}
+
+	slog.Info(
+		"check out booking",
+		slog.String("method", "POST"),
+		slog.String("trace_id", traceId),
+		userProperties(user),
+		slog.Group("booking-properties",
+			slog.Int64("booking-id", booking.ID),
+			slog.Int64("parking-place-id", booking.ParkingPlaceID),
+			slog.Int64("full-cost", booking.FullCost),
+		),
+		slog.Int("status_code", driver.CheckOutBookingOKCode),
+	)
+
+	result := new(driver.CheckOutBookingOK)
+	result.SetPayload(ToAPIBooking(booking))
+	return result
+}
+
+func (h *AttendanceHandler) GetEntryPass(params driver.GetEntryPassParams, user *models.User) (responder middleware.Responder) {
+	defer utils.CatchPanic(&responder)
+
+	ctx, span := h.tracer.Start(context.Background(), "get entry pass")
+	defer span.End()
+	traceId := fmt.Sprintf("%s", span.SpanContext().TraceID())
+	ctx = metadata.AppendToOutgoingContext(ctx, "x-trace-id", traceId)
+
+	entryPass, appErr := h.service.GetEntryPass(ctx, params.BookingID, ToDomainUser(user))
+	if appErr != nil {
+		logFailure("failed get entry pass", "GET", traceId, user, appErr.Code, appErr.Error(),
+			slog.Int64("booking-id", params.BookingID))
+		payload := errorPayload(appErr)
+		switch appErr.Code {
+		case driver.GetEntryPassBadRequestCode:
+			return &driver.GetEntryPassBadRequest{Payload: payload}
+		case driver.GetEntryPassForbiddenCode:
+			return &driver.GetEntryPassForbidden{Payload: payload}
+		case driver.GetEntryPassNotFoundCode:
+			return &driver.GetEntryPassNotFound{Payload: payload}
+		}
+		return errorResponder(appErr)
+	}
+
+	slog.Info(
+		"get entry pass",
+		slog.String("method", "GET"),
+		slog.String("trace_id", traceId),
+		userProperties(user),
+		slog.Group("booking-properties",
+			slog.Int64("booking-id", entryPass.BookingID),
+			slog.Int64("parking-place-id", entryPass.ParkingPlaceID),
+		),
+		slog.Int("status_code", driver.GetEntryPassOKCode),
+	)
+
+	result := new(driver.GetEntryPassOK)
+	result.SetPayload(ToAPIEntryPass(entryPass))
+	return result
+}
+
+func (h *AttendanceHandler) VerifyEntryPass(params owner.VerifyEntryPassParams, user *models.User) (responder middleware.Responder) {
+	defer utils.CatchPanic(&responder)
+
+	ctx, span := h.tracer.Start(context.Background(), "verify entry pass")
+	defer span.End()
+	traceId := fmt.Sprintf("%s", span.SpanContext().TraceID())
+	ctx = metadata.AppendToOutgoingContext(ctx, "x-trace-id", traceId)
+
+	placeID := *params.Object.ParkingPlaceID
+	verification, appErr := h.service.VerifyEntryPass(ctx, *params.Object.Token, placeID, params.Object.CheckIn,
+		ToDomainUser(user))
+	if appErr != nil {
+		logFailure("failed verify entry pass", "POST", traceId, user, appErr.Code, appErr.Error(),
+			slog.Int64("parking-place-id", placeID))
+		if appErr.Code == owner.VerifyEntryPassForbiddenCode {
+			return &owner.VerifyEntryPassForbidden{Payload: errorPayload(appErr)}
+		}
+		return errorResponder(appErr)
+	}
+
+	slog.Info(
+		"verify entry pass",
+		slog.String("method", "POST"),
+		slog.String("trace_id", traceId),
+		userProperties(user),
+		slog.Group("booking-properties",
+			slog.Int64("parking-place-id", placeID),
+		),
+		slog.Bool("valid", verification.Valid),
+		slog.Bool("checked-in", verification.CheckedIn),
+		slog.String("reason", verification.Reason),
+		slog.Int("status_code", owner.VerifyEntryPassOKCode),
+	)
+
+	result := new(owner.VerifyEntryPassOK)
+	result.SetPayload(ToAPIPassVerification(verification))
+	return result
+}
@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/h4x4d/parking_net/booking/internal/models"
+	"github.com/h4x4d/parking_net/booking/internal/restapi/operations/driver"
+	"github.com/h4x4d/parking_net/booking/internal/service"
+	"github.com/h4x4d/parking_net/booking/internal/utils"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/metadata"
+)
+
+var granularitySteps = map[string]time.Duration{
+	models.AvailabilityGranularityHour: time.Hour,
+	models.AvailabilityGranularityDay:  24 * time.Hour,
+}
+
+type AvailabilityHandler struct {
+	service *service.AvailabilityService
+	tracer  trace.Tracer
+}
+
+// NewAvailabilityHandler uses the global "Booking" tracer, like NewBookingHandler.
+func NewAvailabilityHandler(svc *service.AvailabilityService) *AvailabilityHandler {
+	return &AvailabilityHandler{
+		service: svc,
+		tracer:  otel.Tracer("Booking"),
+	}
+}
+
+func (h *AvailabilityHandler) CreateBookingHold(params driver.CreateBookingHoldParams, user *models.User) (responder middleware.Responder) {
+	defer utils.CatchPanic(&responder)
+
+	ctx, span := h.tracer.Start(context.Background(), "create booking hold")
+	defer span.End()
+	traceId := fmt.Sprintf("%s", span.SpanContext().TraceID())
+	ctx = metadata.AppendToOutgoingContext(ctx, "x-trace-id", traceId)
+
+	hold, appErr := h.service.CreateHold(ctx, ToDomainHold(params.Object), ToDomainUser(user))
+	if appErr != nil {
+		logFailure("failed create booking hold", "POST", traceId, user, appErr.Code, appErr.Error(),
+			slog.Any("parking-place-id", params.Object.ParkingPlaceID))
+		payload := errorPayload(appErr)
+		switch appErr.Code {
+		case driver.CreateBookingHoldBadRequestCode:
+			return &driver.CreateBookingHoldBadRequest{Payload: payload}
+		case driver.CreateBookingHoldForbiddenCode:
+			return &driver.CreateBookingHoldForbidden{Payload: payload}
+		case driver.CreateBookingHoldConflictCode:
+			return &driver.CreateBookingHoldConflict{Payload: payload}
+		}
+		return errorResponder(appErr)
+	}
+
+	slog.Info(
+		"create booking hold",
+		slog.String("method", "POST"),
+		slog.String("trace_id", traceId),
+		userProperties(user),
+		slog.Group("booking-properties",
+			slog.Int64("hold-id", hold.ID),
+			slog.Int64("parking-place-id", hold.ParkingPlaceID),
+			slog.String("expires-at", hold.ExpiresAt.String()),
+		),
+		slog.Int("status_code", driver.CreateBookingHoldOKCode),
+	)
+
+	result := new(driver.CreateBookingHoldOK)
+	result.SetPayload(ToAPIHold(hold))
+	return result
+}
+
+func (h *AvailabilityHandler) GetAvailability(params driver.GetAvailabilityParams, user *models.User) (responder middleware.Responder) {
+	defer utils.CatchPanic(&responder)
+
+	ctx, span := h.tracer.Start(context.Background(), "get availability")
+	defer span.End()
+	traceId := fmt.Sprintf("%s", span.SpanContext().TraceID())
+	ctx = metadata.AppendToOutgoingContext(ctx, "x-trace-id", traceId)
+
+	bookingProperties := []any{
+		slog.Int64("parking-place-id", params.ParkingPlaceID),
+		slog.String("date-from", params.From.String()),
+		slog.String("date-to", params.To.String()),
+	}
+
+	granularity := *params.Granularity
+	availability, appErr := h.service.GetAvailability(ctx, params.ParkingPlaceID, time.Time(params.From),
+		time.Time(params.To), granularitySteps[granularity], ToDomainUser(user))
+	if appErr != nil {
+		logFailure("failed get availability", "GET", traceId, user, appErr.Code, appErr.Error(),
+			bookingProperties...)
+		payload := errorPayload(appErr)
+		switch appErr.Code {
+		case driver.GetAvailabilityBadRequestCode:
+			return &driver.GetAvailabilityBadRequest{Payload: payload}
+		case driver.GetAvailabilityForbiddenCode:
+			return &driver.GetAvailabilityForbidden{Payload: payload}
+		case driver.GetAvailabilityNotFoundCode:
+			return &driver.GetAvailabilityNotFound{Payload: payload}
+		}
+		return errorResponder(appErr)
+	}
+
+	slog.Info(
+		"get availability",
+		slog.String("method", "GET"),
+		slog.String("trace_id", traceId),
+		userProperties(user),
+		slog.Group("booking-properties", append(bookingProperties, slog.String("granularity", granularity))...),
+		slog.Int("status_code", driver.GetAvailabilityOKCode),
+	)
+
+	result := new(driver.GetAvailabilityOK)
+	result.SetPayload(ToAPIAvailability(availability, granularity))
+	return result
+}
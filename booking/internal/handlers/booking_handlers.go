@@ -0,0 +1,506 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/h4x4d/parking_net/booking/internal/models"
+	"github.com/h4x4d/parking_net/booking/internal/restapi/operations/driver"
+	"github.com/h4x4d/parking_net/booking/internal/service"
+	"github.com/h4x4d/parking_net/booking/internal/utils"
+	"github.com/h4x4d/parking_net/pkg/client"
+	"github.com/h4x4d/parking_net/pkg/errors"
+	pkg_models "github.com/h4x4d/parking_net/pkg/models"
+	"github.com/h4x4d/parking_net/pkg/notification"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/metadata"
+)
+
+type BookingHandler struct {
+	service   *service.BookingService
+	parking   service.ParkingClient
+	kafkaConn *notification.KafkaConnection
+	keyCloak  *client.Client
+	tracer    trace.Tracer
+}
+
+// NewBookingHandler uses the global "Booking" tracer, so the tracer provider
+// must be initialized before requests are served.
+func NewBookingHandler(svc *service.BookingService, parking service.ParkingClient,
+	kafkaConn *notification.KafkaConnection, keyCloak *client.Client) *BookingHandler {
+	return &BookingHandler{
+		service:   svc,
+		parking:   parking,
+		kafkaConn: kafkaConn,
+		keyCloak:  keyCloak,
+		tracer:    otel.Tracer("Booking"),
+	}
+}
+
+func (h *BookingHandler) CreateBooking(params driver.CreateBookingParams, user *models.User) (responder middleware.Responder) {
+	defer utils.CatchPanic(&responder)
+
+	ctx, span := h.tracer.Start(context.Background(), "create booking")
+	defer span.End()
+	traceId := fmt.Sprintf("%s", span.SpanContext().TraceID())
+	ctx = metadata.AppendToOutgoingContext(ctx, "x-trace-id", traceId)
+
+	if params.Object.DateFrom == nil || params.Object.DateTo == nil || params.Object.ParkingPlaceID == nil {
+		logFailure("failed create new booking", "POST", traceId, user, http.StatusBadRequest,
+			"missing required fields")
+		errCode := int64(driver.CreateBookingBadRequestCode)
+		return &driver.CreateBookingBadRequest{
+			Payload: &models.Error{
+				ErrorMessage:    "Invalid request: missing required fields",
+				ErrorStatusCode: &errCode,
+			},
+		}
+	}
+	bookingProperties := []any{
+		slog.String("date-from", params.Object.DateFrom.String()),
+		slog.String("date-to", params.Object.DateTo.String()),
+		slog.Int64("parking-place-id", *params.Object.ParkingPlaceID),
+		slog.Int64("hold-id", params.Object.HoldID),
+	}
+
+	opts := service.CreateOptions{
+		HoldID:         params.Object.HoldID,
+		IdempotencyKey: params.IdempotencyKey,
+	}
+	created, appErr := h.service.CreateBooking(ctx, ToDomainBooking(&params.Object), opts, ToDomainUser(user))
+	if appErr != nil {
+		logFailure("failed create new booking", "POST", traceId, user, appErr.Code, appErr.Error(),
+			bookingProperties...)
+		payload := errorPayload(appErr)
+		switch appErr.Code {
+		case driver.CreateBookingBadRequestCode:
+			return &driver.CreateBookingBadRequest{Payload: payload}
+		case driver.CreateBookingForbiddenCode:
+			return &driver.CreateBookingForbidden{Payload: payload}
+		case driver.CreateBookingConflictCode:
+			return &driver.CreateBookingConflict{Payload: payload}
+		case driver.CreateBookingUnprocessableEntityCode:
+			return &driver.CreateBookingUnprocessableEntity{Payload: payload}
+		}
+		return errorResponder(appErr)
+	}
+
+	slog.Info(
+		"create new booking",
+		slog.String("method", "POST"),
+		slog.String("trace_id", traceId),
+		userProperties(user),
+		slog.Group("booking-properties", append(bookingProperties, slog.Int64("booking-id", created.ID))...),
+		slog.Int("status_code", driver.CreateBookingOKCode),
+	)
+
+	result := new(driver.CreateBookingOK)
+	result.SetPayload(&driver.CreateBookingOKBody{BookingID: created.ID})
+	return result
+}
+
+func (h *BookingHandler) QuoteBooking(params driver.QuoteBookingParams, user *models.User) (responder middleware.Responder) {
+	defer utils.CatchPanic(&responder)
+
+	ctx, span := h.tracer.Start(context.Background(), "quote booking")
+	defer span.End()
+	traceId := fmt.Sprintf("%s", span.SpanContext().TraceID())
+	ctx = metadata.AppendToOutgoingContext(ctx, "x-trace-id", traceId)
+
+	booking := ToDomainQuoteBooking(params.Object)
+	bookingProperties := []any{
+		slog.String("date-from", booking.DateFrom.String()),
+		slog.String("date-to", booking.DateTo.String()),
+		slog.Int64("parking-place-id", booking.ParkingPlaceID),
+	}
+
+	price, appErr := h.service.QuoteBooking(ctx, booking, ToDomainUser(user))
+	if appErr != nil {
+		logFailure("failed quote booking", "POST", traceId, user, appErr.Code, appErr.Error(),
+			bookingProperties...)
+		payload := errorPayload(appErr)
+		switch appErr.Code {
+		case driver.QuoteBookingBadRequestCode:
+			return &driver.QuoteBookingBadRequest{Payload: payload}
+		case driver.QuoteBookingForbiddenCode:
+			return &driver.QuoteBookingForbidden{Payload: payload}
+		case driver.QuoteBookingNotFoundCode:
+			return &driver.QuoteBookingNotFound{Payload: payload}
+		case driver.QuoteBookingConflictCode:
+			return &driver.QuoteBookingConflict{Payload: payload}
+		}
+		return errorResponder(appErr)
+	}
+
+	slog.Info(
+		"quote booking",
+		slog.String("method", "POST"),
+		slog.String("trace_id", traceId),
+		userProperties(user),
+		slog.Group("booking-properties", append(bookingProperties, slog.Int64("total", price.Total))...),
+		slog.Int("status_code", driver.QuoteBookingOKCode),
+	)
+	result := new(driver.QuoteBookingOK)
+	result.SetPayload(ToAPIBookingQuote(booking, price))
+	return result
+}
+
+func (h *BookingHandler) GetBooking(params driver.GetBookingParams, user *models.User) (responder middleware.Responder) {
+	defer utils.CatchPanic(&responder)
+
+	ctx, span := h.tracer.Start(context.Background(), "get booking")
+	defer span.End()
+	traceId := fmt.Sprintf("%s", span.SpanContext().TraceID())
+	ctx = metadata.AppendToOutgoingContext(ctx, "x-trace-id", traceId)
+
+	bookings, next, appErr := h.service.ListBookings(ctx, ToBookingFilters(params), ToDomainUser(user))
+	if appErr != nil {
+		logFailure("failed get bookings", "GET", traceId, user, appErr.Code, appErr.Error(),
+			slog.Any("parking-place-ids", params.ParkingPlaceID))
+		payload := errorPayload(appErr)
+		switch appErr.Code {
+		case driver.GetBookingBadRequestCode:
+			return &driver.GetBookingBadRequest{Payload: payload}
+		case driver.GetBookingForbiddenCode:
+			return &driver.GetBookingForbidden{Payload: payload}
+		case driver.GetBookingNotFoundCode:
+			return &driver.GetBookingNotFound{Payload: payload}
+		}
+		return errorResponder(appErr)
+	}
+
+	slog.Info(
+		"get bookings",
+		slog.String("method", "GET"),
+		slog.String("trace_id", traceId),
+		userProperties(user),
+		slog.Group("booking-properties",
+			slog.Any("parking-place-ids", params.ParkingPlaceID),
+			slog.Int("count", len(bookings)),
+			slog.Bool("has-next", next != ""),
+		),
+		slog.Int("status_code", driver.GetBookingOKCode),
+	)
+
+	result := new(driver.GetBookingOK)
+	result.SetPayload(ToAPIBookingList(bookings))
+	if next != "" {
+		result.SetXNextCursor(next)
+	}
+	return result
+}
+
+func (h *BookingHandler) GetBookingByID(params driver.GetBookingByIDParams, user *models.User) (responder middleware.Responder) {
+	defer utils.CatchPanic(&responder)
+
+	ctx, span := h.tracer.Start(context.Background(), "get booking by id")
+	defer span.End()
+	traceId := fmt.Sprintf("%s", span.SpanContext().TraceID())
+	ctx = metadata.AppendToOutgoingContext(ctx, "x-trace-id", traceId)
+
+	booking, appErr := h.service.GetBooking(ctx, params.BookingID, ToDomainUser(user))
+	if appErr != nil {
+		logFailure("failed get booking by id", "GET", traceId, user, appErr.Code, appErr.Error(),
+			slog.Int64("booking-id", params.BookingID))
+		payload := errorPayload(appErr)
+		switch appErr.Code {
+		case driver.GetBookingByIDForbiddenCode:
+			return &driver.GetBookingByIDForbidden{Payload: payload}
+		case driver.GetBookingByIDNotFoundCode:
+			return &driver.GetBookingByIDNotFound{Payload: payload}
+		}
+		return errorResponder(appErr)
+	}
+
+	slog.Info(
+		"get booking by id",
+		slog.String("method", "GET"),
+		slog.String("trace_id", traceId),
+		userProperties(user),
+		slog.Group("booking-properties",
+			slog.Int64("booking-id", params.BookingID),
+		),
+		slog.Int("status_code", driver.GetBookingByIDOKCode),
+	)
+	result := new(driver.GetBookingByIDOK)
+	result.SetPayload(ToAPIBooking(booking))
+	return result
+}
+
+func (h *BookingHandler) GetBookingHistory(params driver.GetBookingHistoryParams, user *models.User) (responder middleware.Responder) {
+	defer utils.CatchPanic(&responder)
+
+	ctx, span := h.tracer.Start(context.Background(), "get booking history")
+	defer span.End()
+	traceId := fmt.Sprintf("%s", span.SpanContext().TraceID())
+	ctx = metadata.AppendToOutgoingContext(ctx, "x-trace-id", traceId)
+
+	events, appErr := h.service.BookingHistory(ctx, params.BookingID, ToDomainUser(user))
+	if appErr != nil {
+		logFailure("failed get booking history", "GET", traceId, user, appErr.Code, appErr.Error(),
+			slog.Int64("booking-id", params.BookingID))
+		payload := errorPayload(appErr)
+		switch appErr.Code {
+		case driver.GetBookingHistoryForbiddenCode:
+			return &driver.GetBookingHistoryForbidden{Payload: payload}
+		case driver.GetBookingHistoryNotFoundCode:
+			return &driver.GetBookingHistoryNotFound{Payload: payload}
+		}
+		return errorResponder(appErr)
+	}
+
+	slog.Info(
+		"get booking history",
+		slog.String("method", "GET"),
+		slog.String("trace_id", traceId),
+		userProperties(user),
+		slog.Group("booking-properties",
+			slog.Int64("booking-id", params.BookingID),
+		),
+		slog.Int("events", len(events)),
+		slog.Int("status_code", driver.GetBookingHistoryOKCode),
+	)
+	result := new(driver.GetBookingHistoryOK)
+	result.SetPayload(ToAPIBookingEvents(events))
+	return result
+}
+
+func (h *BookingHandler) UpdateBooking(params driver.UpdateBookingParams, user *models.User) (responder middleware.Responder) {
+	defer utils.CatchPanic(&responder)
+
+	ctx, span := h.tracer.Start(context.Background(), "update booking")
+	defer span.End()
+	traceId := fmt.Sprintf("%s", span.SpanContext().TraceID())
+	ctx = metadata.AppendToOutgoingContext(ctx, "x-trace-id", traceId)
+
+	if params.Object == nil {
+		logFailure("failed update booking", "PUT", traceId, user, driver.UpdateBookingBadRequestCode,
+			"missing request body", slog.Int64("booking-id", params.BookingID))
+		errCode := int64(driver.UpdateBookingBadRequestCode)
+		return &driver.UpdateBookingBadRequest{
+			Payload: &models.Error{
+				ErrorMessage:    "Invalid request: missing required fields",
+				ErrorStatusCode: &errCode,
+			},
+		}
+	}
+
+	booking, appErr := h.service.UpdateBooking(ctx, params.BookingID, ToBookingChanges(params.Object),
+		ToDomainUser(user))
+	if appErr != nil {
+		logFailure("failed update booking", "PUT", traceId, user, appErr.Code, appErr.Error(),
+			slog.Int64("booking-id", params.BookingID),
+			slog.String("status", params.Object.Status),
+		)
+		payload := errorPayload(appErr)
+		switch appErr.Code {
+		case driver.UpdateBookingBadRequestCode:
+			return &driver.UpdateBookingBadRequest{Payload: payload}
+		case driver.UpdateBookingForbiddenCode:
+			return &driver.UpdateBookingForbidden{Payload: payload}
+		case driver.UpdateBookingConflictCode:
+			return &driver.UpdateBookingConflict{Payload: payload}
+		}
+		return errorResponder(appErr)
+	}
+
+	h.notify(ctx, user.TelegramID, fmt.Sprintf("Your booking with booking_id %d was updated successfully",
+		booking.ID))
+	if h.keyCloak != nil {
+		parkingPlace, err := h.parking.GetParkingPlace(ctx, booking.ParkingPlaceID)
+		if err != nil {
+			slog.Warn("failed to get parking place for owner notification", "error", err)
+		} else if tgId, err := h.keyCloak.GetTelegramId(ctx, parkingPlace.OwnerID); err != nil {
+			slog.Warn("failed to get telegram ID for owner, skipping owner notification", "error", err)
+		} else {
+			h.notify(ctx, tgId, fmt.Sprintf("Your parking place %d booking with booking_id %d was updated",
+				booking.ParkingPlaceID, booking.ID))
+		}
+	} else {
+		slog.Warn("Keycloak client not available, skipping owner notification")
+	}
+
+	slog.Info(
+		"update booking",
+		slog.String("method", "PUT"),
+		slog.String("trace_id", traceId),
+		userProperties(user),
+		slog.Group("booking-properties",
+			slog.Int64("booking-id", booking.ID),
+			slog.Int64("parking-place-id", booking.ParkingPlaceID),
+			slog.String("date-from", booking.DateFrom.String()),
+			slog.String("date-to", booking.DateTo.String()),
+			slog.String("status", string(booking.Status)),
+			slog.Int64("full-cost", booking.FullCost),
+		),
+		slog.Int("status_code", driver.UpdateBookingOKCode),
+	)
+
+	result := new(driver.UpdateBookingOK)
+	result.SetPayload(ToAPIBooking(booking))
+	return result
+}
+
+// ExtendBooking leaves the notifications to the outbox, which sends them only
+// once the added time is paid.
+func (h *BookingHandler) ExtendBooking(params driver.ExtendBookingParams, user *models.User) (responder middleware.Responder) {
+	defer utils.CatchPanic(&responder)
+
+	ctx, span := h.tracer.Start(context.Background(), "extend booking")
+	defer span.End()
+	traceId := fmt.Sprintf("%s", span.SpanContext().TraceID())
+	ctx = metadata.AppendToOutgoingContext(ctx, "x-trace-id", traceId)
+
+	if params.Object == nil {
+		logFailure("failed extend booking", "POST", traceId, user, driver.ExtendBookingBadRequestCode,
+			"missing request body", slog.Int64("booking-id", params.BookingID))
+		errCode := int64(driver.ExtendBookingBadRequestCode)
+		return &driver.ExtendBookingBadRequest{
+			Payload: &models.Error{
+				ErrorMessage:    "Invalid request: missing required fields",
+				ErrorStatusCode: &errCode,
+			},
+		}
+	}
+
+	booking, appErr := h.service.ExtendBooking(ctx, params.BookingID, ToBookingExtension(params.Object),
+		ToDomainUser(user))
+	if appErr != nil {
+		logFailure("failed extend booking", "POST", traceId, user, appErr.Code, appErr.Error(),
+			slog.Int64("booking-id", params.BookingID))
+		payload := errorPayload(appErr)
+		switch appErr.Code {
+		case driver.ExtendBookingBadRequestCode:
+			return &driver.ExtendBookingBadRequest{Payload: payload}
+		case driver.ExtendBookingForbiddenCode:
+			return &driver.ExtendBookingForbidden{Payload: payload}
+		case driver.ExtendBookingNotFoundCode:
+			return &driver.ExtendBookingNotFound{Payload: payload}
+		case driver.ExtendBookingConflictCode:
+			return &driver.ExtendBookingConflict{Payload: payload}
+		}
+		return errorResponder(appErr)
+	}
+
+	slog.Info(
+		"extend booking",
+		slog.String("method", "POST"),
+		slog.String("trace_id", traceId),
+		userProperties(user),
+		slog.Group("booking-properties",
+			slog.Int64("booking-id", booking.ID),
+			slog.Int64("parking-place-id", booking.ParkingPlaceID),
+			slog.String("date-to", booking.DateTo.String()),
+			slog.Int64("full-cost", booking.FullCost),
+		),
+		slog.Int("status_code", driver.ExtendBookingOKCode),
+	)
+
+	result := new(driver.ExtendBookingOK)
+	result.SetPayload(ToAPIBooking(booking))
+	return result
+}
+
+func (h *BookingHandler) DeleteBooking(params driver.DeleteBookingParams, user *models.User) (responder middleware.Responder) {
+	defer utils.CatchPanic(&responder)
+
+	ctx, span := h.tracer.Start(context.Background(), "delete booking")
+	defer span.End()
+	traceId := fmt.Sprintf("%s", span.SpanContext().TraceID())
+	ctx = metadata.AppendToOutgoingContext(ctx, "x-trace-id", traceId)
+
+	if appErr := h.service.DeleteBooking(ctx, params.BookingID, ToDomainUser(user)); appErr != nil {
+		logFailure("failed delete booking", "DELETE", traceId, user, appErr.Code, appErr.Error(),
+			slog.Int64("booking-id", params.BookingID))
+		payload := errorPayload(appErr)
+		switch appErr.Code {
+		case driver.DeleteBookingForbiddenCode:
+			return &driver.DeleteBookingForbidden{Payload: payload}
+		case driver.DeleteBookingNotFoundCode:
+			return &driver.DeleteBookingNotFound{Payload: payload}
+		}
+		return errorResponder(appErr)
+	}
+
+	slog.Info(
+		"delete booking",
+		slog.String("method", "DELETE"),
+		slog.String("trace_id", traceId),
+		userProperties(user),
+		slog.Group("booking-properties",
+			slog.Int64("booking-id", params.BookingID),
+		),
+		slog.Int("status_code", driver.DeleteBookingOKCode),
+	)
+
+	result := new(driver.DeleteBookingOK)
+	result.SetPayload(&models.Result{
+		Status:  "success",
+		Message: fmt.Sprintf("Booking %d deleted successfully", params.BookingID),
+	})
+	return result
+}
+
+func (h *BookingHandler) notify(ctx context.Context, telegramID int, text string) {
+	if h.kafkaConn == nil || telegramID <= 0 {
+		return
+	}
+	err := h.kafkaConn.SendNotification(pkg_models.Notification{
+		Name:       "Booking update",
+		Text:       text,
+		TelegramID: telegramID,
+	})
+	if err != nil {
+		slog.Warn("failed to send notification", "error", err, "telegram_id", telegramID)
+	}
+}
+
+func userProperties(user *models.User) slog.Attr {
+	userID := "unknown"
+	role := "unknown"
+	telegramID := 0
+	if user != nil {
+		userID = user.UserID
+		role = user.Role
+		telegramID = user.TelegramID
+	}
+	return slog.Group("user-properties",
+		slog.String("user-id", userID),
+		slog.String("role", role),
+		slog.Int("telegram-id", telegramID),
+	)
+}
+
+func logFailure(message string, method string, traceId string, user *models.User, code int, err string,
+	bookingProperties ...any) {
+	slog.Error(
+		message,
+		slog.String("method", method),
+		slog.String("trace_id", traceId),
+		userProperties(user),
+		slog.Group("booking-properties", bookingProperties...),
+		slog.Int("status_code", code),
+		slog.String("error", err),
+	)
+}
+
+func errorPayload(appErr *errors.AppError) *models.Error {
+	errCode := int64(appErr.Code)
+	return &models.Error{
+		ErrorMessage:    appErr.Message,
+		ErrorStatusCode: &errCode,
+	}
+}
+
+// errorResponder answers with the status of appErr when the operation has no
+// response of its own for it.
+func errorResponder(appErr *errors.AppError) middleware.Responder {
+	if appErr.Code >= http.StatusInternalServerError {
+		return utils.HandleInternalError(appErr)
+	}
+	return utils.HandleError(&appErr.Message, appErr.Code)
+}
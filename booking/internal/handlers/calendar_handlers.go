@@ -0,0 +1,146 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/h4x4d/parking_net/booking/internal/calendar"
+	"github.com/h4x4d/parking_net/booking/internal/models"
+	"github.com/h4x4d/parking_net/booking/internal/restapi/operations/driver"
+	"github.com/h4x4d/parking_net/booking/internal/service"
+	"github.com/h4x4d/parking_net/booking/internal/utils"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type CalendarHandler struct {
+	service *service.CalendarService
+	tracer  trace.Tracer
+}
+
+// NewCalendarHandler uses the global "Booking" tracer, like NewBookingHandler.
+func NewCalendarHandler(svc *service.CalendarService) *CalendarHandler {
+	return &CalendarHandler{
+		service: svc,
+		tracer:  otel.Tracer("Booking"),
+	}
+}
+
+func (h *CalendarHandler) CreateCalendarFeed(params driver.CreateCalendarFeedParams, user *models.User) (responder middleware.Responder) {
+	defer utils.CatchPanic(&responder)
+
+	ctx, span := h.tracer.Start(context.Background(), "create calendar feed")
+	defer span.End()
+	traceId := fmt.Sprintf("%s", span.SpanContext().TraceID())
+
+	feed, appErr := h.service.CreateFeed(ctx, ToDomainUser(user))
+	if appErr != nil {
+		logFailure("failed create calendar feed", "POST", traceId, user, appErr.Code, appErr.Error())
+		if appErr.Code == driver.CreateCalendarFeedForbiddenCode {
+			return &driver.CreateCalendarFeedForbidden{Payload: errorPayload(appErr)}
+		}
+		return errorResponder(appErr)
+	}
+
+	slog.Info(
+		"create calendar feed",
+		slog.String("method", "POST"),
+		slog.String("trace_id", traceId),
+		userProperties(user),
+		slog.Int("status_code", driver.CreateCalendarFeedOKCode),
+	)
+
+	result := new(driver.CreateCalendarFeedOK)
+	result.SetPayload(&models.CalendarFeed{
+		Token:     feed.Token,
+		URL:       feedURL(params.HTTPRequest, feed.Token),
+		CreatedAt: strfmt.DateTime(feed.CreatedAt),
+	})
+	return result
+}
+
+func (h *CalendarHandler) RevokeCalendarFeed(params driver.RevokeCalendarFeedParams, user *models.User) (responder middleware.Responder) {
+	defer utils.CatchPanic(&responder)
+
+	ctx, span := h.tracer.Start(context.Background(), "revoke calendar feed")
+	defer span.End()
+	traceId := fmt.Sprintf("%s", span.SpanContext().TraceID())
+
+	if appErr := h.service.RevokeFeed(ctx, ToDomainUser(user)); appErr != nil {
+		logFailure("failed revoke calendar feed", "DELETE", traceId, user, appErr.Code, appErr.Error())
+		payload := errorPayload(appErr)
+		switch appErr.Code {
+		case driver.RevokeCalendarFeedForbiddenCode:
+			return &driver.RevokeCalendarFeedForbidden{Payload: payload}
+		case driver.RevokeCalendarFeedNotFoundCode:
+			return &driver.RevokeCalendarFeedNotFound{Payload: payload}
+		}
+		return errorResponder(appErr)
+	}
+
+	slog.Info(
+		"revoke calendar feed",
+		slog.String("method", "DELETE"),
+		slog.String("trace_id", traceId),
+		userProperties(user),
+		slog.Int("status_code", driver.RevokeCalendarFeedOKCode),
+	)
+
+	result := new(driver.RevokeCalendarFeedOK)
+	result.SetPayload(&models.Result{Status: "success", Message: "Calendar feed revoked"})
+	return result
+}
+
+// GetCalendarFeed serves the feed to calendar apps, which authenticate with
+// the token in the path only.
+func (h *CalendarHandler) GetCalendarFeed(params driver.GetCalendarFeedParams) (responder middleware.Responder) {
+	defer utils.CatchPanic(&responder)
+
+	ctx, span := h.tracer.Start(context.Background(), "get calendar feed")
+	defer span.End()
+	traceId := fmt.Sprintf("%s", span.SpanContext().TraceID())
+
+	now := time.Now().UTC()
+	feed, appErr := h.service.GetFeed(ctx, params.Token, params.ParkingPlaceID, now)
+	if appErr != nil {
+		logFailure("failed get calendar feed", "GET", traceId, nil, appErr.Code, appErr.Error(),
+			slog.Any("parking-place-id", params.ParkingPlaceID))
+		payload := errorPayload(appErr)
+		switch appErr.Code {
+		case driver.GetCalendarFeedForbiddenCode:
+			return &driver.GetCalendarFeedForbidden{Payload: payload}
+		case driver.GetCalendarFeedNotFoundCode:
+			return &driver.GetCalendarFeedNotFound{Payload: payload}
+		}
+		return errorResponder(appErr)
+	}
+
+	slog.Info(
+		"get calendar feed",
+		slog.String("method", "GET"),
+		slog.String("trace_id", traceId),
+		slog.Int("events", len(feed.Events)),
+		slog.Int("status_code", driver.GetCalendarFeedOKCode),
+	)
+
+	result := new(driver.GetCalendarFeedOK)
+	result.SetPayload(calendar.Encode(feed.Name, feed.Events, now))
+	return result
+}
+
+// feedURL is the address the request reached the service at, behind a proxy too.
+func feedURL(r *http.Request, token string) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	if forwarded := r.Header.Get("X-Forwarded-Proto"); forwarded != "" {
+		scheme = forwarded
+	}
+	return fmt.Sprintf("%s://%s/booking/calendar/%s", scheme, r.Host, token)
+}
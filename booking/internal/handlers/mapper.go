@@ -0,0 +1,413 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/h4x4d/parking_net/booking/internal/models"
+	"github.com/h4x4d/parking_net/booking/internal/restapi/operations/driver"
+	"github.com/h4x4d/parking_net/booking/internal/service"
+	"github.com/h4x4d/parking_net/pkg/domain"
+)
+
+func ToDomainBooking(api *driver.CreateBookingBody) *domain.Booking {
+	if api == nil {
+		return nil
+	}
+
+	b := &domain.Booking{}
+	if api.DateFrom != nil {
+		b.DateFrom = time.Time(*api.DateFrom)
+	}
+	if api.DateTo != nil {
+		b.DateTo = time.Time(*api.DateTo)
+	}
+	if api.ParkingPlaceID != nil {
+		b.ParkingPlaceID = *api.ParkingPlaceID
+	}
+	b.VehicleID = api.VehicleID
+	return b
+}
+
+// ToBookingChanges keeps the fields of an update request that were sent;
+// full_cost is always derived from the hourly rate and is ignored.
+func ToBookingChanges(api *models.Booking) service.BookingChanges {
+	changes := service.BookingChanges{ParkingPlaceID: api.ParkingPlaceID}
+	if api.DateFrom != nil {
+		dateFrom := time.Time(*api.DateFrom)
+		changes.DateFrom = &dateFrom
+	}
+	if api.DateTo != nil {
+		dateTo := time.Time(*api.DateTo)
+		changes.DateTo = &dateTo
+	}
+	if api.Status != "" {
+		status := domain.BookingStatus(api.Status)
+		changes.Status = &status
+	}
+	if api.UserID != "" {
+		changes.UserID = &api.UserID
+	}
+	return changes
+}
+
+func ToBookingExtension(api *models.BookingExtension) service.BookingExtension {
+	var extension service.BookingExtension
+	if api.DateTo != nil {
+		dateTo := time.Time(*api.DateTo)
+		extension.DateTo = &dateTo
+	}
+	if api.DurationMinutes != nil {
+		duration := time.Duration(*api.DurationMinutes) * time.Minute
+		extension.Duration = &duration
+	}
+	return extension
+}
+
+func ToBookingFilters(params driver.GetBookingParams) service.BookingFilters {
+	filters := service.BookingFilters{
+		UserID: params.UserID,
+	}
+	if len(params.ParkingPlaceID) > 0 {
+		filters.ParkingPlaceIDs = params.ParkingPlaceID
+	}
+	for _, status := range params.Status {
+		filters.Statuses = append(filters.Statuses, domain.BookingStatus(status))
+	}
+	if params.DateFrom != nil {
+		dateFrom := time.Time(*params.DateFrom)
+		filters.DateFrom = &dateFrom
+	}
+	if params.DateTo != nil {
+		dateTo := time.Time(*params.DateTo)
+		filters.DateTo = &dateTo
+	}
+	if params.Sort != nil {
+		filters.Sort = *params.Sort
+	}
+	if params.Limit != nil {
+		filters.Limit = *params.Limit
+	}
+	if params.Cursor != nil {
+		filters.Cursor = *params.Cursor
+	}
+	return filters
+}
+
+func ToAPIBooking(d *domain.Booking) *models.Booking {
+	if d == nil {
+		return nil
+	}
+
+	parkingPlaceID := d.ParkingPlaceID
+	b := &models.Booking{
+		BookingID:      d.ID,
+		DateFrom:       dateTimePtr(d.DateFrom),
+		DateTo:         dateTimePtr(d.DateTo),
+		ParkingPlaceID: &parkingPlaceID,
+		FullCost:       d.FullCost,
+		Status:         string(d.Status),
+		UserID:         d.UserID,
+		SeriesID:       d.SeriesID,
+		OvertimeCost:   d.OvertimeCost,
+		VehicleID:      d.VehicleID,
+	}
+	if d.CheckedInAt != nil {
+		b.CheckedInAt = dateTimePtr(*d.CheckedInAt)
+	}
+	if d.CheckedOutAt != nil {
+		b.CheckedOutAt = dateTimePtr(*d.CheckedOutAt)
+	}
+	return b
+}
+
+func ToAPIBookingList(bookings []*domain.Booking) []*models.Booking {
+	result := make([]*models.Booking, 0, len(bookings))
+	for _, b := range bookings {
+		result = append(result, ToAPIBooking(b))
+	}
+	return result
+}
+
+func ToDomainVehicle(api *models.Vehicle) *domain.Vehicle {
+	if api == nil {
+		return nil
+	}
+
+	v := &domain.Vehicle{Electric: api.Electric}
+	if api.Plate != nil {
+		v.Plate = *api.Plate
+	}
+	if api.Country != nil {
+		v.Country = *api.Country
+	}
+	if api.Size != nil {
+		v.Size = domain.VehicleSize(*api.Size)
+	}
+	return v
+}
+
+func ToAPIVehicle(d *domain.Vehicle) *models.Vehicle {
+	if d == nil {
+		return nil
+	}
+
+	plate := d.Plate
+	country := d.Country
+	size := string(d.Size)
+	return &models.Vehicle{
+		VehicleID: d.ID,
+		UserID:    d.UserID,
+		Plate:     &plate,
+		Country:   &country,
+		Size:      &size,
+		Electric:  d.Electric,
+		CreatedAt: strfmt.DateTime(d.CreatedAt),
+	}
+}
+
+func ToAPIVehicleList(vehicles []*domain.Vehicle) []*models.Vehicle {
+	result := make([]*models.Vehicle, 0, len(vehicles))
+	for _, v := range vehicles {
+		result = append(result, ToAPIVehicle(v))
+	}
+	return result
+}
+
+func ToAPIPlateBookings(found []*service.PlateBooking) []*models.PlateBooking {
+	result := make([]*models.PlateBooking, 0, len(found))
+	for _, f := range found {
+		result = append(result, &models.PlateBooking{
+			Booking: ToAPIBooking(f.Booking),
+			Vehicle: ToAPIVehicle(f.Vehicle),
+		})
+	}
+	return result
+}
+
+func ToAPIBookingEvents(events []*domain.BookingEvent) []*models.BookingEvent {
+	result := make([]*models.BookingEvent, 0, len(events))
+	for _, e := range events {
+		result = append(result, &models.BookingEvent{
+			EventID:   e.ID,
+			BookingID: e.BookingID,
+			EventType: string(e.Type),
+			ActorID:   e.Actor.ID,
+			ActorRole: string(e.Actor.Role),
+			OldValue:  e.OldValue,
+			NewValue:  e.NewValue,
+			TraceID:   e.TraceID,
+			CreatedAt: strfmt.DateTime(e.CreatedAt),
+		})
+	}
+	return result
+}
+
+func ToDomainQuoteBooking(api *models.BookingQuoteRequest) *domain.Booking {
+	b := &domain.Booking{VehicleID: api.VehicleID}
+	if api.DateFrom != nil {
+		b.DateFrom = time.Time(*api.DateFrom)
+	}
+	if api.DateTo != nil {
+		b.DateTo = time.Time(*api.DateTo)
+	}
+	if api.ParkingPlaceID != nil {
+		b.ParkingPlaceID = *api.ParkingPlaceID
+	}
+	return b
+}
+
+func ToAPIBookingQuote(booking *domain.Booking, price *domain.Price) *models.BookingQuote {
+	items := make([]*models.PriceItem, 0, len(price.Items))
+	for _, item := range price.Items {
+		items = append(items, &models.PriceItem{
+			Kind:        string(item.Kind),
+			Description: item.Description,
+			Amount:      item.Amount,
+		})
+	}
+	return &models.BookingQuote{
+		ParkingPlaceID: booking.ParkingPlaceID,
+		DateFrom:       strfmt.DateTime(booking.DateFrom),
+		DateTo:         strfmt.DateTime(booking.DateTo),
+		HourlyRate:     price.HourlyRate,
+		Hours:          price.Hours(),
+		Items:          items,
+		Total:          price.Total,
+	}
+}
+
+func ToDomainHold(api *models.BookingHold) *domain.BookingHold {
+	hold := &domain.BookingHold{}
+	if api.DateFrom != nil {
+		hold.DateFrom = time.Time(*api.DateFrom)
+	}
+	if api.DateTo != nil {
+		hold.DateTo = time.Time(*api.DateTo)
+	}
+	if api.ParkingPlaceID != nil {
+		hold.ParkingPlaceID = *api.ParkingPlaceID
+	}
+	return hold
+}
+
+func ToAPIHold(d *domain.BookingHold) *models.BookingHold {
+	parkingPlaceID := d.ParkingPlaceID
+	return &models.BookingHold{
+		HoldID:         d.ID,
+		UserID:         d.UserID,
+		ParkingPlaceID: &parkingPlaceID,
+		DateFrom:       dateTimePtr(d.DateFrom),
+		DateTo:         dateTimePtr(d.DateTo),
+		ExpiresAt:      strfmt.DateTime(d.ExpiresAt),
+	}
+}
+
+func ToAPIAvailability(d *domain.Availability, granularity string) *models.Availability {
+	slots := make([]*models.AvailabilitySlot, 0, len(d.Slots))
+	for _, slot := range d.Slots {
+		slots = append(slots, &models.AvailabilitySlot{
+			DateFrom: strfmt.DateTime(slot.DateFrom),
+			DateTo:   strfmt.DateTime(slot.DateTo),
+			Booked:   slot.Booked,
+			Free:     slot.Free,
+		})
+	}
+	return &models.Availability{
+		ParkingPlaceID: d.ParkingPlaceID,
+		Capacity:       d.Capacity,
+		Granularity:    granularity,
+		Slots:          slots,
+	}
+}
+
+func ToDomainSeries(api *models.BookingSeries) *domain.BookingSeries {
+	series := &domain.BookingSeries{
+		VehicleID: api.VehicleID,
+		Billing:   domain.SeriesBilling(api.Billing),
+	}
+	if api.ParkingPlaceID != nil {
+		series.ParkingPlaceID = *api.ParkingPlaceID
+	}
+	if api.DateFrom != nil {
+		series.DateFrom = time.Time(*api.DateFrom)
+	}
+	if api.DateTo != nil {
+		series.DateTo = time.Time(*api.DateTo)
+	}
+	if api.Rrule != nil {
+		series.RRule = *api.Rrule
+	}
+	if api.Until != nil {
+		series.Until = time.Time(*api.Until)
+	}
+	return series
+}
+
+func ToAPISeries(d *domain.BookingSeries) *models.BookingSeries {
+	parkingPlaceID := d.ParkingPlaceID
+	rrule := d.RRule
+	conflicts := make([]*models.SeriesConflict, 0, len(d.Conflicts))
+	for _, conflict := range d.Conflicts {
+		conflicts = append(conflicts, &models.SeriesConflict{
+			DateFrom: strfmt.DateTime(conflict.DateFrom),
+			DateTo:   strfmt.DateTime(conflict.DateTo),
+			Reason:   conflict.Reason,
+		})
+	}
+	return &models.BookingSeries{
+		SeriesID:       d.ID,
+		UserID:         d.UserID,
+		ParkingPlaceID: &parkingPlaceID,
+		VehicleID:      d.VehicleID,
+		DateFrom:       dateTimePtr(d.DateFrom),
+		DateTo:         dateTimePtr(d.DateTo),
+		Rrule:          &rrule,
+		Until:          dateTimePtr(d.Until),
+		Billing:        string(d.Billing),
+		Status:         string(d.Status),
+		Bookings:       ToAPIBookingList(d.Bookings),
+		Conflicts:      conflicts,
+	}
+}
+
+func ToAPIEntryPass(d *domain.EntryPass) *models.EntryPass {
+	return &models.EntryPass{
+		BookingID:      d.BookingID,
+		ParkingPlaceID: d.ParkingPlaceID,
+		Token:          d.Token,
+		QrCode:         d.QRCode,
+		ValidFrom:      strfmt.DateTime(d.ValidFrom),
+		ValidUntil:     strfmt.DateTime(d.ValidUntil),
+	}
+}
+
+func ToAPIPassVerification(d *domain.PassVerification) *models.PassVerification {
+	valid := d.Valid
+	verification := &models.PassVerification{Valid: &valid, CheckedIn: d.CheckedIn, Reason: d.Reason}
+	if d.Booking != nil {
+		verification.Booking = ToAPIBooking(d.Booking)
+	}
+	return verification
+}
+
+func ToDomainWaitlistEntry(api *models.WaitlistEntry) *domain.WaitlistEntry {
+	entry := &domain.WaitlistEntry{
+		VehicleID:  api.VehicleID,
+		AutoAccept: api.AutoAccept,
+	}
+	if api.ParkingPlaceID != nil {
+		entry.ParkingPlaceID = *api.ParkingPlaceID
+	}
+	if api.DateFrom != nil {
+		entry.DateFrom = time.Time(*api.DateFrom)
+	}
+	if api.DateTo != nil {
+		entry.DateTo = time.Time(*api.DateTo)
+	}
+	return entry
+}
+
+func ToAPIWaitlistEntry(d *domain.WaitlistEntry) *models.WaitlistEntry {
+	parkingPlaceID := d.ParkingPlaceID
+	entry := &models.WaitlistEntry{
+		EntryID:        d.ID,
+		UserID:         d.UserID,
+		ParkingPlaceID: &parkingPlaceID,
+		VehicleID:      d.VehicleID,
+		DateFrom:       dateTimePtr(d.DateFrom),
+		DateTo:         dateTimePtr(d.DateTo),
+		AutoAccept:     d.AutoAccept,
+		Status:         string(d.Status),
+		BookingID:      d.BookingID,
+	}
+	if d.OfferExpiresAt != nil {
+		entry.OfferExpiresAt = dateTimePtr(*d.OfferExpiresAt)
+	}
+	return entry
+}
+
+func ToAPIWaitlist(entries []*domain.WaitlistEntry) []*models.WaitlistEntry {
+	result := make([]*models.WaitlistEntry, 0, len(entries))
+	for _, entry := range entries {
+		result = append(result, ToAPIWaitlistEntry(entry))
+	}
+	return result
+}
+
+func ToDomainUser(api *models.User) *domain.User {
+	if api == nil {
+		return nil
+	}
+
+	return &domain.User{
+		ID:         api.UserID,
+		Role:       domain.UserRole(api.Role),
+		TelegramID: int64(api.TelegramID),
+	}
+}
+
+func dateTimePtr(t time.Time) *strfmt.DateTime {
+	dt := strfmt.DateTime(t)
+	return &dt
+}
@@ -0,0 +1,195 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/h4x4d/parking_net/booking/internal/models"
+	"github.com/h4x4d/parking_net/booking/internal/restapi/operations/driver"
+	"github.com/h4x4d/parking_net/booking/internal/service"
+	"github.com/h4x4d/parking_net/booking/internal/utils"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/metadata"
+)
+
+type SeriesHandler struct {
+	service *service.SeriesService
+	tracer  trace.Tracer
+}
+
+// NewSeriesHandler uses the global "Booking" tracer, like NewBookingHandler.
+func NewSeriesHandler(svc *service.SeriesService) *SeriesHandler {
+	return &SeriesHandler{
+		service: svc,
+		tracer:  otel.Tracer("Booking"),
+	}
+}
+
+func (h *SeriesHandler) CreateBookingSeries(params driver.CreateBookingSeriesParams, user *models.User) (responder middleware.Responder) {
+	defer utils.CatchPanic(&responder)
+
+	ctx, span := h.tracer.Start(context.Background(), "create booking series")
+	defer span.End()
+	traceId := fmt.Sprintf("%s", span.SpanContext().TraceID())
+	ctx = metadata.AppendToOutgoingContext(ctx, "x-trace-id", traceId)
+
+	series, appErr := h.service.CreateSeries(ctx, ToDomainSeries(params.Object), ToDomainUser(user))
+	if appErr != nil {
+		logFailure("failed create booking series", "POST", traceId, user, appErr.Code, appErr.Error(),
+			slog.Any("parking-place-id", params.Object.ParkingPlaceID),
+			slog.Any("rrule", params.Object.Rrule),
+			slog.String("billing", params.Object.Billing))
+		payload := errorPayload(appErr)
+		switch appErr.Code {
+		case driver.CreateBookingSeriesBadRequestCode:
+			return &driver.CreateBookingSeriesBadRequest{Payload: payload}
+		case driver.CreateBookingSeriesForbiddenCode:
+			return &driver.CreateBookingSeriesForbidden{Payload: payload}
+		case driver.CreateBookingSeriesConflictCode:
+			return &driver.CreateBookingSeriesConflict{Payload: payload}
+		}
+		return errorResponder(appErr)
+	}
+
+	slog.Info(
+		"create booking series",
+		slog.String("method", "POST"),
+		slog.String("trace_id", traceId),
+		userProperties(user),
+		slog.Group("booking-properties",
+			slog.Int64("series-id", series.ID),
+			slog.Int64("parking-place-id", series.ParkingPlaceID),
+			slog.String("billing", string(series.Billing)),
+			slog.Int("bookings", len(series.Bookings)),
+			slog.Int("conflicts", len(series.Conflicts)),
+		),
+		slog.Int("status_code", driver.CreateBookingSeriesOKCode),
+	)
+
+	result := new(driver.CreateBookingSeriesOK)
+	result.SetPayload(ToAPISeries(series))
+	return result
+}
+
+func (h *SeriesHandler) GetBookingSeries(params driver.GetBookingSeriesParams, user *models.User) (responder middleware.Responder) {
+	defer utils.CatchPanic(&responder)
+
+	ctx, span := h.tracer.Start(context.Background(), "get booking series")
+	defer span.End()
+	traceId := fmt.Sprintf("%s", span.SpanContext().TraceID())
+	ctx = metadata.AppendToOutgoingContext(ctx, "x-trace-id", traceId)
+
+	series, appErr := h.service.GetSeries(ctx, params.SeriesID, ToDomainUser(user))
+	if appErr != nil {
+		logFailure("failed get booking series", "GET", traceId, user, appErr.Code, appErr.Error(),
+			slog.Int64("series-id", params.SeriesID))
+		payload := errorPayload(appErr)
+		switch appErr.Code {
+		case driver.GetBookingSeriesForbiddenCode:
+			return &driver.GetBookingSeriesForbidden{Payload: payload}
+		case driver.GetBookingSeriesNotFoundCode:
+			return &driver.GetBookingSeriesNotFound{Payload: payload}
+		}
+		return errorResponder(appErr)
+	}
+
+	slog.Info(
+		"get booking series",
+		slog.String("method", "GET"),
+		slog.String("trace_id", traceId),
+		userProperties(user),
+		slog.Group("booking-properties",
+			slog.Int64("series-id", series.ID),
+			slog.Int("bookings", len(series.Bookings)),
+		),
+		slog.Int("status_code", driver.GetBookingSeriesOKCode),
+	)
+
+	result := new(driver.GetBookingSeriesOK)
+	result.SetPayload(ToAPISeries(series))
+	return result
+}
+
+func (h *SeriesHandler) CancelBookingSeries(params driver.CancelBookingSeriesParams, user *models.User) (responder middleware.Responder) {
+	defer utils.CatchPanic(&responder)
+
+	ctx, span := h.tracer.Start(context.Background(), "cancel booking series")
+	defer span.End()
+	traceId := fmt.Sprintf("%s", span.SpanContext().TraceID())
+	ctx = metadata.AppendToOutgoingContext(ctx, "x-trace-id", traceId)
+
+	series, appErr := h.service.CancelSeries(ctx, params.SeriesID, ToDomainUser(user))
+	if appErr != nil {
+		logFailure("failed cancel booking series", "DELETE", traceId, user, appErr.Code, appErr.Error(),
+			slog.Int64("series-id", params.SeriesID))
+		payload := errorPayload(appErr)
+		switch appErr.Code {
+		case driver.CancelBookingSeriesForbiddenCode:
+			return &driver.CancelBookingSeriesForbidden{Payload: payload}
+		case driver.CancelBookingSeriesNotFoundCode:
+			return &driver.CancelBookingSeriesNotFound{Payload: payload}
+		}
+		return errorResponder(appErr)
+	}
+
+	slog.Info(
+		"cancel booking series",
+		slog.String("method", "DELETE"),
+		slog.String("trace_id", traceId),
+		userProperties(user),
+		slog.Group("booking-properties",
+			slog.Int64("series-id", params.SeriesID),
+		),
+		slog.Int("status_code", driver.CancelBookingSeriesOKCode),
+	)
+
+	result := new(driver.CancelBookingSeriesOK)
+	result.SetPayload(ToAPISeries(series))
+	return result
+}
+
+func (h *SeriesHandler) CancelSeriesOccurrence(params driver.CancelSeriesOccurrenceParams, user *models.User) (responder middleware.Responder) {
+	defer utils.CatchPanic(&responder)
+
+	ctx, span := h.tracer.Start(context.Background(), "cancel series occurrence")
+	defer span.End()
+	traceId := fmt.Sprintf("%s", span.SpanContext().TraceID())
+	ctx = metadata.AppendToOutgoingContext(ctx, "x-trace-id", traceId)
+
+	bookingProperties := []any{
+		slog.Int64("series-id", params.SeriesID),
+		slog.Int64("booking-id", params.BookingID),
+	}
+
+	booking, appErr := h.service.CancelOccurrence(ctx, params.SeriesID, params.BookingID, ToDomainUser(user))
+	if appErr != nil {
+		logFailure("failed cancel series occurrence", "DELETE", traceId, user, appErr.Code, appErr.Error(),
+			bookingProperties...)
+		payload := errorPayload(appErr)
+		switch appErr.Code {
+		case driver.CancelSeriesOccurrenceBadRequestCode:
+			return &driver.CancelSeriesOccurrenceBadRequest{Payload: payload}
+		case driver.CancelSeriesOccurrenceForbiddenCode:
+			return &driver.CancelSeriesOccurrenceForbidden{Payload: payload}
+		case driver.CancelSeriesOccurrenceNotFoundCode:
+			return &driver.CancelSeriesOccurrenceNotFound{Payload: payload}
+		}
+		return errorResponder(appErr)
+	}
+
+	slog.Info(
+		"cancel series occurrence",
+		slog.String("method", "DELETE"),
+		slog.String("trace_id", traceId),
+		userProperties(user),
+		slog.Group("booking-properties", bookingProperties...),
+		slog.Int("status_code", driver.CancelSeriesOccurrenceOKCode),
+	)
+
+	result := new(driver.CancelSeriesOccurrenceOK)
+	result.SetPayload(ToAPIBooking(booking))
+	return result
+}
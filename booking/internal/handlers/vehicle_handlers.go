@@ -0,0 +1,175 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/h4x4d/parking_net/booking/internal/models"
+	"github.com/h4x4d/parking_net/booking/internal/restapi/operations/driver"
+	"github.com/h4x4d/parking_net/booking/internal/restapi/operations/owner"
+	"github.com/h4x4d/parking_net/booking/internal/service"
+	"github.com/h4x4d/parking_net/booking/internal/utils"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/metadata"
+)
+
+type VehicleHandler struct {
+	service *service.VehicleService
+	tracer  trace.Tracer
+}
+
+// NewVehicleHandler uses the global "Booking" tracer, like NewBookingHandler.
+func NewVehicleHandler(svc *service.VehicleService) *VehicleHandler {
+	return &VehicleHandler{
+		service: svc,
+		tracer:  otel.Tracer("Booking"),
+	}
+}
+
+func (h *VehicleHandler) ListVehicles(params driver.ListVehiclesParams, user *models.User) (responder middleware.Responder) {
+	defer utils.CatchPanic(&responder)
+
+	ctx, span := h.tracer.Start(context.Background(), "list vehicles")
+	defer span.End()
+	traceId := fmt.Sprintf("%s", span.SpanContext().TraceID())
+
+	vehicles, appErr := h.service.ListVehicles(ctx, ToDomainUser(user))
+	if appErr != nil {
+		logFailure("failed list vehicles", "GET", traceId, user, appErr.Code, appErr.Error())
+		if appErr.Code == driver.ListVehiclesForbiddenCode {
+			return &driver.ListVehiclesForbidden{Payload: errorPayload(appErr)}
+		}
+		return errorResponder(appErr)
+	}
+
+	slog.Info(
+		"list vehicles",
+		slog.String("method", "GET"),
+		slog.String("trace_id", traceId),
+		userProperties(user),
+		slog.Int("count", len(vehicles)),
+		slog.Int("status_code", driver.ListVehiclesOKCode),
+	)
+
+	result := new(driver.ListVehiclesOK)
+	result.SetPayload(ToAPIVehicleList(vehicles))
+	return result
+}
+
+func (h *VehicleHandler) RegisterVehicle(params driver.RegisterVehicleParams, user *models.User) (responder middleware.Responder) {
+	defer utils.CatchPanic(&responder)
+
+	ctx, span := h.tracer.Start(context.Background(), "register vehicle")
+	defer span.End()
+	traceId := fmt.Sprintf("%s", span.SpanContext().TraceID())
+
+	created, appErr := h.service.RegisterVehicle(ctx, ToDomainVehicle(params.Object), ToDomainUser(user))
+	if appErr != nil {
+		logFailure("failed register vehicle", "POST", traceId, user, appErr.Code, appErr.Error())
+		payload := errorPayload(appErr)
+		switch appErr.Code {
+		case driver.RegisterVehicleBadRequestCode:
+			return &driver.RegisterVehicleBadRequest{Payload: payload}
+		case driver.RegisterVehicleForbiddenCode:
+			return &driver.RegisterVehicleForbidden{Payload: payload}
+		case driver.RegisterVehicleConflictCode:
+			return &driver.RegisterVehicleConflict{Payload: payload}
+		}
+		return errorResponder(appErr)
+	}
+
+	slog.Info(
+		"register vehicle",
+		slog.String("method", "POST"),
+		slog.String("trace_id", traceId),
+		userProperties(user),
+		slog.Int64("vehicle-id", created.ID),
+		slog.String("size", string(created.Size)),
+		slog.Int("status_code", driver.RegisterVehicleOKCode),
+	)
+
+	result := new(driver.RegisterVehicleOK)
+	result.SetPayload(ToAPIVehicle(created))
+	return result
+}
+
+func (h *VehicleHandler) RemoveVehicle(params driver.RemoveVehicleParams, user *models.User) (responder middleware.Responder) {
+	defer utils.CatchPanic(&responder)
+
+	ctx, span := h.tracer.Start(context.Background(), "remove vehicle")
+	defer span.End()
+	traceId := fmt.Sprintf("%s", span.SpanContext().TraceID())
+
+	if appErr := h.service.RemoveVehicle(ctx, params.VehicleID, ToDomainUser(user)); appErr != nil {
+		logFailure("failed remove vehicle", "DELETE", traceId, user, appErr.Code, appErr.Error(),
+			slog.Int64("vehicle-id", params.VehicleID))
+		payload := errorPayload(appErr)
+		switch appErr.Code {
+		case driver.RemoveVehicleForbiddenCode:
+			return &driver.RemoveVehicleForbidden{Payload: payload}
+		case driver.RemoveVehicleNotFoundCode:
+			return &driver.RemoveVehicleNotFound{Payload: payload}
+		case driver.RemoveVehicleConflictCode:
+			return &driver.RemoveVehicleConflict{Payload: payload}
+		}
+		return errorResponder(appErr)
+	}
+
+	slog.Info(
+		"remove vehicle",
+		slog.String("method", "DELETE"),
+		slog.String("trace_id", traceId),
+		userProperties(user),
+		slog.Int64("vehicle-id", params.VehicleID),
+		slog.Int("status_code", driver.RemoveVehicleOKCode),
+	)
+
+	result := new(driver.RemoveVehicleOK)
+	result.SetPayload(&models.Result{
+		Status:  "success",
+		Message: fmt.Sprintf("Vehicle %d removed successfully", params.VehicleID),
+	})
+	return result
+}
+
+func (h *VehicleHandler) FindBookingsByPlate(params owner.FindBookingsByPlateParams, user *models.User) (responder middleware.Responder) {
+	defer utils.CatchPanic(&responder)
+
+	ctx, span := h.tracer.Start(context.Background(), "find bookings by plate")
+	defer span.End()
+	traceId := fmt.Sprintf("%s", span.SpanContext().TraceID())
+	ctx = metadata.AppendToOutgoingContext(ctx, "x-trace-id", traceId)
+
+	found, appErr := h.service.FindBookingsByPlate(ctx, params.Plate, params.ParkingPlaceID, ToDomainUser(user))
+	if appErr != nil {
+		logFailure("failed find bookings by plate", "GET", traceId, user, appErr.Code, appErr.Error(),
+			slog.Any("parking-place-id", params.ParkingPlaceID))
+		payload := errorPayload(appErr)
+		switch appErr.Code {
+		case owner.FindBookingsByPlateBadRequestCode:
+			return &owner.FindBookingsByPlateBadRequest{Payload: payload}
+		case owner.FindBookingsByPlateForbiddenCode:
+			return &owner.FindBookingsByPlateForbidden{Payload: payload}
+		}
+		return errorResponder(appErr)
+	}
+
+	slog.Info(
+		"find bookings by plate",
+		slog.String("method", "GET"),
+		slog.String("trace_id", traceId),
+		userProperties(user),
+		slog.Group("booking-properties",
+			slog.Any("parking-place-id", params.ParkingPlaceID),
+			slog.Int("count", len(found)),
+		),
+		slog.Int("status_code", owner.FindBookingsByPlateOKCode),
+	)
+
+	result := new(owner.FindBookingsByPlateOK)
+	result.SetPayload(ToAPIPlateBookings(found))
+	return result
+}
@@ -0,0 +1,184 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/h4x4d/parking_net/booking/internal/models"
+	"github.com/h4x4d/parking_net/booking/internal/restapi/operations/driver"
+	"github.com/h4x4d/parking_net/booking/internal/service"
+	"github.com/h4x4d/parking_net/booking/internal/utils"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/metadata"
+)
+
+type WaitlistHandler struct {
+	service *service.WaitlistService
+	tracer  trace.Tracer
+}
+
+// NewWaitlistHandler uses the global "Booking" tracer, like NewBookingHandler.
+func NewWaitlistHandler(svc *service.WaitlistService) *WaitlistHandler {
+	return &WaitlistHandler{
+		service: svc,
+		tracer:  otel.Tracer("Booking"),
+	}
+}
+
+func (h *WaitlistHandler) GetWaitlist(params driver.GetWaitlistParams, user *models.User) (responder middleware.Responder) {
+	defer utils.CatchPanic(&responder)
+
+	ctx, span := h.tracer.Start(context.Background(), "get waitlist")
+	defer span.End()
+	traceId := fmt.Sprintf("%s", span.SpanContext().TraceID())
+	ctx = metadata.AppendToOutgoingContext(ctx, "x-trace-id", traceId)
+
+	entries, appErr := h.service.List(ctx, ToDomainUser(user))
+	if appErr != nil {
+		logFailure("failed get waitlist", "GET", traceId, user, appErr.Code, appErr.Error())
+		if appErr.Code == driver.GetWaitlistForbiddenCode {
+			return &driver.GetWaitlistForbidden{Payload: errorPayload(appErr)}
+		}
+		return errorResponder(appErr)
+	}
+
+	slog.Info(
+		"get waitlist",
+		slog.String("method", "GET"),
+		slog.String("trace_id", traceId),
+		userProperties(user),
+		slog.Int("entries", len(entries)),
+		slog.Int("status_code", driver.GetWaitlistOKCode),
+	)
+
+	result := new(driver.GetWaitlistOK)
+	result.SetPayload(ToAPIWaitlist(entries))
+	return result
+}
+
+func (h *WaitlistHandler) JoinWaitlist(params driver.JoinWaitlistParams, user *models.User) (responder middleware.Responder) {
+	defer utils.CatchPanic(&responder)
+
+	ctx, span := h.tracer.Start(context.Background(), "join waitlist")
+	defer span.End()
+	traceId := fmt.Sprintf("%s", span.SpanContext().TraceID())
+	ctx = metadata.AppendToOutgoingContext(ctx, "x-trace-id", traceId)
+
+	entry, appErr := h.service.Join(ctx, ToDomainWaitlistEntry(params.Object), ToDomainUser(user))
+	if appErr != nil {
+		logFailure("failed join waitlist", "POST", traceId, user, appErr.Code, appErr.Error(),
+			slog.Any("parking-place-id", params.Object.ParkingPlaceID),
+			slog.Bool("auto-accept", params.Object.AutoAccept))
+		payload := errorPayload(appErr)
+		switch appErr.Code {
+		case driver.JoinWaitlistBadRequestCode:
+			return &driver.JoinWaitlistBadRequest{Payload: payload}
+		case driver.JoinWaitlistForbiddenCode:
+			return &driver.JoinWaitlistForbidden{Payload: payload}
+		}
+		return errorResponder(appErr)
+	}
+
+	slog.Info(
+		"join waitlist",
+		slog.String("method", "POST"),
+		slog.String("trace_id", traceId),
+		userProperties(user),
+		slog.Group("waitlist-properties",
+			slog.Int64("entry-id", entry.ID),
+			slog.Int64("parking-place-id", entry.ParkingPlaceID),
+			slog.Bool("auto-accept", entry.AutoAccept),
+		),
+		slog.Int("status_code", driver.JoinWaitlistOKCode),
+	)
+
+	result := new(driver.JoinWaitlistOK)
+	result.SetPayload(ToAPIWaitlistEntry(entry))
+	return result
+}
+
+func (h *WaitlistHandler) LeaveWaitlist(params driver.LeaveWaitlistParams, user *models.User) (responder middleware.Responder) {
+	defer utils.CatchPanic(&responder)
+
+	ctx, span := h.tracer.Start(context.Background(), "leave waitlist")
+	defer span.End()
+	traceId := fmt.Sprintf("%s", span.SpanContext().TraceID())
+	ctx = metadata.AppendToOutgoingContext(ctx, "x-trace-id", traceId)
+
+	entry, appErr := h.service.Leave(ctx, params.EntryID, ToDomainUser(user))
+	if appErr != nil {
+		logFailure("failed leave waitlist", "DELETE", traceId, user, appErr.Code, appErr.Error(),
+			slog.Int64("entry-id", params.EntryID))
+		payload := errorPayload(appErr)
+		switch appErr.Code {
+		case driver.LeaveWaitlistBadRequestCode:
+			return &driver.LeaveWaitlistBadRequest{Payload: payload}
+		case driver.LeaveWaitlistForbiddenCode:
+			return &driver.LeaveWaitlistForbidden{Payload: payload}
+		case driver.LeaveWaitlistNotFoundCode:
+			return &driver.LeaveWaitlistNotFound{Payload: payload}
+		}
+		return errorResponder(appErr)
+	}
+
+	slog.Info(
+		"leave waitlist",
+		slog.String("method", "DELETE"),
+		slog.String("trace_id", traceId),
+		userProperties(user),
+		slog.Group("waitlist-properties",
+			slog.Int64("entry-id", entry.ID),
+			slog.Int64("parking-place-id", entry.ParkingPlaceID),
+		),
+		slog.Int("status_code", driver.LeaveWaitlistOKCode),
+	)
+
+	result := new(driver.LeaveWaitlistOK)
+	result.SetPayload(ToAPIWaitlistEntry(entry))
+	return result
+}
+
+func (h *WaitlistHandler) AcceptWaitlistOffer(params driver.AcceptWaitlistOfferParams, user *models.User) (responder middleware.Responder) {
+	defer utils.CatchPanic(&responder)
+
+	ctx, span := h.tracer.Start(context.Background(), "accept waitlist offer")
+	defer span.End()
+	traceId := fmt.Sprintf("%s", span.SpanContext().TraceID())
+	ctx = metadata.AppendToOutgoingContext(ctx, "x-trace-id", traceId)
+
+	entry, appErr := h.service.AcceptOffer(ctx, params.EntryID, ToDomainUser(user))
+	if appErr != nil {
+		logFailure("failed accept waitlist offer", "POST", traceId, user, appErr.Code, appErr.Error(),
+			slog.Int64("entry-id", params.EntryID))
+		payload := errorPayload(appErr)
+		switch appErr.Code {
+		case driver.AcceptWaitlistOfferBadRequestCode:
+			return &driver.AcceptWaitlistOfferBadRequest{Payload: payload}
+		case driver.AcceptWaitlistOfferForbiddenCode:
+			return &driver.AcceptWaitlistOfferForbidden{Payload: payload}
+		case driver.AcceptWaitlistOfferNotFoundCode:
+			return &driver.AcceptWaitlistOfferNotFound{Payload: payload}
+		}
+		return errorResponder(appErr)
+	}
+
+	slog.Info(
+		"accept waitlist offer",
+		slog.String("method", "POST"),
+		slog.String("trace_id", traceId),
+		userProperties(user),
+		slog.Group("waitlist-properties",
+			slog.Int64("entry-id", entry.ID),
+			slog.Int64("parking-place-id", entry.ParkingPlaceID),
+			slog.Int64("booking-id", entry.BookingID),
+		),
+		slog.Int("status_code", driver.AcceptWaitlistOfferOKCode),
+	)
+
+	result := new(driver.AcceptWaitlistOfferOK)
+	result.SetPayload(ToAPIWaitlistEntry(entry))
+	return result
+}
@@ -23,6 +23,14 @@ type Booking struct {
 	// booking id
 	BookingID int64 `json:"booking_id,omitempty"`
 
+	// actual arrival of the driver
+	// Format: date-time
+	CheckedInAt *strfmt.DateTime `json:"checked_in_at,omitempty"`
+
+	// actual departure of the driver
+	// Format: date-time
+	CheckedOutAt *strfmt.DateTime `json:"checked_out_at,omitempty"`
+
 	// date from
 	// Example: 2024-12-31T10:00:00Z
 	// Required: true
@@ -38,22 +46,39 @@ type Booking struct {
 	// full cost
 	FullCost int64 `json:"full_cost,omitempty"`
 
+	// amount billed for staying past date_to
+	OvertimeCost int64 `json:"overtime_cost,omitempty"`
+
 	// parking place id
 	// Required: true
 	ParkingPlaceID *int64 `json:"parking_place_id"`
 
+	// booking series the booking belongs to, if any
+	SeriesID int64 `json:"series_id,omitempty"`
+
 	// status of booking
-	// Enum: ["Waiting","Confirmed","Canceled"]
+	// Enum: ["Waiting","Confirmed","Active","Completed","Canceled","Expired","NoShow"]
 	Status string `json:"status,omitempty"`
 
 	// user id
 	UserID string `json:"user_id,omitempty"`
+
+	// vehicle that parks; may be left out by drivers with a single vehicle
+	VehicleID int64 `json:"vehicle_id,omitempty"`
 }
 
 // Validate validates this booking
 func (m *Booking) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateCheckedInAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCheckedOutAt(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateDateFrom(formats); err != nil {
 		res = append(res, err)
 	}
@@ -76,6 +101,30 @@ func (m *Booking) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Booking) validateCheckedInAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.CheckedInAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("checked_in_at", "body", "date-time", m.CheckedInAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Booking) validateCheckedOutAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.CheckedOutAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("checked_out_at", "body", "date-time", m.CheckedOutAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Booking) validateDateFrom(formats strfmt.Registry) error {
 
 	if err := validate.Required("date_from", "body", m.DateFrom); err != nil {
@@ -115,7 +164,7 @@ var bookingTypeStatusPropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["Waiting","Confirmed","Canceled"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["Waiting","Confirmed","Active","Completed","Canceled","Expired","NoShow"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -131,8 +180,20 @@ const (
 	// BookingStatusConfirmed captures enum value "Confirmed"
 	BookingStatusConfirmed string = "Confirmed"
 
+	// BookingStatusActive captures enum value "Active"
+	BookingStatusActive string = "Active"
+
+	// BookingStatusCompleted captures enum value "Completed"
+	BookingStatusCompleted string = "Completed"
+
 	// BookingStatusCanceled captures enum value "Canceled"
 	BookingStatusCanceled string = "Canceled"
+
+	// BookingStatusExpired captures enum value "Expired"
+	BookingStatusExpired string = "Expired"
+
+	// BookingStatusNoShow captures enum value "NoShow"
+	BookingStatusNoShow string = "NoShow"
 )
 
 // prop value enum
@@ -8,6 +8,7 @@ package models
 import (
 	"context"
 	"encoding/json"
+	"strconv"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
@@ -25,6 +26,9 @@ type ParkingPlace struct {
 	// Required: true
 	Address *string `json:"address"`
 
+	// vehicle sizes the place takes; empty takes every size
+	AllowedVehicleSizes []string `json:"allowed_vehicle_sizes,omitempty"`
+
 	// total number of parking spots
 	Capacity int64 `json:"capacity,omitempty"`
 
@@ -50,6 +54,10 @@ type ParkingPlace struct {
 	// type of parking facility
 	// Enum: ["outdoor","covered","underground","multi-level"]
 	ParkingType string `json:"parking_type,omitempty"`
+
+	// only Active parking places take bookings
+	// Enum: ["Active","Suspended","Closing"]
+	Status string `json:"status,omitempty"`
 }
 
 // Validate validates this parking place
@@ -60,6 +68,10 @@ func (m *ParkingPlace) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateAllowedVehicleSizes(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateCity(formats); err != nil {
 		res = append(res, err)
 	}
@@ -72,6 +84,10 @@ func (m *ParkingPlace) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -87,6 +103,42 @@ func (m *ParkingPlace) validateAddress(formats strfmt.Registry) error {
 	return nil
 }
 
+var parkingPlaceAllowedVehicleSizesItemsEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["motorcycle","small","medium","large"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		parkingPlaceAllowedVehicleSizesItemsEnum = append(parkingPlaceAllowedVehicleSizesItemsEnum, v)
+	}
+}
+
+func (m *ParkingPlace) validateAllowedVehicleSizesItemsEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, parkingPlaceAllowedVehicleSizesItemsEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ParkingPlace) validateAllowedVehicleSizes(formats strfmt.Registry) error {
+	if swag.IsZero(m.AllowedVehicleSizes) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.AllowedVehicleSizes); i++ {
+
+		// value enum
+		if err := m.validateAllowedVehicleSizesItemsEnum("allowed_vehicle_sizes"+"."+strconv.Itoa(i), "body", m.AllowedVehicleSizes[i]); err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+}
+
 func (m *ParkingPlace) validateCity(formats strfmt.Registry) error {
 
 	if err := validate.Required("city", "body", m.City); err != nil {
@@ -153,6 +205,51 @@ func (m *ParkingPlace) validateParkingType(formats strfmt.Registry) error {
 	return nil
 }
 
+var parkingPlaceTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["Active","Suspended","Closing"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		parkingPlaceTypeStatusPropEnum = append(parkingPlaceTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// ParkingPlaceStatusActive captures enum value "Active"
+	ParkingPlaceStatusActive string = "Active"
+
+	// ParkingPlaceStatusSuspended captures enum value "Suspended"
+	ParkingPlaceStatusSuspended string = "Suspended"
+
+	// ParkingPlaceStatusClosing captures enum value "Closing"
+	ParkingPlaceStatusClosing string = "Closing"
+)
+
+// prop value enum
+func (m *ParkingPlace) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, parkingPlaceTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ParkingPlace) validateStatus(formats strfmt.Registry) error {
+	if swag.IsZero(m.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateStatusEnum("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // ContextValidate validates this parking place based on context it is used
 func (m *ParkingPlace) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
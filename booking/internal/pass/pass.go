@@ -0,0 +1,118 @@
+// Package pass issues and verifies entry passes, the signed tokens drivers show
+// at the gate as a QR code.
+package pass
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/h4x4d/parking_net/pkg/domain"
+	"github.com/skip2/go-qrcode"
+)
+
+const issuer = "parking-net-booking"
+
+// clockSkew is how far the clock of gate hardware may be off.
+const clockSkew = time.Minute
+
+const qrSize = 256
+
+// Claims of a pass. The subject is the booking ID and the ID tells passes of
+// the same booking apart, so a used one can be refused.
+type Claims struct {
+	ParkingPlaceID int64 `json:"pid"`
+	// Revision of the booking the pass was issued for; a pass of a booking
+	// that changed since is outdated.
+	Revision int64 `json:"rev"`
+	jwt.RegisteredClaims
+}
+
+func (c *Claims) BookingID() (int64, error) {
+	return strconv.ParseInt(c.Subject, 10, 64)
+}
+
+// Booking is what a pass is issued for.
+type Booking struct {
+	ID             int64
+	ParkingPlaceID int64
+	Revision       int64
+	DateFrom       time.Time
+	DateTo         time.Time
+}
+
+// Signer signs passes with HMAC-SHA256.
+type Signer struct {
+	secret []byte
+}
+
+func NewSigner(secret []byte) *Signer {
+	return &Signer{secret: secret}
+}
+
+// Issue returns a pass valid from the opening of check-in until the booking
+// ends.
+func (s *Signer) Issue(booking Booking, now time.Time) (string, *Claims, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", nil, fmt.Errorf("failed to generate pass id: %w", err)
+	}
+	claims := &Claims{
+		ParkingPlaceID: booking.ParkingPlaceID,
+		Revision:       booking.Revision,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        hex.EncodeToString(id),
+			Issuer:    issuer,
+			Subject:   strconv.FormatInt(booking.ID, 10),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(booking.DateFrom.Add(-domain.EarlyCheckIn)),
+			ExpiresAt: jwt.NewNumericDate(booking.DateTo),
+		},
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(s.secret)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to sign pass: %w", err)
+	}
+	return token, claims, nil
+}
+
+// Verify checks the signature and time window of the pass at now. Outside the
+// window it fails with the domain check-in errors, otherwise with
+// domain.ErrEntryPassInvalid.
+func (s *Signer) Verify(token string, now time.Time) (*Claims, error) {
+	claims := new(Claims)
+	_, err := jwt.ParseWithClaims(token, claims, func(*jwt.Token) (interface{}, error) {
+		return s.secret, nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(issuer),
+		jwt.WithLeeway(clockSkew),
+		jwt.WithTimeFunc(func() time.Time { return now }),
+	)
+	switch {
+	case errors.Is(err, jwt.ErrTokenNotValidYet):
+		return nil, domain.ErrCheckInTooEarly
+	case errors.Is(err, jwt.ErrTokenExpired):
+		return nil, domain.ErrCheckInClosed
+	case err != nil:
+		return nil, domain.ErrEntryPassInvalid
+	}
+	if _, err := claims.BookingID(); err != nil || claims.ID == "" {
+		return nil, domain.ErrEntryPassInvalid
+	}
+	return claims, nil
+}
+
+// QRCode renders the pass as a PNG data URI.
+func QRCode(token string) (string, error) {
+	png, err := qrcode.Encode(token, qrcode.Medium, qrSize)
+	if err != nil {
+		return "", fmt.Errorf("failed to render QR code: %w", err)
+	}
+	return "data:image/png;base64," + base64.StdEncoding.EncodeToString(png), nil
+}
@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/h4x4d/parking_net/booking/internal/database_service"
+	"github.com/h4x4d/parking_net/booking/internal/pass"
+	"github.com/h4x4d/parking_net/pkg/domain"
+)
+
+// PostgresAttendanceRepository records check-ins and check-outs through the
+// database service.
+type PostgresAttendanceRepository struct {
+	db *database_service.DatabaseService
+}
+
+func NewPostgresAttendanceRepository(db *database_service.DatabaseService) AttendanceRepository {
+	return &PostgresAttendanceRepository{db: db}
+}
+
+func (r *PostgresAttendanceRepository) CheckIn(ctx context.Context, bookingID int64, now time.Time) error {
+	return r.db.CheckIn(ctx, bookingID, now)
+}
+
+func (r *PostgresAttendanceRepository) CheckOut(ctx context.Context, current *domain.Booking,
+	checkOut CheckOut) error {
+	return r.db.CheckOut(ctx, current.ID, database_service.BookingCheckOut{
+		At:             checkOut.At,
+		ExpectedDateTo: current.DateTo,
+		OvertimeCost:   checkOut.OvertimeCost,
+		OwnerID:        checkOut.OwnerID,
+	})
+}
+
+func (r *PostgresAttendanceRepository) GetPassBooking(ctx context.Context, bookingID int64) (*PassBooking, error) {
+	return r.db.GetPassBooking(ctx, bookingID)
+}
+
+func (r *PostgresAttendanceRepository) UseEntryPass(ctx context.Context, claims *pass.Claims, parkingPlaceID int64,
+	checkIn bool, now time.Time) error {
+	return r.db.UseEntryPass(ctx, claims, parkingPlaceID, checkIn, now)
+}
@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/h4x4d/parking_net/booking/internal/database_service"
+	"github.com/h4x4d/parking_net/pkg/domain"
+)
+
+// PostgresCalendarRepository stores calendar feeds through the database
+// service.
+type PostgresCalendarRepository struct {
+	db *database_service.DatabaseService
+}
+
+func NewPostgresCalendarRepository(db *database_service.DatabaseService) CalendarRepository {
+	return &PostgresCalendarRepository{db: db}
+}
+
+func (r *PostgresCalendarRepository) CreateFeed(ctx context.Context, userID string,
+	role domain.UserRole) (string, time.Time, error) {
+	return r.db.CreateCalendarFeed(ctx, userID, string(role))
+}
+
+func (r *PostgresCalendarRepository) RevokeFeed(ctx context.Context, userID string) (bool, error) {
+	return r.db.RevokeCalendarFeed(ctx, userID)
+}
+
+func (r *PostgresCalendarRepository) GetFeed(ctx context.Context, token string) (*CalendarFeed, error) {
+	return r.db.GetCalendarFeed(ctx, token)
+}
+
+func (r *PostgresCalendarRepository) Bookings(ctx context.Context, userID *string, parkingPlaceIDs []int64,
+	since time.Time) ([]*CalendarBooking, error) {
+	return r.db.GetCalendarBookings(ctx, userID, parkingPlaceIDs, since)
+}
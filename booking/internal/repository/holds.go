@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/h4x4d/parking_net/booking/internal/database_service"
+	"github.com/h4x4d/parking_net/pkg/domain"
+)
+
+// PostgresHoldRepository stores holds through the database service, which
+// checks them against the capacity of the place in the same transaction.
+type PostgresHoldRepository struct {
+	db *database_service.DatabaseService
+}
+
+func NewPostgresHoldRepository(db *database_service.DatabaseService) HoldRepository {
+	return &PostgresHoldRepository{db: db}
+}
+
+func (r *PostgresHoldRepository) Create(ctx context.Context, hold *domain.BookingHold, place *domain.ParkingPlace,
+	ttl time.Duration) (*domain.BookingHold, error) {
+	return r.db.CreateHold(ctx, hold, int64(place.Capacity), ttl)
+}
+
+func (r *PostgresHoldRepository) Availability(ctx context.Context, place *domain.ParkingPlace, dateFrom,
+	dateTo time.Time, step time.Duration) ([]*domain.AvailabilitySlot, error) {
+	return r.db.GetAvailability(ctx, place.ID, int64(place.Capacity), dateFrom, dateTo, step)
+}
@@ -2,24 +2,230 @@ package repository
 
 import (
 	"context"
+	"time"
+
+	"github.com/h4x4d/parking_net/booking/internal/database_service"
+	"github.com/h4x4d/parking_net/booking/internal/pass"
 	"github.com/h4x4d/parking_net/pkg/domain"
 )
 
-// BookingRepository defines the interface for booking data access
+// BookingRepository defines the interface for booking data access. Every write
+// stores the payment commands it implies in the same transaction, and Update
+// and Delete only apply to a booking that is still as the caller read it,
+// failing with utils.ErrBookingChanged otherwise.
 type BookingRepository interface {
-	Create(ctx context.Context, booking *domain.Booking) (*domain.Booking, error)
+	// Create stores booking as Waiting at place, which must have a free spot
+	// for the period, and enqueues the charge of its driver.
+	Create(ctx context.Context, booking *domain.Booking, place *domain.ParkingPlace, opts CreateOptions) (*domain.Booking, error)
+	// GetByID returns nil without an error when the booking does not exist.
 	GetByID(ctx context.Context, id int64) (*domain.Booking, error)
-	GetAll(ctx context.Context, filters BookingFilters) ([]*domain.Booking, error)
-	Update(ctx context.Context, booking *domain.Booking) error
-	Delete(ctx context.Context, id int64) error
-	GetByParkingPlaceID(ctx context.Context, parkingPlaceID int64) ([]*domain.Booking, error)
-	GetByUserID(ctx context.Context, userID string) ([]*domain.Booking, error)
+	// GetAll returns a page of bookings and the cursor of the next page, which
+	// is empty on the last page.
+	GetAll(ctx context.Context, filters BookingFilters) ([]*domain.Booking, string, error)
+	Update(ctx context.Context, current *domain.Booking, update BookingUpdate) (*domain.Booking, error)
+	// Extend moves the end of current to that of extended, which must leave a
+	// spot of place free for the added time, enqueues the charge of the cost
+	// difference and notifies the driver and the owner of place once it is paid.
+	Extend(ctx context.Context, current *domain.Booking, extended *domain.Booking,
+		place *domain.ParkingPlace) (*domain.Booking, error)
+	Delete(ctx context.Context, current *domain.Booking, cancellation *Cancellation) error
+	// History returns the events of the booking, oldest first, including those
+	// of a deleted booking. Writes record their events attributed to the actor
+	// set on ctx with audit.WithActor.
+	History(ctx context.Context, id int64) ([]*domain.BookingEvent, error)
 }
 
-// BookingFilters represents filters for querying bookings
+// BookingFilters represents filters for querying bookings. Nil and empty
+// fields do not filter, except that an empty non-nil ParkingPlaceIDs or
+// VehicleIDs matches nothing.
 type BookingFilters struct {
-	ParkingPlaceID *int64
-	UserID         *string
-	Status         *domain.BookingStatus
+	ParkingPlaceIDs []int64
+	VehicleIDs      []int64
+	UserID          *string
+	Statuses        []domain.BookingStatus
+	// DateFrom and DateTo keep the bookings that overlap [DateFrom, DateTo).
+	DateFrom *time.Time
+	DateTo   *time.Time
+	Sort     string
+	Limit    int64
+	Cursor   string
+}
+
+// Sort keys of BookingFilters.
+const (
+	SortDateFrom      = "date_from"
+	SortDateFromDesc  = "-date_from"
+	SortCreatedAt     = "created_at"
+	SortCreatedAtDesc = "-created_at"
+)
+
+type CreateOptions struct {
+	// HoldID, when non-zero, books the spot reserved by that hold of the driver.
+	HoldID int64
+	// IdempotencyKey makes a retried create return the booking of the first attempt.
+	IdempotencyKey *string
+}
+
+// BookingUpdate is the new state of a booking and what it takes to apply it.
+type BookingUpdate struct {
+	Booking *domain.Booking
+	// Place is the parking place of Booking. It is required when the booking
+	// moves to another place or time, to check capacity and settle the price.
+	Place *domain.ParkingPlace
+	// PreviousOwnerID owns the parking place the booking is moved away from.
+	PreviousOwnerID string
+	// Cancellation is set when the update cancels a paid booking.
+	Cancellation *Cancellation
+}
+
+// Cancellation is the refund decision for a paid booking: the cancellation
+// policy version it follows and the share of the price kept as a fee.
+type Cancellation struct {
+	PolicyVersion int64
+	FeePercent    int64
 }
 
+// VehicleRepository stores the vehicles of drivers. Removed vehicles are kept
+// for the bookings that refer to them but are no longer returned by Get, List
+// or Resolve.
+type VehicleRepository interface {
+	Create(ctx context.Context, vehicle *domain.Vehicle) (*domain.Vehicle, error)
+	// Get returns nil without an error when the vehicle does not exist.
+	Get(ctx context.Context, id int64) (*domain.Vehicle, error)
+	List(ctx context.Context, userID string) ([]*domain.Vehicle, error)
+	// FindByPlate also returns removed vehicles, so past bookings of a plate
+	// can still be found.
+	FindByPlate(ctx context.Context, plate string) ([]*domain.Vehicle, error)
+	// Delete fails with utils.ErrVehicleInUse while the vehicle has bookings
+	// holding a spot or open waitlist entries.
+	Delete(ctx context.Context, id int64) error
+	// Resolve returns the vehicle of the driver a booking is made for; a zero
+	// vehicleID picks the driver's only vehicle.
+	Resolve(ctx context.Context, userID string, vehicleID int64) (*domain.Vehicle, error)
+}
+
+// HoldRepository stores booking holds and tells how booked parking places are.
+// Spots of bookings, live holds and open waitlist offers all count as taken.
+type HoldRepository interface {
+	// Create reserves a spot of place for the driver of hold until ttl from now.
+	// It fails with utils.ErrNoFreeSpots when the period of hold is fully booked
+	// and with utils.ErrTooManyHolds when the driver already holds
+	// utils.MaxActiveHolds spots.
+	Create(ctx context.Context, hold *domain.BookingHold, place *domain.ParkingPlace,
+		ttl time.Duration) (*domain.BookingHold, error)
+	// Availability splits [dateFrom, dateTo) into slots of the given length,
+	// the last one cut at dateTo.
+	Availability(ctx context.Context, place *domain.ParkingPlace, dateFrom, dateTo time.Time,
+		step time.Duration) ([]*domain.AvailabilitySlot, error)
+}
+
+// SeriesRepository stores booking series. Their occurrences are bookings and
+// are read and changed through the BookingRepository like any other.
+type SeriesRepository interface {
+	// Create stores series and books each of occurrences, already priced, that
+	// still has a free spot at place. The charges are enqueued as the billing of
+	// the series says: per occurrence, the first booking is charged right away
+	// and every other one domain.OccurrenceChargeLead before it starts; up
+	// front, a single charge with the first booking pays for all of them. The
+	// occurrences without a spot are returned as Conflicts; when none could be
+	// booked it fails with utils.ErrNoFreeSpots.
+	Create(ctx context.Context, series *domain.BookingSeries, occurrences []*domain.Booking,
+		place *domain.ParkingPlace) (*domain.BookingSeries, error)
+	// Get returns nil without an error when the series does not exist.
+	Get(ctx context.Context, id int64) (*domain.BookingSeries, error)
+	// Cancel cancels the series and its occurrences that have not started,
+	// enqueuing the refunds of paid ones as cancellations, keyed by booking ID,
+	// decides, and returns the IDs of the canceled bookings. It fails with
+	// utils.ErrBookingChanged when a paid occurrence has no cancellation.
+	Cancel(ctx context.Context, id int64, cancellations map[int64]Cancellation) ([]int64, error)
+	// CancelOccurrence cancels one booking of the series; cancellation is set
+	// when the booking is paid. It fails with domain.ErrInvalidStatusTransition
+	// when the booking can no longer be canceled and with
+	// utils.ErrBookingChanged when it is paid but cancellation is nil.
+	CancelOccurrence(ctx context.Context, seriesID int64, bookingID int64, cancellation *Cancellation) error
+}
+
+// CheckOut is a check-out the service priced: the driver left at At and owes
+// OvertimeCost to OwnerID, the owner of the place, for the time past the end
+// of the booking.
+type CheckOut struct {
+	At           time.Time
+	OvertimeCost int64
+	OwnerID      string
+}
+
+// PassBooking is a booking as an entry pass sees it.
+type PassBooking = database_service.PassBooking
+
+// AttendanceRepository records drivers arriving at and leaving their bookings.
+type AttendanceRepository interface {
+	// CheckIn moves the Confirmed booking to Active at now. It fails with
+	// domain.ErrInvalidStatusTransition for any other status and with the
+	// domain check-in errors outside the check-in window.
+	CheckIn(ctx context.Context, bookingID int64, now time.Time) error
+	// CheckOut completes the Active booking as checkOut says and enqueues the
+	// charge of its overtime. It fails with domain.ErrInvalidStatusTransition
+	// for any other status and with utils.ErrBookingChanged when the end of the
+	// booking moved since current was read.
+	CheckOut(ctx context.Context, current *domain.Booking, checkOut CheckOut) error
+	// GetPassBooking returns nil without an error when the booking does not
+	// exist.
+	GetPassBooking(ctx context.Context, bookingID int64) (*PassBooking, error)
+	// UseEntryPass checks the pass shown at the gate of the parking place
+	// against the current booking and, with checkIn, checks the booking in and
+	// spends the pass. It fails with the domain entry pass and check-in errors
+	// when the pass does not let the vehicle in.
+	UseEntryPass(ctx context.Context, claims *pass.Claims, parkingPlaceID int64, checkIn bool, now time.Time) error
+}
+
+// WaitlistRepository queues drivers for spots of full parking places.
+type WaitlistRepository interface {
+	// Join fails with utils.ErrTooManyWaitlistEntries when the driver already
+	// has utils.MaxWaitlistEntries open entries.
+	Join(ctx context.Context, entry *domain.WaitlistEntry) (*domain.WaitlistEntry, error)
+	// List returns the entries of the driver, newest first.
+	List(ctx context.Context, userID string) ([]*domain.WaitlistEntry, error)
+	// Get returns nil without an error when the entry does not exist.
+	Get(ctx context.Context, id int64) (*domain.WaitlistEntry, error)
+	// Leave fails with utils.ErrWaitlistEntryClosed unless the entry is Queued
+	// or Offered.
+	Leave(ctx context.Context, id int64) (*domain.WaitlistEntry, error)
+	// AcceptOffer books the spot the open offer of the entry reserved, as the
+	// priced booking. It fails with utils.ErrNoWaitlistOffer when the offer
+	// expired and with utils.ErrNoFreeSpots when the place is full anyway.
+	AcceptOffer(ctx context.Context, id int64, booking *domain.Booking,
+		place *domain.ParkingPlace) (*domain.WaitlistEntry, error)
+	// Expire closes the entries whose window has started at now and the
+	// offers that were not accepted in time, and returns the expired offers.
+	Expire(ctx context.Context, now time.Time) ([]*domain.WaitlistEntry, error)
+	// Queued returns up to limit Queued entries in the order they joined.
+	Queued(ctx context.Context, limit int) ([]*domain.WaitlistEntry, error)
+	// Match hands a free spot at place to the Queued entry: with booking,
+	// already priced, the spot is booked and charged right away; without, the
+	// entry gets an offer that reserves it until offerExpiresAt. It returns nil
+	// without an error when the entry is no longer Queued or its window has no
+	// free spot.
+	Match(ctx context.Context, entry *domain.WaitlistEntry, booking *domain.Booking, place *domain.ParkingPlace,
+		offerExpiresAt time.Time) (*domain.WaitlistEntry, error)
+}
+
+// CalendarFeed is the user a calendar feed token belongs to.
+type CalendarFeed = database_service.CalendarFeed
+
+// CalendarBooking is a booking as a calendar feed shows it.
+type CalendarBooking = database_service.CalendarBooking
+
+// CalendarRepository stores the calendar feed tokens of users. Only the hash
+// of a token is kept, so a lost token cannot be shown again.
+type CalendarRepository interface {
+	// CreateFeed issues a new token for the user and revokes the one issued
+	// before.
+	CreateFeed(ctx context.Context, userID string, role domain.UserRole) (string, time.Time, error)
+	// RevokeFeed reports false when the user had no feed.
+	RevokeFeed(ctx context.Context, userID string) (bool, error)
+	// GetFeed returns nil without an error for an unknown or revoked token.
+	GetFeed(ctx context.Context, token string) (*CalendarFeed, error)
+	// Bookings returns the bookings of every status that end after since,
+	// of the driver userID and at parkingPlaceIDs; either may be nil.
+	Bookings(ctx context.Context, userID *string, parkingPlaceIDs []int64, since time.Time) ([]*CalendarBooking, error)
+}
@@ -0,0 +1,824 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/h4x4d/parking_net/booking/internal/audit"
+	"github.com/h4x4d/parking_net/booking/internal/database_service"
+	"github.com/h4x4d/parking_net/booking/internal/utils"
+	"github.com/h4x4d/parking_net/pkg/domain"
+)
+
+// MemoryBookingRepository keeps bookings in memory, so the booking rules can be
+// exercised without Postgres. It enforces capacity, holds, idempotency keys and
+// stale writes like the Postgres repository, and records the payment commands
+// it would have written to the outbox as Payments and the history of every
+// booking.
+type MemoryBookingRepository struct {
+	mu       sync.Mutex
+	bookings map[int64]*domain.Booking
+	holds    map[int64]*Hold
+	keys     map[string]idempotentCreate
+	payments []*Payment
+	events   []*domain.BookingEvent
+	series   map[int64]*domain.BookingSeries
+	nextID   int64
+}
+
+// Hold is a spot reserved for a driver, as created by the hold endpoint.
+type Hold struct {
+	ID             int64
+	UserID         string
+	ParkingPlaceID int64
+	DateFrom       time.Time
+	DateTo         time.Time
+	ExpiresAt      time.Time
+	Used           bool
+}
+
+// Payment is a payment command recorded in place of an outbox message. Amount
+// is the price of a charge and the delta of an adjust; Change is set on the
+// adjust that is rolled back if the driver cannot pay it. A charge with
+// Occurrences pays for every listed booking of an upfront series, and a charge
+// with DueAt set is not to be delivered before then.
+type Payment struct {
+	ID          int64
+	BookingID   int64
+	Command     database_service.OutboxCommand
+	DriverID    string
+	OwnerID     string
+	Amount      int64
+	FeePercent  int64
+	DependsOn   int64
+	Change      *database_service.BookingChange
+	Occurrences []database_service.OccurrenceCharge
+	DueAt       time.Time
+	Done        bool
+}
+
+type idempotentCreate struct {
+	request   string
+	bookingID int64
+}
+
+func NewMemoryBookingRepository() *MemoryBookingRepository {
+	return &MemoryBookingRepository{
+		bookings: make(map[int64]*domain.Booking),
+		holds:    make(map[int64]*Hold),
+		keys:     make(map[string]idempotentCreate),
+		series:   make(map[int64]*domain.BookingSeries),
+	}
+}
+
+func (r *MemoryBookingRepository) Create(ctx context.Context, booking *domain.Booking, place *domain.ParkingPlace,
+	opts CreateOptions) (*domain.Booking, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	request := fmt.Sprintf("%s|%s|%d|%d|%d", booking.DateFrom.UTC().Format(time.RFC3339Nano),
+		booking.DateTo.UTC().Format(time.RFC3339Nano), booking.ParkingPlaceID, booking.VehicleID, opts.HoldID)
+	key := ""
+	if opts.IdempotencyKey != nil {
+		key = booking.UserID + "|" + *opts.IdempotencyKey
+		if existing, ok := r.keys[key]; ok {
+			if existing.request != request {
+				return nil, utils.ErrIdempotencyKeyReused
+			}
+			return clone(r.bookings[existing.bookingID]), nil
+		}
+	}
+
+	var hold *Hold
+	if opts.HoldID != 0 {
+		hold = r.holds[opts.HoldID]
+		if hold == nil || hold.Used || !hold.ExpiresAt.After(time.Now()) || hold.UserID != booking.UserID ||
+			hold.ParkingPlaceID != booking.ParkingPlaceID || hold.DateFrom.After(booking.DateFrom) ||
+			hold.DateTo.Before(booking.DateTo) {
+			return nil, utils.ErrInvalidHold
+		}
+		// The booking takes over the spot of its hold.
+		hold.Used = true
+	}
+	if err := r.checkCapacity(booking, int64(place.Capacity), 0); err != nil {
+		if hold != nil {
+			hold.Used = false
+		}
+		return nil, err
+	}
+
+	r.nextID++
+	created := clone(booking)
+	created.ID = r.nextID
+	created.Status = domain.BookingStatusWaiting
+	r.bookings[created.ID] = created
+	r.recordEvent(ctx, created, domain.BookingEventCreated, nil, eventValues(created))
+	r.record(&Payment{
+		BookingID: created.ID,
+		Command:   database_service.OutboxCharge,
+		DriverID:  created.UserID,
+		OwnerID:   place.OwnerID,
+		Amount:    created.FullCost,
+	})
+	if key != "" {
+		r.keys[key] = idempotentCreate{request: request, bookingID: created.ID}
+	}
+	return clone(created), nil
+}
+
+func (r *MemoryBookingRepository) GetByID(ctx context.Context, id int64) (*domain.Booking, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return clone(r.bookings[id]), nil
+}
+
+func (r *MemoryBookingRepository) History(ctx context.Context, id int64) ([]*domain.BookingEvent, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	history := make([]*domain.BookingEvent, 0)
+	for _, event := range r.events {
+		if event.BookingID == id {
+			copied := *event
+			history = append(history, &copied)
+		}
+	}
+	return history, nil
+}
+
+func (r *MemoryBookingRepository) GetAll(ctx context.Context, filters BookingFilters) ([]*domain.Booking, string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if filters.Limit <= 0 || filters.Limit > utils.MaxBookingsPage {
+		filters.Limit = utils.DefaultBookingsPage
+	}
+	if filters.Sort == "" {
+		filters.Sort = SortCreatedAtDesc
+	}
+	var less func(a, b *domain.Booking) bool
+	switch filters.Sort {
+	case SortDateFrom:
+		less = func(a, b *domain.Booking) bool {
+			return a.DateFrom.Before(b.DateFrom) || a.DateFrom.Equal(b.DateFrom) && a.ID < b.ID
+		}
+	case SortDateFromDesc:
+		less = func(a, b *domain.Booking) bool {
+			return a.DateFrom.After(b.DateFrom) || a.DateFrom.Equal(b.DateFrom) && a.ID > b.ID
+		}
+	case SortCreatedAt:
+		less = func(a, b *domain.Booking) bool { return a.ID < b.ID }
+	case SortCreatedAtDesc:
+		less = func(a, b *domain.Booking) bool { return a.ID > b.ID }
+	default:
+		return nil, "", fmt.Errorf("%w: %q", utils.ErrInvalidSort, filters.Sort)
+	}
+
+	matched := make([]*domain.Booking, 0)
+	for _, booking := range r.bookings {
+		if matches(booking, filters) {
+			matched = append(matched, booking)
+		}
+	}
+	sort.Slice(matched, func(i, j int) bool { return less(matched[i], matched[j]) })
+
+	// The cursor is the sort key and the ID of the last booking of the page.
+	if filters.Cursor != "" {
+		sortKey, rawID, _ := strings.Cut(filters.Cursor, ":")
+		lastID, err := strconv.ParseInt(rawID, 10, 64)
+		last := r.bookings[lastID]
+		if err != nil || sortKey != filters.Sort || last == nil {
+			return nil, "", utils.ErrInvalidCursor
+		}
+		start := sort.Search(len(matched), func(i int) bool { return less(last, matched[i]) })
+		matched = matched[start:]
+	}
+
+	next := ""
+	if int64(len(matched)) > filters.Limit {
+		matched = matched[:filters.Limit]
+		next = fmt.Sprintf("%s:%d", filters.Sort, matched[len(matched)-1].ID)
+	}
+	page := make([]*domain.Booking, 0, len(matched))
+	for _, booking := range matched {
+		page = append(page, clone(booking))
+	}
+	return page, next, nil
+}
+
+func (r *MemoryBookingRepository) Update(ctx context.Context, current *domain.Booking,
+	update BookingUpdate) (*domain.Booking, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored := r.bookings[current.ID]
+	if stored == nil {
+		return nil, domain.ErrBookingNotFound
+	}
+	if !toTerms(stored).Equal(toTerms(current)) || stored.Status != current.Status {
+		return nil, utils.ErrBookingChanged
+	}
+
+	next := clone(update.Booking)
+	next.ID = stored.ID
+	if !toTerms(stored).Equal(toTerms(next)) && next.Status.OccupiesSpot() {
+		if err := r.checkCapacity(next, int64(update.Place.Capacity), next.ID); err != nil {
+			return nil, err
+		}
+		if stored.Status != domain.BookingStatusWaiting || !r.repriceDeferred(next, update.Place.OwnerID) {
+			r.recordAdjustment(stored, next, update.PreviousOwnerID, update.Place.OwnerID)
+		}
+	}
+	r.bookings[next.ID] = next
+	if toTerms(stored).Equal(toTerms(next)) && stored.UserID == next.UserID && stored.Status != next.Status {
+		r.recordEvent(ctx, next, domain.BookingEventStatusChanged, map[string]any{"status": stored.Status},
+			map[string]any{"status": next.Status})
+	} else {
+		r.recordEvent(ctx, next, domain.BookingEventModified, eventValues(stored), eventValues(next))
+	}
+	if update.Cancellation != nil {
+		r.recordRefund(ctx, stored, *update.Cancellation)
+	}
+	return clone(next), nil
+}
+
+func (r *MemoryBookingRepository) Extend(ctx context.Context, current *domain.Booking, extended *domain.Booking,
+	place *domain.ParkingPlace) (*domain.Booking, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored := r.bookings[current.ID]
+	if stored == nil {
+		return nil, domain.ErrBookingNotFound
+	}
+	if !toTerms(stored).Equal(toTerms(current)) || stored.Status != current.Status {
+		return nil, utils.ErrBookingChanged
+	}
+
+	added := clone(stored)
+	added.DateFrom = stored.DateTo
+	added.DateTo = extended.DateTo
+	if err := r.checkCapacity(added, int64(place.Capacity), stored.ID); err != nil {
+		return nil, err
+	}
+	next := clone(stored)
+	next.DateTo = extended.DateTo
+	next.FullCost = extended.FullCost
+	r.recordAdjustment(stored, next, place.OwnerID, place.OwnerID)
+	r.bookings[next.ID] = next
+	r.recordEvent(ctx, next, domain.BookingEventModified, eventValues(stored), eventValues(next))
+	return clone(next), nil
+}
+
+func (r *MemoryBookingRepository) Delete(ctx context.Context, current *domain.Booking, cancellation *Cancellation) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored := r.bookings[current.ID]
+	if stored == nil || stored.Status != current.Status {
+		return utils.ErrBookingChanged
+	}
+	delete(r.bookings, current.ID)
+	r.recordEvent(ctx, stored, domain.BookingEventDeleted, eventValues(stored), nil)
+	if cancellation != nil {
+		r.recordRefund(ctx, stored, *cancellation)
+	}
+	return nil
+}
+
+// AddHold stores a hold and returns its ID.
+func (r *MemoryBookingRepository) AddHold(hold Hold) int64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	hold.ID = int64(len(r.holds) + 1)
+	r.holds[hold.ID] = &hold
+	return hold.ID
+}
+
+// Payments returns every payment command recorded for the booking, in order.
+func (r *MemoryBookingRepository) Payments(bookingID int64) []Payment {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	result := make([]Payment, 0)
+	for _, payment := range r.payments {
+		if payment.BookingID == bookingID {
+			result = append(result, *payment)
+		}
+	}
+	return result
+}
+
+// CompletePayment marks a payment command as delivered.
+func (r *MemoryBookingRepository) CompletePayment(id int64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, payment := range r.payments {
+		if payment.ID == id {
+			payment.Done = true
+		}
+	}
+}
+
+// SettleCharge moves a Waiting booking to the status its charge resulted in.
+// Like the relay, it cancels the rest of an upfront series whose payment
+// failed.
+func (r *MemoryBookingRepository) SettleCharge(bookingID int64, next domain.BookingStatus) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	booking := r.bookings[bookingID]
+	if booking == nil || booking.Status != domain.BookingStatusWaiting {
+		return
+	}
+	booking.Status = next
+	r.recordEvent(relayContext(), booking, domain.BookingEventStatusChanged,
+		map[string]any{"status": domain.BookingStatusWaiting}, map[string]any{"status": next})
+
+	series := r.series[booking.SeriesID]
+	if next != domain.BookingStatusCanceled || series == nil || series.Billing != domain.SeriesBillingUpfront ||
+		series.Status != domain.SeriesStatusActive {
+		return
+	}
+	series.Status = domain.SeriesStatusCanceled
+	for _, sibling := range r.bookings {
+		if sibling.SeriesID == series.ID && (sibling.Status == domain.BookingStatusWaiting ||
+			sibling.Status == domain.BookingStatusConfirmed) {
+			r.cancelOccurrence(relayContext(), sibling, nil)
+		}
+	}
+}
+
+// cancelOccurrence cancels a booking of a series. A paid booking is refunded as
+// cancellation decides, or in full without one; an unpaid one has its charge
+// dropped when that is not due yet.
+func (r *MemoryBookingRepository) cancelOccurrence(ctx context.Context, booking *domain.Booking,
+	cancellation *Cancellation) {
+	previous := booking.Status
+	booking.Status = domain.BookingStatusCanceled
+	r.recordEvent(ctx, booking, domain.BookingEventStatusChanged, map[string]any{"status": previous},
+		map[string]any{"status": booking.Status})
+	if previous == domain.BookingStatusConfirmed && cancellation != nil {
+		r.recordRefund(ctx, booking, *cancellation)
+	} else if previous == domain.BookingStatusConfirmed {
+		r.record(&Payment{BookingID: booking.ID, Command: database_service.OutboxRefund, DriverID: booking.UserID})
+	} else if charge := r.deferredCharge(booking.ID); charge != nil {
+		charge.Done = true
+	}
+}
+
+// RollBack restores the terms a declined adjust changed, unless the booking
+// was modified again since.
+func (r *MemoryBookingRepository) RollBack(bookingID int64, change database_service.BookingChange) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	booking := r.bookings[bookingID]
+	if booking == nil || !toTerms(booking).Equal(change.To) {
+		return false
+	}
+	changed := eventValues(booking)
+	booking.DateFrom = change.From.DateFrom
+	booking.DateTo = change.From.DateTo
+	booking.ParkingPlaceID = change.From.ParkingPlaceID
+	booking.FullCost = change.From.FullCost
+	r.recordEvent(relayContext(), booking, domain.BookingEventModified, changed, eventValues(booking))
+	return true
+}
+
+func (r *MemoryBookingRepository) checkCapacity(booking *domain.Booking, capacity int64, excludeID int64) error {
+	var periods []database_service.Period
+	for _, other := range r.bookings {
+		if other.ID != excludeID && other.ParkingPlaceID == booking.ParkingPlaceID && other.Status.OccupiesSpot() {
+			periods = append(periods, database_service.Period{From: other.DateFrom, To: other.DateTo})
+		}
+	}
+	now := time.Now()
+	for _, hold := range r.holds {
+		if !hold.Used && hold.ExpiresAt.After(now) && hold.ParkingPlaceID == booking.ParkingPlaceID {
+			periods = append(periods, database_service.Period{From: hold.DateFrom, To: hold.DateTo})
+		}
+	}
+	if database_service.PeakOccupancy(periods, booking.DateFrom, booking.DateTo) >= capacity {
+		return utils.ErrNoFreeSpots
+	}
+	return nil
+}
+
+// deferredCharge returns the charge of the booking that is not due yet.
+func (r *MemoryBookingRepository) deferredCharge(bookingID int64) *Payment {
+	for _, payment := range r.payments {
+		if payment.BookingID == bookingID && payment.Command == database_service.OutboxCharge && !payment.Done &&
+			payment.DueAt.After(time.Now()) {
+			return payment
+		}
+	}
+	return nil
+}
+
+// repriceDeferred mirrors the database service charging a booking whose charge
+// is not due yet its new terms instead of adjusting it.
+func (r *MemoryBookingRepository) repriceDeferred(booking *domain.Booking, ownerID string) bool {
+	charge := r.deferredCharge(booking.ID)
+	if charge == nil {
+		return false
+	}
+	charge.OwnerID = ownerID
+	charge.Amount = booking.FullCost
+	charge.DueAt = booking.DateFrom.Add(-domain.OccurrenceChargeLead)
+	return true
+}
+
+// recordAdjustment mirrors the adjust commands the database service enqueues
+// for a moved booking.
+func (r *MemoryBookingRepository) recordAdjustment(from, to *domain.Booking, fromOwner, toOwner string) {
+	change := database_service.BookingChange{From: toTerms(from), To: toTerms(to)}
+	if fromOwner == toOwner {
+		if delta := to.FullCost - from.FullCost; delta != 0 {
+			r.record(&Payment{BookingID: to.ID, Command: database_service.OutboxAdjust, DriverID: from.UserID,
+				OwnerID: toOwner, Amount: delta, Change: &change})
+		}
+		return
+	}
+	first := r.record(&Payment{BookingID: to.ID, Command: database_service.OutboxAdjust, DriverID: from.UserID,
+		OwnerID: toOwner, Amount: to.FullCost, Change: &change})
+	r.record(&Payment{BookingID: to.ID, Command: database_service.OutboxAdjust, DriverID: from.UserID,
+		OwnerID: fromOwner, Amount: -from.FullCost, DependsOn: first})
+}
+
+func (r *MemoryBookingRepository) recordRefund(ctx context.Context, booking *domain.Booking,
+	cancellation Cancellation) {
+	r.recordEvent(ctx, booking, domain.BookingEventCanceled, nil, map[string]any{
+		"policy_version": cancellation.PolicyVersion,
+		"fee_percent":    cancellation.FeePercent,
+	})
+	if cancellation.FeePercent >= 100 {
+		return
+	}
+	r.record(&Payment{BookingID: booking.ID, Command: database_service.OutboxRefund, DriverID: booking.UserID,
+		FeePercent: cancellation.FeePercent})
+}
+
+func (r *MemoryBookingRepository) record(payment *Payment) int64 {
+	payment.ID = int64(len(r.payments) + 1)
+	r.payments = append(r.payments, payment)
+	return payment.ID
+}
+
+// recordEvent mirrors the booking events the database service records, except
+// that the values keep their Go types instead of going through JSON.
+func (r *MemoryBookingRepository) recordEvent(ctx context.Context, booking *domain.Booking,
+	eventType domain.BookingEventType, oldValue, newValue map[string]any) {
+	r.events = append(r.events, &domain.BookingEvent{
+		ID:             int64(len(r.events) + 1),
+		BookingID:      booking.ID,
+		UserID:         booking.UserID,
+		ParkingPlaceID: booking.ParkingPlaceID,
+		Type:           eventType,
+		Actor:          audit.ActorFrom(ctx),
+		OldValue:       oldValue,
+		NewValue:       newValue,
+		TraceID:        audit.TraceID(ctx),
+		CreatedAt:      time.Now().UTC(),
+	})
+}
+
+// relayContext stands for the outbox relay, which settles charges and rolls
+// back declined changes outside of any request.
+func relayContext() context.Context {
+	return audit.WithActor(context.Background(), domain.SystemActor("outbox-relay"))
+}
+
+func eventValues(booking *domain.Booking) map[string]any {
+	return map[string]any{
+		"date_from":        booking.DateFrom,
+		"date_to":          booking.DateTo,
+		"parking_place_id": booking.ParkingPlaceID,
+		"full_cost":        booking.FullCost,
+		"status":           booking.Status,
+	}
+}
+
+// MemorySeriesRepository keeps booking series in memory and books their
+// occurrences in its booking repository, recording their charges the way the
+// Postgres repository enqueues them.
+type MemorySeriesRepository struct {
+	bookings *MemoryBookingRepository
+}
+
+func NewMemorySeriesRepository(bookings *MemoryBookingRepository) *MemorySeriesRepository {
+	return &MemorySeriesRepository{bookings: bookings}
+}
+
+func (r *MemorySeriesRepository) Create(ctx context.Context, series *domain.BookingSeries,
+	occurrences []*domain.Booking, place *domain.ParkingPlace) (*domain.BookingSeries, error) {
+	repo := r.bookings
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	created := *series
+	created.ID = int64(len(repo.series) + 1)
+	created.Status = domain.SeriesStatusActive
+	created.Bookings = make([]*domain.Booking, 0, len(occurrences))
+	created.Conflicts = make([]*domain.SeriesConflict, 0)
+	var upfront []database_service.OccurrenceCharge
+	var total int64
+	for _, occurrence := range occurrences {
+		if err := repo.checkCapacity(occurrence, int64(place.Capacity), 0); err != nil {
+			created.Conflicts = append(created.Conflicts, &domain.SeriesConflict{
+				DateFrom: occurrence.DateFrom,
+				DateTo:   occurrence.DateTo,
+				Reason:   "no free parking spots",
+			})
+			continue
+		}
+
+		repo.nextID++
+		booking := clone(occurrence)
+		booking.ID = repo.nextID
+		booking.Status = domain.BookingStatusWaiting
+		booking.UserID = series.UserID
+		booking.SeriesID = created.ID
+		booking.VehicleID = series.VehicleID
+		repo.bookings[booking.ID] = booking
+		repo.recordEvent(ctx, booking, domain.BookingEventCreated, nil, eventValues(booking))
+
+		charge := &Payment{BookingID: booking.ID, Command: database_service.OutboxCharge, DriverID: booking.UserID,
+			OwnerID: place.OwnerID, Amount: booking.FullCost}
+		switch {
+		case series.Billing == domain.SeriesBillingUpfront:
+			upfront = append(upfront, database_service.OccurrenceCharge{BookingID: booking.ID, Amount: booking.FullCost})
+			total += booking.FullCost
+			charge = nil
+		case len(created.Bookings) > 0:
+			charge.DueAt = booking.DateFrom.Add(-domain.OccurrenceChargeLead)
+		}
+		if charge != nil {
+			repo.record(charge)
+		}
+		created.Bookings = append(created.Bookings, clone(booking))
+	}
+	if len(created.Bookings) == 0 {
+		return nil, fmt.Errorf("%w: every occurrence of the series is taken", utils.ErrNoFreeSpots)
+	}
+	if len(upfront) > 0 {
+		first := created.Bookings[0]
+		repo.record(&Payment{BookingID: first.ID, Command: database_service.OutboxCharge, DriverID: first.UserID,
+			OwnerID: place.OwnerID, Amount: total, Occurrences: upfront})
+	}
+
+	stored := created
+	stored.Bookings = nil
+	stored.Conflicts = nil
+	repo.series[created.ID] = &stored
+	return &created, nil
+}
+
+func (r *MemorySeriesRepository) Get(ctx context.Context, id int64) (*domain.BookingSeries, error) {
+	r.bookings.mu.Lock()
+	defer r.bookings.mu.Unlock()
+
+	stored := r.bookings.series[id]
+	if stored == nil {
+		return nil, nil
+	}
+	series := *stored
+	series.Bookings = r.occurrences(id)
+	return &series, nil
+}
+
+func (r *MemorySeriesRepository) Cancel(ctx context.Context, id int64,
+	cancellations map[int64]Cancellation) ([]int64, error) {
+	r.bookings.mu.Lock()
+	defer r.bookings.mu.Unlock()
+
+	stored := r.bookings.series[id]
+	if stored == nil {
+		return nil, fmt.Errorf("booking series not found")
+	}
+	pending := make([]*domain.Booking, 0)
+	for _, booking := range r.occurrences(id) {
+		switch booking.Status {
+		case domain.BookingStatusConfirmed:
+			if _, ok := cancellations[booking.ID]; !ok {
+				return nil, utils.ErrBookingChanged
+			}
+			pending = append(pending, booking)
+		case domain.BookingStatusWaiting:
+			pending = append(pending, booking)
+		}
+	}
+
+	stored.Status = domain.SeriesStatusCanceled
+	canceled := make([]int64, 0, len(pending))
+	for _, booking := range pending {
+		var cancellation *Cancellation
+		if decided, ok := cancellations[booking.ID]; ok {
+			cancellation = &decided
+		}
+		r.bookings.cancelOccurrence(ctx, r.bookings.bookings[booking.ID], cancellation)
+		canceled = append(canceled, booking.ID)
+	}
+	return canceled, nil
+}
+
+func (r *MemorySeriesRepository) CancelOccurrence(ctx context.Context, seriesID int64, bookingID int64,
+	cancellation *Cancellation) error {
+	r.bookings.mu.Lock()
+	defer r.bookings.mu.Unlock()
+
+	booking := r.bookings.bookings[bookingID]
+	if booking == nil || booking.SeriesID != seriesID {
+		return fmt.Errorf("booking not found")
+	}
+	if err := domain.ValidateTransition(booking.Status, domain.BookingStatusCanceled); err != nil {
+		return err
+	}
+	if booking.Status == domain.BookingStatusConfirmed && cancellation == nil {
+		return utils.ErrBookingChanged
+	}
+	r.bookings.cancelOccurrence(ctx, booking, cancellation)
+	return nil
+}
+
+// occurrences returns copies of the bookings of the series ordered by start.
+func (r *MemorySeriesRepository) occurrences(seriesID int64) []*domain.Booking {
+	bookings := make([]*domain.Booking, 0)
+	for _, booking := range r.bookings.bookings {
+		if booking.SeriesID == seriesID {
+			bookings = append(bookings, clone(booking))
+		}
+	}
+	sort.Slice(bookings, func(i, j int) bool { return bookings[i].DateFrom.Before(bookings[j].DateFrom) })
+	return bookings
+}
+
+// MemoryVehicleRepository keeps vehicles in memory. It checks the bookings of
+// its booking repository before removing a vehicle.
+type MemoryVehicleRepository struct {
+	mu       sync.Mutex
+	vehicles map[int64]*domain.Vehicle
+	deleted  map[int64]bool
+	bookings *MemoryBookingRepository
+	nextID   int64
+}
+
+func NewMemoryVehicleRepository(bookings *MemoryBookingRepository) *MemoryVehicleRepository {
+	return &MemoryVehicleRepository{
+		vehicles: make(map[int64]*domain.Vehicle),
+		deleted:  make(map[int64]bool),
+		bookings: bookings,
+	}
+}
+
+func (r *MemoryVehicleRepository) Create(ctx context.Context, vehicle *domain.Vehicle) (*domain.Vehicle, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	owned := r.owned(vehicle.UserID)
+	for _, other := range owned {
+		if other.Country == vehicle.Country && other.Plate == vehicle.Plate {
+			return nil, fmt.Errorf("%w: %s %s", utils.ErrVehicleExists, vehicle.Country, vehicle.Plate)
+		}
+	}
+	if len(owned) >= domain.MaxVehiclesPerDriver {
+		return nil, fmt.Errorf("%w: at most %d", utils.ErrTooManyVehicles, domain.MaxVehiclesPerDriver)
+	}
+
+	r.nextID++
+	created := *vehicle
+	created.ID = r.nextID
+	created.CreatedAt = time.Now().UTC()
+	r.vehicles[created.ID] = &created
+	copied := created
+	return &copied, nil
+}
+
+func (r *MemoryVehicleRepository) Get(ctx context.Context, id int64) (*domain.Vehicle, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	vehicle := r.vehicles[id]
+	if vehicle == nil || r.deleted[id] {
+		return nil, nil
+	}
+	copied := *vehicle
+	return &copied, nil
+}
+
+func (r *MemoryVehicleRepository) List(ctx context.Context, userID string) ([]*domain.Vehicle, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.owned(userID), nil
+}
+
+func (r *MemoryVehicleRepository) FindByPlate(ctx context.Context, plate string) ([]*domain.Vehicle, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	found := make([]*domain.Vehicle, 0)
+	for id := int64(1); id <= r.nextID; id++ {
+		if vehicle := r.vehicles[id]; vehicle != nil && vehicle.Plate == plate {
+			copied := *vehicle
+			found = append(found, &copied)
+		}
+	}
+	return found, nil
+}
+
+func (r *MemoryVehicleRepository) Delete(ctx context.Context, id int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.vehicles[id] == nil || r.deleted[id] {
+		return domain.ErrVehicleNotFound
+	}
+	if r.bookings != nil {
+		r.bookings.mu.Lock()
+		defer r.bookings.mu.Unlock()
+		for _, booking := range r.bookings.bookings {
+			if booking.VehicleID == id && booking.Status.OccupiesSpot() {
+				return utils.ErrVehicleInUse
+			}
+		}
+	}
+	r.deleted[id] = true
+	return nil
+}
+
+func (r *MemoryVehicleRepository) Resolve(ctx context.Context, userID string, vehicleID int64) (*domain.Vehicle, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if vehicleID != 0 {
+		vehicle := r.vehicles[vehicleID]
+		if vehicle == nil || r.deleted[vehicleID] || vehicle.UserID != userID {
+			return nil, domain.ErrVehicleNotFound
+		}
+		copied := *vehicle
+		return &copied, nil
+	}
+	owned := r.owned(userID)
+	if len(owned) != 1 {
+		return nil, domain.ErrVehicleRequired
+	}
+	return owned[0], nil
+}
+
+// owned returns copies of the driver's vehicles in the order they were added.
+func (r *MemoryVehicleRepository) owned(userID string) []*domain.Vehicle {
+	owned := make([]*domain.Vehicle, 0)
+	for id := int64(1); id <= r.nextID; id++ {
+		if vehicle := r.vehicles[id]; vehicle != nil && !r.deleted[id] && vehicle.UserID == userID {
+			copied := *vehicle
+			owned = append(owned, &copied)
+		}
+	}
+	return owned
+}
+
+func matches(booking *domain.Booking, filters BookingFilters) bool {
+	if filters.ParkingPlaceIDs != nil && !contains(filters.ParkingPlaceIDs, booking.ParkingPlaceID) {
+		return false
+	}
+	if filters.VehicleIDs != nil && !contains(filters.VehicleIDs, booking.VehicleID) {
+		return false
+	}
+	if filters.UserID != nil && booking.UserID != *filters.UserID {
+		return false
+	}
+	if len(filters.Statuses) > 0 && !contains(filters.Statuses, booking.Status) {
+		return false
+	}
+	if filters.DateFrom != nil && !booking.DateTo.After(*filters.DateFrom) {
+		return false
+	}
+	if filters.DateTo != nil && !booking.DateFrom.Before(*filters.DateTo) {
+		return false
+	}
+	return true
+}
+
+func contains[T comparable](values []T, value T) bool {
+	for _, candidate := range values {
+		if candidate == value {
+			return true
+		}
+	}
+	return false
+}
+
+func clone(booking *domain.Booking) *domain.Booking {
+	if booking == nil {
+		return nil
+	}
+	copied := *booking
+	return &copied
+}
@@ -0,0 +1,209 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/h4x4d/parking_net/booking/internal/database_service"
+	"github.com/h4x4d/parking_net/booking/internal/models"
+	"github.com/h4x4d/parking_net/pkg/domain"
+)
+
+// PostgresBookingRepository stores bookings through the database service, which
+// owns the transactions that keep bookings, capacity and the outbox consistent.
+type PostgresBookingRepository struct {
+	db *database_service.DatabaseService
+}
+
+func NewPostgresBookingRepository(db *database_service.DatabaseService) BookingRepository {
+	return &PostgresBookingRepository{db: db}
+}
+
+func (r *PostgresBookingRepository) Create(ctx context.Context, booking *domain.Booking, place *domain.ParkingPlace,
+	opts CreateOptions) (*domain.Booking, error) {
+	bookingID, err := r.db.Create(ctx, toModel(booking), int64(place.Capacity), place.OwnerID, opts.HoldID,
+		opts.IdempotencyKey)
+	if err != nil {
+		return nil, err
+	}
+	return r.GetByID(ctx, *bookingID)
+}
+
+func (r *PostgresBookingRepository) GetByID(ctx context.Context, id int64) (*domain.Booking, error) {
+	booking, err := r.db.GetByID(ctx, id)
+	if err != nil || booking == nil {
+		return nil, err
+	}
+	return toDomain(booking), nil
+}
+
+func (r *PostgresBookingRepository) GetAll(ctx context.Context, filters BookingFilters) ([]*domain.Booking, string, error) {
+	filter := database_service.BookingFilter{
+		ParkingPlaceIDs: filters.ParkingPlaceIDs,
+		VehicleIDs:      filters.VehicleIDs,
+		UserID:          filters.UserID,
+		DateFrom:        filters.DateFrom,
+		DateTo:          filters.DateTo,
+		Sort:            filters.Sort,
+		Limit:           filters.Limit,
+		Cursor:          filters.Cursor,
+	}
+	for _, status := range filters.Statuses {
+		filter.Statuses = append(filter.Statuses, string(status))
+	}
+
+	bookings, next, err := r.db.ListBookings(ctx, filter)
+	if err != nil {
+		return nil, "", err
+	}
+	result := make([]*domain.Booking, 0, len(bookings))
+	for _, booking := range bookings {
+		result = append(result, toDomain(booking))
+	}
+	return result, next, nil
+}
+
+func (r *PostgresBookingRepository) Update(ctx context.Context, current *domain.Booking,
+	update BookingUpdate) (*domain.Booking, error) {
+	change := database_service.BookingUpdate{
+		Expected:       toTerms(current),
+		ExpectedStatus: current.Status,
+		Terms:          toTerms(update.Booking),
+		Status:         update.Booking.Status,
+		UserID:         update.Booking.UserID,
+		FromOwner:      update.PreviousOwnerID,
+	}
+	if update.Place != nil {
+		change.Capacity = int64(update.Place.Capacity)
+		change.ToOwner = update.Place.OwnerID
+	}
+	if update.Cancellation != nil {
+		change.Cancellation = &database_service.Cancellation{
+			PolicyVersion: update.Cancellation.PolicyVersion,
+			FeePercent:    update.Cancellation.FeePercent,
+		}
+	}
+
+	updated, err := r.db.Update(ctx, current.ID, change)
+	if err != nil {
+		return nil, err
+	}
+	return toDomain(updated), nil
+}
+
+func (r *PostgresBookingRepository) Extend(ctx context.Context, current *domain.Booking, extended *domain.Booking,
+	place *domain.ParkingPlace) (*domain.Booking, error) {
+	updated, err := r.db.Extend(ctx, current.ID, database_service.BookingExtension{
+		Expected:       toTerms(current),
+		ExpectedStatus: current.Status,
+		DateTo:         extended.DateTo,
+		FullCost:       extended.FullCost,
+		Capacity:       int64(place.Capacity),
+		OwnerID:        place.OwnerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toDomain(updated), nil
+}
+
+func (r *PostgresBookingRepository) Delete(ctx context.Context, current *domain.Booking,
+	cancellation *Cancellation) error {
+	var decided *database_service.Cancellation
+	if cancellation != nil {
+		decided = &database_service.Cancellation{
+			PolicyVersion: cancellation.PolicyVersion,
+			FeePercent:    cancellation.FeePercent,
+		}
+	}
+	return r.db.Delete(ctx, current.ID, current.Status, decided)
+}
+
+func (r *PostgresBookingRepository) History(ctx context.Context, id int64) ([]*domain.BookingEvent, error) {
+	return r.db.GetBookingEvents(ctx, id)
+}
+
+// PostgresVehicleRepository stores vehicles through the database service.
+type PostgresVehicleRepository struct {
+	db *database_service.DatabaseService
+}
+
+func NewPostgresVehicleRepository(db *database_service.DatabaseService) VehicleRepository {
+	return &PostgresVehicleRepository{db: db}
+}
+
+func (r *PostgresVehicleRepository) Create(ctx context.Context, vehicle *domain.Vehicle) (*domain.Vehicle, error) {
+	return r.db.CreateVehicle(ctx, vehicle)
+}
+
+func (r *PostgresVehicleRepository) Get(ctx context.Context, id int64) (*domain.Vehicle, error) {
+	return r.db.GetVehicle(ctx, id)
+}
+
+func (r *PostgresVehicleRepository) List(ctx context.Context, userID string) ([]*domain.Vehicle, error) {
+	return r.db.ListVehicles(ctx, userID)
+}
+
+func (r *PostgresVehicleRepository) FindByPlate(ctx context.Context, plate string) ([]*domain.Vehicle, error) {
+	return r.db.FindVehiclesByPlate(ctx, plate)
+}
+
+func (r *PostgresVehicleRepository) Delete(ctx context.Context, id int64) error {
+	return r.db.DeleteVehicle(ctx, id)
+}
+
+func (r *PostgresVehicleRepository) Resolve(ctx context.Context, userID string, vehicleID int64) (*domain.Vehicle, error) {
+	return r.db.ResolveVehicle(ctx, userID, vehicleID)
+}
+
+func toTerms(booking *domain.Booking) database_service.BookingTerms {
+	return database_service.BookingTerms{
+		DateFrom:       booking.DateFrom,
+		DateTo:         booking.DateTo,
+		ParkingPlaceID: booking.ParkingPlaceID,
+		FullCost:       booking.FullCost,
+	}
+}
+
+func toModel(booking *domain.Booking) *models.Booking {
+	dateFrom := strfmt.DateTime(booking.DateFrom)
+	dateTo := strfmt.DateTime(booking.DateTo)
+	parkingPlaceID := booking.ParkingPlaceID
+	return &models.Booking{
+		BookingID:      booking.ID,
+		DateFrom:       &dateFrom,
+		DateTo:         &dateTo,
+		ParkingPlaceID: &parkingPlaceID,
+		FullCost:       booking.FullCost,
+		Status:         string(booking.Status),
+		UserID:         booking.UserID,
+		SeriesID:       booking.SeriesID,
+		OvertimeCost:   booking.OvertimeCost,
+		VehicleID:      booking.VehicleID,
+	}
+}
+
+func toDomain(booking *models.Booking) *domain.Booking {
+	result := &domain.Booking{
+		ID:             booking.BookingID,
+		DateFrom:       time.Time(*booking.DateFrom),
+		DateTo:         time.Time(*booking.DateTo),
+		ParkingPlaceID: *booking.ParkingPlaceID,
+		FullCost:       booking.FullCost,
+		Status:         domain.BookingStatus(booking.Status),
+		UserID:         booking.UserID,
+		SeriesID:       booking.SeriesID,
+		OvertimeCost:   booking.OvertimeCost,
+		VehicleID:      booking.VehicleID,
+	}
+	if booking.CheckedInAt != nil {
+		checkedIn := time.Time(*booking.CheckedInAt)
+		result.CheckedInAt = &checkedIn
+	}
+	if booking.CheckedOutAt != nil {
+		checkedOut := time.Time(*booking.CheckedOutAt)
+		result.CheckedOutAt = &checkedOut
+	}
+	return result
+}
@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/h4x4d/parking_net/booking/internal/database_service"
+	"github.com/h4x4d/parking_net/booking/internal/models"
+	"github.com/h4x4d/parking_net/pkg/domain"
+)
+
+// PostgresSeriesRepository stores booking series through the database service.
+type PostgresSeriesRepository struct {
+	db *database_service.DatabaseService
+}
+
+func NewPostgresSeriesRepository(db *database_service.DatabaseService) SeriesRepository {
+	return &PostgresSeriesRepository{db: db}
+}
+
+func (r *PostgresSeriesRepository) Create(ctx context.Context, series *domain.BookingSeries,
+	occurrences []*domain.Booking, place *domain.ParkingPlace) (*domain.BookingSeries, error) {
+	bookings := make([]*models.Booking, 0, len(occurrences))
+	for _, occurrence := range occurrences {
+		bookings = append(bookings, toModel(occurrence))
+	}
+	created, err := r.db.CreateSeries(ctx, toSeriesModel(series), bookings, int64(place.Capacity), place.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+	return toSeriesDomain(created), nil
+}
+
+func (r *PostgresSeriesRepository) Get(ctx context.Context, id int64) (*domain.BookingSeries, error) {
+	series, err := r.db.GetSeries(ctx, id)
+	if err != nil || series == nil {
+		return nil, err
+	}
+	return toSeriesDomain(series), nil
+}
+
+func (r *PostgresSeriesRepository) Cancel(ctx context.Context, id int64,
+	cancellations map[int64]Cancellation) ([]int64, error) {
+	decided := make(map[int64]database_service.Cancellation, len(cancellations))
+	for bookingID, cancellation := range cancellations {
+		decided[bookingID] = database_service.Cancellation{
+			PolicyVersion: cancellation.PolicyVersion,
+			FeePercent:    cancellation.FeePercent,
+		}
+	}
+	return r.db.CancelSeries(ctx, id, decided)
+}
+
+func (r *PostgresSeriesRepository) CancelOccurrence(ctx context.Context, seriesID int64, bookingID int64,
+	cancellation *Cancellation) error {
+	var decided *database_service.Cancellation
+	if cancellation != nil {
+		decided = &database_service.Cancellation{
+			PolicyVersion: cancellation.PolicyVersion,
+			FeePercent:    cancellation.FeePercent,
+		}
+	}
+	return r.db.CancelOccurrence(ctx, seriesID, bookingID, decided)
+}
+
+func toSeriesModel(series *domain.BookingSeries) *models.BookingSeries {
+	dateFrom := strfmt.DateTime(series.DateFrom)
+	dateTo := strfmt.DateTime(series.DateTo)
+	until := strfmt.DateTime(series.Until)
+	parkingPlaceID := series.ParkingPlaceID
+	rrule := series.RRule
+	return &models.BookingSeries{
+		SeriesID:       series.ID,
+		UserID:         series.UserID,
+		ParkingPlaceID: &parkingPlaceID,
+		VehicleID:      series.VehicleID,
+		DateFrom:       &dateFrom,
+		DateTo:         &dateTo,
+		Rrule:          &rrule,
+		Until:          &until,
+		Billing:        string(series.Billing),
+		Status:         string(series.Status),
+	}
+}
+
+func toSeriesDomain(series *models.BookingSeries) *domain.BookingSeries {
+	result := &domain.BookingSeries{
+		ID:             series.SeriesID,
+		UserID:         series.UserID,
+		ParkingPlaceID: *series.ParkingPlaceID,
+		VehicleID:      series.VehicleID,
+		DateFrom:       time.Time(*series.DateFrom),
+		DateTo:         time.Time(*series.DateTo),
+		RRule:          *series.Rrule,
+		Until:          time.Time(*series.Until),
+		Billing:        domain.SeriesBilling(series.Billing),
+		Status:         domain.SeriesStatus(series.Status),
+		Bookings:       make([]*domain.Booking, 0, len(series.Bookings)),
+		Conflicts:      make([]*domain.SeriesConflict, 0, len(series.Conflicts)),
+	}
+	for _, booking := range series.Bookings {
+		result.Bookings = append(result.Bookings, toDomain(booking))
+	}
+	for _, conflict := range series.Conflicts {
+		result.Conflicts = append(result.Conflicts, &domain.SeriesConflict{
+			DateFrom: time.Time(conflict.DateFrom),
+			DateTo:   time.Time(conflict.DateTo),
+			Reason:   conflict.Reason,
+		})
+	}
+	return result
+}
@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/h4x4d/parking_net/booking/internal/database_service"
+	"github.com/h4x4d/parking_net/booking/internal/models"
+	"github.com/h4x4d/parking_net/pkg/domain"
+)
+
+// PostgresWaitlistRepository stores waitlist entries through the database
+// service.
+type PostgresWaitlistRepository struct {
+	db *database_service.DatabaseService
+}
+
+func NewPostgresWaitlistRepository(db *database_service.DatabaseService) WaitlistRepository {
+	return &PostgresWaitlistRepository{db: db}
+}
+
+func (r *PostgresWaitlistRepository) Join(ctx context.Context, entry *domain.WaitlistEntry) (*domain.WaitlistEntry, error) {
+	created, err := r.db.JoinWaitlist(ctx, toWaitlistModel(entry))
+	if err != nil {
+		return nil, err
+	}
+	return toWaitlistDomain(created), nil
+}
+
+func (r *PostgresWaitlistRepository) List(ctx context.Context, userID string) ([]*domain.WaitlistEntry, error) {
+	entries, err := r.db.GetWaitlist(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return toWaitlistDomains(entries), nil
+}
+
+func (r *PostgresWaitlistRepository) Get(ctx context.Context, id int64) (*domain.WaitlistEntry, error) {
+	entry, err := r.db.GetWaitlistEntry(ctx, id)
+	if err != nil || entry == nil {
+		return nil, err
+	}
+	return toWaitlistDomain(entry), nil
+}
+
+func (r *PostgresWaitlistRepository) Leave(ctx context.Context, id int64) (*domain.WaitlistEntry, error) {
+	entry, err := r.db.LeaveWaitlist(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return toWaitlistDomain(entry), nil
+}
+
+func (r *PostgresWaitlistRepository) AcceptOffer(ctx context.Context, id int64, booking *domain.Booking,
+	place *domain.ParkingPlace) (*domain.WaitlistEntry, error) {
+	entry, err := r.db.AcceptOffer(ctx, id, toModel(booking), int64(place.Capacity), place.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+	return toWaitlistDomain(entry), nil
+}
+
+func (r *PostgresWaitlistRepository) Expire(ctx context.Context, now time.Time) ([]*domain.WaitlistEntry, error) {
+	entries, err := r.db.ExpireWaitlist(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+	return toWaitlistDomains(entries), nil
+}
+
+func (r *PostgresWaitlistRepository) Queued(ctx context.Context, limit int) ([]*domain.WaitlistEntry, error) {
+	entries, err := r.db.GetQueuedWaitlist(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+	return toWaitlistDomains(entries), nil
+}
+
+func (r *PostgresWaitlistRepository) Match(ctx context.Context, entry *domain.WaitlistEntry, booking *domain.Booking,
+	place *domain.ParkingPlace, offerExpiresAt time.Time) (*domain.WaitlistEntry, error) {
+	var priced *models.Booking
+	if booking != nil {
+		priced = toModel(booking)
+	}
+	matched := toWaitlistModel(entry)
+	ok, err := r.db.MatchWaitlistEntry(ctx, matched, priced, int64(place.Capacity), place.OwnerID, offerExpiresAt)
+	if err != nil || !ok {
+		return nil, err
+	}
+	return toWaitlistDomain(matched), nil
+}
+
+func toWaitlistModel(entry *domain.WaitlistEntry) *models.WaitlistEntry {
+	dateFrom := strfmt.DateTime(entry.DateFrom)
+	dateTo := strfmt.DateTime(entry.DateTo)
+	parkingPlaceID := entry.ParkingPlaceID
+	return &models.WaitlistEntry{
+		EntryID:        entry.ID,
+		UserID:         entry.UserID,
+		ParkingPlaceID: &parkingPlaceID,
+		VehicleID:      entry.VehicleID,
+		DateFrom:       &dateFrom,
+		DateTo:         &dateTo,
+		AutoAccept:     entry.AutoAccept,
+		Status:         string(entry.Status),
+		BookingID:      entry.BookingID,
+	}
+}
+
+func toWaitlistDomain(entry *models.WaitlistEntry) *domain.WaitlistEntry {
+	result := &domain.WaitlistEntry{
+		ID:             entry.EntryID,
+		UserID:         entry.UserID,
+		ParkingPlaceID: *entry.ParkingPlaceID,
+		VehicleID:      entry.VehicleID,
+		DateFrom:       time.Time(*entry.DateFrom),
+		DateTo:         time.Time(*entry.DateTo),
+		AutoAccept:     entry.AutoAccept,
+		Status:         domain.WaitlistStatus(entry.Status),
+		BookingID:      entry.BookingID,
+	}
+	if entry.OfferExpiresAt != nil {
+		offerExpiresAt := time.Time(*entry.OfferExpiresAt)
+		result.OfferExpiresAt = &offerExpiresAt
+	}
+	return result
+}
+
+func toWaitlistDomains(entries []*models.WaitlistEntry) []*domain.WaitlistEntry {
+	result := make([]*domain.WaitlistEntry, 0, len(entries))
+	for _, entry := range entries {
+		result = append(result, toWaitlistDomain(entry))
+	}
+	return result
+}
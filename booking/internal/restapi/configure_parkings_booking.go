@@ -11,12 +11,16 @@ import (
 	swaggererrors "github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
 
+	"github.com/h4x4d/parking_net/booking/internal/di"
 	"github.com/h4x4d/parking_net/booking/internal/models"
 	"github.com/h4x4d/parking_net/booking/internal/restapi/handlers"
 	"github.com/h4x4d/parking_net/booking/internal/restapi/operations"
 	"github.com/h4x4d/parking_net/booking/internal/restapi/operations/driver"
 	"github.com/h4x4d/parking_net/booking/internal/restapi/operations/instruments"
+	"github.com/h4x4d/parking_net/booking/internal/restapi/operations/owner"
+	"github.com/h4x4d/parking_net/booking/internal/scheduler"
 	"github.com/h4x4d/parking_net/pkg/client"
+	"github.com/h4x4d/parking_net/pkg/events"
 	"github.com/h4x4d/parking_net/pkg/middlewares"
 )
 
@@ -28,6 +32,10 @@ func configureFlags(api *operations.ParkingsBookingAPI) {
 var keycloakClient *client.Client
 var bookingHandler *handlers.Handler
 var prometheusMetrics *middlewares.PrometheusMetrics
+var bookingScheduler *scheduler.Scheduler
+var bookingReaper *scheduler.Reaper
+var bookingWaitlist *scheduler.Waitlist
+var bookingPublisher *scheduler.Publisher
 
 func configureAPI(api *operations.ParkingsBookingAPI) http.Handler {
 	var err error
@@ -49,6 +57,31 @@ func configureAPI(api *operations.ParkingsBookingAPI) http.Handler {
 		panic(fmt.Sprintf("failed to initialize booking handler: %v", err))
 	}
 
+	container := di.NewContainer(bookingHandler.Database, bookingHandler.Relay, bookingHandler.KafkaConn,
+		bookingHandler.KeyCloak, di.Settings{
+			HoldTTL:         bookingHandler.GetHoldTTL(),
+			OvertimePenalty: bookingHandler.GetOvertimePenalty(),
+			Passes:          bookingHandler.GetPassSigner(),
+		})
+
+	bookingScheduler = scheduler.NewScheduler(bookingHandler.Database, container.Attendance, bookingHandler.KafkaConn,
+		bookingHandler.KeyCloak)
+	bookingScheduler.Start()
+	bookingReaper = scheduler.NewReaper(bookingHandler.Database, bookingHandler.PaymentClient,
+		bookingHandler.KafkaConn, bookingHandler.KeyCloak)
+	bookingReaper.Start()
+	bookingWaitlist = scheduler.NewWaitlist(container.Waitlist, bookingHandler.KafkaConn, bookingHandler.KeyCloak)
+	bookingWaitlist.Start()
+	bookingHandler.Relay.Start()
+
+	eventProducer, err := events.NewEnvProducer("booking")
+	if err != nil {
+		slog.Warn("failed to initialize event producer, continuing without domain events", "error", err)
+		eventProducer = nil
+	}
+	bookingPublisher = scheduler.NewPublisher(bookingHandler.Database, eventProducer)
+	bookingPublisher.Start()
+
 	prometheusMetrics = middlewares.NewPrometheusMetrics()
 
 	api.ServeError = swaggererrors.ServeError
@@ -59,6 +92,8 @@ func configureAPI(api *operations.ParkingsBookingAPI) http.Handler {
 
 	api.JSONProducer = runtime.JSONProducer()
 
+	api.RegisterProducer("text/calendar", runtime.TextProducer())
+
 	api.APIKeyAuth = func(token string) (*models.User, error) {
 		if token == "" {
 			return nil, errors.New("token required")
@@ -87,15 +122,45 @@ func configureAPI(api *operations.ParkingsBookingAPI) http.Handler {
 	}
 
 	api.InstrumentsGetMetricsHandler = instruments.GetMetricsHandlerFunc(handlers.MetricsHandler)
-	api.DriverCreateBookingHandler = driver.CreateBookingHandlerFunc(bookingHandler.CreateBooking)
-	api.DriverGetBookingHandler = driver.GetBookingHandlerFunc(bookingHandler.GetBooking)
-	api.DriverGetBookingByIDHandler = driver.GetBookingByIDHandlerFunc(bookingHandler.GetBookingByID)
-	api.DriverUpdateBookingHandler = driver.UpdateBookingHandlerFunc(bookingHandler.UpdateBooking)
-	api.DriverDeleteBookingHandler = driver.DeleteBookingHandlerFunc(bookingHandler.DeleteBooking)
+	api.DriverCreateBookingHandler = driver.CreateBookingHandlerFunc(container.BookingHandler.CreateBooking)
+	api.DriverQuoteBookingHandler = driver.QuoteBookingHandlerFunc(container.BookingHandler.QuoteBooking)
+	api.DriverCreateBookingHoldHandler = driver.CreateBookingHoldHandlerFunc(container.AvailabilityHandler.CreateBookingHold)
+	api.DriverGetBookingHandler = driver.GetBookingHandlerFunc(container.BookingHandler.GetBooking)
+	api.DriverGetBookingByIDHandler = driver.GetBookingByIDHandlerFunc(container.BookingHandler.GetBookingByID)
+	api.DriverGetBookingHistoryHandler = driver.GetBookingHistoryHandlerFunc(container.BookingHandler.GetBookingHistory)
+	api.DriverUpdateBookingHandler = driver.UpdateBookingHandlerFunc(container.BookingHandler.UpdateBooking)
+	api.DriverExtendBookingHandler = driver.ExtendBookingHandlerFunc(container.BookingHandler.ExtendBooking)
+	api.DriverDeleteBookingHandler = driver.DeleteBookingHandlerFunc(container.BookingHandler.DeleteBooking)
+	api.DriverGetAvailabilityHandler = driver.GetAvailabilityHandlerFunc(container.AvailabilityHandler.GetAvailability)
+	api.DriverCreateBookingSeriesHandler = driver.CreateBookingSeriesHandlerFunc(container.SeriesHandler.CreateBookingSeries)
+	api.DriverGetBookingSeriesHandler = driver.GetBookingSeriesHandlerFunc(container.SeriesHandler.GetBookingSeries)
+	api.DriverCancelBookingSeriesHandler = driver.CancelBookingSeriesHandlerFunc(container.SeriesHandler.CancelBookingSeries)
+	api.DriverCancelSeriesOccurrenceHandler = driver.CancelSeriesOccurrenceHandlerFunc(container.SeriesHandler.CancelSeriesOccurrence)
+	api.DriverCheckInBookingHandler = driver.CheckInBookingHandlerFunc(container.AttendanceHandler.CheckInBooking)
+	api.DriverCheckOutBookingHandler = driver.CheckOutBookingHandlerFunc(container.AttendanceHandler.CheckOutBooking)
+	api.DriverGetWaitlistHandler = driver.GetWaitlistHandlerFunc(container.WaitlistHandler.GetWaitlist)
+	api.DriverJoinWaitlistHandler = driver.JoinWaitlistHandlerFunc(container.WaitlistHandler.JoinWaitlist)
+	api.DriverLeaveWaitlistHandler = driver.LeaveWaitlistHandlerFunc(container.WaitlistHandler.LeaveWaitlist)
+	api.DriverAcceptWaitlistOfferHandler = driver.AcceptWaitlistOfferHandlerFunc(container.WaitlistHandler.AcceptWaitlistOffer)
+	api.DriverCreateCalendarFeedHandler = driver.CreateCalendarFeedHandlerFunc(container.CalendarHandler.CreateCalendarFeed)
+	api.DriverRevokeCalendarFeedHandler = driver.RevokeCalendarFeedHandlerFunc(container.CalendarHandler.RevokeCalendarFeed)
+	api.DriverGetCalendarFeedHandler = driver.GetCalendarFeedHandlerFunc(container.CalendarHandler.GetCalendarFeed)
+	api.DriverGetEntryPassHandler = driver.GetEntryPassHandlerFunc(container.AttendanceHandler.GetEntryPass)
+	api.OwnerVerifyEntryPassHandler = owner.VerifyEntryPassHandlerFunc(container.AttendanceHandler.VerifyEntryPass)
+	api.DriverListVehiclesHandler = driver.ListVehiclesHandlerFunc(container.VehicleHandler.ListVehicles)
+	api.DriverRegisterVehicleHandler = driver.RegisterVehicleHandlerFunc(container.VehicleHandler.RegisterVehicle)
+	api.DriverRemoveVehicleHandler = driver.RemoveVehicleHandlerFunc(container.VehicleHandler.RemoveVehicle)
+	api.OwnerFindBookingsByPlateHandler = owner.FindBookingsByPlateHandlerFunc(container.VehicleHandler.FindBookingsByPlate)
 
 	api.PreServerShutdown = func() {}
 
-	api.ServerShutdown = func() {}
+	api.ServerShutdown = func() {
+		bookingScheduler.Stop()
+		bookingReaper.Stop()
+		bookingWaitlist.Stop()
+		bookingHandler.Relay.Stop()
+		bookingPublisher.Stop()
+	}
 
 	return setupGlobalMiddleware(api.Serve(setupMiddlewares))
 }
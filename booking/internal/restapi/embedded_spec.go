@@ -35,6 +35,7 @@ func init() {
             "api_key": []
           }
         ],
+        "description": "Returns one page of bookings. Drivers see their own bookings, owners the bookings of their parking places and admins all bookings. Pass the X-Next-Cursor header of a page as cursor to get the next one.",
         "produces": [
           "application/json"
         ],
@@ -46,8 +47,13 @@ func init() {
         "operationId": "get_booking",
         "parameters": [
           {
-            "type": "integer",
-            "format": "int64",
+            "type": "array",
+            "items": {
+              "type": "integer",
+              "format": "int64"
+            },
+            "collectionFormat": "multi",
+            "description": "Only bookings of these parking places; repeat the parameter for several",
             "name": "parking_place_id",
             "in": "query"
           },
@@ -56,6 +62,59 @@ func init() {
             "description": "Filter bookings by user ID (for drivers to get their own bookings)",
             "name": "user_id",
             "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "Only bookings in these statuses; repeat the parameter for several",
+            "name": "status",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Only bookings that end after this time",
+            "name": "date_from",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Only bookings that start before this time",
+            "name": "date_to",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "date_from",
+              "-date_from",
+              "created_at",
+              "-created_at"
+            ],
+            "type": "string",
+            "default": "-created_at",
+            "description": "Sort key; a leading minus sorts in descending order",
+            "name": "sort",
+            "in": "query"
+          },
+          {
+            "maximum": 200,
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "default": 50,
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "maxLength": 512,
+            "type": "string",
+            "description": "X-Next-Cursor of the previous page",
+            "name": "cursor",
+            "in": "query"
           }
         ],
         "responses": {
@@ -66,6 +125,18 @@ func init() {
               "items": {
                 "$ref": "#/definitions/Booking"
               }
+            },
+            "headers": {
+              "X-Next-Cursor": {
+                "type": "string",
+                "description": "Cursor of the next page; absent on the last page"
+              }
+            }
+          },
+          "400": {
+            "description": "Incorrect filters or cursor",
+            "schema": {
+              "$ref": "#/definitions/Error"
             }
           },
           "403": {
@@ -100,6 +171,13 @@ func init() {
         "summary": "Create booking",
         "operationId": "create_booking",
         "parameters": [
+          {
+            "maxLength": 255,
+            "type": "string",
+            "description": "Client-generated key that makes retries of the same request return the original booking",
+            "name": "Idempotency-Key",
+            "in": "header"
+          },
           {
             "name": "object",
             "in": "body",
@@ -121,9 +199,19 @@ func init() {
                   "format": "date-time",
                   "example": "2024-12-31T18:00:00Z"
                 },
+                "hold_id": {
+                  "description": "hold of the driver that reserves a spot covering the period",
+                  "type": "integer",
+                  "format": "int64"
+                },
                 "parking_place_id": {
                   "type": "integer",
                   "format": "int64"
+                },
+                "vehicle_id": {
+                  "description": "vehicle that parks; may be left out by drivers with a single vehicle",
+                  "type": "integer",
+                  "format": "int64"
                 }
               }
             }
@@ -153,11 +241,23 @@ func init() {
             "schema": {
               "$ref": "#/definitions/Error"
             }
+          },
+          "409": {
+            "description": "No free parking spots for the requested period",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "422": {
+            "description": "Idempotency key was already used with a different request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
           }
         }
       }
     },
-    "/booking/{booking_id}": {
+    "/booking/availability": {
       "get": {
         "security": [
           {
@@ -171,23 +271,53 @@ func init() {
           "driver",
           "owner"
         ],
-        "summary": "Get booking info by id",
-        "operationId": "get_booking_by_id",
+        "summary": "Get free spots of a parking place per time slot",
+        "operationId": "get_availability",
         "parameters": [
           {
             "type": "integer",
             "format": "int64",
-            "description": "ID of booking to return",
-            "name": "booking_id",
-            "in": "path",
+            "name": "parking_place_id",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "name": "from",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "name": "to",
+            "in": "query",
             "required": true
+          },
+          {
+            "enum": [
+              "hour",
+              "day"
+            ],
+            "type": "string",
+            "default": "hour",
+            "description": "length of a single time slot",
+            "name": "granularity",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "successful operation",
             "schema": {
-              "$ref": "#/definitions/Booking"
+              "$ref": "#/definitions/Availability"
+            }
+          },
+          "400": {
+            "description": "Incorrect data",
+            "schema": {
+              "$ref": "#/definitions/Error"
             }
           },
           "403": {
@@ -197,46 +327,157 @@ func init() {
             }
           },
           "404": {
-            "description": "Booking not found",
+            "description": "Parking place not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/booking/calendar": {
+      "post": {
+        "security": [
+          {
+            "api_key": []
+          }
+        ],
+        "description": "Returns a new secret feed URL for calendar apps. Drivers get their own bookings, owners the bookings at their parking places. A previous feed of the user stops working.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "driver"
+        ],
+        "summary": "Create the calendar feed of the user",
+        "operationId": "create_calendar_feed",
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/CalendarFeed"
+            }
+          },
+          "403": {
+            "description": "No access",
             "schema": {
               "$ref": "#/definitions/Error"
             }
           }
         }
       },
-      "put": {
+      "delete": {
         "security": [
           {
             "api_key": []
           }
         ],
-        "consumes": [
+        "produces": [
           "application/json"
         ],
+        "tags": [
+          "driver"
+        ],
+        "summary": "Revoke the calendar feed of the user",
+        "operationId": "revoke_calendar_feed",
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/Result"
+            }
+          },
+          "403": {
+            "description": "No access",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "The user has no calendar feed",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/booking/calendar/{token}": {
+      "get": {
+        "security": [],
+        "description": "Authenticated by the feed token in the URL instead of api_key, so calendar apps can subscribe to it. Canceled bookings stay in the feed as cancelled events for a while.",
         "produces": [
+          "text/calendar",
           "application/json"
         ],
         "tags": [
-          "driver",
-          "owner"
+          "driver"
         ],
-        "summary": "Update booking",
-        "operationId": "update_booking",
+        "summary": "iCalendar feed of bookings",
+        "operationId": "get_calendar_feed",
         "parameters": [
           {
-            "type": "integer",
-            "format": "int64",
-            "description": "ID of booking to change",
-            "name": "booking_id",
+            "type": "string",
+            "name": "token",
             "in": "path",
             "required": true
           },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Only bookings at this parking place",
+            "name": "parking_place_id",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "403": {
+            "description": "The parking place is not one of the owner's",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Unknown or revoked feed token",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/booking/hold": {
+      "post": {
+        "security": [
+          {
+            "api_key": []
+          }
+        ],
+        "description": "The spot counts as taken until the hold expires or a booking is created with its hold_id.",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "driver"
+        ],
+        "summary": "Hold a spot for a few minutes before booking it",
+        "operationId": "create_booking_hold",
+        "parameters": [
           {
             "name": "object",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Booking"
+              "$ref": "#/definitions/BookingHold"
             }
           }
         ],
@@ -244,7 +485,7 @@ func init() {
           "200": {
             "description": "successful operation",
             "schema": {
-              "$ref": "#/definitions/Booking"
+              "$ref": "#/definitions/BookingHold"
             }
           },
           "400": {
@@ -258,49 +499,54 @@ func init() {
             "schema": {
               "$ref": "#/definitions/Error"
             }
+          },
+          "409": {
+            "description": "No free parking spots for the requested period",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
           }
         }
-      },
-      "delete": {
+      }
+    },
+    "/booking/pass/verify": {
+      "post": {
         "security": [
           {
             "api_key": []
           }
         ],
+        "description": "Checks the pass against the current booking, its status and time window. With check_in the booking is checked in at once and the pass cannot be used again.",
+        "consumes": [
+          "application/json"
+        ],
         "produces": [
           "application/json"
         ],
         "tags": [
-          "driver",
           "owner"
         ],
-        "summary": "Delete booking",
-        "operationId": "delete_booking",
+        "summary": "Verify an entry pass at the gate",
+        "operationId": "verify_entry_pass",
         "parameters": [
           {
-            "type": "integer",
-            "format": "int64",
-            "description": "ID of booking to delete",
-            "name": "booking_id",
-            "in": "path",
-            "required": true
+            "name": "object",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/PassVerificationRequest"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "successful operation",
+            "description": "The verdict; an unusable pass is not an error",
             "schema": {
-              "$ref": "#/definitions/Result"
+              "$ref": "#/definitions/PassVerification"
             }
           },
           "403": {
-            "description": "No access",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
-          "404": {
-            "description": "Booking not found",
+            "description": "The parking place is not yours",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -308,215 +554,46 @@ func init() {
         }
       }
     },
-    "/metrics": {
-      "get": {
-        "security": [],
+    "/booking/quote": {
+      "post": {
+        "security": [
+          {
+            "api_key": []
+          }
+        ],
+        "description": "Returns the itemized cost of parking at the place for the time range. The total is what POST /booking charges for the same place, time range and vehicle. Nothing is created or held.",
+        "consumes": [
+          "application/json"
+        ],
         "produces": [
           "application/json"
         ],
         "tags": [
-          "instruments"
-        ],
-        "summary": "Prometheus metrics",
-        "responses": {
-          "200": {
-            "description": "ok"
-          }
-        }
-      }
-    }
-  },
-  "definitions": {
-    "Booking": {
-      "type": "object",
-      "required": [
-        "date_from",
-        "date_to",
-        "parking_place_id"
-      ],
-      "properties": {
-        "booking_id": {
-          "type": "integer",
-          "format": "int64"
-        },
-        "date_from": {
-          "type": "string",
-          "format": "date-time",
-          "example": "2024-12-31T10:00:00Z"
-        },
-        "date_to": {
-          "type": "string",
-          "format": "date-time",
-          "example": "2024-12-31T18:00:00Z"
-        },
-        "full_cost": {
-          "type": "integer",
-          "format": "int64"
-        },
-        "parking_place_id": {
-          "type": "integer",
-          "format": "int64"
-        },
-        "status": {
-          "description": "status of booking",
-          "type": "string",
-          "enum": [
-            "Waiting",
-            "Confirmed",
-            "Canceled"
-          ]
-        },
-        "user_id": {
-          "type": "string"
-        }
-      }
-    },
-    "Error": {
-      "type": "object",
-      "required": [
-        "error_status_code"
-      ],
-      "properties": {
-        "error_message": {
-          "type": "string"
-        },
-        "error_status_code": {
-          "type": "integer"
-        }
-      }
-    },
-    "ParkingPlace": {
-      "type": "object",
-      "required": [
-        "name",
-        "city",
-        "address"
-      ],
-      "properties": {
-        "address": {
-          "type": "string",
-          "example": "Red Square №1"
-        },
-        "capacity": {
-          "description": "total number of parking spots",
-          "type": "integer",
-          "format": "int64"
-        },
-        "city": {
-          "type": "string",
-          "example": "Moscow"
-        },
-        "hourly_rate": {
-          "description": "hourly parking rate",
-          "type": "integer",
-          "format": "int64"
-        },
-        "id": {
-          "type": "integer",
-          "format": "int64"
-        },
-        "name": {
-          "type": "string",
-          "example": "Central Parking"
-        },
-        "owner_id": {
-          "type": "string"
-        },
-        "parking_type": {
-          "description": "type of parking facility",
-          "type": "string",
-          "enum": [
-            "outdoor",
-            "covered",
-            "underground",
-            "multi-level"
-          ]
-        }
-      }
-    },
-    "Result": {
-      "type": "object",
-      "properties": {
-        "message": {
-          "type": "string"
-        },
-        "status": {
-          "type": "string"
-        }
-      }
-    }
-  },
-  "securityDefinitions": {
-    "api_key": {
-      "type": "apiKey",
-      "name": "api_key",
-      "in": "header"
-    }
-  },
-  "tags": [
-    {
-      "description": "Driver operations",
-      "name": "driver"
-    },
-    {
-      "description": "Parking owner operations",
-      "name": "owner"
-    },
-    {
-      "description": "Inner operations",
-      "name": "instruments"
-    }
-  ]
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "schemes": [
-    "http"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "description": "Parking Net Go project | Booking svc",
-    "title": "parkings.booking",
-    "version": "0.3.0"
-  },
-  "paths": {
-    "/booking": {
-      "get": {
-        "security": [
-          {
-            "api_key": []
-          }
-        ],
-        "produces": [
-          "application/json"
-        ],
-        "tags": [
-          "driver",
-          "owner"
-        ],
-        "summary": "Get suitable bookings",
-        "operationId": "get_booking",
-        "parameters": [
-          {
-            "type": "integer",
-            "format": "int64",
-            "name": "parking_place_id",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "Filter bookings by user ID (for drivers to get their own bookings)",
-            "name": "user_id",
-            "in": "query"
-          }
+          "driver"
+        ],
+        "summary": "Price a booking without making it",
+        "operationId": "quote_booking",
+        "parameters": [
+          {
+            "name": "object",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BookingQuoteRequest"
+            }
+          }
         ],
         "responses": {
           "200": {
             "description": "successful operation",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Booking"
-              }
+              "$ref": "#/definitions/BookingQuote"
+            }
+          },
+          "400": {
+            "description": "Incorrect data",
+            "schema": {
+              "$ref": "#/definitions/Error"
             }
           },
           "403": {
@@ -526,19 +603,28 @@ func init() {
             }
           },
           "404": {
-            "description": "Booking not found",
+            "description": "Parking place not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Parking place does not take bookings",
             "schema": {
               "$ref": "#/definitions/Error"
             }
           }
         }
-      },
+      }
+    },
+    "/booking/series": {
       "post": {
         "security": [
           {
             "api_key": []
           }
         ],
+        "description": "Expands the weekly rule into individual bookings. Occurrences without a free spot are skipped and reported as conflicts.",
         "consumes": [
           "application/json"
         ],
@@ -548,35 +634,15 @@ func init() {
         "tags": [
           "driver"
         ],
-        "summary": "Create booking",
-        "operationId": "create_booking",
+        "summary": "Create a recurring booking series",
+        "operationId": "create_booking_series",
         "parameters": [
           {
             "name": "object",
             "in": "body",
             "required": true,
             "schema": {
-              "required": [
-                "parking_place_id",
-                "date_from",
-                "date_to"
-              ],
-              "properties": {
-                "date_from": {
-                  "type": "string",
-                  "format": "date-time",
-                  "example": "2024-12-31T10:00:00Z"
-                },
-                "date_to": {
-                  "type": "string",
-                  "format": "date-time",
-                  "example": "2024-12-31T18:00:00Z"
-                },
-                "parking_place_id": {
-                  "type": "integer",
-                  "format": "int64"
-                }
-              }
+              "$ref": "#/definitions/BookingSeries"
             }
           }
         ],
@@ -584,13 +650,7 @@ func init() {
           "200": {
             "description": "successful operation",
             "schema": {
-              "type": "object",
-              "properties": {
-                "booking_id": {
-                  "type": "integer",
-                  "format": "int64"
-                }
-              }
+              "$ref": "#/definitions/BookingSeries"
             }
           },
           "400": {
@@ -604,11 +664,17 @@ func init() {
             "schema": {
               "$ref": "#/definitions/Error"
             }
+          },
+          "409": {
+            "description": "No occurrence of the series has a free spot",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
           }
         }
       }
     },
-    "/booking/{booking_id}": {
+    "/booking/series/{series_id}": {
       "get": {
         "security": [
           {
@@ -622,14 +688,13 @@ func init() {
           "driver",
           "owner"
         ],
-        "summary": "Get booking info by id",
-        "operationId": "get_booking_by_id",
+        "summary": "Get booking series with its bookings",
+        "operationId": "get_booking_series",
         "parameters": [
           {
             "type": "integer",
             "format": "int64",
-            "description": "ID of booking to return",
-            "name": "booking_id",
+            "name": "series_id",
             "in": "path",
             "required": true
           }
@@ -638,7 +703,7 @@ func init() {
           "200": {
             "description": "successful operation",
             "schema": {
-              "$ref": "#/definitions/Booking"
+              "$ref": "#/definitions/BookingSeries"
             }
           },
           "403": {
@@ -648,46 +713,3204 @@ func init() {
             }
           },
           "404": {
-            "description": "Booking not found",
+            "description": "Series not found",
             "schema": {
               "$ref": "#/definitions/Error"
             }
           }
         }
       },
-      "put": {
+      "delete": {
         "security": [
           {
             "api_key": []
           }
         ],
-        "consumes": [
-          "application/json"
-        ],
+        "description": "Cancels every occurrence that has not started yet; paid occurrences are refunded according to the cancellation policy.",
         "produces": [
           "application/json"
         ],
         "tags": [
-          "driver",
-          "owner"
+          "driver"
         ],
-        "summary": "Update booking",
-        "operationId": "update_booking",
+        "summary": "Cancel the whole series",
+        "operationId": "cancel_booking_series",
         "parameters": [
           {
             "type": "integer",
             "format": "int64",
-            "description": "ID of booking to change",
-            "name": "booking_id",
+            "name": "series_id",
             "in": "path",
             "required": true
-          },
-          {
-            "name": "object",
-            "in": "body",
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/BookingSeries"
+            }
+          },
+          "403": {
+            "description": "No access",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Series not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/booking/series/{series_id}/bookings/{booking_id}": {
+      "delete": {
+        "security": [
+          {
+            "api_key": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "driver"
+        ],
+        "summary": "Cancel a single occurrence of a series",
+        "operationId": "cancel_series_occurrence",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "series_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "booking_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/Booking"
+            }
+          },
+          "400": {
+            "description": "The occurrence can no longer be canceled",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "No access",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Occurrence not found in the series",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/booking/vehicles": {
+      "get": {
+        "security": [
+          {
+            "api_key": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "driver"
+        ],
+        "summary": "List the vehicles of the driver",
+        "operationId": "list_vehicles",
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Vehicle"
+              }
+            }
+          },
+          "403": {
+            "description": "No access",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "api_key": []
+          }
+        ],
+        "description": "Bookings name the vehicle that parks. A driver may register at most 10 vehicles.",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "driver"
+        ],
+        "summary": "Register a vehicle",
+        "operationId": "register_vehicle",
+        "parameters": [
+          {
+            "name": "object",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Vehicle"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/Vehicle"
+            }
+          },
+          "400": {
+            "description": "Invalid plate, country or size",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "No access",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "The vehicle is already registered or the driver has too many",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/booking/vehicles/lookup": {
+      "get": {
+        "security": [
+          {
+            "api_key": []
+          }
+        ],
+        "description": "Bookings at the owner's parking places that a vehicle with the plate may enter with now: Confirmed bookings whose check-in window is open and Active bookings.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "owner"
+        ],
+        "summary": "Find the current bookings of a license plate",
+        "operationId": "find_bookings_by_plate",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "spaces and dashes are ignored",
+            "name": "plate",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "parking_place_id",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/PlateBooking"
+              }
+            }
+          },
+          "400": {
+            "description": "Invalid plate",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "No access",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/booking/vehicles/{vehicle_id}": {
+      "delete": {
+        "security": [
+          {
+            "api_key": []
+          }
+        ],
+        "description": "A vehicle with bookings or waitlist entries that are not over cannot be removed.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "driver"
+        ],
+        "summary": "Remove a vehicle",
+        "operationId": "remove_vehicle",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "vehicle_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/Result"
+            }
+          },
+          "403": {
+            "description": "No access",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Vehicle not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "The vehicle is still in use",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/booking/waitlist": {
+      "get": {
+        "security": [
+          {
+            "api_key": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "driver"
+        ],
+        "summary": "List the waitlist entries of the driver",
+        "operationId": "get_waitlist",
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/WaitlistEntry"
+              }
+            }
+          },
+          "403": {
+            "description": "No access",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "api_key": []
+          }
+        ],
+        "description": "When a spot frees up for the window, the driver gets a time-limited offer, or the booking is created and charged right away if auto_accept is set.",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "driver"
+        ],
+        "summary": "Join the waitlist of a parking place for a time window",
+        "operationId": "join_waitlist",
+        "parameters": [
+          {
+            "name": "object",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/WaitlistEntry"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/WaitlistEntry"
+            }
+          },
+          "400": {
+            "description": "Incorrect data",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "No access",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/booking/waitlist/{entry_id}": {
+      "delete": {
+        "security": [
+          {
+            "api_key": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "driver"
+        ],
+        "summary": "Leave a waitlist",
+        "operationId": "leave_waitlist",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "entry_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/WaitlistEntry"
+            }
+          },
+          "400": {
+            "description": "The entry was already booked or closed",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "No access",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Waitlist entry not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/booking/waitlist/{entry_id}/accept": {
+      "post": {
+        "security": [
+          {
+            "api_key": []
+          }
+        ],
+        "description": "Creates and charges the booking reserved by the offer.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "driver"
+        ],
+        "summary": "Accept the offer of a waitlist entry",
+        "operationId": "accept_waitlist_offer",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "entry_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/WaitlistEntry"
+            }
+          },
+          "400": {
+            "description": "The entry has no open offer",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "No access",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Waitlist entry not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/booking/{booking_id}": {
+      "get": {
+        "security": [
+          {
+            "api_key": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "driver",
+          "owner"
+        ],
+        "summary": "Get booking info by id",
+        "operationId": "get_booking_by_id",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "ID of booking to return",
+            "name": "booking_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/Booking"
+            }
+          },
+          "403": {
+            "description": "No access",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Booking not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "put": {
+        "security": [
+          {
+            "api_key": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "driver",
+          "owner"
+        ],
+        "summary": "Update booking",
+        "operationId": "update_booking",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "ID of booking to change",
+            "name": "booking_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "object",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Booking"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/Booking"
+            }
+          },
+          "400": {
+            "description": "Incorrect data",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "No access",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "No free parking spots for the requested period",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "security": [
+          {
+            "api_key": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "driver",
+          "owner"
+        ],
+        "summary": "Delete booking",
+        "operationId": "delete_booking",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "ID of booking to delete",
+            "name": "booking_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/Result"
+            }
+          },
+          "403": {
+            "description": "No access",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Booking not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/booking/{booking_id}/check-in": {
+      "post": {
+        "security": [
+          {
+            "api_key": []
+          }
+        ],
+        "description": "Called by the driver or by the gate system of the parking owner. Moves a Confirmed booking to Active.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "driver",
+          "owner"
+        ],
+        "summary": "Record the arrival of the driver",
+        "operationId": "check_in_booking",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "booking_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/Booking"
+            }
+          },
+          "400": {
+            "description": "The booking cannot be checked in now",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "No access",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Booking not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/booking/{booking_id}/check-out": {
+      "post": {
+        "security": [
+          {
+            "api_key": []
+          }
+        ],
+        "description": "Completes an Active booking and bills the time spent past date_to at the hourly rate of the place times the overtime penalty.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "driver",
+          "owner"
+        ],
+        "summary": "Record the departure of the driver",
+        "operationId": "check_out_booking",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "booking_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/Booking"
+            }
+          },
+          "400": {
+            "description": "The booking is not checked in",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "No access",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Booking not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/booking/{booking_id}/extend": {
+      "post": {
+        "security": [
+          {
+            "api_key": []
+          }
+        ],
+        "description": "Moves the end of a Confirmed or Active booking later, either to date_to or by duration_minutes. The whole stay is repriced at the current hourly rate of the place and the difference to what was paid is charged; the driver and the owner are notified once the charge went through.",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "driver"
+        ],
+        "summary": "Extend a booking",
+        "operationId": "extend_booking",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "booking_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "object",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BookingExtension"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/Booking"
+            }
+          },
+          "400": {
+            "description": "Incorrect data or the payment for the added time failed",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "No access",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Booking not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "The booking cannot be extended now or no spot is free for the added time",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/booking/{booking_id}/history": {
+      "get": {
+        "security": [
+          {
+            "api_key": []
+          }
+        ],
+        "description": "Lists every change of the booking, oldest first: who made it, the old and new values and the trace of the request. The history stays available after the booking is deleted.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "driver",
+          "owner"
+        ],
+        "summary": "Get the history of a booking",
+        "operationId": "get_booking_history",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "booking_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/BookingEvent"
+              }
+            }
+          },
+          "403": {
+            "description": "No access",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Booking not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/booking/{booking_id}/pass": {
+      "get": {
+        "security": [
+          {
+            "api_key": []
+          }
+        ],
+        "description": "Issues a signed pass for a Confirmed booking to show at the gate as a QR code. It is valid from the opening of check-in until the booking ends and lets the car in once.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "driver"
+        ],
+        "summary": "Get an entry pass for the booking",
+        "operationId": "get_entry_pass",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "booking_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/EntryPass"
+            }
+          },
+          "400": {
+            "description": "The booking is not Confirmed or has ended",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "No access",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Booking not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/metrics": {
+      "get": {
+        "security": [],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "instruments"
+        ],
+        "summary": "Prometheus metrics",
+        "responses": {
+          "200": {
+            "description": "ok"
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "Availability": {
+      "type": "object",
+      "properties": {
+        "capacity": {
+          "description": "total number of parking spots",
+          "type": "integer",
+          "format": "int64"
+        },
+        "granularity": {
+          "type": "string",
+          "enum": [
+            "hour",
+            "day"
+          ]
+        },
+        "parking_place_id": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "slots": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/AvailabilitySlot"
+          }
+        }
+      }
+    },
+    "AvailabilitySlot": {
+      "type": "object",
+      "properties": {
+        "booked": {
+          "description": "number of spots taken by bookings in this slot",
+          "type": "integer",
+          "format": "int64"
+        },
+        "date_from": {
+          "type": "string",
+          "format": "date-time",
+          "example": "2024-12-31T10:00:00Z"
+        },
+        "date_to": {
+          "type": "string",
+          "format": "date-time",
+          "example": "2024-12-31T11:00:00Z"
+        },
+        "free": {
+          "description": "number of spots still available in this slot",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "Booking": {
+      "type": "object",
+      "required": [
+        "date_from",
+        "date_to",
+        "parking_place_id"
+      ],
+      "properties": {
+        "booking_id": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "checked_in_at": {
+          "description": "actual arrival of the driver",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "checked_out_at": {
+          "description": "actual departure of the driver",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "date_from": {
+          "type": "string",
+          "format": "date-time",
+          "example": "2024-12-31T10:00:00Z"
+        },
+        "date_to": {
+          "type": "string",
+          "format": "date-time",
+          "example": "2024-12-31T18:00:00Z"
+        },
+        "full_cost": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "overtime_cost": {
+          "description": "amount billed for staying past date_to",
+          "type": "integer",
+          "format": "int64"
+        },
+        "parking_place_id": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "series_id": {
+          "description": "booking series the booking belongs to, if any",
+          "type": "integer",
+          "format": "int64"
+        },
+        "status": {
+          "description": "status of booking",
+          "type": "string",
+          "enum": [
+            "Waiting",
+            "Confirmed",
+            "Active",
+            "Completed",
+            "Canceled",
+            "Expired",
+            "NoShow"
+          ]
+        },
+        "user_id": {
+          "type": "string"
+        },
+        "vehicle_id": {
+          "description": "vehicle that parks; may be left out by drivers with a single vehicle",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "BookingEvent": {
+      "type": "object",
+      "properties": {
+        "actor_id": {
+          "description": "user ID, or the name of the system job",
+          "type": "string"
+        },
+        "actor_role": {
+          "type": "string",
+          "enum": [
+            "driver",
+            "owner",
+            "admin",
+            "system"
+          ]
+        },
+        "booking_id": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "event_id": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "event_type": {
+          "description": "canceled records the refund decision for a paid booking, refunded the amount returned",
+          "type": "string",
+          "enum": [
+            "created",
+            "modified",
+            "status_changed",
+            "canceled",
+            "refunded",
+            "deleted"
+          ]
+        },
+        "new_value": {
+          "type": "object",
+          "additionalProperties": true
+        },
+        "old_value": {
+          "type": "object",
+          "additionalProperties": true
+        },
+        "trace_id": {
+          "type": "string"
+        }
+      }
+    },
+    "BookingExtension": {
+      "description": "exactly one of date_to and duration_minutes",
+      "type": "object",
+      "properties": {
+        "date_to": {
+          "description": "new end of the booking",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true,
+          "example": "2024-12-31T20:00:00Z"
+        },
+        "duration_minutes": {
+          "description": "time to add to the current end of the booking",
+          "type": "integer",
+          "format": "int64",
+          "maximum": 525600,
+          "minimum": 1,
+          "x-nullable": true
+        }
+      }
+    },
+    "BookingHold": {
+      "type": "object",
+      "required": [
+        "parking_place_id",
+        "date_from",
+        "date_to"
+      ],
+      "properties": {
+        "date_from": {
+          "type": "string",
+          "format": "date-time",
+          "example": "2024-12-31T10:00:00Z"
+        },
+        "date_to": {
+          "type": "string",
+          "format": "date-time",
+          "example": "2024-12-31T18:00:00Z"
+        },
+        "expires_at": {
+          "description": "the spot is released if no booking uses the hold by then",
+          "type": "string",
+          "format": "date-time"
+        },
+        "hold_id": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "parking_place_id": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "user_id": {
+          "type": "string"
+        }
+      }
+    },
+    "BookingQuote": {
+      "type": "object",
+      "properties": {
+        "date_from": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "date_to": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "hourly_rate": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "hours": {
+          "description": "billed duration, fractions of an hour included",
+          "type": "number",
+          "format": "double"
+        },
+        "items": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/PriceItem"
+          }
+        },
+        "parking_place_id": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "total": {
+          "description": "sum of the items, the amount the booking is charged",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "BookingQuoteRequest": {
+      "type": "object",
+      "required": [
+        "parking_place_id",
+        "date_from",
+        "date_to"
+      ],
+      "properties": {
+        "date_from": {
+          "type": "string",
+          "format": "date-time",
+          "example": "2024-12-31T10:00:00Z"
+        },
+        "date_to": {
+          "type": "string",
+          "format": "date-time",
+          "example": "2024-12-31T18:30:00Z"
+        },
+        "parking_place_id": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "vehicle_id": {
+          "description": "vehicle to check against the sizes the place takes",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "BookingSeries": {
+      "type": "object",
+      "required": [
+        "parking_place_id",
+        "date_from",
+        "date_to",
+        "rrule",
+        "until"
+      ],
+      "properties": {
+        "billing": {
+          "description": "per_occurrence charges the first occurrence when the series is created and every other one 24 hours before it starts, canceling only an occurrence that cannot be paid; upfront charges every occurrence at once and cancels the whole series when that payment fails",
+          "type": "string",
+          "default": "per_occurrence",
+          "enum": [
+            "per_occurrence",
+            "upfront"
+          ]
+        },
+        "bookings": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Booking"
+          }
+        },
+        "conflicts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SeriesConflict"
+          }
+        },
+        "date_from": {
+          "description": "start of the first occurrence",
+          "type": "string",
+          "format": "date-time",
+          "example": "2024-12-02T09:00:00Z"
+        },
+        "date_to": {
+          "description": "end of the first occurrence",
+          "type": "string",
+          "format": "date-time",
+          "example": "2024-12-02T18:00:00Z"
+        },
+        "parking_place_id": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "rrule": {
+          "description": "weekly recurrence rule: FREQ=WEEKLY with optional INTERVAL and BYDAY",
+          "type": "string",
+          "maxLength": 255,
+          "example": "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR"
+        },
+        "series_id": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "Active",
+            "Canceled"
+          ]
+        },
+        "until": {
+          "description": "no occurrence starts after this moment",
+          "type": "string",
+          "format": "date-time",
+          "example": "2025-02-28T23:59:59Z"
+        },
+        "user_id": {
+          "type": "string"
+        },
+        "vehicle_id": {
+          "description": "vehicle that parks at every occurrence; may be left out by drivers with a single vehicle",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "CalendarFeed": {
+      "type": "object",
+      "properties": {
+        "created_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "token": {
+          "description": "secret of the feed; it is shown only once",
+          "type": "string"
+        },
+        "url": {
+          "description": "address to subscribe to in a calendar app",
+          "type": "string"
+        }
+      }
+    },
+    "EntryPass": {
+      "type": "object",
+      "properties": {
+        "booking_id": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "parking_place_id": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "qr_code": {
+          "description": "QR code of the token as a PNG data URI",
+          "type": "string"
+        },
+        "token": {
+          "description": "signed pass, the content of the QR code",
+          "type": "string"
+        },
+        "valid_from": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "valid_until": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "Error": {
+      "type": "object",
+      "required": [
+        "error_status_code"
+      ],
+      "properties": {
+        "error_message": {
+          "type": "string"
+        },
+        "error_status_code": {
+          "type": "integer"
+        }
+      }
+    },
+    "ParkingPlace": {
+      "type": "object",
+      "required": [
+        "name",
+        "city",
+        "address"
+      ],
+      "properties": {
+        "address": {
+          "type": "string",
+          "example": "Red Square №1"
+        },
+        "allowed_vehicle_sizes": {
+          "description": "vehicle sizes the place takes; empty takes every size",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "enum": [
+              "motorcycle",
+              "small",
+              "medium",
+              "large"
+            ]
+          }
+        },
+        "capacity": {
+          "description": "total number of parking spots",
+          "type": "integer",
+          "format": "int64"
+        },
+        "city": {
+          "type": "string",
+          "example": "Moscow"
+        },
+        "hourly_rate": {
+          "description": "hourly parking rate",
+          "type": "integer",
+          "format": "int64"
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "name": {
+          "type": "string",
+          "example": "Central Parking"
+        },
+        "owner_id": {
+          "type": "string"
+        },
+        "parking_type": {
+          "description": "type of parking facility",
+          "type": "string",
+          "enum": [
+            "outdoor",
+            "covered",
+            "underground",
+            "multi-level"
+          ]
+        },
+        "status": {
+          "description": "only Active parking places take bookings",
+          "type": "string",
+          "enum": [
+            "Active",
+            "Suspended",
+            "Closing"
+          ],
+          "readOnly": true
+        }
+      }
+    },
+    "PassVerification": {
+      "type": "object",
+      "required": [
+        "valid"
+      ],
+      "properties": {
+        "booking": {
+          "$ref": "#/definitions/Booking"
+        },
+        "checked_in": {
+          "type": "boolean"
+        },
+        "reason": {
+          "description": "why the pass was refused",
+          "type": "string"
+        },
+        "valid": {
+          "description": "whether the car may enter",
+          "type": "boolean"
+        }
+      }
+    },
+    "PassVerificationRequest": {
+      "type": "object",
+      "required": [
+        "token",
+        "parking_place_id"
+      ],
+      "properties": {
+        "check_in": {
+          "description": "check the booking in when the pass is valid",
+          "type": "boolean",
+          "default": false
+        },
+        "parking_place_id": {
+          "description": "parking place of the gate",
+          "type": "integer",
+          "format": "int64"
+        },
+        "token": {
+          "type": "string"
+        }
+      }
+    },
+    "PlateBooking": {
+      "type": "object",
+      "properties": {
+        "booking": {
+          "$ref": "#/definitions/Booking"
+        },
+        "vehicle": {
+          "$ref": "#/definitions/Vehicle"
+        }
+      }
+    },
+    "PriceItem": {
+      "type": "object",
+      "properties": {
+        "amount": {
+          "description": "negative for rounding and discounts",
+          "type": "number",
+          "format": "double"
+        },
+        "description": {
+          "type": "string"
+        },
+        "kind": {
+          "type": "string",
+          "enum": [
+            "base",
+            "rounding",
+            "discount",
+            "fee"
+          ]
+        }
+      }
+    },
+    "Result": {
+      "type": "object",
+      "properties": {
+        "message": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "SeriesConflict": {
+      "type": "object",
+      "properties": {
+        "date_from": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "date_to": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "reason": {
+          "type": "string"
+        }
+      }
+    },
+    "Vehicle": {
+      "type": "object",
+      "required": [
+        "plate",
+        "country",
+        "size"
+      ],
+      "properties": {
+        "country": {
+          "description": "ISO 3166-1 alpha-2 code of the country of registration",
+          "type": "string",
+          "example": "RU"
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "electric": {
+          "type": "boolean"
+        },
+        "plate": {
+          "description": "license plate; stored upper case without spaces and dashes",
+          "type": "string",
+          "maxLength": 20,
+          "example": "A123BC77"
+        },
+        "size": {
+          "type": "string",
+          "enum": [
+            "motorcycle",
+            "small",
+            "medium",
+            "large"
+          ]
+        },
+        "user_id": {
+          "type": "string",
+          "readOnly": true
+        },
+        "vehicle_id": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        }
+      }
+    },
+    "WaitlistEntry": {
+      "type": "object",
+      "required": [
+        "parking_place_id",
+        "date_from",
+        "date_to"
+      ],
+      "properties": {
+        "auto_accept": {
+          "description": "create and charge the booking as soon as a spot frees up instead of sending an offer",
+          "type": "boolean"
+        },
+        "booking_id": {
+          "description": "booking created from the entry",
+          "type": "integer",
+          "format": "int64"
+        },
+        "date_from": {
+          "type": "string",
+          "format": "date-time",
+          "example": "2024-12-31T10:00:00Z"
+        },
+        "date_to": {
+          "type": "string",
+          "format": "date-time",
+          "example": "2024-12-31T18:00:00Z"
+        },
+        "entry_id": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "offer_expires_at": {
+          "description": "end of the current offer; the spot is reserved for the driver until then",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "parking_place_id": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "Queued",
+            "Offered",
+            "Booked",
+            "Expired",
+            "Left"
+          ]
+        },
+        "user_id": {
+          "type": "string"
+        },
+        "vehicle_id": {
+          "description": "vehicle the booking is made for; may be left out by drivers with a single vehicle",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    }
+  },
+  "securityDefinitions": {
+    "api_key": {
+      "type": "apiKey",
+      "name": "api_key",
+      "in": "header"
+    }
+  },
+  "tags": [
+    {
+      "description": "Driver operations",
+      "name": "driver"
+    },
+    {
+      "description": "Parking owner operations",
+      "name": "owner"
+    },
+    {
+      "description": "Inner operations",
+      "name": "instruments"
+    }
+  ]
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "schemes": [
+    "http"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "description": "Parking Net Go project | Booking svc",
+    "title": "parkings.booking",
+    "version": "0.3.0"
+  },
+  "paths": {
+    "/booking": {
+      "get": {
+        "security": [
+          {
+            "api_key": []
+          }
+        ],
+        "description": "Returns one page of bookings. Drivers see their own bookings, owners the bookings of their parking places and admins all bookings. Pass the X-Next-Cursor header of a page as cursor to get the next one.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "driver",
+          "owner"
+        ],
+        "summary": "Get suitable bookings",
+        "operationId": "get_booking",
+        "parameters": [
+          {
+            "type": "array",
+            "items": {
+              "type": "integer",
+              "format": "int64"
+            },
+            "collectionFormat": "multi",
+            "description": "Only bookings of these parking places; repeat the parameter for several",
+            "name": "parking_place_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Filter bookings by user ID (for drivers to get their own bookings)",
+            "name": "user_id",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "Only bookings in these statuses; repeat the parameter for several",
+            "name": "status",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Only bookings that end after this time",
+            "name": "date_from",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Only bookings that start before this time",
+            "name": "date_to",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "date_from",
+              "-date_from",
+              "created_at",
+              "-created_at"
+            ],
+            "type": "string",
+            "default": "-created_at",
+            "description": "Sort key; a leading minus sorts in descending order",
+            "name": "sort",
+            "in": "query"
+          },
+          {
+            "maximum": 200,
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "default": 50,
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "maxLength": 512,
+            "type": "string",
+            "description": "X-Next-Cursor of the previous page",
+            "name": "cursor",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Booking"
+              }
+            },
+            "headers": {
+              "X-Next-Cursor": {
+                "type": "string",
+                "description": "Cursor of the next page; absent on the last page"
+              }
+            }
+          },
+          "400": {
+            "description": "Incorrect filters or cursor",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "No access",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Booking not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "api_key": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "driver"
+        ],
+        "summary": "Create booking",
+        "operationId": "create_booking",
+        "parameters": [
+          {
+            "maxLength": 255,
+            "type": "string",
+            "description": "Client-generated key that makes retries of the same request return the original booking",
+            "name": "Idempotency-Key",
+            "in": "header"
+          },
+          {
+            "name": "object",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "required": [
+                "parking_place_id",
+                "date_from",
+                "date_to"
+              ],
+              "properties": {
+                "date_from": {
+                  "type": "string",
+                  "format": "date-time",
+                  "example": "2024-12-31T10:00:00Z"
+                },
+                "date_to": {
+                  "type": "string",
+                  "format": "date-time",
+                  "example": "2024-12-31T18:00:00Z"
+                },
+                "hold_id": {
+                  "description": "hold of the driver that reserves a spot covering the period",
+                  "type": "integer",
+                  "format": "int64"
+                },
+                "parking_place_id": {
+                  "type": "integer",
+                  "format": "int64"
+                },
+                "vehicle_id": {
+                  "description": "vehicle that parks; may be left out by drivers with a single vehicle",
+                  "type": "integer",
+                  "format": "int64"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "booking_id": {
+                  "type": "integer",
+                  "format": "int64"
+                }
+              }
+            }
+          },
+          "400": {
+            "description": "Incorrect data",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "No access",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "No free parking spots for the requested period",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "422": {
+            "description": "Idempotency key was already used with a different request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/booking/availability": {
+      "get": {
+        "security": [
+          {
+            "api_key": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "driver",
+          "owner"
+        ],
+        "summary": "Get free spots of a parking place per time slot",
+        "operationId": "get_availability",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "parking_place_id",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "name": "from",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "name": "to",
+            "in": "query",
+            "required": true
+          },
+          {
+            "enum": [
+              "hour",
+              "day"
+            ],
+            "type": "string",
+            "default": "hour",
+            "description": "length of a single time slot",
+            "name": "granularity",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/Availability"
+            }
+          },
+          "400": {
+            "description": "Incorrect data",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "No access",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Parking place not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/booking/calendar": {
+      "post": {
+        "security": [
+          {
+            "api_key": []
+          }
+        ],
+        "description": "Returns a new secret feed URL for calendar apps. Drivers get their own bookings, owners the bookings at their parking places. A previous feed of the user stops working.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "driver"
+        ],
+        "summary": "Create the calendar feed of the user",
+        "operationId": "create_calendar_feed",
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/CalendarFeed"
+            }
+          },
+          "403": {
+            "description": "No access",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "security": [
+          {
+            "api_key": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "driver"
+        ],
+        "summary": "Revoke the calendar feed of the user",
+        "operationId": "revoke_calendar_feed",
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/Result"
+            }
+          },
+          "403": {
+            "description": "No access",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "The user has no calendar feed",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/booking/calendar/{token}": {
+      "get": {
+        "security": [],
+        "description": "Authenticated by the feed token in the URL instead of api_key, so calendar apps can subscribe to it. Canceled bookings stay in the feed as cancelled events for a while.",
+        "produces": [
+          "text/calendar",
+          "application/json"
+        ],
+        "tags": [
+          "driver"
+        ],
+        "summary": "iCalendar feed of bookings",
+        "operationId": "get_calendar_feed",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "token",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Only bookings at this parking place",
+            "name": "parking_place_id",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "403": {
+            "description": "The parking place is not one of the owner's",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Unknown or revoked feed token",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/booking/hold": {
+      "post": {
+        "security": [
+          {
+            "api_key": []
+          }
+        ],
+        "description": "The spot counts as taken until the hold expires or a booking is created with its hold_id.",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "driver"
+        ],
+        "summary": "Hold a spot for a few minutes before booking it",
+        "operationId": "create_booking_hold",
+        "parameters": [
+          {
+            "name": "object",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BookingHold"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/BookingHold"
+            }
+          },
+          "400": {
+            "description": "Incorrect data",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "No access",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "No free parking spots for the requested period",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/booking/pass/verify": {
+      "post": {
+        "security": [
+          {
+            "api_key": []
+          }
+        ],
+        "description": "Checks the pass against the current booking, its status and time window. With check_in the booking is checked in at once and the pass cannot be used again.",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "owner"
+        ],
+        "summary": "Verify an entry pass at the gate",
+        "operationId": "verify_entry_pass",
+        "parameters": [
+          {
+            "name": "object",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/PassVerificationRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The verdict; an unusable pass is not an error",
+            "schema": {
+              "$ref": "#/definitions/PassVerification"
+            }
+          },
+          "403": {
+            "description": "The parking place is not yours",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/booking/quote": {
+      "post": {
+        "security": [
+          {
+            "api_key": []
+          }
+        ],
+        "description": "Returns the itemized cost of parking at the place for the time range. The total is what POST /booking charges for the same place, time range and vehicle. Nothing is created or held.",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "driver"
+        ],
+        "summary": "Price a booking without making it",
+        "operationId": "quote_booking",
+        "parameters": [
+          {
+            "name": "object",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BookingQuoteRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/BookingQuote"
+            }
+          },
+          "400": {
+            "description": "Incorrect data",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "No access",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Parking place not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Parking place does not take bookings",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/booking/series": {
+      "post": {
+        "security": [
+          {
+            "api_key": []
+          }
+        ],
+        "description": "Expands the weekly rule into individual bookings. Occurrences without a free spot are skipped and reported as conflicts.",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "driver"
+        ],
+        "summary": "Create a recurring booking series",
+        "operationId": "create_booking_series",
+        "parameters": [
+          {
+            "name": "object",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BookingSeries"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/BookingSeries"
+            }
+          },
+          "400": {
+            "description": "Incorrect data",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "No access",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "No occurrence of the series has a free spot",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/booking/series/{series_id}": {
+      "get": {
+        "security": [
+          {
+            "api_key": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "driver",
+          "owner"
+        ],
+        "summary": "Get booking series with its bookings",
+        "operationId": "get_booking_series",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "series_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/BookingSeries"
+            }
+          },
+          "403": {
+            "description": "No access",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Series not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "security": [
+          {
+            "api_key": []
+          }
+        ],
+        "description": "Cancels every occurrence that has not started yet; paid occurrences are refunded according to the cancellation policy.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "driver"
+        ],
+        "summary": "Cancel the whole series",
+        "operationId": "cancel_booking_series",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "series_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/BookingSeries"
+            }
+          },
+          "403": {
+            "description": "No access",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Series not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/booking/series/{series_id}/bookings/{booking_id}": {
+      "delete": {
+        "security": [
+          {
+            "api_key": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "driver"
+        ],
+        "summary": "Cancel a single occurrence of a series",
+        "operationId": "cancel_series_occurrence",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "series_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "booking_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/Booking"
+            }
+          },
+          "400": {
+            "description": "The occurrence can no longer be canceled",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "No access",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Occurrence not found in the series",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/booking/vehicles": {
+      "get": {
+        "security": [
+          {
+            "api_key": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "driver"
+        ],
+        "summary": "List the vehicles of the driver",
+        "operationId": "list_vehicles",
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Vehicle"
+              }
+            }
+          },
+          "403": {
+            "description": "No access",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "api_key": []
+          }
+        ],
+        "description": "Bookings name the vehicle that parks. A driver may register at most 10 vehicles.",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "driver"
+        ],
+        "summary": "Register a vehicle",
+        "operationId": "register_vehicle",
+        "parameters": [
+          {
+            "name": "object",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Vehicle"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/Vehicle"
+            }
+          },
+          "400": {
+            "description": "Invalid plate, country or size",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "No access",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "The vehicle is already registered or the driver has too many",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/booking/vehicles/lookup": {
+      "get": {
+        "security": [
+          {
+            "api_key": []
+          }
+        ],
+        "description": "Bookings at the owner's parking places that a vehicle with the plate may enter with now: Confirmed bookings whose check-in window is open and Active bookings.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "owner"
+        ],
+        "summary": "Find the current bookings of a license plate",
+        "operationId": "find_bookings_by_plate",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "spaces and dashes are ignored",
+            "name": "plate",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "parking_place_id",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/PlateBooking"
+              }
+            }
+          },
+          "400": {
+            "description": "Invalid plate",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "No access",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/booking/vehicles/{vehicle_id}": {
+      "delete": {
+        "security": [
+          {
+            "api_key": []
+          }
+        ],
+        "description": "A vehicle with bookings or waitlist entries that are not over cannot be removed.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "driver"
+        ],
+        "summary": "Remove a vehicle",
+        "operationId": "remove_vehicle",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "vehicle_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/Result"
+            }
+          },
+          "403": {
+            "description": "No access",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Vehicle not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "The vehicle is still in use",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/booking/waitlist": {
+      "get": {
+        "security": [
+          {
+            "api_key": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "driver"
+        ],
+        "summary": "List the waitlist entries of the driver",
+        "operationId": "get_waitlist",
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/WaitlistEntry"
+              }
+            }
+          },
+          "403": {
+            "description": "No access",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "api_key": []
+          }
+        ],
+        "description": "When a spot frees up for the window, the driver gets a time-limited offer, or the booking is created and charged right away if auto_accept is set.",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "driver"
+        ],
+        "summary": "Join the waitlist of a parking place for a time window",
+        "operationId": "join_waitlist",
+        "parameters": [
+          {
+            "name": "object",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/WaitlistEntry"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/WaitlistEntry"
+            }
+          },
+          "400": {
+            "description": "Incorrect data",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "No access",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/booking/waitlist/{entry_id}": {
+      "delete": {
+        "security": [
+          {
+            "api_key": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "driver"
+        ],
+        "summary": "Leave a waitlist",
+        "operationId": "leave_waitlist",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "entry_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/WaitlistEntry"
+            }
+          },
+          "400": {
+            "description": "The entry was already booked or closed",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "No access",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Waitlist entry not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/booking/waitlist/{entry_id}/accept": {
+      "post": {
+        "security": [
+          {
+            "api_key": []
+          }
+        ],
+        "description": "Creates and charges the booking reserved by the offer.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "driver"
+        ],
+        "summary": "Accept the offer of a waitlist entry",
+        "operationId": "accept_waitlist_offer",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "entry_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/WaitlistEntry"
+            }
+          },
+          "400": {
+            "description": "The entry has no open offer",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "No access",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Waitlist entry not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/booking/{booking_id}": {
+      "get": {
+        "security": [
+          {
+            "api_key": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "driver",
+          "owner"
+        ],
+        "summary": "Get booking info by id",
+        "operationId": "get_booking_by_id",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "ID of booking to return",
+            "name": "booking_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/Booking"
+            }
+          },
+          "403": {
+            "description": "No access",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Booking not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "put": {
+        "security": [
+          {
+            "api_key": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "driver",
+          "owner"
+        ],
+        "summary": "Update booking",
+        "operationId": "update_booking",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "ID of booking to change",
+            "name": "booking_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "object",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Booking"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/Booking"
+            }
+          },
+          "400": {
+            "description": "Incorrect data",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "No access",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "No free parking spots for the requested period",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "security": [
+          {
+            "api_key": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "driver",
+          "owner"
+        ],
+        "summary": "Delete booking",
+        "operationId": "delete_booking",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "ID of booking to delete",
+            "name": "booking_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/Result"
+            }
+          },
+          "403": {
+            "description": "No access",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Booking not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/booking/{booking_id}/check-in": {
+      "post": {
+        "security": [
+          {
+            "api_key": []
+          }
+        ],
+        "description": "Called by the driver or by the gate system of the parking owner. Moves a Confirmed booking to Active.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "driver",
+          "owner"
+        ],
+        "summary": "Record the arrival of the driver",
+        "operationId": "check_in_booking",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "booking_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/Booking"
+            }
+          },
+          "400": {
+            "description": "The booking cannot be checked in now",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "No access",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Booking not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/booking/{booking_id}/check-out": {
+      "post": {
+        "security": [
+          {
+            "api_key": []
+          }
+        ],
+        "description": "Completes an Active booking and bills the time spent past date_to at the hourly rate of the place times the overtime penalty.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "driver",
+          "owner"
+        ],
+        "summary": "Record the departure of the driver",
+        "operationId": "check_out_booking",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "booking_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/Booking"
+            }
+          },
+          "400": {
+            "description": "The booking is not checked in",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "No access",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Booking not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/booking/{booking_id}/extend": {
+      "post": {
+        "security": [
+          {
+            "api_key": []
+          }
+        ],
+        "description": "Moves the end of a Confirmed or Active booking later, either to date_to or by duration_minutes. The whole stay is repriced at the current hourly rate of the place and the difference to what was paid is charged; the driver and the owner are notified once the charge went through.",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "driver"
+        ],
+        "summary": "Extend a booking",
+        "operationId": "extend_booking",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "booking_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "object",
+            "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Booking"
+              "$ref": "#/definitions/BookingExtension"
             }
           }
         ],
@@ -699,7 +3922,7 @@ func init() {
             }
           },
           "400": {
-            "description": "Incorrect data",
+            "description": "Incorrect data or the payment for the added time failed",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -709,15 +3932,30 @@ func init() {
             "schema": {
               "$ref": "#/definitions/Error"
             }
+          },
+          "404": {
+            "description": "Booking not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "The booking cannot be extended now or no spot is free for the added time",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
           }
         }
-      },
-      "delete": {
+      }
+    },
+    "/booking/{booking_id}/history": {
+      "get": {
         "security": [
           {
             "api_key": []
           }
         ],
+        "description": "Lists every change of the booking, oldest first: who made it, the old and new values and the trace of the request. The history stays available after the booking is deleted.",
         "produces": [
           "application/json"
         ],
@@ -725,13 +3963,12 @@ func init() {
           "driver",
           "owner"
         ],
-        "summary": "Delete booking",
-        "operationId": "delete_booking",
+        "summary": "Get the history of a booking",
+        "operationId": "get_booking_history",
         "parameters": [
           {
             "type": "integer",
             "format": "int64",
-            "description": "ID of booking to delete",
             "name": "booking_id",
             "in": "path",
             "required": true
@@ -741,7 +3978,63 @@ func init() {
           "200": {
             "description": "successful operation",
             "schema": {
-              "$ref": "#/definitions/Result"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/BookingEvent"
+              }
+            }
+          },
+          "403": {
+            "description": "No access",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Booking not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/booking/{booking_id}/pass": {
+      "get": {
+        "security": [
+          {
+            "api_key": []
+          }
+        ],
+        "description": "Issues a signed pass for a Confirmed booking to show at the gate as a QR code. It is valid from the opening of check-in until the booking ends and lets the car in once.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "driver"
+        ],
+        "summary": "Get an entry pass for the booking",
+        "operationId": "get_entry_pass",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "booking_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/EntryPass"
+            }
+          },
+          "400": {
+            "description": "The booking is not Confirmed or has ended",
+            "schema": {
+              "$ref": "#/definitions/Error"
             }
           },
           "403": {
@@ -759,66 +4052,451 @@ func init() {
         }
       }
     },
-    "/metrics": {
-      "get": {
-        "security": [],
-        "produces": [
-          "application/json"
-        ],
-        "tags": [
-          "instruments"
-        ],
-        "summary": "Prometheus metrics",
-        "responses": {
-          "200": {
-            "description": "ok"
+    "/metrics": {
+      "get": {
+        "security": [],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "instruments"
+        ],
+        "summary": "Prometheus metrics",
+        "responses": {
+          "200": {
+            "description": "ok"
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "Availability": {
+      "type": "object",
+      "properties": {
+        "capacity": {
+          "description": "total number of parking spots",
+          "type": "integer",
+          "format": "int64"
+        },
+        "granularity": {
+          "type": "string",
+          "enum": [
+            "hour",
+            "day"
+          ]
+        },
+        "parking_place_id": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "slots": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/AvailabilitySlot"
+          }
+        }
+      }
+    },
+    "AvailabilitySlot": {
+      "type": "object",
+      "properties": {
+        "booked": {
+          "description": "number of spots taken by bookings in this slot",
+          "type": "integer",
+          "format": "int64"
+        },
+        "date_from": {
+          "type": "string",
+          "format": "date-time",
+          "example": "2024-12-31T10:00:00Z"
+        },
+        "date_to": {
+          "type": "string",
+          "format": "date-time",
+          "example": "2024-12-31T11:00:00Z"
+        },
+        "free": {
+          "description": "number of spots still available in this slot",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "Booking": {
+      "type": "object",
+      "required": [
+        "date_from",
+        "date_to",
+        "parking_place_id"
+      ],
+      "properties": {
+        "booking_id": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "checked_in_at": {
+          "description": "actual arrival of the driver",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "checked_out_at": {
+          "description": "actual departure of the driver",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "date_from": {
+          "type": "string",
+          "format": "date-time",
+          "example": "2024-12-31T10:00:00Z"
+        },
+        "date_to": {
+          "type": "string",
+          "format": "date-time",
+          "example": "2024-12-31T18:00:00Z"
+        },
+        "full_cost": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "overtime_cost": {
+          "description": "amount billed for staying past date_to",
+          "type": "integer",
+          "format": "int64"
+        },
+        "parking_place_id": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "series_id": {
+          "description": "booking series the booking belongs to, if any",
+          "type": "integer",
+          "format": "int64"
+        },
+        "status": {
+          "description": "status of booking",
+          "type": "string",
+          "enum": [
+            "Waiting",
+            "Confirmed",
+            "Active",
+            "Completed",
+            "Canceled",
+            "Expired",
+            "NoShow"
+          ]
+        },
+        "user_id": {
+          "type": "string"
+        },
+        "vehicle_id": {
+          "description": "vehicle that parks; may be left out by drivers with a single vehicle",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "BookingEvent": {
+      "type": "object",
+      "properties": {
+        "actor_id": {
+          "description": "user ID, or the name of the system job",
+          "type": "string"
+        },
+        "actor_role": {
+          "type": "string",
+          "enum": [
+            "driver",
+            "owner",
+            "admin",
+            "system"
+          ]
+        },
+        "booking_id": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "event_id": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "event_type": {
+          "description": "canceled records the refund decision for a paid booking, refunded the amount returned",
+          "type": "string",
+          "enum": [
+            "created",
+            "modified",
+            "status_changed",
+            "canceled",
+            "refunded",
+            "deleted"
+          ]
+        },
+        "new_value": {
+          "type": "object",
+          "additionalProperties": true
+        },
+        "old_value": {
+          "type": "object",
+          "additionalProperties": true
+        },
+        "trace_id": {
+          "type": "string"
+        }
+      }
+    },
+    "BookingExtension": {
+      "description": "exactly one of date_to and duration_minutes",
+      "type": "object",
+      "properties": {
+        "date_to": {
+          "description": "new end of the booking",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true,
+          "example": "2024-12-31T20:00:00Z"
+        },
+        "duration_minutes": {
+          "description": "time to add to the current end of the booking",
+          "type": "integer",
+          "format": "int64",
+          "maximum": 525600,
+          "minimum": 1,
+          "x-nullable": true
+        }
+      }
+    },
+    "BookingHold": {
+      "type": "object",
+      "required": [
+        "parking_place_id",
+        "date_from",
+        "date_to"
+      ],
+      "properties": {
+        "date_from": {
+          "type": "string",
+          "format": "date-time",
+          "example": "2024-12-31T10:00:00Z"
+        },
+        "date_to": {
+          "type": "string",
+          "format": "date-time",
+          "example": "2024-12-31T18:00:00Z"
+        },
+        "expires_at": {
+          "description": "the spot is released if no booking uses the hold by then",
+          "type": "string",
+          "format": "date-time"
+        },
+        "hold_id": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "parking_place_id": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "user_id": {
+          "type": "string"
+        }
+      }
+    },
+    "BookingQuote": {
+      "type": "object",
+      "properties": {
+        "date_from": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "date_to": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "hourly_rate": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "hours": {
+          "description": "billed duration, fractions of an hour included",
+          "type": "number",
+          "format": "double"
+        },
+        "items": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/PriceItem"
           }
+        },
+        "parking_place_id": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "total": {
+          "description": "sum of the items, the amount the booking is charged",
+          "type": "integer",
+          "format": "int64"
         }
       }
-    }
-  },
-  "definitions": {
-    "Booking": {
+    },
+    "BookingQuoteRequest": {
       "type": "object",
       "required": [
+        "parking_place_id",
         "date_from",
-        "date_to",
-        "parking_place_id"
+        "date_to"
       ],
       "properties": {
-        "booking_id": {
+        "date_from": {
+          "type": "string",
+          "format": "date-time",
+          "example": "2024-12-31T10:00:00Z"
+        },
+        "date_to": {
+          "type": "string",
+          "format": "date-time",
+          "example": "2024-12-31T18:30:00Z"
+        },
+        "parking_place_id": {
           "type": "integer",
           "format": "int64"
         },
+        "vehicle_id": {
+          "description": "vehicle to check against the sizes the place takes",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "BookingSeries": {
+      "type": "object",
+      "required": [
+        "parking_place_id",
+        "date_from",
+        "date_to",
+        "rrule",
+        "until"
+      ],
+      "properties": {
+        "billing": {
+          "description": "per_occurrence charges the first occurrence when the series is created and every other one 24 hours before it starts, canceling only an occurrence that cannot be paid; upfront charges every occurrence at once and cancels the whole series when that payment fails",
+          "type": "string",
+          "default": "per_occurrence",
+          "enum": [
+            "per_occurrence",
+            "upfront"
+          ]
+        },
+        "bookings": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Booking"
+          }
+        },
+        "conflicts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SeriesConflict"
+          }
+        },
         "date_from": {
+          "description": "start of the first occurrence",
           "type": "string",
           "format": "date-time",
-          "example": "2024-12-31T10:00:00Z"
+          "example": "2024-12-02T09:00:00Z"
         },
         "date_to": {
+          "description": "end of the first occurrence",
           "type": "string",
           "format": "date-time",
-          "example": "2024-12-31T18:00:00Z"
+          "example": "2024-12-02T18:00:00Z"
         },
-        "full_cost": {
+        "parking_place_id": {
           "type": "integer",
           "format": "int64"
         },
-        "parking_place_id": {
+        "rrule": {
+          "description": "weekly recurrence rule: FREQ=WEEKLY with optional INTERVAL and BYDAY",
+          "type": "string",
+          "maxLength": 255,
+          "example": "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR"
+        },
+        "series_id": {
           "type": "integer",
           "format": "int64"
         },
         "status": {
-          "description": "status of booking",
           "type": "string",
           "enum": [
-            "Waiting",
-            "Confirmed",
+            "Active",
             "Canceled"
           ]
         },
+        "until": {
+          "description": "no occurrence starts after this moment",
+          "type": "string",
+          "format": "date-time",
+          "example": "2025-02-28T23:59:59Z"
+        },
         "user_id": {
           "type": "string"
+        },
+        "vehicle_id": {
+          "description": "vehicle that parks at every occurrence; may be left out by drivers with a single vehicle",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "CalendarFeed": {
+      "type": "object",
+      "properties": {
+        "created_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "token": {
+          "description": "secret of the feed; it is shown only once",
+          "type": "string"
+        },
+        "url": {
+          "description": "address to subscribe to in a calendar app",
+          "type": "string"
+        }
+      }
+    },
+    "EntryPass": {
+      "type": "object",
+      "properties": {
+        "booking_id": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "parking_place_id": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "qr_code": {
+          "description": "QR code of the token as a PNG data URI",
+          "type": "string"
+        },
+        "token": {
+          "description": "signed pass, the content of the QR code",
+          "type": "string"
+        },
+        "valid_from": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "valid_until": {
+          "type": "string",
+          "format": "date-time"
         }
       }
     },
@@ -848,6 +4526,19 @@ func init() {
           "type": "string",
           "example": "Red Square №1"
         },
+        "allowed_vehicle_sizes": {
+          "description": "vehicle sizes the place takes; empty takes every size",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "enum": [
+              "motorcycle",
+              "small",
+              "medium",
+              "large"
+            ]
+          }
+        },
         "capacity": {
           "description": "total number of parking spots",
           "type": "integer",
@@ -882,6 +4573,93 @@ func init() {
             "underground",
             "multi-level"
           ]
+        },
+        "status": {
+          "description": "only Active parking places take bookings",
+          "type": "string",
+          "enum": [
+            "Active",
+            "Suspended",
+            "Closing"
+          ],
+          "readOnly": true
+        }
+      }
+    },
+    "PassVerification": {
+      "type": "object",
+      "required": [
+        "valid"
+      ],
+      "properties": {
+        "booking": {
+          "$ref": "#/definitions/Booking"
+        },
+        "checked_in": {
+          "type": "boolean"
+        },
+        "reason": {
+          "description": "why the pass was refused",
+          "type": "string"
+        },
+        "valid": {
+          "description": "whether the car may enter",
+          "type": "boolean"
+        }
+      }
+    },
+    "PassVerificationRequest": {
+      "type": "object",
+      "required": [
+        "token",
+        "parking_place_id"
+      ],
+      "properties": {
+        "check_in": {
+          "description": "check the booking in when the pass is valid",
+          "type": "boolean",
+          "default": false
+        },
+        "parking_place_id": {
+          "description": "parking place of the gate",
+          "type": "integer",
+          "format": "int64"
+        },
+        "token": {
+          "type": "string"
+        }
+      }
+    },
+    "PlateBooking": {
+      "type": "object",
+      "properties": {
+        "booking": {
+          "$ref": "#/definitions/Booking"
+        },
+        "vehicle": {
+          "$ref": "#/definitions/Vehicle"
+        }
+      }
+    },
+    "PriceItem": {
+      "type": "object",
+      "properties": {
+        "amount": {
+          "description": "negative for rounding and discounts",
+          "type": "number",
+          "format": "double"
+        },
+        "description": {
+          "type": "string"
+        },
+        "kind": {
+          "type": "string",
+          "enum": [
+            "base",
+            "rounding",
+            "discount",
+            "fee"
+          ]
         }
       }
     },
@@ -895,6 +4673,130 @@ func init() {
           "type": "string"
         }
       }
+    },
+    "SeriesConflict": {
+      "type": "object",
+      "properties": {
+        "date_from": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "date_to": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "reason": {
+          "type": "string"
+        }
+      }
+    },
+    "Vehicle": {
+      "type": "object",
+      "required": [
+        "plate",
+        "country",
+        "size"
+      ],
+      "properties": {
+        "country": {
+          "description": "ISO 3166-1 alpha-2 code of the country of registration",
+          "type": "string",
+          "example": "RU"
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "electric": {
+          "type": "boolean"
+        },
+        "plate": {
+          "description": "license plate; stored upper case without spaces and dashes",
+          "type": "string",
+          "maxLength": 20,
+          "example": "A123BC77"
+        },
+        "size": {
+          "type": "string",
+          "enum": [
+            "motorcycle",
+            "small",
+            "medium",
+            "large"
+          ]
+        },
+        "user_id": {
+          "type": "string",
+          "readOnly": true
+        },
+        "vehicle_id": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        }
+      }
+    },
+    "WaitlistEntry": {
+      "type": "object",
+      "required": [
+        "parking_place_id",
+        "date_from",
+        "date_to"
+      ],
+      "properties": {
+        "auto_accept": {
+          "description": "create and charge the booking as soon as a spot frees up instead of sending an offer",
+          "type": "boolean"
+        },
+        "booking_id": {
+          "description": "booking created from the entry",
+          "type": "integer",
+          "format": "int64"
+        },
+        "date_from": {
+          "type": "string",
+          "format": "date-time",
+          "example": "2024-12-31T10:00:00Z"
+        },
+        "date_to": {
+          "type": "string",
+          "format": "date-time",
+          "example": "2024-12-31T18:00:00Z"
+        },
+        "entry_id": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "offer_expires_at": {
+          "description": "end of the current offer; the spot is reserved for the driver until then",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "parking_place_id": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "Queued",
+            "Offered",
+            "Booked",
+            "Expired",
+            "Left"
+          ]
+        },
+        "user_id": {
+          "type": "string"
+        },
+        "vehicle_id": {
+          "description": "vehicle the booking is made for; may be left out by drivers with a single vehicle",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
     }
   },
   "securityDefinitions": {
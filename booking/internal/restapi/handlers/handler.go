@@ -1,22 +1,38 @@
 package handlers
 
 import (
+	"crypto/rand"
 	"github.com/h4x4d/parking_net/booking/internal/database_service"
 	payment_client "github.com/h4x4d/parking_net/booking/internal/grpc/client"
+	"github.com/h4x4d/parking_net/booking/internal/pass"
+	"github.com/h4x4d/parking_net/booking/internal/scheduler"
 	"github.com/h4x4d/parking_net/pkg/client"
+	"github.com/h4x4d/parking_net/pkg/domain"
 	"github.com/h4x4d/parking_net/pkg/jaeger"
 	"github.com/h4x4d/parking_net/pkg/notification"
 	"go.opentelemetry.io/otel/trace"
 	"log"
 	"log/slog"
+	"os"
+	"strconv"
+	"time"
 )
 
+const defaultHoldTTL = 5 * time.Minute
+
 type Handler struct {
 	Database      *database_service.DatabaseService
 	KafkaConn     *notification.KafkaConnection
 	KeyCloak      *client.Client
 	PaymentClient *payment_client.PaymentClient
+	Relay         *scheduler.Relay
 	tracer        trace.Tracer
+	// overtimePenalty multiplies the hourly rate for time spent past date_to.
+	overtimePenalty float64
+	// holdTTL is how long POST /booking/hold keeps a spot reserved.
+	holdTTL time.Duration
+	// passes signs and verifies entry passes.
+	passes *pass.Signer
 }
 
 func NewHandler(connStr string) (*Handler, error) {
@@ -35,13 +51,71 @@ func NewHandler(connStr string) (*Handler, error) {
 		keycloakClient = nil
 	}
 	paymentClient := payment_client.NewPaymentClient()
+	relay := scheduler.NewRelay(db, paymentClient, conn, keycloakClient)
 	tracer, err := jaeger.InitTracer("Booking")
 	if err != nil {
 		log.Fatal("init tracer", err)
 	}
-	return &Handler{db, conn, keycloakClient, paymentClient, tracer}, nil
+	return &Handler{db, conn, keycloakClient, paymentClient, relay, tracer, overtimePenaltyFromEnv(),
+		holdTTLFromEnv(), passSignerFromEnv()}, nil
+}
+
+func overtimePenaltyFromEnv() float64 {
+	raw := os.Getenv("BOOKING_OVERTIME_PENALTY")
+	if raw == "" {
+		return domain.DefaultOvertimePenalty
+	}
+	penalty, err := strconv.ParseFloat(raw, 64)
+	if err == nil {
+		err = domain.ValidateOvertimePenalty(penalty)
+	}
+	if err != nil {
+		slog.Warn("invalid BOOKING_OVERTIME_PENALTY, using default",
+			"value", raw, "default", domain.DefaultOvertimePenalty)
+		return domain.DefaultOvertimePenalty
+	}
+	return penalty
+}
+
+func holdTTLFromEnv() time.Duration {
+	raw := os.Getenv("BOOKING_HOLD_TTL")
+	if raw == "" {
+		return defaultHoldTTL
+	}
+	ttl, err := time.ParseDuration(raw)
+	if err != nil || ttl <= 0 {
+		slog.Warn("invalid BOOKING_HOLD_TTL, using default", "value", raw, "default", defaultHoldTTL.String())
+		return defaultHoldTTL
+	}
+	return ttl
+}
+
+// passSignerFromEnv falls back to a random key, which is fine for a single
+// instance but invalidates every pass on restart.
+func passSignerFromEnv() *pass.Signer {
+	secret := []byte(os.Getenv("BOOKING_PASS_SECRET"))
+	if len(secret) == 0 {
+		slog.Warn("BOOKING_PASS_SECRET is not set, entry passes will not survive a restart")
+		secret = make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			log.Fatal("generate entry pass secret", err)
+		}
+	}
+	return pass.NewSigner(secret)
 }
 
 func (handler *Handler) GetTracer() trace.Tracer {
 	return handler.tracer
 }
+
+func (handler *Handler) GetOvertimePenalty() float64 {
+	return handler.overtimePenalty
+}
+
+func (handler *Handler) GetHoldTTL() time.Duration {
+	return handler.holdTTL
+}
+
+func (handler *Handler) GetPassSigner() *pass.Signer {
+	return handler.passes
+}
@@ -92,9 +92,15 @@ type CreateBookingBody struct {
 	// Format: date-time
 	DateTo *strfmt.DateTime `json:"date_to"`
 
+	// hold of the driver that reserves a spot covering the period
+	HoldID int64 `json:"hold_id,omitempty"`
+
 	// parking place id
 	// Required: true
 	ParkingPlaceID *int64 `json:"parking_place_id"`
+
+	// vehicle that parks; may be left out by drivers with a single vehicle
+	VehicleID int64 `json:"vehicle_id,omitempty"`
 }
 
 // Validate validates this create booking body
@@ -12,6 +12,7 @@ import (
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/validate"
 )
 
@@ -32,6 +33,11 @@ type CreateBookingParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*Client-generated key that makes retries of the same request return the original booking
+	  Max Length: 255
+	  In: header
+	*/
+	IdempotencyKey *string
 	/*
 	  Required: true
 	  In: body
@@ -48,6 +54,10 @@ func (o *CreateBookingParams) BindRequest(r *http.Request, route *middleware.Mat
 
 	o.HTTPRequest = r
 
+	if err := o.bindIdempotencyKey(r.Header[http.CanonicalHeaderKey("Idempotency-Key")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if runtime.HasBody(r) {
 		defer r.Body.Close()
 		var body CreateBookingBody
@@ -80,3 +90,34 @@ func (o *CreateBookingParams) BindRequest(r *http.Request, route *middleware.Mat
 	}
 	return nil
 }
+
+// bindIdempotencyKey binds and validates parameter IdempotencyKey from header.
+func (o *CreateBookingParams) bindIdempotencyKey(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.IdempotencyKey = &raw
+
+	if err := o.validateIdempotencyKey(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateIdempotencyKey carries on validations for parameter IdempotencyKey
+func (o *CreateBookingParams) validateIdempotencyKey(formats strfmt.Registry) error {
+
+	if err := validate.MaxLength("Idempotency-Key", "header", *o.IdempotencyKey, 255); err != nil {
+		return err
+	}
+
+	return nil
+}
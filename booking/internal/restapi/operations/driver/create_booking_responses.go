@@ -147,3 +147,93 @@ func (o *CreateBookingForbidden) WriteResponse(rw http.ResponseWriter, producer
 		}
 	}
 }
+
+// CreateBookingConflictCode is the HTTP code returned for type CreateBookingConflict
+const CreateBookingConflictCode int = 409
+
+/*
+CreateBookingConflict No free parking spots for the requested period
+
+swagger:response createBookingConflict
+*/
+type CreateBookingConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateBookingConflict creates CreateBookingConflict with default headers values
+func NewCreateBookingConflict() *CreateBookingConflict {
+
+	return &CreateBookingConflict{}
+}
+
+// WithPayload adds the payload to the create booking conflict response
+func (o *CreateBookingConflict) WithPayload(payload *models.Error) *CreateBookingConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create booking conflict response
+func (o *CreateBookingConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateBookingConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateBookingUnprocessableEntityCode is the HTTP code returned for type CreateBookingUnprocessableEntity
+const CreateBookingUnprocessableEntityCode int = 422
+
+/*
+CreateBookingUnprocessableEntity Idempotency key was already used with a different request
+
+swagger:response createBookingUnprocessableEntity
+*/
+type CreateBookingUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateBookingUnprocessableEntity creates CreateBookingUnprocessableEntity with default headers values
+func NewCreateBookingUnprocessableEntity() *CreateBookingUnprocessableEntity {
+
+	return &CreateBookingUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the create booking unprocessable entity response
+func (o *CreateBookingUnprocessableEntity) WithPayload(payload *models.Error) *CreateBookingUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create booking unprocessable entity response
+func (o *CreateBookingUnprocessableEntity) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateBookingUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -35,6 +35,8 @@ func NewGetBooking(ctx *middleware.Context, handler GetBookingHandler) *GetBooki
 	GetBooking swagger:route GET /booking driver owner getBooking
 
 Get suitable bookings
+
+Returns one page of bookings. Drivers see their own bookings, owners the bookings of their parking places and admins all bookings. Pass the X-Next-Cursor header of a page as cursor to get the next one.
 */
 type GetBooking struct {
 	Context *middleware.Context
@@ -6,6 +6,7 @@ package driver
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/go-openapi/errors"
@@ -13,14 +14,25 @@ import (
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // NewGetBookingParams creates a new GetBookingParams object
-//
-// There are no default values defined in the spec.
+// with the default values initialized.
 func NewGetBookingParams() GetBookingParams {
 
-	return GetBookingParams{}
+	var (
+		// initialize parameters with default values
+
+		limitDefault = int64(50)
+		sortDefault  = string("-created_at")
+	)
+
+	return GetBookingParams{
+		Limit: &limitDefault,
+
+		Sort: &sortDefault,
+	}
 }
 
 // GetBookingParams contains all the bound params for the get booking operation
@@ -32,10 +44,41 @@ type GetBookingParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*X-Next-Cursor of the previous page
+	  Max Length: 512
+	  In: query
+	*/
+	Cursor *string
+	/*Only bookings that end after this time
+	  In: query
+	*/
+	DateFrom *strfmt.DateTime
+	/*Only bookings that start before this time
+	  In: query
+	*/
+	DateTo *strfmt.DateTime
 	/*
+	  Maximum: 200
+	  Minimum: 1
 	  In: query
+	  Default: 50
 	*/
-	ParkingPlaceID *int64
+	Limit *int64
+	/*Only bookings of these parking places; repeat the parameter for several
+	  In: query
+	  Collection Format: multi
+	*/
+	ParkingPlaceID []int64
+	/*Sort key; a leading minus sorts in descending order
+	  In: query
+	  Default: "-created_at"
+	*/
+	Sort *string
+	/*Only bookings in these statuses; repeat the parameter for several
+	  In: query
+	  Collection Format: multi
+	*/
+	Status []string
 	/*Filter bookings by user ID (for drivers to get their own bookings)
 	  In: query
 	*/
@@ -53,11 +96,41 @@ func (o *GetBookingParams) BindRequest(r *http.Request, route *middleware.Matche
 
 	qs := runtime.Values(r.URL.Query())
 
+	qCursor, qhkCursor, _ := qs.GetOK("cursor")
+	if err := o.bindCursor(qCursor, qhkCursor, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qDateFrom, qhkDateFrom, _ := qs.GetOK("date_from")
+	if err := o.bindDateFrom(qDateFrom, qhkDateFrom, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qDateTo, qhkDateTo, _ := qs.GetOK("date_to")
+	if err := o.bindDateTo(qDateTo, qhkDateTo, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qLimit, qhkLimit, _ := qs.GetOK("limit")
+	if err := o.bindLimit(qLimit, qhkLimit, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qParkingPlaceID, qhkParkingPlaceID, _ := qs.GetOK("parking_place_id")
 	if err := o.bindParkingPlaceID(qParkingPlaceID, qhkParkingPlaceID, route.Formats); err != nil {
 		res = append(res, err)
 	}
 
+	qSort, qhkSort, _ := qs.GetOK("sort")
+	if err := o.bindSort(qSort, qhkSort, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qStatus, qhkStatus, _ := qs.GetOK("status")
+	if err := o.bindStatus(qStatus, qhkStatus, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qUserID, qhkUserID, _ := qs.GetOK("user_id")
 	if err := o.bindUserID(qUserID, qhkUserID, route.Formats); err != nil {
 		res = append(res, err)
@@ -68,8 +141,8 @@ func (o *GetBookingParams) BindRequest(r *http.Request, route *middleware.Matche
 	return nil
 }
 
-// bindParkingPlaceID binds and validates parameter ParkingPlaceID from query.
-func (o *GetBookingParams) bindParkingPlaceID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+// bindCursor binds and validates parameter Cursor from query.
+func (o *GetBookingParams) bindCursor(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
 	if len(rawData) > 0 {
 		raw = rawData[len(rawData)-1]
@@ -81,12 +154,218 @@ func (o *GetBookingParams) bindParkingPlaceID(rawData []string, hasKey bool, for
 	if raw == "" { // empty values pass all other validations
 		return nil
 	}
+	o.Cursor = &raw
+
+	if err := o.validateCursor(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateCursor carries on validations for parameter Cursor
+func (o *GetBookingParams) validateCursor(formats strfmt.Registry) error {
+
+	if err := validate.MaxLength("cursor", "query", *o.Cursor, 512); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindDateFrom binds and validates parameter DateFrom from query.
+func (o *GetBookingParams) bindDateFrom(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	// Format: date-time
+	value, err := formats.Parse("date-time", raw)
+	if err != nil {
+		return errors.InvalidType("date_from", "query", "strfmt.DateTime", raw)
+	}
+	o.DateFrom = (value.(*strfmt.DateTime))
+
+	if err := o.validateDateFrom(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateDateFrom carries on validations for parameter DateFrom
+func (o *GetBookingParams) validateDateFrom(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("date_from", "query", "date-time", o.DateFrom.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
+// bindDateTo binds and validates parameter DateTo from query.
+func (o *GetBookingParams) bindDateTo(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	// Format: date-time
+	value, err := formats.Parse("date-time", raw)
+	if err != nil {
+		return errors.InvalidType("date_to", "query", "strfmt.DateTime", raw)
+	}
+	o.DateTo = (value.(*strfmt.DateTime))
+
+	if err := o.validateDateTo(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateDateTo carries on validations for parameter DateTo
+func (o *GetBookingParams) validateDateTo(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("date_to", "query", "date-time", o.DateTo.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
+// bindLimit binds and validates parameter Limit from query.
+func (o *GetBookingParams) bindLimit(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewGetBookingParams()
+		return nil
+	}
 
 	value, err := swag.ConvertInt64(raw)
 	if err != nil {
-		return errors.InvalidType("parking_place_id", "query", "int64", raw)
+		return errors.InvalidType("limit", "query", "int64", raw)
+	}
+	o.Limit = &value
+
+	if err := o.validateLimit(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateLimit carries on validations for parameter Limit
+func (o *GetBookingParams) validateLimit(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("limit", "query", *o.Limit, 1, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("limit", "query", *o.Limit, 200, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindParkingPlaceID binds and validates array parameter ParkingPlaceID from query.
+//
+// Arrays are parsed according to CollectionFormat: "multi" (defaults to "csv" when empty).
+func (o *GetBookingParams) bindParkingPlaceID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	// CollectionFormat: multi
+	parkingPlaceIDIC := rawData
+	if len(parkingPlaceIDIC) == 0 {
+		return nil
+	}
+
+	var parkingPlaceIDIR []int64
+	for i, parkingPlaceIDIV := range parkingPlaceIDIC {
+		// items.Format: "int64"
+		parkingPlaceIDI, err := swag.ConvertInt64(parkingPlaceIDIV)
+		if err != nil {
+			return errors.InvalidType(fmt.Sprintf("%s.%v", "parking_place_id", i), "query", "int64", parkingPlaceIDI)
+		}
+
+		parkingPlaceIDIR = append(parkingPlaceIDIR, parkingPlaceIDI)
 	}
-	o.ParkingPlaceID = &value
+
+	o.ParkingPlaceID = parkingPlaceIDIR
+
+	return nil
+}
+
+// bindSort binds and validates parameter Sort from query.
+func (o *GetBookingParams) bindSort(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewGetBookingParams()
+		return nil
+	}
+	o.Sort = &raw
+
+	if err := o.validateSort(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateSort carries on validations for parameter Sort
+func (o *GetBookingParams) validateSort(formats strfmt.Registry) error {
+
+	if err := validate.EnumCase("sort", "query", *o.Sort, []interface{}{"date_from", "-date_from", "created_at", "-created_at"}, true); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindStatus binds and validates array parameter Status from query.
+//
+// Arrays are parsed according to CollectionFormat: "multi" (defaults to "csv" when empty).
+func (o *GetBookingParams) bindStatus(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	// CollectionFormat: multi
+	statusIC := rawData
+	if len(statusIC) == 0 {
+		return nil
+	}
+
+	var statusIR []string
+	for _, statusIV := range statusIC {
+		statusI := statusIV
+
+		statusIR = append(statusIR, statusI)
+	}
+
+	o.Status = statusIR
 
 	return nil
 }
@@ -22,6 +22,10 @@ GetBookingOK successful operation
 swagger:response getBookingOK
 */
 type GetBookingOK struct {
+	/*Cursor of the next page; absent on the last page
+
+	 */
+	XNextCursor string `json:"X-Next-Cursor"`
 
 	/*
 	  In: Body
@@ -35,6 +39,17 @@ func NewGetBookingOK() *GetBookingOK {
 	return &GetBookingOK{}
 }
 
+// WithXNextCursor adds the xNextCursor to the get booking o k response
+func (o *GetBookingOK) WithXNextCursor(xNextCursor string) *GetBookingOK {
+	o.XNextCursor = xNextCursor
+	return o
+}
+
+// SetXNextCursor sets the xNextCursor to the get booking o k response
+func (o *GetBookingOK) SetXNextCursor(xNextCursor string) {
+	o.XNextCursor = xNextCursor
+}
+
 // WithPayload adds the payload to the get booking o k response
 func (o *GetBookingOK) WithPayload(payload []*models.Booking) *GetBookingOK {
 	o.Payload = payload
@@ -49,6 +64,13 @@ func (o *GetBookingOK) SetPayload(payload []*models.Booking) {
 // WriteResponse to the client
 func (o *GetBookingOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header X-Next-Cursor
+
+	xNextCursor := o.XNextCursor
+	if xNextCursor != "" {
+		rw.Header().Set("X-Next-Cursor", xNextCursor)
+	}
+
 	rw.WriteHeader(200)
 	payload := o.Payload
 	if payload == nil {
@@ -61,6 +83,51 @@ func (o *GetBookingOK) WriteResponse(rw http.ResponseWriter, producer runtime.Pr
 	}
 }
 
+// GetBookingBadRequestCode is the HTTP code returned for type GetBookingBadRequest
+const GetBookingBadRequestCode int = 400
+
+/*
+GetBookingBadRequest Incorrect filters or cursor
+
+swagger:response getBookingBadRequest
+*/
+type GetBookingBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetBookingBadRequest creates GetBookingBadRequest with default headers values
+func NewGetBookingBadRequest() *GetBookingBadRequest {
+
+	return &GetBookingBadRequest{}
+}
+
+// WithPayload adds the payload to the get booking bad request response
+func (o *GetBookingBadRequest) WithPayload(payload *models.Error) *GetBookingBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get booking bad request response
+func (o *GetBookingBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetBookingBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // GetBookingForbiddenCode is the HTTP code returned for type GetBookingForbidden
 const GetBookingForbiddenCode int = 403
 
@@ -10,12 +10,19 @@ import (
 	"net/url"
 	golangswaggerpaths "path"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 )
 
 // GetBookingURL generates an URL for the get booking operation
 type GetBookingURL struct {
-	ParkingPlaceID *int64
+	Cursor         *string
+	DateFrom       *strfmt.DateTime
+	DateTo         *strfmt.DateTime
+	Limit          *int64
+	ParkingPlaceID []int64
+	Sort           *string
+	Status         []string
 	UserID         *string
 
 	_basePath string
@@ -49,12 +56,72 @@ func (o *GetBookingURL) Build() (*url.URL, error) {
 
 	qs := make(url.Values)
 
-	var parkingPlaceIDQ string
-	if o.ParkingPlaceID != nil {
-		parkingPlaceIDQ = swag.FormatInt64(*o.ParkingPlaceID)
+	var cursorQ string
+	if o.Cursor != nil {
+		cursorQ = *o.Cursor
 	}
-	if parkingPlaceIDQ != "" {
-		qs.Set("parking_place_id", parkingPlaceIDQ)
+	if cursorQ != "" {
+		qs.Set("cursor", cursorQ)
+	}
+
+	var dateFromQ string
+	if o.DateFrom != nil {
+		dateFromQ = o.DateFrom.String()
+	}
+	if dateFromQ != "" {
+		qs.Set("date_from", dateFromQ)
+	}
+
+	var dateToQ string
+	if o.DateTo != nil {
+		dateToQ = o.DateTo.String()
+	}
+	if dateToQ != "" {
+		qs.Set("date_to", dateToQ)
+	}
+
+	var limitQ string
+	if o.Limit != nil {
+		limitQ = swag.FormatInt64(*o.Limit)
+	}
+	if limitQ != "" {
+		qs.Set("limit", limitQ)
+	}
+
+	var parkingPlaceIDIR []string
+	for _, parkingPlaceIDI := range o.ParkingPlaceID {
+		parkingPlaceIDIS := swag.FormatInt64(parkingPlaceIDI)
+		if parkingPlaceIDIS != "" {
+			parkingPlaceIDIR = append(parkingPlaceIDIR, parkingPlaceIDIS)
+		}
+	}
+
+	parkingPlaceID := swag.JoinByFormat(parkingPlaceIDIR, "multi")
+
+	for _, qsv := range parkingPlaceID {
+		qs.Add("parking_place_id", qsv)
+	}
+
+	var sortQ string
+	if o.Sort != nil {
+		sortQ = *o.Sort
+	}
+	if sortQ != "" {
+		qs.Set("sort", sortQ)
+	}
+
+	var statusIR []string
+	for _, statusI := range o.Status {
+		statusIS := statusI
+		if statusIS != "" {
+			statusIR = append(statusIR, statusIS)
+		}
+	}
+
+	status := swag.JoinByFormat(statusIR, "multi")
+
+	for _, qsv := range status {
+		qs.Add("status", qsv)
 	}
 
 	var userIDQ string
@@ -147,3 +147,48 @@ func (o *UpdateBookingForbidden) WriteResponse(rw http.ResponseWriter, producer
 		}
 	}
 }
+
+// UpdateBookingConflictCode is the HTTP code returned for type UpdateBookingConflict
+const UpdateBookingConflictCode int = 409
+
+/*
+UpdateBookingConflict No free parking spots for the requested period
+
+swagger:response updateBookingConflict
+*/
+type UpdateBookingConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewUpdateBookingConflict creates UpdateBookingConflict with default headers values
+func NewUpdateBookingConflict() *UpdateBookingConflict {
+
+	return &UpdateBookingConflict{}
+}
+
+// WithPayload adds the payload to the update booking conflict response
+func (o *UpdateBookingConflict) WithPayload(payload *models.Error) *UpdateBookingConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update booking conflict response
+func (o *UpdateBookingConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateBookingConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/h4x4d/parking_net/booking/internal/models"
 	"github.com/h4x4d/parking_net/booking/internal/restapi/operations/driver"
 	"github.com/h4x4d/parking_net/booking/internal/restapi/operations/instruments"
+	"github.com/h4x4d/parking_net/booking/internal/restapi/operations/owner"
 )
 
 // NewParkingsBookingAPI creates a new ParkingsBooking instance
@@ -49,21 +50,93 @@ func NewParkingsBookingAPI(spec *loads.Document) *ParkingsBookingAPI {
 		InstrumentsGetMetricsHandler: instruments.GetMetricsHandlerFunc(func(params instruments.GetMetricsParams) middleware.Responder {
 			return middleware.NotImplemented("operation instruments.GetMetrics has not yet been implemented")
 		}),
+		DriverAcceptWaitlistOfferHandler: driver.AcceptWaitlistOfferHandlerFunc(func(params driver.AcceptWaitlistOfferParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation driver.AcceptWaitlistOffer has not yet been implemented")
+		}),
+		DriverCancelBookingSeriesHandler: driver.CancelBookingSeriesHandlerFunc(func(params driver.CancelBookingSeriesParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation driver.CancelBookingSeries has not yet been implemented")
+		}),
+		DriverCancelSeriesOccurrenceHandler: driver.CancelSeriesOccurrenceHandlerFunc(func(params driver.CancelSeriesOccurrenceParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation driver.CancelSeriesOccurrence has not yet been implemented")
+		}),
+		DriverCheckInBookingHandler: driver.CheckInBookingHandlerFunc(func(params driver.CheckInBookingParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation driver.CheckInBooking has not yet been implemented")
+		}),
+		DriverCheckOutBookingHandler: driver.CheckOutBookingHandlerFunc(func(params driver.CheckOutBookingParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation driver.CheckOutBooking has not yet been implemented")
+		}),
 		DriverCreateBookingHandler: driver.CreateBookingHandlerFunc(func(params driver.CreateBookingParams, principal *models.User) middleware.Responder {
 			return middleware.NotImplemented("operation driver.CreateBooking has not yet been implemented")
 		}),
+		DriverCreateBookingHoldHandler: driver.CreateBookingHoldHandlerFunc(func(params driver.CreateBookingHoldParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation driver.CreateBookingHold has not yet been implemented")
+		}),
+		DriverCreateBookingSeriesHandler: driver.CreateBookingSeriesHandlerFunc(func(params driver.CreateBookingSeriesParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation driver.CreateBookingSeries has not yet been implemented")
+		}),
+		DriverCreateCalendarFeedHandler: driver.CreateCalendarFeedHandlerFunc(func(params driver.CreateCalendarFeedParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation driver.CreateCalendarFeed has not yet been implemented")
+		}),
 		DriverDeleteBookingHandler: driver.DeleteBookingHandlerFunc(func(params driver.DeleteBookingParams, principal *models.User) middleware.Responder {
 			return middleware.NotImplemented("operation driver.DeleteBooking has not yet been implemented")
 		}),
+		DriverExtendBookingHandler: driver.ExtendBookingHandlerFunc(func(params driver.ExtendBookingParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation driver.ExtendBooking has not yet been implemented")
+		}),
+		OwnerFindBookingsByPlateHandler: owner.FindBookingsByPlateHandlerFunc(func(params owner.FindBookingsByPlateParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation owner.FindBookingsByPlate has not yet been implemented")
+		}),
+		DriverGetAvailabilityHandler: driver.GetAvailabilityHandlerFunc(func(params driver.GetAvailabilityParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation driver.GetAvailability has not yet been implemented")
+		}),
 		DriverGetBookingHandler: driver.GetBookingHandlerFunc(func(params driver.GetBookingParams, principal *models.User) middleware.Responder {
 			return middleware.NotImplemented("operation driver.GetBooking has not yet been implemented")
 		}),
 		DriverGetBookingByIDHandler: driver.GetBookingByIDHandlerFunc(func(params driver.GetBookingByIDParams, principal *models.User) middleware.Responder {
 			return middleware.NotImplemented("operation driver.GetBookingByID has not yet been implemented")
 		}),
+		DriverGetBookingHistoryHandler: driver.GetBookingHistoryHandlerFunc(func(params driver.GetBookingHistoryParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation driver.GetBookingHistory has not yet been implemented")
+		}),
+		DriverGetBookingSeriesHandler: driver.GetBookingSeriesHandlerFunc(func(params driver.GetBookingSeriesParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation driver.GetBookingSeries has not yet been implemented")
+		}),
+		DriverGetCalendarFeedHandler: driver.GetCalendarFeedHandlerFunc(func(params driver.GetCalendarFeedParams) middleware.Responder {
+			return middleware.NotImplemented("operation driver.GetCalendarFeed has not yet been implemented")
+		}),
+		DriverGetEntryPassHandler: driver.GetEntryPassHandlerFunc(func(params driver.GetEntryPassParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation driver.GetEntryPass has not yet been implemented")
+		}),
+		DriverGetWaitlistHandler: driver.GetWaitlistHandlerFunc(func(params driver.GetWaitlistParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation driver.GetWaitlist has not yet been implemented")
+		}),
+		DriverJoinWaitlistHandler: driver.JoinWaitlistHandlerFunc(func(params driver.JoinWaitlistParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation driver.JoinWaitlist has not yet been implemented")
+		}),
+		DriverLeaveWaitlistHandler: driver.LeaveWaitlistHandlerFunc(func(params driver.LeaveWaitlistParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation driver.LeaveWaitlist has not yet been implemented")
+		}),
+		DriverListVehiclesHandler: driver.ListVehiclesHandlerFunc(func(params driver.ListVehiclesParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation driver.ListVehicles has not yet been implemented")
+		}),
+		DriverQuoteBookingHandler: driver.QuoteBookingHandlerFunc(func(params driver.QuoteBookingParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation driver.QuoteBooking has not yet been implemented")
+		}),
+		DriverRegisterVehicleHandler: driver.RegisterVehicleHandlerFunc(func(params driver.RegisterVehicleParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation driver.RegisterVehicle has not yet been implemented")
+		}),
+		DriverRemoveVehicleHandler: driver.RemoveVehicleHandlerFunc(func(params driver.RemoveVehicleParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation driver.RemoveVehicle has not yet been implemented")
+		}),
+		DriverRevokeCalendarFeedHandler: driver.RevokeCalendarFeedHandlerFunc(func(params driver.RevokeCalendarFeedParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation driver.RevokeCalendarFeed has not yet been implemented")
+		}),
 		DriverUpdateBookingHandler: driver.UpdateBookingHandlerFunc(func(params driver.UpdateBookingParams, principal *models.User) middleware.Responder {
 			return middleware.NotImplemented("operation driver.UpdateBooking has not yet been implemented")
 		}),
+		OwnerVerifyEntryPassHandler: owner.VerifyEntryPassHandlerFunc(func(params owner.VerifyEntryPassParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation owner.VerifyEntryPass has not yet been implemented")
+		}),
 
 		// Applies when the "api_key" header is set
 		APIKeyAuth: func(token string) (*models.User, error) {
@@ -116,16 +189,64 @@ type ParkingsBookingAPI struct {
 
 	// InstrumentsGetMetricsHandler sets the operation handler for the get metrics operation
 	InstrumentsGetMetricsHandler instruments.GetMetricsHandler
+	// DriverAcceptWaitlistOfferHandler sets the operation handler for the accept waitlist offer operation
+	DriverAcceptWaitlistOfferHandler driver.AcceptWaitlistOfferHandler
+	// DriverCancelBookingSeriesHandler sets the operation handler for the cancel booking series operation
+	DriverCancelBookingSeriesHandler driver.CancelBookingSeriesHandler
+	// DriverCancelSeriesOccurrenceHandler sets the operation handler for the cancel series occurrence operation
+	DriverCancelSeriesOccurrenceHandler driver.CancelSeriesOccurrenceHandler
+	// DriverCheckInBookingHandler sets the operation handler for the check in booking operation
+	DriverCheckInBookingHandler driver.CheckInBookingHandler
+	// DriverCheckOutBookingHandler sets the operation handler for the check out booking operation
+	DriverCheckOutBookingHandler driver.CheckOutBookingHandler
 	// DriverCreateBookingHandler sets the operation handler for the create booking operation
 	DriverCreateBookingHandler driver.CreateBookingHandler
+	// DriverCreateBookingHoldHandler sets the operation handler for the create booking hold operation
+	DriverCreateBookingHoldHandler driver.CreateBookingHoldHandler
+	// DriverCreateBookingSeriesHandler sets the operation handler for the create booking series operation
+	DriverCreateBookingSeriesHandler driver.CreateBookingSeriesHandler
+	// DriverCreateCalendarFeedHandler sets the operation handler for the create calendar feed operation
+	DriverCreateCalendarFeedHandler driver.CreateCalendarFeedHandler
 	// DriverDeleteBookingHandler sets the operation handler for the delete booking operation
 	DriverDeleteBookingHandler driver.DeleteBookingHandler
+	// DriverExtendBookingHandler sets the operation handler for the extend booking operation
+	DriverExtendBookingHandler driver.ExtendBookingHandler
+	// OwnerFindBookingsByPlateHandler sets the operation handler for the find bookings by plate operation
+	OwnerFindBookingsByPlateHandler owner.FindBookingsByPlateHandler
+	// DriverGetAvailabilityHandler sets the operation handler for the get availability operation
+	DriverGetAvailabilityHandler driver.GetAvailabilityHandler
 	// DriverGetBookingHandler sets the operation handler for the get booking operation
 	DriverGetBookingHandler driver.GetBookingHandler
 	// DriverGetBookingByIDHandler sets the operation handler for the get booking by id operation
 	DriverGetBookingByIDHandler driver.GetBookingByIDHandler
+	// DriverGetBookingHistoryHandler sets the operation handler for the get booking history operation
+	DriverGetBookingHistoryHandler driver.GetBookingHistoryHandler
+	// DriverGetBookingSeriesHandler sets the operation handler for the get booking series operation
+	DriverGetBookingSeriesHandler driver.GetBookingSeriesHandler
+	// DriverGetCalendarFeedHandler sets the operation handler for the get calendar feed operation
+	DriverGetCalendarFeedHandler driver.GetCalendarFeedHandler
+	// DriverGetEntryPassHandler sets the operation handler for the get entry pass operation
+	DriverGetEntryPassHandler driver.GetEntryPassHandler
+	// DriverGetWaitlistHandler sets the operation handler for the get waitlist operation
+	DriverGetWaitlistHandler driver.GetWaitlistHandler
+	// DriverJoinWaitlistHandler sets the operation handler for the join waitlist operation
+	DriverJoinWaitlistHandler driver.JoinWaitlistHandler
+	// DriverLeaveWaitlistHandler sets the operation handler for the leave waitlist operation
+	DriverLeaveWaitlistHandler driver.LeaveWaitlistHandler
+	// DriverListVehiclesHandler sets the operation handler for the list vehicles operation
+	DriverListVehiclesHandler driver.ListVehiclesHandler
+	// DriverQuoteBookingHandler sets the operation handler for the quote booking operation
+	DriverQuoteBookingHandler driver.QuoteBookingHandler
+	// DriverRegisterVehicleHandler sets the operation handler for the register vehicle operation
+	DriverRegisterVehicleHandler driver.RegisterVehicleHandler
+	// DriverRemoveVehicleHandler sets the operation handler for the remove vehicle operation
+	DriverRemoveVehicleHandler driver.RemoveVehicleHandler
+	// DriverRevokeCalendarFeedHandler sets the operation handler for the revoke calendar feed operation
+	DriverRevokeCalendarFeedHandler driver.RevokeCalendarFeedHandler
 	// DriverUpdateBookingHandler sets the operation handler for the update booking operation
 	DriverUpdateBookingHandler driver.UpdateBookingHandler
+	// OwnerVerifyEntryPassHandler sets the operation handler for the verify entry pass operation
+	OwnerVerifyEntryPassHandler owner.VerifyEntryPassHandler
 
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
@@ -210,21 +331,93 @@ func (o *ParkingsBookingAPI) Validate() error {
 	if o.InstrumentsGetMetricsHandler == nil {
 		unregistered = append(unregistered, "instruments.GetMetricsHandler")
 	}
+	if o.DriverAcceptWaitlistOfferHandler == nil {
+		unregistered = append(unregistered, "driver.AcceptWaitlistOfferHandler")
+	}
+	if o.DriverCancelBookingSeriesHandler == nil {
+		unregistered = append(unregistered, "driver.CancelBookingSeriesHandler")
+	}
+	if o.DriverCancelSeriesOccurrenceHandler == nil {
+		unregistered = append(unregistered, "driver.CancelSeriesOccurrenceHandler")
+	}
+	if o.DriverCheckInBookingHandler == nil {
+		unregistered = append(unregistered, "driver.CheckInBookingHandler")
+	}
+	if o.DriverCheckOutBookingHandler == nil {
+		unregistered = append(unregistered, "driver.CheckOutBookingHandler")
+	}
 	if o.DriverCreateBookingHandler == nil {
 		unregistered = append(unregistered, "driver.CreateBookingHandler")
 	}
+	if o.DriverCreateBookingHoldHandler == nil {
+		unregistered = append(unregistered, "driver.CreateBookingHoldHandler")
+	}
+	if o.DriverCreateBookingSeriesHandler == nil {
+		unregistered = append(unregistered, "driver.CreateBookingSeriesHandler")
+	}
+	if o.DriverCreateCalendarFeedHandler == nil {
+		unregistered = append(unregistered, "driver.CreateCalendarFeedHandler")
+	}
 	if o.DriverDeleteBookingHandler == nil {
 		unregistered = append(unregistered, "driver.DeleteBookingHandler")
 	}
+	if o.DriverExtendBookingHandler == nil {
+		unregistered = append(unregistered, "driver.ExtendBookingHandler")
+	}
+	if o.OwnerFindBookingsByPlateHandler == nil {
+		unregistered = append(unregistered, "owner.FindBookingsByPlateHandler")
+	}
+	if o.DriverGetAvailabilityHandler == nil {
+		unregistered = append(unregistered, "driver.GetAvailabilityHandler")
+	}
 	if o.DriverGetBookingHandler == nil {
 		unregistered = append(unregistered, "driver.GetBookingHandler")
 	}
 	if o.DriverGetBookingByIDHandler == nil {
 		unregistered = append(unregistered, "driver.GetBookingByIDHandler")
 	}
+	if o.DriverGetBookingHistoryHandler == nil {
+		unregistered = append(unregistered, "driver.GetBookingHistoryHandler")
+	}
+	if o.DriverGetBookingSeriesHandler == nil {
+		unregistered = append(unregistered, "driver.GetBookingSeriesHandler")
+	}
+	if o.DriverGetCalendarFeedHandler == nil {
+		unregistered = append(unregistered, "driver.GetCalendarFeedHandler")
+	}
+	if o.DriverGetEntryPassHandler == nil {
+		unregistered = append(unregistered, "driver.GetEntryPassHandler")
+	}
+	if o.DriverGetWaitlistHandler == nil {
+		unregistered = append(unregistered, "driver.GetWaitlistHandler")
+	}
+	if o.DriverJoinWaitlistHandler == nil {
+		unregistered = append(unregistered, "driver.JoinWaitlistHandler")
+	}
+	if o.DriverLeaveWaitlistHandler == nil {
+		unregistered = append(unregistered, "driver.LeaveWaitlistHandler")
+	}
+	if o.DriverListVehiclesHandler == nil {
+		unregistered = append(unregistered, "driver.ListVehiclesHandler")
+	}
+	if o.DriverQuoteBookingHandler == nil {
+		unregistered = append(unregistered, "driver.QuoteBookingHandler")
+	}
+	if o.DriverRegisterVehicleHandler == nil {
+		unregistered = append(unregistered, "driver.RegisterVehicleHandler")
+	}
+	if o.DriverRemoveVehicleHandler == nil {
+		unregistered = append(unregistered, "driver.RemoveVehicleHandler")
+	}
+	if o.DriverRevokeCalendarFeedHandler == nil {
+		unregistered = append(unregistered, "driver.RevokeCalendarFeedHandler")
+	}
 	if o.DriverUpdateBookingHandler == nil {
 		unregistered = append(unregistered, "driver.UpdateBookingHandler")
 	}
+	if o.OwnerVerifyEntryPassHandler == nil {
+		unregistered = append(unregistered, "owner.VerifyEntryPassHandler")
+	}
 
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
@@ -331,11 +524,55 @@ func (o *ParkingsBookingAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/booking/waitlist/{entry_id}/accept"] = driver.NewAcceptWaitlistOffer(o.context, o.DriverAcceptWaitlistOfferHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/booking/series/{series_id}"] = driver.NewCancelBookingSeries(o.context, o.DriverCancelBookingSeriesHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/booking/series/{series_id}/bookings/{booking_id}"] = driver.NewCancelSeriesOccurrence(o.context, o.DriverCancelSeriesOccurrenceHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/booking/{booking_id}/check-in"] = driver.NewCheckInBooking(o.context, o.DriverCheckInBookingHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/booking/{booking_id}/check-out"] = driver.NewCheckOutBooking(o.context, o.DriverCheckOutBookingHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/booking"] = driver.NewCreateBooking(o.context, o.DriverCreateBookingHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/booking/hold"] = driver.NewCreateBookingHold(o.context, o.DriverCreateBookingHoldHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/booking/series"] = driver.NewCreateBookingSeries(o.context, o.DriverCreateBookingSeriesHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/booking/calendar"] = driver.NewCreateCalendarFeed(o.context, o.DriverCreateCalendarFeedHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
 	o.handlers["DELETE"]["/booking/{booking_id}"] = driver.NewDeleteBooking(o.context, o.DriverDeleteBookingHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/booking/{booking_id}/extend"] = driver.NewExtendBooking(o.context, o.DriverExtendBookingHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/booking/vehicles/lookup"] = owner.NewFindBookingsByPlate(o.context, o.OwnerFindBookingsByPlateHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/booking/availability"] = driver.NewGetAvailability(o.context, o.DriverGetAvailabilityHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -344,10 +581,62 @@ func (o *ParkingsBookingAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/booking/{booking_id}"] = driver.NewGetBookingByID(o.context, o.DriverGetBookingByIDHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/booking/{booking_id}/history"] = driver.NewGetBookingHistory(o.context, o.DriverGetBookingHistoryHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/booking/series/{series_id}"] = driver.NewGetBookingSeries(o.context, o.DriverGetBookingSeriesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/booking/calendar/{token}"] = driver.NewGetCalendarFeed(o.context, o.DriverGetCalendarFeedHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/booking/{booking_id}/pass"] = driver.NewGetEntryPass(o.context, o.DriverGetEntryPassHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/booking/waitlist"] = driver.NewGetWaitlist(o.context, o.DriverGetWaitlistHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/booking/waitlist"] = driver.NewJoinWaitlist(o.context, o.DriverJoinWaitlistHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/booking/waitlist/{entry_id}"] = driver.NewLeaveWaitlist(o.context, o.DriverLeaveWaitlistHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/booking/vehicles"] = driver.NewListVehicles(o.context, o.DriverListVehiclesHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/booking/quote"] = driver.NewQuoteBooking(o.context, o.DriverQuoteBookingHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/booking/vehicles"] = driver.NewRegisterVehicle(o.context, o.DriverRegisterVehicleHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/booking/vehicles/{vehicle_id}"] = driver.NewRemoveVehicle(o.context, o.DriverRemoveVehicleHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/booking/calendar"] = driver.NewRevokeCalendarFeed(o.context, o.DriverRevokeCalendarFeedHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
 	o.handlers["PUT"]["/booking/{booking_id}"] = driver.NewUpdateBooking(o.context, o.DriverUpdateBookingHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/booking/pass/verify"] = owner.NewVerifyEntryPass(o.context, o.OwnerVerifyEntryPassHandler)
 }
 
 // Serve creates a http handler to serve the API over HTTP
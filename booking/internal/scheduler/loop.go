@@ -0,0 +1,110 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/h4x4d/parking_net/pkg/client"
+	pkg_models "github.com/h4x4d/parking_net/pkg/models"
+	"github.com/h4x4d/parking_net/pkg/notification"
+)
+
+// loop calls a job immediately and then on every tick until stopped.
+type loop struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func (l *loop) start(interval time.Duration, job func(ctx context.Context)) {
+	ctx, cancel := context.WithCancel(context.Background())
+	l.cancel = cancel
+	l.done = make(chan struct{})
+
+	go func() {
+		defer close(l.done)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		runSafely(ctx, job)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				runSafely(ctx, job)
+			}
+		}
+	}()
+}
+
+// stop cancels the loop and waits for the running job. It reports false if the
+// loop was never started.
+func (l *loop) stop() bool {
+	if l.cancel == nil {
+		return false
+	}
+	l.cancel()
+	<-l.done
+	return true
+}
+
+func runSafely(ctx context.Context, job func(ctx context.Context)) {
+	defer func() {
+		if r := recover(); r != nil {
+			slog.Error("booking background job panic", "error", r)
+		}
+	}()
+	job(ctx)
+}
+
+func durationFromEnv(name string, def time.Duration) time.Duration {
+	raw := os.Getenv(name)
+	if raw == "" {
+		return def
+	}
+	parsed, err := time.ParseDuration(raw)
+	if err != nil || parsed <= 0 {
+		slog.Warn(fmt.Sprintf("invalid %s, using default", name), "value", raw, "default", def.String())
+		return def
+	}
+	return parsed
+}
+
+func intFromEnv(name string, def int) int {
+	raw := os.Getenv(name)
+	if raw == "" {
+		return def
+	}
+	parsed, err := strconv.Atoi(raw)
+	if err != nil || parsed <= 0 {
+		slog.Warn(fmt.Sprintf("invalid %s, using default", name), "value", raw, "default", def)
+		return def
+	}
+	return parsed
+}
+
+func notifyDriver(ctx context.Context, kafkaConn *notification.KafkaConnection, keyCloak *client.Client,
+	userID string, bookingID int64, text string) {
+	if kafkaConn == nil || keyCloak == nil {
+		return
+	}
+	tgId, err := keyCloak.GetTelegramId(ctx, userID)
+	if err != nil || tgId == 0 {
+		slog.Warn("failed to get telegram ID for driver, skipping notification",
+			"booking_id", bookingID, "error", err)
+		return
+	}
+	notifyErr := kafkaConn.SendNotification(
+		pkg_models.Notification{
+			Name:       "Booking status",
+			Text:       text,
+			TelegramID: tgId,
+		})
+	if notifyErr != nil {
+		slog.Warn("failed to send notification", "error", notifyErr)
+	}
+}
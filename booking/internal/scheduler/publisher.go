@@ -0,0 +1,171 @@
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/h4x4d/parking_net/booking/internal/database_service"
+	"github.com/h4x4d/parking_net/pkg/domain"
+	"github.com/h4x4d/parking_net/pkg/events"
+)
+
+const (
+	defaultPublishInterval = 5 * time.Second
+	publishBatchSize       = 100
+)
+
+// statusEventTypes names the domain event of a booking moving to a status.
+var statusEventTypes = map[domain.BookingStatus]string{
+	domain.BookingStatusConfirmed: events.BookingConfirmed,
+	domain.BookingStatusActive:    events.BookingCheckedIn,
+	domain.BookingStatusCompleted: events.BookingCompleted,
+	domain.BookingStatusCanceled:  events.BookingCanceled,
+	domain.BookingStatusExpired:   events.BookingExpired,
+	domain.BookingStatusNoShow:    events.BookingNoShow,
+}
+
+// Publisher streams the booking history to the domain event topic. The
+// history is written in the transaction of every change, so publishing from
+// it misses no committed change and publishes nothing that was rolled back.
+type Publisher struct {
+	Database *database_service.DatabaseService
+	Producer *events.Producer
+	interval time.Duration
+	loop     loop
+}
+
+func NewPublisher(db *database_service.DatabaseService, producer *events.Producer) *Publisher {
+	return &Publisher{
+		Database: db,
+		Producer: producer,
+		interval: durationFromEnv("BOOKING_EVENTS_INTERVAL", defaultPublishInterval),
+	}
+}
+
+func (p *Publisher) Start() {
+	if p.Producer == nil {
+		slog.Warn("booking event producer is not configured, domain events are not published")
+		return
+	}
+	p.loop.start(p.interval, p.run)
+	slog.Info("booking event publisher started", slog.String("interval", p.interval.String()))
+}
+
+// Stop cancels the publisher, waits for the current run to finish and closes
+// the producer.
+func (p *Publisher) Stop() {
+	if !p.loop.stop() {
+		return
+	}
+	if err := p.Producer.Close(); err != nil {
+		slog.Warn("failed to close booking event producer", "error", err)
+	}
+	slog.Info("booking event publisher stopped")
+}
+
+func (p *Publisher) run(ctx context.Context) {
+	for ctx.Err() == nil {
+		published, err := p.Database.PublishBookingEvents(ctx, publishBatchSize, p.publish)
+		if err != nil {
+			slog.Error("failed publish booking events", slog.String("error", err.Error()))
+			return
+		}
+		if published < publishBatchSize {
+			return
+		}
+	}
+}
+
+func (p *Publisher) publish(ctx context.Context, history []*domain.BookingEvent) error {
+	batch := make([]events.Event, 0, len(history))
+	for _, entry := range history {
+		event, err := bookingDomainEvent(entry)
+		if err != nil {
+			// A malformed entry would block every later one, so it is dropped.
+			slog.Error("skip unpublishable booking event",
+				slog.Int64("event_id", entry.ID), slog.String("error", err.Error()))
+			continue
+		}
+		batch = append(batch, event)
+	}
+	return p.Producer.Publish(ctx, batch...)
+}
+
+func bookingDomainEvent(entry *domain.BookingEvent) (events.Event, error) {
+	data := events.BookingData{
+		BookingID:      entry.BookingID,
+		UserID:         entry.UserID,
+		ParkingPlaceID: entry.ParkingPlaceID,
+		Actor:          events.Actor{ID: entry.Actor.ID, Role: string(entry.Actor.Role)},
+	}
+	var eventType string
+	var err error
+	switch entry.Type {
+	case domain.BookingEventCreated:
+		eventType = events.BookingCreated
+		data.Booking, err = bookingState(entry.NewValue)
+	case domain.BookingEventModified, domain.BookingEventStatusChanged:
+		eventType = events.BookingModified
+		if entry.Type == domain.BookingEventStatusChanged {
+			if statusType, ok := statusEventTypes[domain.BookingStatus(fmt.Sprint(entry.NewValue["status"]))]; ok {
+				eventType = statusType
+			}
+		}
+		if data.Previous, err = bookingState(entry.OldValue); err == nil {
+			data.Booking, err = bookingState(entry.NewValue)
+		}
+	case domain.BookingEventDeleted:
+		eventType = events.BookingDeleted
+		data.Previous, err = bookingState(entry.OldValue)
+	case domain.BookingEventCanceled:
+		eventType = events.BookingCancellationAssessed
+		data.Cancellation = new(events.BookingCancellation)
+		err = convertValue(entry.NewValue, data.Cancellation)
+	case domain.BookingEventRefunded:
+		eventType = events.BookingRefunded
+		data.Refund = new(events.BookingRefund)
+		err = convertValue(entry.NewValue, data.Refund)
+	default:
+		err = fmt.Errorf("unknown booking event type %q", entry.Type)
+	}
+	if err != nil {
+		return events.Event{}, err
+	}
+
+	return events.Event{
+		ID:         "booking-event-" + strconv.FormatInt(entry.ID, 10),
+		Type:       eventType,
+		Subject:    strconv.FormatInt(entry.BookingID, 10),
+		OccurredAt: entry.CreatedAt,
+		TraceID:    entry.TraceID,
+		Data:       data,
+	}, nil
+}
+
+func bookingState(value map[string]any) (*events.BookingState, error) {
+	if value == nil {
+		return nil, nil
+	}
+	state := new(events.BookingState)
+	if err := convertValue(value, state); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+// convertValue fills v from a history value, whose fields are named after the
+// JSON fields of the event data.
+func convertValue(value map[string]any, v any) error {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(raw, v); err != nil {
+		return fmt.Errorf("failed to convert booking event value: %w", err)
+	}
+	return nil
+}
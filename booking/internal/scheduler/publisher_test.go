@@ -0,0 +1,158 @@
+package scheduler
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/h4x4d/parking_net/pkg/domain"
+	"github.com/h4x4d/parking_net/pkg/events"
+)
+
+// historyValue decodes a history value the way it is read from the database.
+func historyValue(t *testing.T, raw string) map[string]any {
+	t.Helper()
+	if raw == "" {
+		return nil
+	}
+	var value map[string]any
+	if err := json.Unmarshal([]byte(raw), &value); err != nil {
+		t.Fatalf("decode history value %s: %v", raw, err)
+	}
+	return value
+}
+
+func TestBookingDomainEvent(t *testing.T) {
+	from := time.Date(2030, 1, 1, 10, 0, 0, 0, time.UTC)
+	hour := from.Add(time.Hour)
+	to := from.Add(2 * time.Hour)
+	cost := int64(200)
+
+	tests := []struct {
+		name      string
+		eventType domain.BookingEventType
+		oldValue  string
+		newValue  string
+		wantType  string
+		want      events.BookingData
+	}{
+		{
+			name:      "created",
+			eventType: domain.BookingEventCreated,
+			newValue: `{"status":"Waiting","user_id":"driver-1","date_from":"2030-01-01T10:00:00Z",` +
+				`"date_to":"2030-01-01T12:00:00Z","parking_place_id":7,"full_cost":200}`,
+			wantType: events.BookingCreated,
+			want: events.BookingData{Booking: &events.BookingState{Status: "Waiting", UserID: "driver-1",
+				DateFrom: &from, DateTo: &to, ParkingPlaceID: 7, FullCost: &cost}},
+		},
+		{
+			name:      "modified",
+			eventType: domain.BookingEventModified,
+			oldValue:  `{"date_to":"2030-01-01T11:00:00Z"}`,
+			newValue:  `{"date_to":"2030-01-01T12:00:00Z"}`,
+			wantType:  events.BookingModified,
+			want: events.BookingData{Previous: &events.BookingState{DateTo: &hour},
+				Booking: &events.BookingState{DateTo: &to}},
+		},
+		{
+			name:      "confirmed",
+			eventType: domain.BookingEventStatusChanged,
+			oldValue:  `{"status":"Waiting"}`,
+			newValue:  `{"status":"Confirmed"}`,
+			wantType:  events.BookingConfirmed,
+			want: events.BookingData{Previous: &events.BookingState{Status: "Waiting"},
+				Booking: &events.BookingState{Status: "Confirmed"}},
+		},
+		{
+			name:      "checked in",
+			eventType: domain.BookingEventStatusChanged,
+			oldValue:  `{"status":"Confirmed"}`,
+			newValue:  `{"status":"Active","checked_in_at":"2030-01-01T10:00:00Z"}`,
+			wantType:  events.BookingCheckedIn,
+			want: events.BookingData{Previous: &events.BookingState{Status: "Confirmed"},
+				Booking: &events.BookingState{Status: "Active", CheckedInAt: &from}},
+		},
+		{
+			name:      "status without an event of its own",
+			eventType: domain.BookingEventStatusChanged,
+			oldValue:  `{"status":"Confirmed"}`,
+			newValue:  `{"status":"Waiting"}`,
+			wantType:  events.BookingModified,
+			want: events.BookingData{Previous: &events.BookingState{Status: "Confirmed"},
+				Booking: &events.BookingState{Status: "Waiting"}},
+		},
+		{
+			name:      "deleted",
+			eventType: domain.BookingEventDeleted,
+			oldValue:  `{"status":"Completed"}`,
+			wantType:  events.BookingDeleted,
+			want:      events.BookingData{Previous: &events.BookingState{Status: "Completed"}},
+		},
+		{
+			name:      "cancellation assessed",
+			eventType: domain.BookingEventCanceled,
+			newValue:  `{"policy_version":2,"fee_percent":50}`,
+			wantType:  events.BookingCancellationAssessed,
+			want:      events.BookingData{Cancellation: &events.BookingCancellation{PolicyVersion: 2, FeePercent: 50}},
+		},
+		{
+			name:      "refunded",
+			eventType: domain.BookingEventRefunded,
+			newValue:  `{"amount":100,"fee_percent":50,"reason":"canceled"}`,
+			wantType:  events.BookingRefunded,
+			want:      events.BookingData{Refund: &events.BookingRefund{Amount: 100, FeePercent: 50, Reason: "canceled"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry := &domain.BookingEvent{
+				ID:             11,
+				BookingID:      42,
+				UserID:         "driver-1",
+				ParkingPlaceID: 7,
+				Type:           tt.eventType,
+				Actor:          domain.SystemActor("expire-holds"),
+				OldValue:       historyValue(t, tt.oldValue),
+				NewValue:       historyValue(t, tt.newValue),
+				TraceID:        "trace",
+				CreatedAt:      from,
+			}
+			event, err := bookingDomainEvent(entry)
+			if err != nil {
+				t.Fatalf("bookingDomainEvent: %v", err)
+			}
+			if event.ID != "booking-event-11" || event.Type != tt.wantType || event.Subject != "42" ||
+				!event.OccurredAt.Equal(from) || event.TraceID != "trace" {
+				t.Errorf("event = %+v, want %s of booking 42", event, tt.wantType)
+			}
+
+			want := tt.want
+			want.BookingID, want.UserID, want.ParkingPlaceID = 42, "driver-1", 7
+			want.Actor = events.Actor{ID: "expire-holds", Role: "system"}
+			if !reflect.DeepEqual(event.Data, want) {
+				got, _ := json.Marshal(event.Data)
+				expected, _ := json.Marshal(want)
+				t.Errorf("data = %s, want %s", got, expected)
+			}
+		})
+	}
+}
+
+func TestBookingDomainEventRejectsMalformedEntry(t *testing.T) {
+	tests := []struct {
+		name  string
+		entry *domain.BookingEvent
+	}{
+		{"unknown type", &domain.BookingEvent{Type: "parked"}},
+		{"malformed value", &domain.BookingEvent{Type: domain.BookingEventRefunded,
+			NewValue: map[string]any{"amount": "a lot"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := bookingDomainEvent(tt.entry); err == nil {
+				t.Errorf("bookingDomainEvent accepted %+v", tt.entry)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/h4x4d/parking_net/booking/internal/audit"
+	"github.com/h4x4d/parking_net/booking/internal/database_service"
+	payment_client "github.com/h4x4d/parking_net/booking/internal/grpc/client"
+	"github.com/h4x4d/parking_net/pkg/client"
+	"github.com/h4x4d/parking_net/pkg/domain"
+	"github.com/h4x4d/parking_net/pkg/notification"
+)
+
+const (
+	defaultWaitingTTL     = 15 * time.Minute
+	defaultReaperInterval = time.Minute
+	reaperBatchSize       = 100
+)
+
+// Reaper resolves bookings left in Waiting after the payment round-trip of
+// CreateBooking was interrupted. For each booking older than the TTL it asks
+// the payment service whether the driver was charged and confirms or cancels
+// the booking accordingly. The TTL must comfortably exceed the payment call
+// timeout, otherwise a booking could be canceled while it is still being paid.
+type Reaper struct {
+	Database      *database_service.DatabaseService
+	PaymentClient *payment_client.PaymentClient
+	KafkaConn     *notification.KafkaConnection
+	KeyCloak      *client.Client
+	ttl           time.Duration
+	interval      time.Duration
+	loop          loop
+}
+
+func NewReaper(db *database_service.DatabaseService, paymentClient *payment_client.PaymentClient,
+	kafkaConn *notification.KafkaConnection, keyCloak *client.Client) *Reaper {
+	return &Reaper{
+		Database:      db,
+		PaymentClient: paymentClient,
+		KafkaConn:     kafkaConn,
+		KeyCloak:      keyCloak,
+		ttl:           durationFromEnv("BOOKING_WAITING_TTL", defaultWaitingTTL),
+		interval:      durationFromEnv("BOOKING_REAPER_INTERVAL", defaultReaperInterval),
+	}
+}
+
+func (r *Reaper) Start() {
+	r.loop.start(r.interval, r.run)
+	slog.Info(
+		"booking reaper started",
+		slog.String("interval", r.interval.String()),
+		slog.String("ttl", r.ttl.String()),
+	)
+}
+
+// Stop cancels the reaper and waits for the current run to finish.
+func (r *Reaper) Stop() {
+	if r.loop.stop() {
+		slog.Info("booking reaper stopped")
+	}
+}
+
+func (r *Reaper) run(ctx context.Context) {
+	ctx = audit.WithActor(ctx, domain.SystemActor("reaper"))
+	stale, err := r.Database.GetStaleWaiting(ctx, r.ttl, reaperBatchSize)
+	if err != nil {
+		slog.Error("failed get stale bookings", slog.String("error", err.Error()))
+		return
+	}
+	for _, booking := range stale {
+		if ctx.Err() != nil {
+			return
+		}
+		r.resolve(ctx, booking)
+	}
+}
+
+func (r *Reaper) resolve(ctx context.Context, booking database_service.StatusChange) {
+	charge, err := r.PaymentClient.GetBookingCharge(ctx, booking.BookingID)
+	if err != nil {
+		// The payment service may be down; leave the booking for the next run.
+		slog.Warn("failed to get booking charge, retrying later",
+			"booking_id", booking.BookingID, "error", err)
+		return
+	}
+
+	next := domain.BookingStatusCanceled
+	text := fmt.Sprintf("Your booking with booking_id %d was canceled because the payment was not completed",
+		booking.BookingID)
+	if charge.Charged {
+		next = domain.BookingStatusConfirmed
+		text = fmt.Sprintf("Your booking with booking_id %d was confirmed", booking.BookingID)
+	}
+
+	changed, err := r.Database.TransitionStatus(ctx, booking.BookingID, domain.BookingStatusWaiting, next)
+	if err != nil {
+		slog.Error(
+			"failed resolve stale booking",
+			slog.Group("booking-properties",
+				slog.Int64("booking-id", booking.BookingID),
+				slog.String("to", string(next)),
+			),
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+	if !changed {
+		return
+	}
+
+	slog.Info(
+		"resolve stale booking",
+		slog.Group("booking-properties",
+			slog.Int64("booking-id", booking.BookingID),
+			slog.Int64("parking-place-id", booking.ParkingPlaceID),
+			slog.String("to", string(next)),
+			slog.Bool("charged", charge.Charged),
+		),
+	)
+	notifyDriver(ctx, r.KafkaConn, r.KeyCloak, booking.UserID, booking.BookingID, text)
+
+	if next == domain.BookingStatusCanceled {
+		if _, err := r.Database.CancelUpfrontSeries(ctx, booking.BookingID); err != nil {
+			slog.Error("failed cancel upfront series",
+				slog.Int64("booking-id", booking.BookingID), slog.String("error", err.Error()))
+		}
+	}
+}
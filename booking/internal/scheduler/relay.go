@@ -0,0 +1,429 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/h4x4d/parking_net/booking/internal/audit"
+	"github.com/h4x4d/parking_net/booking/internal/database_service"
+	payment_client "github.com/h4x4d/parking_net/booking/internal/grpc/client"
+	"github.com/h4x4d/parking_net/pkg/client"
+	"github.com/h4x4d/parking_net/pkg/domain"
+	pkg_models "github.com/h4x4d/parking_net/pkg/models"
+	"github.com/h4x4d/parking_net/pkg/notification"
+)
+
+const (
+	defaultRelayInterval    = 5 * time.Second
+	defaultOutboxMaxAttempt = 10
+	outboxBatchSize         = 50
+	outboxLease             = time.Minute
+	outboxRetryBase         = 5 * time.Second
+	outboxRetryMax          = 5 * time.Minute
+	// outboxFollowUpRounds bounds how many times ProcessBooking re-claims, so
+	// notifications enqueued by a settled charge are delivered in the same call.
+	outboxFollowUpRounds = 3
+)
+
+// Relay delivers the charge, refund, adjust and notify commands written to the
+// outbox together with booking changes. Failed deliveries are retried with
+// exponential backoff; a charge that keeps failing is compensated by canceling
+// the booking and refunding whatever may have been charged.
+type Relay struct {
+	Database      *database_service.DatabaseService
+	PaymentClient *payment_client.PaymentClient
+	KafkaConn     *notification.KafkaConnection
+	KeyCloak      *client.Client
+	interval      time.Duration
+	maxAttempts   int
+	loop          loop
+}
+
+func NewRelay(db *database_service.DatabaseService, paymentClient *payment_client.PaymentClient,
+	kafkaConn *notification.KafkaConnection, keyCloak *client.Client) *Relay {
+	return &Relay{
+		Database:      db,
+		PaymentClient: paymentClient,
+		KafkaConn:     kafkaConn,
+		KeyCloak:      keyCloak,
+		interval:      durationFromEnv("BOOKING_OUTBOX_INTERVAL", defaultRelayInterval),
+		maxAttempts:   intFromEnv("BOOKING_OUTBOX_MAX_ATTEMPTS", defaultOutboxMaxAttempt),
+	}
+}
+
+func (r *Relay) Start() {
+	r.loop.start(r.interval, r.run)
+	slog.Info(
+		"booking outbox relay started",
+		slog.String("interval", r.interval.String()),
+		slog.Int("max-attempts", r.maxAttempts),
+	)
+}
+
+// Stop cancels the relay and waits for the current run to finish.
+func (r *Relay) Stop() {
+	if r.loop.stop() {
+		slog.Info("booking outbox relay stopped")
+	}
+}
+
+// ProcessBooking delivers the due messages of a single booking right away, so
+// request handlers can report the outcome without waiting for the next tick.
+func (r *Relay) ProcessBooking(ctx context.Context, bookingID int64) {
+	for round := 0; round < outboxFollowUpRounds; round++ {
+		if r.deliver(ctx, &bookingID) == 0 {
+			return
+		}
+	}
+}
+
+func (r *Relay) run(ctx context.Context) {
+	r.deliver(ctx, nil)
+}
+
+func (r *Relay) deliver(ctx context.Context, bookingID *int64) int {
+	messages, err := r.Database.ClaimOutbox(ctx, bookingID, outboxBatchSize, outboxLease)
+	if err != nil {
+		slog.Error("failed claim outbox", slog.String("error", err.Error()))
+		return 0
+	}
+	for _, message := range messages {
+		if ctx.Err() != nil {
+			break
+		}
+		r.handle(ctx, message)
+	}
+	return len(messages)
+}
+
+func (r *Relay) handle(ctx context.Context, message database_service.OutboxMessage) {
+	// Messages are delivered on behalf of whoever enqueued them, but what the
+	// delivery changes is up to the payment service.
+	ctx = audit.WithActor(ctx, domain.SystemActor("outbox-relay"))
+	var err error
+	switch message.Command {
+	case database_service.OutboxCharge:
+		err = r.charge(ctx, message)
+	case database_service.OutboxRefund:
+		err = r.refund(ctx, message)
+	case database_service.OutboxNotify:
+		err = r.notify(ctx, message)
+	case database_service.OutboxAdjust:
+		err = r.adjust(ctx, message)
+	default:
+		err = fmt.Errorf("unknown outbox command %q", message.Command)
+	}
+	if err == nil {
+		return
+	}
+
+	logAttrs := []any{
+		slog.Group("outbox-properties",
+			slog.Int64("id", message.ID),
+			slog.Int64("booking-id", message.BookingID),
+			slog.String("command", string(message.Command)),
+			slog.Int("attempt", message.Attempts+1),
+		),
+		slog.String("error", err.Error()),
+	}
+	if message.Attempts+1 < r.maxAttempts {
+		slog.Warn("failed deliver outbox message, retrying later", logAttrs...)
+		if retryErr := r.Database.RetryOutbox(ctx, message.ID, err, retryDelay(message.Attempts)); retryErr != nil {
+			slog.Error("failed reschedule outbox message", slog.String("error", retryErr.Error()))
+		}
+		return
+	}
+
+	slog.Error("failed deliver outbox message, giving up", logAttrs...)
+	if message.Command == database_service.OutboxCharge {
+		if settleErr := r.settle(ctx, message, domain.BookingStatusCanceled); settleErr == nil {
+			return
+		}
+	}
+	if failErr := r.Database.FailOutbox(ctx, message.ID, err); failErr != nil {
+		slog.Error("failed mark outbox message as failed", slog.String("error", failErr.Error()))
+	}
+}
+
+func (r *Relay) charge(ctx context.Context, message database_service.OutboxMessage) error {
+	if len(message.Payload.Occurrences) > 0 {
+		return r.chargeSeries(ctx, message)
+	}
+	booking, err := r.Database.GetByID(ctx, message.BookingID)
+	if err != nil {
+		return err
+	}
+	if booking == nil || domain.BookingStatus(booking.Status) != domain.BookingStatusWaiting {
+		// Deleted, canceled or already resolved by the reaper: nothing to charge,
+		// but an earlier attempt might have gone through and must be compensated.
+		return r.settle(ctx, message, domain.BookingStatusCanceled)
+	}
+
+	if message.Attempts > 0 {
+		// The previous attempt may have reached the payment service before failing.
+		charge, err := r.PaymentClient.GetBookingCharge(ctx, message.BookingID)
+		if err != nil {
+			return err
+		}
+		if charge.Charged {
+			return r.settle(ctx, message, domain.BookingStatusConfirmed)
+		}
+	}
+
+	payload := message.Payload
+	result, err := r.PaymentClient.ProcessTransaction(ctx, message.BookingID, payload.DriverID, payload.OwnerID,
+		payload.Amount)
+	if err != nil {
+		return err
+	}
+	if result.Status != "completed" {
+		slog.Warn("payment processing failed",
+			"status", result.Status, "message", result.Message, "booking_id", message.BookingID)
+		return r.settle(ctx, message, domain.BookingStatusCanceled)
+	}
+	return r.settle(ctx, message, domain.BookingStatusConfirmed)
+}
+
+// chargeSeries charges the occurrences of an upfront series that are still
+// Waiting in a single payment. The payment service skips bookings it already
+// charged, so a retry after an interrupted attempt cannot charge twice.
+func (r *Relay) chargeSeries(ctx context.Context, message database_service.OutboxMessage) error {
+	payload := message.Payload
+	charges := make([]payment_client.BookingAmount, 0, len(payload.Occurrences))
+	for _, occurrence := range payload.Occurrences {
+		booking, err := r.Database.GetByID(ctx, occurrence.BookingID)
+		if err != nil {
+			return err
+		}
+		if booking != nil && domain.BookingStatus(booking.Status) == domain.BookingStatusWaiting {
+			charges = append(charges, payment_client.BookingAmount{BookingID: occurrence.BookingID,
+				Amount: occurrence.Amount})
+		}
+	}
+	if len(charges) == 0 {
+		return r.settle(ctx, message, domain.BookingStatusCanceled)
+	}
+
+	result, err := r.PaymentClient.ProcessSeriesTransaction(ctx, payload.DriverID, payload.OwnerID, charges)
+	if err != nil {
+		return err
+	}
+	if result.Status != "completed" {
+		slog.Warn("series payment processing failed",
+			"status", result.Status, "message", result.Message, "booking_id", message.BookingID)
+		return r.settle(ctx, message, domain.BookingStatusCanceled)
+	}
+	return r.settle(ctx, message, domain.BookingStatusConfirmed)
+}
+
+func (r *Relay) settle(ctx context.Context, message database_service.OutboxMessage, next domain.BookingStatus) error {
+	var notifications []database_service.OutboxPayload
+	if next == domain.BookingStatusConfirmed {
+		notifications = []database_service.OutboxPayload{
+			{
+				UserID: message.Payload.DriverID,
+				Name:   "New booking",
+				Text: fmt.Sprintf("Your booking with booking_id %d was created successfully",
+					message.BookingID),
+			},
+			{
+				UserID: message.Payload.OwnerID,
+				Name:   "New Booking",
+				Text: fmt.Sprintf("Your parking place %d was booked with booking_id %d",
+					message.Payload.ParkingPlaceID, message.BookingID),
+			},
+		}
+	} else {
+		notifications = []database_service.OutboxPayload{
+			{
+				UserID: message.Payload.DriverID,
+				Name:   "Booking canceled",
+				Text: fmt.Sprintf("Your booking with booking_id %d was canceled because the payment failed",
+					message.BookingID),
+			},
+		}
+	}
+
+	changed, err := r.Database.CompleteCharge(ctx, message, next, notifications)
+	if err != nil {
+		return err
+	}
+	if changed {
+		slog.Info(
+			"settle booking charge",
+			slog.Group("booking-properties",
+				slog.Int64("booking-id", message.BookingID),
+				slog.Int64("parking-place-id", message.Payload.ParkingPlaceID),
+				slog.String("to", string(next)),
+			),
+		)
+	}
+	return nil
+}
+
+func (r *Relay) refund(ctx context.Context, message database_service.OutboxMessage) error {
+	charge, err := r.PaymentClient.GetBookingCharge(ctx, message.BookingID)
+	if err != nil {
+		return err
+	}
+	if !charge.Charged {
+		return r.Database.CompleteOutbox(ctx, message.ID)
+	}
+	amount := domain.RefundAmount(charge.Amount, message.Payload.FeePercent)
+	if amount <= 0 {
+		return r.Database.CompleteOutbox(ctx, message.ID)
+	}
+
+	ownerID := message.Payload.OwnerID
+	if ownerID == "" {
+		parkingPlace, err := payment_client.GetParkingPlaceById(ctx, &message.Payload.ParkingPlaceID)
+		if err != nil {
+			return err
+		}
+		ownerID = parkingPlace.OwnerID
+	}
+
+	result, err := r.PaymentClient.ProcessRefund(ctx, message.BookingID, message.Payload.DriverID, ownerID,
+		amount, message.Payload.Reason)
+	if err != nil {
+		return err
+	}
+	if result.Status != "completed" {
+		return fmt.Errorf("refund %s: %s", result.Status, result.Message)
+	}
+
+	slog.Info(
+		"refund booking",
+		slog.Group("booking-properties",
+			slog.Int64("booking-id", message.BookingID),
+			slog.Int64("amount", amount),
+			slog.Int64("fee-percent", message.Payload.FeePercent),
+		),
+	)
+	return r.Database.CompleteRefund(ctx, message, amount)
+}
+
+// adjust settles the price difference of a modified booking. It waits until the
+// initial charge is settled, and rolls the modification back when the payment
+// service declines the difference.
+func (r *Relay) adjust(ctx context.Context, message database_service.OutboxMessage) error {
+	payload := message.Payload
+	if delivered, err := r.awaitDependency(ctx, message); !delivered || err != nil {
+		return err
+	}
+
+	booking, err := r.Database.GetByID(ctx, message.BookingID)
+	if err != nil {
+		return err
+	}
+	if booking == nil {
+		return r.Database.CompleteOutbox(ctx, message.ID)
+	}
+	switch domain.BookingStatus(booking.Status) {
+	case domain.BookingStatusWaiting:
+		return fmt.Errorf("booking %d is not paid yet", message.BookingID)
+	case domain.BookingStatusCanceled, domain.BookingStatusExpired:
+		// The refund returns the net amount, so the difference is settled there.
+		return r.Database.CompleteOutbox(ctx, message.ID)
+	}
+
+	result, err := r.PaymentClient.AdjustCharge(ctx, message.BookingID, payload.DriverID, payload.OwnerID,
+		payload.Delta, message.ID)
+	if err != nil {
+		return err
+	}
+	if result.Status != "completed" {
+		if payload.Change == nil {
+			return fmt.Errorf("adjustment %s: %s", result.Status, result.Message)
+		}
+		slog.Warn("booking adjustment failed",
+			"status", result.Status, "message", result.Message, "booking_id", message.BookingID)
+		restored, err := r.Database.CompensateAdjustment(ctx, message, database_service.OutboxPayload{
+			UserID: payload.DriverID,
+			Name:   "Booking change rejected",
+			Text: fmt.Sprintf("The change of your booking with booking_id %d was reverted because the payment failed",
+				message.BookingID),
+		})
+		if err != nil {
+			return err
+		}
+		if restored {
+			slog.Info(
+				"revert booking change",
+				slog.Group("booking-properties",
+					slog.Int64("booking-id", message.BookingID),
+					slog.Int64("parking-place-id", payload.Change.From.ParkingPlaceID),
+					slog.Int64("full-cost", payload.Change.From.FullCost),
+				),
+			)
+		}
+		return nil
+	}
+
+	slog.Info(
+		"adjust booking charge",
+		slog.Group("booking-properties",
+			slog.Int64("booking-id", message.BookingID),
+			slog.Int64("delta", payload.Delta),
+		),
+	)
+	return r.Database.CompleteOutbox(ctx, message.ID)
+}
+
+func (r *Relay) notify(ctx context.Context, message database_service.OutboxMessage) error {
+	if delivered, err := r.awaitDependency(ctx, message); !delivered || err != nil {
+		return err
+	}
+	if r.KafkaConn == nil || r.KeyCloak == nil {
+		slog.Warn("notifications are not available, dropping outbox notification",
+			"booking_id", message.BookingID)
+		return r.Database.CompleteOutbox(ctx, message.ID)
+	}
+	tgId, err := r.KeyCloak.GetTelegramId(ctx, message.Payload.UserID)
+	if err != nil {
+		return err
+	}
+	if tgId > 0 {
+		err = r.KafkaConn.SendNotification(
+			pkg_models.Notification{
+				Name:       message.Payload.Name,
+				Text:       message.Payload.Text,
+				TelegramID: tgId,
+			})
+		if err != nil {
+			return err
+		}
+	}
+	return r.Database.CompleteOutbox(ctx, message.ID)
+}
+
+// awaitDependency reports whether the message the given one DependsOn was
+// delivered. It fails while that message is pending, so the delivery is retried
+// later, and skips the given message when the other one was given up on.
+func (r *Relay) awaitDependency(ctx context.Context, message database_service.OutboxMessage) (bool, error) {
+	dependsOn := message.Payload.DependsOn
+	if dependsOn == 0 {
+		return true, nil
+	}
+	status, err := r.Database.GetOutboxStatus(ctx, dependsOn)
+	if err != nil {
+		return false, err
+	}
+	switch status {
+	case "pending":
+		return false, fmt.Errorf("waiting for outbox message %d", dependsOn)
+	case "compensated", "failed":
+		return false, r.Database.SkipOutbox(ctx, message.ID)
+	}
+	return true, nil
+}
+
+func retryDelay(attempts int) time.Duration {
+	delay := outboxRetryBase
+	for i := 0; i < attempts && delay < outboxRetryMax; i++ {
+		delay *= 2
+	}
+	return min(delay, outboxRetryMax)
+}
@@ -0,0 +1,156 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/h4x4d/parking_net/booking/internal/audit"
+	"github.com/h4x4d/parking_net/booking/internal/database_service"
+	"github.com/h4x4d/parking_net/booking/internal/service"
+	"github.com/h4x4d/parking_net/pkg/client"
+	"github.com/h4x4d/parking_net/pkg/domain"
+	"github.com/h4x4d/parking_net/pkg/notification"
+)
+
+const (
+	defaultInterval          = time.Minute
+	defaultNoShowGrace       = 30 * time.Minute
+	defaultAutoCheckOutAfter = 12 * time.Hour
+	autoCheckOutBatchSize    = 100
+)
+
+type transition struct {
+	from  domain.BookingStatus
+	to    domain.BookingStatus
+	due   database_service.DueField
+	delay time.Duration
+}
+
+// Scheduler periodically advances bookings whose date_from or date_to has
+// passed and notifies drivers about every status change. Confirmed bookings
+// nobody checked in within the no-show grace become NoShow, and Active bookings
+// nobody checked out are checked out once autoCheckOutAfter has passed since
+// date_to through the attendance service, billing the overtime the same way a
+// manual check-out does. It also closes the booking holds that expired unused.
+type Scheduler struct {
+	Database          *database_service.DatabaseService
+	Attendance        *service.AttendanceService
+	KafkaConn         *notification.KafkaConnection
+	KeyCloak          *client.Client
+	interval          time.Duration
+	transitions       []transition
+	autoCheckOutAfter time.Duration
+	loop              loop
+}
+
+func NewScheduler(db *database_service.DatabaseService, attendance *service.AttendanceService,
+	kafkaConn *notification.KafkaConnection, keyCloak *client.Client) *Scheduler {
+	noShowGrace := durationFromEnv("BOOKING_NO_SHOW_GRACE", defaultNoShowGrace)
+	return &Scheduler{
+		Database:   db,
+		Attendance: attendance,
+		KafkaConn:  kafkaConn,
+		KeyCloak:   keyCloak,
+		interval:   durationFromEnv("BOOKING_SCHEDULER_INTERVAL", defaultInterval),
+		transitions: []transition{
+			{domain.BookingStatusWaiting, domain.BookingStatusExpired, database_service.DueAtDateFrom, 0},
+			{domain.BookingStatusConfirmed, domain.BookingStatusNoShow, database_service.DueAtDateFrom, noShowGrace},
+		},
+		autoCheckOutAfter: durationFromEnv("BOOKING_AUTO_CHECKOUT_AFTER", defaultAutoCheckOutAfter),
+	}
+}
+
+func (s *Scheduler) Start() {
+	s.loop.start(s.interval, s.run)
+	slog.Info("booking scheduler started", slog.String("interval", s.interval.String()))
+}
+
+// Stop cancels the scheduler and waits for the current run to finish.
+func (s *Scheduler) Stop() {
+	if s.loop.stop() {
+		slog.Info("booking scheduler stopped")
+	}
+}
+
+func (s *Scheduler) run(ctx context.Context) {
+	ctx = audit.WithActor(ctx, domain.SystemActor("scheduler"))
+	now := time.Now().UTC()
+	if expired, err := s.Database.ExpireHolds(ctx, now); err != nil {
+		slog.Error("failed expire booking holds", slog.String("error", err.Error()))
+	} else if expired > 0 {
+		slog.Info("expire booking holds", slog.Int64("count", expired))
+	}
+	for _, t := range s.transitions {
+		changes, err := s.Database.AdvanceStatuses(ctx, t.from, t.to, t.due, now.Add(-t.delay))
+		if err != nil {
+			slog.Error(
+				"failed advance booking statuses",
+				slog.String("from", string(t.from)),
+				slog.String("to", string(t.to)),
+				slog.String("error", err.Error()),
+			)
+			continue
+		}
+		for _, change := range changes {
+			slog.Info(
+				"advance booking status",
+				slog.Group("booking-properties",
+					slog.Int64("booking-id", change.BookingID),
+					slog.Int64("parking-place-id", change.ParkingPlaceID),
+					slog.String("from", string(change.From)),
+					slog.String("to", string(change.To)),
+				),
+			)
+			s.notify(ctx, change)
+		}
+	}
+	s.autoCheckOut(ctx, now)
+}
+
+// autoCheckOut checks out the Active bookings overdue by autoCheckOutAfter at
+// now. The service prices the overtime and delivers its charge.
+func (s *Scheduler) autoCheckOut(ctx context.Context, now time.Time) {
+	overdue, err := s.Database.GetOverdueActive(ctx, now.Add(-s.autoCheckOutAfter), autoCheckOutBatchSize)
+	if err != nil {
+		slog.Error("failed get overdue bookings", slog.String("error", err.Error()))
+		return
+	}
+	for _, booking := range overdue {
+		if ctx.Err() != nil {
+			return
+		}
+		overtime, appErr := s.Attendance.CheckOutOverdue(ctx, booking.BookingID, now)
+		if appErr != nil && appErr.Code < http.StatusInternalServerError {
+			// The booking was checked out, deleted or extended since it was
+			// listed.
+			continue
+		}
+		if appErr != nil {
+			slog.Error(
+				"failed auto check out booking",
+				slog.Int64("booking-id", booking.BookingID),
+				slog.String("error", appErr.Error()),
+			)
+			continue
+		}
+		slog.Info(
+			"auto check out booking",
+			slog.Group("booking-properties",
+				slog.Int64("booking-id", booking.BookingID),
+				slog.Int64("parking-place-id", booking.ParkingPlaceID),
+				slog.Int64("overtime-cost", overtime),
+			),
+		)
+		booking.To = domain.BookingStatusCompleted
+		s.notify(ctx, booking)
+	}
+}
+
+func (s *Scheduler) notify(ctx context.Context, change database_service.StatusChange) {
+	notifyDriver(ctx, s.KafkaConn, s.KeyCloak, change.UserID, change.BookingID,
+		fmt.Sprintf("Your booking with booking_id %d changed status from %s to %s",
+			change.BookingID, change.From, change.To))
+}
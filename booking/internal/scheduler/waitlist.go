@@ -0,0 +1,102 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/h4x4d/parking_net/booking/internal/audit"
+	"github.com/h4x4d/parking_net/booking/internal/service"
+	"github.com/h4x4d/parking_net/pkg/client"
+	"github.com/h4x4d/parking_net/pkg/domain"
+	"github.com/h4x4d/parking_net/pkg/notification"
+)
+
+const (
+	defaultWaitlistInterval = 30 * time.Second
+	defaultWaitlistOfferTTL = 15 * time.Minute
+	waitlistBatchSize       = 100
+)
+
+// Waitlist hands spots that became free to the drivers queued for them. Every
+// run first expires offers that were not accepted in time, so their spots go
+// to the next entry in the same run, and then matches the queue in the order
+// the drivers joined it through the waitlist service. Charges of auto-accepted
+// bookings are delivered by the relay like those of any other booking.
+type Waitlist struct {
+	Service   *service.WaitlistService
+	KafkaConn *notification.KafkaConnection
+	KeyCloak  *client.Client
+	offerTTL  time.Duration
+	interval  time.Duration
+	loop      loop
+}
+
+func NewWaitlist(waitlist *service.WaitlistService, kafkaConn *notification.KafkaConnection,
+	keyCloak *client.Client) *Waitlist {
+	return &Waitlist{
+		Service:   waitlist,
+		KafkaConn: kafkaConn,
+		KeyCloak:  keyCloak,
+		offerTTL:  durationFromEnv("BOOKING_WAITLIST_OFFER_TTL", defaultWaitlistOfferTTL),
+		interval:  durationFromEnv("BOOKING_WAITLIST_INTERVAL", defaultWaitlistInterval),
+	}
+}
+
+func (w *Waitlist) Start() {
+	w.loop.start(w.interval, w.run)
+	slog.Info(
+		"booking waitlist started",
+		slog.String("interval", w.interval.String()),
+		slog.String("offer_ttl", w.offerTTL.String()),
+	)
+}
+
+// Stop cancels the waitlist worker and waits for the current run to finish.
+func (w *Waitlist) Stop() {
+	if w.loop.stop() {
+		slog.Info("booking waitlist stopped")
+	}
+}
+
+func (w *Waitlist) run(ctx context.Context) {
+	ctx = audit.WithActor(ctx, domain.SystemActor("waitlist"))
+	expired, appErr := w.Service.ExpireOffers(ctx, time.Now().UTC())
+	if appErr != nil {
+		slog.Error("failed expire waitlist", slog.String("error", appErr.Error()))
+		return
+	}
+	for _, entry := range expired {
+		notifyDriver(ctx, w.KafkaConn, w.KeyCloak, entry.UserID, entry.BookingID,
+			fmt.Sprintf("Your offer for parking place %d expired", entry.ParkingPlaceID))
+	}
+
+	matched, appErr := w.Service.MatchQueue(ctx, w.offerTTL, waitlistBatchSize)
+	if appErr != nil {
+		// Entries matched before the failure are committed; still notify them.
+		slog.Error("failed match waitlist", slog.String("error", appErr.Error()))
+	}
+	for _, entry := range matched {
+		slog.Info(
+			"match waitlist entry",
+			slog.Group("waitlist-properties",
+				slog.Int64("entry-id", entry.ID),
+				slog.Int64("parking-place-id", entry.ParkingPlaceID),
+				slog.String("status", string(entry.Status)),
+				slog.Int64("booking-id", entry.BookingID),
+			),
+		)
+		notifyDriver(ctx, w.KafkaConn, w.KeyCloak, entry.UserID, entry.BookingID, matchText(entry))
+	}
+}
+
+func matchText(entry *domain.WaitlistEntry) string {
+	window := fmt.Sprintf("%s - %s", entry.DateFrom.Format(time.DateTime), entry.DateTo.Format(time.DateTime))
+	if entry.Status == domain.WaitlistStatusBooked {
+		return fmt.Sprintf("A spot at parking place %d freed up for %s, your booking with booking_id %d was created",
+			entry.ParkingPlaceID, window, entry.BookingID)
+	}
+	return fmt.Sprintf("A spot at parking place %d freed up for %s, accept waitlist entry %d before %s to book it",
+		entry.ParkingPlaceID, window, entry.ID, entry.OfferExpiresAt.Format(time.DateTime))
+}
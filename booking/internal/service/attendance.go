@@ -0,0 +1,218 @@
+package service
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/h4x4d/parking_net/booking/internal/audit"
+	"github.com/h4x4d/parking_net/booking/internal/pass"
+	"github.com/h4x4d/parking_net/booking/internal/repository"
+	"github.com/h4x4d/parking_net/pkg/domain"
+	"github.com/h4x4d/parking_net/pkg/errors"
+)
+
+type AttendanceService struct {
+	attendance repository.AttendanceRepository
+	bookings   repository.BookingRepository
+	parking    ParkingClient
+	payments   PaymentProcessor
+	passes     *pass.Signer
+	// overtimePenalty multiplies the hourly rate for time spent past date_to.
+	overtimePenalty float64
+}
+
+func NewAttendanceService(attendance repository.AttendanceRepository, bookings repository.BookingRepository,
+	parking ParkingClient, payments PaymentProcessor, passes *pass.Signer, overtimePenalty float64) *AttendanceService {
+	return &AttendanceService{attendance: attendance, bookings: bookings, parking: parking, payments: payments,
+		passes: passes, overtimePenalty: overtimePenalty}
+}
+
+// CheckIn records that the driver arrived and moves the Confirmed booking to
+// Active. It is open from domain.EarlyCheckIn before the booking starts until
+// it ends.
+func (s *AttendanceService) CheckIn(ctx context.Context, id int64, user *domain.User) (*domain.Booking, *errors.AppError) {
+	booking, appErr := s.getBooking(ctx, id)
+	if appErr != nil {
+		return nil, appErr
+	}
+	if appErr := authorize(ctx, s.parking, booking.UserID, booking.ParkingPlaceID, user,
+		"You don't have permission to check in this booking"); appErr != nil {
+		return nil, appErr
+	}
+	ctx = audit.WithActor(ctx, domain.UserActor(user))
+
+	err := s.attendance.CheckIn(ctx, id, time.Now().UTC())
+	switch {
+	case stderrors.Is(err, domain.ErrInvalidStatusTransition), stderrors.Is(err, domain.ErrCheckInTooEarly),
+		stderrors.Is(err, domain.ErrCheckInClosed):
+		return nil, errors.BadRequest(err.Error())
+	case stderrors.Is(err, domain.ErrBookingNotFound):
+		return nil, errors.New(http.StatusNotFound, fmt.Sprintf("Booking with id %d not found", id))
+	case err != nil:
+		return nil, errors.Internal(err)
+	}
+	return s.getBooking(ctx, id)
+}
+
+// CheckOut records that the driver left and completes the Active booking. Time
+// spent past the end of the booking is billed at the hourly rate of the place
+// times the overtime penalty.
+func (s *AttendanceService) CheckOut(ctx context.Context, id int64, user *domain.User) (*domain.Booking, *errors.AppError) {
+	booking, appErr := s.getBooking(ctx, id)
+	if appErr != nil {
+		return nil, appErr
+	}
+	if appErr := authorize(ctx, s.parking, booking.UserID, booking.ParkingPlaceID, user,
+		"You don't have permission to check out this booking"); appErr != nil {
+		return nil, appErr
+	}
+	ctx = audit.WithActor(ctx, domain.UserActor(user))
+
+	if _, appErr := s.checkOut(ctx, booking, time.Now().UTC()); appErr != nil {
+		return nil, appErr
+	}
+	return s.getBooking(ctx, id)
+}
+
+// CheckOutOverdue checks out at now an Active booking its driver never checked
+// out, billing the overtime like CheckOut does, and returns the overtime
+// billed. The actor comes from ctx.
+func (s *AttendanceService) CheckOutOverdue(ctx context.Context, id int64, now time.Time) (int64, *errors.AppError) {
+	booking, appErr := s.getBooking(ctx, id)
+	if appErr != nil {
+		return 0, appErr
+	}
+	return s.checkOut(ctx, booking, now)
+}
+
+// checkOut prices the overtime of the booking at the current hourly rate of
+// its place, completes the booking at now and delivers the overtime charge.
+func (s *AttendanceService) checkOut(ctx context.Context, booking *domain.Booking,
+	now time.Time) (int64, *errors.AppError) {
+	place, err := s.parking.GetParkingPlace(ctx, booking.ParkingPlaceID)
+	if err != nil {
+		return 0, errors.Internal(fmt.Errorf("failed to get parking place: %w", err))
+	}
+	checkOut := repository.CheckOut{
+		At:           now,
+		OvertimeCost: domain.OvertimeCost(int64(place.HourlyRate), booking.DateTo, now, s.overtimePenalty),
+		OwnerID:      place.OwnerID,
+	}
+
+	err = s.attendance.CheckOut(ctx, booking, checkOut)
+	switch {
+	case stderrors.Is(err, domain.ErrInvalidStatusTransition):
+		return 0, errors.BadRequest(err.Error())
+	case stderrors.Is(err, domain.ErrBookingNotFound):
+		return 0, errors.New(http.StatusNotFound, fmt.Sprintf("Booking with id %d not found", booking.ID))
+	case err != nil:
+		return 0, writeError(err)
+	}
+	if checkOut.OvertimeCost > 0 {
+		// Deliver the overtime charge right away instead of waiting for the relay.
+		s.payments.ProcessBooking(ctx, booking.ID)
+	}
+	return checkOut.OvertimeCost, nil
+}
+
+// GetEntryPass issues a pass for a Confirmed booking that has not ended. The
+// pass opens the gate, so only the driver who booked and admins get it.
+func (s *AttendanceService) GetEntryPass(ctx context.Context, id int64, user *domain.User) (*domain.EntryPass, *errors.AppError) {
+	booking, err := s.attendance.GetPassBooking(ctx, id)
+	if err != nil {
+		return nil, errors.Internal(err)
+	}
+	if booking == nil {
+		return nil, errors.New(http.StatusNotFound, fmt.Sprintf("Booking with id %d not found", id))
+	}
+	if user == nil || (!user.IsAdmin() && booking.UserID != user.ID) {
+		return nil, errors.New(http.StatusForbidden, "You don't have permission to get a pass for this booking")
+	}
+
+	now := time.Now().UTC()
+	if booking.Status != domain.BookingStatusConfirmed {
+		return nil, errors.BadRequest(fmt.Sprintf("A %s booking has no entry pass", booking.Status))
+	}
+	if !now.Before(booking.DateTo) {
+		return nil, errors.BadRequest(domain.ErrCheckInClosed.Error())
+	}
+
+	token, claims, err := s.passes.Issue(booking.Booking, now)
+	if err != nil {
+		return nil, errors.Internal(err)
+	}
+	qrCode, err := pass.QRCode(token)
+	if err != nil {
+		return nil, errors.Internal(err)
+	}
+	return &domain.EntryPass{
+		BookingID:      booking.ID,
+		ParkingPlaceID: booking.ParkingPlaceID,
+		Token:          token,
+		QRCode:         qrCode,
+		ValidFrom:      claims.NotBefore.Time,
+		ValidUntil:     claims.ExpiresAt.Time,
+	}, nil
+}
+
+// VerifyEntryPass checks a pass shown at the gate of the parking place and,
+// with checkIn, checks its booking in and spends the pass. Only admins and the
+// owner of the place may verify passes there. A pass that does not let the
+// vehicle in is not an error; the verification tells why.
+func (s *AttendanceService) VerifyEntryPass(ctx context.Context, token string, parkingPlaceID int64, checkIn bool,
+	user *domain.User) (*domain.PassVerification, *errors.AppError) {
+	forbidden := errors.New(http.StatusForbidden, fmt.Sprintf("Parking place %d is not yours", parkingPlaceID))
+	switch {
+	case user == nil:
+		return nil, forbidden
+	case user.IsAdmin():
+	case user.IsOwner():
+		owned, err := s.parking.GetOwnerParkingIDs(ctx, user.ID)
+		if err != nil {
+			return nil, errors.Internal(err)
+		}
+		if !contains(owned, parkingPlaceID) {
+			return nil, forbidden
+		}
+	default:
+		return nil, forbidden
+	}
+	ctx = audit.WithActor(ctx, domain.UserActor(user))
+
+	now := time.Now().UTC()
+	claims, err := s.passes.Verify(token, now)
+	if err == nil {
+		err = s.attendance.UseEntryPass(ctx, claims, parkingPlaceID, checkIn, now)
+	}
+	switch {
+	case err == nil:
+	case stderrors.Is(err, domain.ErrEntryPassInvalid), stderrors.Is(err, domain.ErrEntryPassUsed),
+		stderrors.Is(err, domain.ErrEntryPassOutdated), stderrors.Is(err, domain.ErrEntryPassWrongPlace),
+		stderrors.Is(err, domain.ErrBookingNotFound), stderrors.Is(err, domain.ErrInvalidStatusTransition),
+		stderrors.Is(err, domain.ErrCheckInTooEarly), stderrors.Is(err, domain.ErrCheckInClosed):
+		return &domain.PassVerification{Reason: err.Error()}, nil
+	default:
+		return nil, errors.Internal(err)
+	}
+
+	bookingID, _ := claims.BookingID()
+	booking, err := s.bookings.GetByID(ctx, bookingID)
+	if err != nil {
+		return nil, errors.Internal(err)
+	}
+	return &domain.PassVerification{Valid: true, Booking: booking, CheckedIn: checkIn}, nil
+}
+
+func (s *AttendanceService) getBooking(ctx context.Context, id int64) (*domain.Booking, *errors.AppError) {
+	booking, err := s.bookings.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.Internal(err)
+	}
+	if booking == nil {
+		return nil, errors.New(http.StatusNotFound, fmt.Sprintf("Booking with id %d not found", id))
+	}
+	return booking, nil
+}
@@ -0,0 +1,234 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/h4x4d/parking_net/booking/internal/pass"
+	"github.com/h4x4d/parking_net/booking/internal/repository"
+	"github.com/h4x4d/parking_net/pkg/domain"
+)
+
+// stubAttendance records check-ins, check-outs and spent passes without a
+// database.
+type stubAttendance struct {
+	checkedIn  map[int64]bool
+	checkedOut map[int64]repository.CheckOut
+	used       map[string]bool
+	bookings   map[int64]*repository.PassBooking
+}
+
+func newStubAttendance() *stubAttendance {
+	return &stubAttendance{
+		checkedIn:  make(map[int64]bool),
+		checkedOut: make(map[int64]repository.CheckOut),
+		used:       make(map[string]bool),
+		bookings:   make(map[int64]*repository.PassBooking),
+	}
+}
+
+func (r *stubAttendance) CheckIn(ctx context.Context, bookingID int64, now time.Time) error {
+	if r.checkedIn[bookingID] {
+		return domain.ErrInvalidStatusTransition
+	}
+	r.checkedIn[bookingID] = true
+	return nil
+}
+
+func (r *stubAttendance) CheckOut(ctx context.Context, current *domain.Booking, checkOut repository.CheckOut) error {
+	if !r.checkedIn[current.ID] {
+		return domain.ErrInvalidStatusTransition
+	}
+	if _, ok := r.checkedOut[current.ID]; ok {
+		return domain.ErrInvalidStatusTransition
+	}
+	r.checkedOut[current.ID] = checkOut
+	return nil
+}
+
+func (r *stubAttendance) GetPassBooking(ctx context.Context, bookingID int64) (*repository.PassBooking, error) {
+	return r.bookings[bookingID], nil
+}
+
+func (r *stubAttendance) UseEntryPass(ctx context.Context, claims *pass.Claims, parkingPlaceID int64, checkIn bool,
+	now time.Time) error {
+	if claims.ParkingPlaceID != parkingPlaceID {
+		return domain.ErrEntryPassWrongPlace
+	}
+	if r.used[claims.ID] {
+		return domain.ErrEntryPassUsed
+	}
+	if checkIn {
+		r.used[claims.ID] = true
+		bookingID, _ := claims.BookingID()
+		return r.CheckIn(ctx, bookingID, now)
+	}
+	return nil
+}
+
+func newAttendanceService(f *fixture, attendance *stubAttendance) *AttendanceService {
+	return NewAttendanceService(attendance, f.repo, f.parking, f.payments, pass.NewSigner([]byte("secret")), 1.5)
+}
+
+func TestCheckInPermissions(t *testing.T) {
+	tests := []struct {
+		name string
+		user *domain.User
+		want int
+	}{
+		{"driver of the booking", driver, http.StatusOK},
+		{"another driver", otherDriver, http.StatusForbidden},
+		{"owner of the parking place", owner, http.StatusOK},
+		{"owner of another parking place", otherOwner, http.StatusForbidden},
+		{"admin", admin, http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture(t)
+			booking := f.book(t, driver, singleSpot, start(), 2)
+			svc := newAttendanceService(f, newStubAttendance())
+
+			_, appErr := svc.CheckIn(context.Background(), booking.ID, tt.user)
+			if got := code(appErr); got != tt.want {
+				t.Fatalf("check in: code = %d, want %d (%v)", got, tt.want, appErr)
+			}
+			_, appErr = svc.CheckOut(context.Background(), booking.ID, tt.user)
+			if got := code(appErr); got != tt.want {
+				t.Errorf("check out: code = %d, want %d (%v)", got, tt.want, appErr)
+			}
+		})
+	}
+}
+
+func TestCheckInTwice(t *testing.T) {
+	f := newFixture(t)
+	booking := f.book(t, driver, singleSpot, start(), 2)
+	svc := newAttendanceService(f, newStubAttendance())
+
+	if _, appErr := svc.CheckIn(context.Background(), booking.ID, driver); appErr != nil {
+		t.Fatalf("check in: %v", appErr)
+	}
+	if _, appErr := svc.CheckIn(context.Background(), booking.ID, driver); code(appErr) != http.StatusBadRequest {
+		t.Errorf("second check in: code = %d, want %d", code(appErr), http.StatusBadRequest)
+	}
+	if _, appErr := svc.CheckIn(context.Background(), booking.ID+1, driver); code(appErr) != http.StatusNotFound {
+		t.Errorf("missing booking: code = %d, want %d", code(appErr), http.StatusNotFound)
+	}
+}
+
+func TestCheckOutOvertime(t *testing.T) {
+	tests := []struct {
+		name  string
+		after time.Duration
+		want  int64
+	}{
+		{"on time", -time.Minute, 0},
+		// 1.5 hours at 100 per hour times the 1.5 penalty.
+		{"late", 90 * time.Minute, 225},
+		// A minute costs 1 of 1.67 rounded down, times the penalty rounded
+		// down again.
+		{"a minute late", time.Minute, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture(t)
+			booking := f.book(t, driver, singleSpot, start(), 2)
+			attendance := newStubAttendance()
+			svc := newAttendanceService(f, attendance)
+			if _, appErr := svc.CheckIn(context.Background(), booking.ID, driver); appErr != nil {
+				t.Fatalf("check in: %v", appErr)
+			}
+
+			now := booking.DateTo.Add(tt.after)
+			overtime, appErr := svc.CheckOutOverdue(context.Background(), booking.ID, now)
+			if appErr != nil {
+				t.Fatalf("check out: %v", appErr)
+			}
+			checkOut := attendance.checkedOut[booking.ID]
+			if overtime != tt.want || checkOut.OvertimeCost != tt.want || !checkOut.At.Equal(now) ||
+				checkOut.OwnerID != owner.ID {
+				t.Errorf("overtime = %d, check-out = %+v; want %d owed to %s", overtime, checkOut, tt.want, owner.ID)
+			}
+			if _, appErr := svc.CheckOutOverdue(context.Background(), booking.ID, now); code(appErr) != http.StatusBadRequest {
+				t.Errorf("second check out: code = %d, want %d", code(appErr), http.StatusBadRequest)
+			}
+		})
+	}
+}
+
+// issuePass issues a pass for the booking of the fixture as the driver would
+// get it. Bookings of the fixture start in the future, so the pass is issued
+// for a window that is open now.
+func issuePass(t *testing.T, svc *AttendanceService, attendance *stubAttendance, booking *domain.Booking) string {
+	t.Helper()
+	now := time.Now().UTC()
+	attendance.bookings[booking.ID] = &repository.PassBooking{
+		Booking: pass.Booking{ID: booking.ID, ParkingPlaceID: booking.ParkingPlaceID,
+			DateFrom: now.Add(-10 * time.Minute), DateTo: now.Add(2 * time.Hour)},
+		UserID: booking.UserID,
+		Status: domain.BookingStatusConfirmed,
+	}
+	entryPass, appErr := svc.GetEntryPass(context.Background(), booking.ID, driver)
+	if appErr != nil {
+		t.Fatalf("get entry pass: %v", appErr)
+	}
+	return entryPass.Token
+}
+
+func TestVerifyEntryPassPermissions(t *testing.T) {
+	tests := []struct {
+		name string
+		user *domain.User
+		want int
+	}{
+		{"owner of the parking place", owner, http.StatusOK},
+		{"admin", admin, http.StatusOK},
+		{"owner of another parking place", otherOwner, http.StatusForbidden},
+		{"driver of the booking", driver, http.StatusForbidden},
+		{"anonymous", nil, http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture(t)
+			attendance := newStubAttendance()
+			svc := newAttendanceService(f, attendance)
+			booking := f.book(t, driver, singleSpot, start(), 2)
+			token := issuePass(t, svc, attendance, booking)
+
+			verification, appErr := svc.VerifyEntryPass(context.Background(), token, singleSpot, false, tt.user)
+			if got := code(appErr); got != tt.want {
+				t.Fatalf("code = %d, want %d (%v)", got, tt.want, appErr)
+			}
+			if appErr == nil && !verification.Valid {
+				t.Errorf("pass refused: %s", verification.Reason)
+			}
+		})
+	}
+}
+
+func TestVerifyEntryPassReplay(t *testing.T) {
+	f := newFixture(t)
+	attendance := newStubAttendance()
+	svc := newAttendanceService(f, attendance)
+	booking := f.book(t, driver, singleSpot, start(), 2)
+	token := issuePass(t, svc, attendance, booking)
+
+	first, appErr := svc.VerifyEntryPass(context.Background(), token, singleSpot, true, owner)
+	if appErr != nil || !first.Valid || !first.CheckedIn {
+		t.Fatalf("first scan = %+v, %v; want a check-in", first, appErr)
+	}
+	second, appErr := svc.VerifyEntryPass(context.Background(), token, singleSpot, true, owner)
+	if appErr != nil {
+		t.Fatalf("second scan: %v", appErr)
+	}
+	if second.Valid || second.Reason != domain.ErrEntryPassUsed.Error() {
+		t.Errorf("second scan = valid %v, reason %q; want refused with %q", second.Valid, second.Reason,
+			domain.ErrEntryPassUsed)
+	}
+	third, appErr := svc.VerifyEntryPass(context.Background(), "not-a-pass", singleSpot, true, owner)
+	if appErr != nil || third.Valid {
+		t.Errorf("forged pass = %+v, %v; want refused", third, appErr)
+	}
+}
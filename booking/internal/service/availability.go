@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	stderrors "errors"
+	"net/http"
+	"time"
+
+	"github.com/h4x4d/parking_net/booking/internal/repository"
+	"github.com/h4x4d/parking_net/booking/internal/utils"
+	"github.com/h4x4d/parking_net/pkg/domain"
+	"github.com/h4x4d/parking_net/pkg/errors"
+)
+
+type AvailabilityService struct {
+	holds   repository.HoldRepository
+	parking ParkingClient
+	// holdTTL is how long a hold keeps its spot.
+	holdTTL time.Duration
+}
+
+func NewAvailabilityService(holds repository.HoldRepository, parking ParkingClient,
+	holdTTL time.Duration) *AvailabilityService {
+	return &AvailabilityService{holds: holds, parking: parking, holdTTL: holdTTL}
+}
+
+// CreateHold reserves a spot of the parking place for the driver during the
+// period of hold, for the hold TTL of the service. A booking of that period
+// made with the hold before it expires takes the reserved spot.
+func (s *AvailabilityService) CreateHold(ctx context.Context, hold *domain.BookingHold,
+	user *domain.User) (*domain.BookingHold, *errors.AppError) {
+	if user == nil || !user.IsDriver() {
+		return nil, errors.New(http.StatusForbidden, "Only drivers can hold a spot")
+	}
+
+	hold.UserID = user.ID
+	if err := utils.ValidateParkingPlaceID(&hold.ParkingPlaceID); err != nil {
+		return nil, errors.BadRequest(err.Error())
+	}
+	if err := utils.ValidateDateRange(&hold.DateFrom, &hold.DateTo); err != nil {
+		return nil, errors.BadRequest(err.Error())
+	}
+
+	place, appErr := getBookablePlace(ctx, s.parking, hold.ParkingPlaceID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	created, err := s.holds.Create(ctx, hold, place, s.holdTTL)
+	switch {
+	case stderrors.Is(err, utils.ErrTooManyHolds):
+		return nil, errors.BadRequest(err.Error())
+	case stderrors.Is(err, utils.ErrNoFreeSpots):
+		return nil, errors.New(http.StatusConflict, "No free parking spots for the requested period")
+	case stderrors.Is(err, domain.ErrParkingUnavailable):
+		return nil, errors.New(http.StatusConflict, "Parking place does not take bookings")
+	case err != nil:
+		return nil, errors.Internal(err)
+	}
+	return created, nil
+}
+
+// GetAvailability splits [dateFrom, dateTo) into slots of the given length and
+// tells how many spots of the parking place are taken and free in each one.
+func (s *AvailabilityService) GetAvailability(ctx context.Context, parkingPlaceID int64, dateFrom, dateTo time.Time,
+	step time.Duration, user *domain.User) (*domain.Availability, *errors.AppError) {
+	if user == nil {
+		return nil, errors.New(http.StatusForbidden, "You don't have permission to view availability")
+	}
+	if err := utils.ValidateParkingPlaceID(&parkingPlaceID); err != nil {
+		return nil, errors.BadRequest(err.Error())
+	}
+	if err := utils.ValidateAvailabilityWindow(dateFrom, dateTo, step); err != nil {
+		return nil, errors.BadRequest(err.Error())
+	}
+
+	place, appErr := getParkingPlace(ctx, s.parking, parkingPlaceID)
+	if appErr != nil {
+		return nil, appErr
+	}
+	slots, err := s.holds.Availability(ctx, place, dateFrom, dateTo, step)
+	if err != nil {
+		return nil, errors.Internal(err)
+	}
+	return &domain.Availability{
+		ParkingPlaceID: place.ID,
+		Capacity:       int64(place.Capacity),
+		Slots:          slots,
+	}, nil
+}
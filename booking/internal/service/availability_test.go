@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/h4x4d/parking_net/booking/internal/utils"
+	"github.com/h4x4d/parking_net/pkg/domain"
+)
+
+// stubHolds answers every hold with err, or with the hold itself.
+type stubHolds struct {
+	err     error
+	created []*domain.BookingHold
+}
+
+func (r *stubHolds) Create(ctx context.Context, hold *domain.BookingHold, place *domain.ParkingPlace,
+	ttl time.Duration) (*domain.BookingHold, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	created := *hold
+	created.ExpiresAt = time.Now().Add(ttl)
+	r.created = append(r.created, &created)
+	return &created, nil
+}
+
+func (r *stubHolds) Availability(ctx context.Context, place *domain.ParkingPlace, dateFrom, dateTo time.Time,
+	step time.Duration) ([]*domain.AvailabilitySlot, error) {
+	return nil, nil
+}
+
+func TestCreateHold(t *testing.T) {
+	from := start()
+	tests := []struct {
+		name    string
+		user    *domain.User
+		placeID int64
+		to      time.Time
+		err     error
+		want    int
+	}{
+		{name: "driver holds a spot", user: driver, placeID: singleSpot, to: from.Add(time.Hour), want: http.StatusOK},
+		{name: "owner", user: owner, placeID: singleSpot, to: from.Add(time.Hour), want: http.StatusForbidden},
+		{name: "date_to before date_from", user: driver, placeID: singleSpot, to: from.Add(-time.Hour),
+			want: http.StatusBadRequest},
+		{name: "suspended parking place", user: driver, placeID: suspended, to: from.Add(time.Hour),
+			want: http.StatusConflict},
+		{name: "unknown parking place", user: driver, placeID: unknownPlace, to: from.Add(time.Hour),
+			want: http.StatusNotFound},
+		{name: "too many holds", user: driver, placeID: singleSpot, to: from.Add(time.Hour),
+			err: utils.ErrTooManyHolds, want: http.StatusBadRequest},
+		{name: "fully booked", user: driver, placeID: singleSpot, to: from.Add(time.Hour),
+			err: utils.ErrNoFreeSpots, want: http.StatusConflict},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			holds := &stubHolds{err: tt.err}
+			svc := NewAvailabilityService(holds, newFixture(t).parking, 5*time.Minute)
+			hold, appErr := svc.CreateHold(context.Background(), &domain.BookingHold{
+				ParkingPlaceID: tt.placeID,
+				DateFrom:       from,
+				DateTo:         tt.to,
+			}, tt.user)
+			if got := code(appErr); got != tt.want {
+				t.Fatalf("code = %d, want %d (%v)", got, tt.want, appErr)
+			}
+			if appErr == nil && hold.UserID != tt.user.ID {
+				t.Errorf("hold of %q, want %q", hold.UserID, tt.user.ID)
+			}
+		})
+	}
+}
+
+func TestGetAvailabilityValidatesWindow(t *testing.T) {
+	svc := NewAvailabilityService(&stubHolds{}, newFixture(t).parking, 5*time.Minute)
+	from := start()
+	tests := []struct {
+		name string
+		to   time.Time
+		want int
+	}{
+		{"one day by the hour", from.Add(24 * time.Hour), http.StatusOK},
+		{"to before from", from.Add(-time.Hour), http.StatusBadRequest},
+		{"too many slots", from.Add(time.Duration(utils.MaxAvailabilitySlots+1) * time.Hour), http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			availability, appErr := svc.GetAvailability(context.Background(), singleSpot, from, tt.to, time.Hour, driver)
+			if got := code(appErr); got != tt.want {
+				t.Fatalf("code = %d, want %d (%v)", got, tt.want, appErr)
+			}
+			if appErr == nil && availability.Capacity != 1 {
+				t.Errorf("capacity = %d, want 1", availability.Capacity)
+			}
+		})
+	}
+}
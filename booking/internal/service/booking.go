@@ -0,0 +1,622 @@
+package service
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/h4x4d/parking_net/booking/internal/audit"
+	"github.com/h4x4d/parking_net/booking/internal/repository"
+	"github.com/h4x4d/parking_net/booking/internal/utils"
+	"github.com/h4x4d/parking_net/pkg/domain"
+	"github.com/h4x4d/parking_net/pkg/errors"
+)
+
+// ParkingClient looks up parking places. GetParkingPlace fails with
+// domain.ErrParkingNotFound for places that do not exist.
+type ParkingClient interface {
+	GetParkingPlace(ctx context.Context, id int64) (*domain.ParkingPlace, error)
+	GetOwnerParkingIDs(ctx context.Context, ownerID string) ([]int64, error)
+}
+
+// PaymentProcessor delivers the payment commands the repository stored for a
+// booking. Commands that cannot be delivered yet are left for a later retry.
+type PaymentProcessor interface {
+	ProcessBooking(ctx context.Context, bookingID int64)
+}
+
+type BookingService struct {
+	repo     repository.BookingRepository
+	vehicles repository.VehicleRepository
+	parking  ParkingClient
+	payments PaymentProcessor
+}
+
+func NewBookingService(repo repository.BookingRepository, vehicles repository.VehicleRepository,
+	parking ParkingClient, payments PaymentProcessor) *BookingService {
+	return &BookingService{repo: repo, vehicles: vehicles, parking: parking, payments: payments}
+}
+
+type BookingFilters = repository.BookingFilters
+
+type CreateOptions = repository.CreateOptions
+
+// BookingChanges are the fields of a booking a user asked to change; nil
+// fields are left as they are.
+type BookingChanges struct {
+	DateFrom       *time.Time
+	DateTo         *time.Time
+	ParkingPlaceID *int64
+	Status         *domain.BookingStatus
+	UserID         *string
+}
+
+// BookingExtension is how far a driver asked to extend a booking: to DateTo or
+// by Duration. Exactly one of them is set.
+type BookingExtension struct {
+	DateTo   *time.Time
+	Duration *time.Duration
+}
+
+// CreateBooking books a spot for the driver at the hourly rate of the parking
+// place and charges for it right away. The booking is made for one of the
+// driver's vehicles, which the place must take. A booking the driver cannot
+// pay for ends up Canceled and is reported as a bad request.
+func (s *BookingService) CreateBooking(ctx context.Context, booking *domain.Booking, opts CreateOptions,
+	user *domain.User) (*domain.Booking, *errors.AppError) {
+	if user == nil || !user.IsDriver() {
+		return nil, errors.New(http.StatusForbidden, "You don't have permission to create a booking")
+	}
+	ctx = audit.WithActor(ctx, domain.UserActor(user))
+
+	booking.UserID = user.ID
+	if err := booking.IsValid(); err != nil {
+		return nil, errors.Validation(err.Error())
+	}
+	if err := utils.ValidateDateRange(&booking.DateFrom, &booking.DateTo); err != nil {
+		return nil, errors.BadRequest(err.Error())
+	}
+
+	place, appErr := getBookablePlace(ctx, s.parking, booking.ParkingPlaceID)
+	if appErr != nil {
+		return nil, appErr
+	}
+	vehicle, err := s.vehicles.Resolve(ctx, booking.UserID, booking.VehicleID)
+	if stderrors.Is(err, domain.ErrVehicleNotFound) || stderrors.Is(err, domain.ErrVehicleRequired) {
+		return nil, errors.BadRequest(err.Error())
+	}
+	if err != nil {
+		return nil, errors.Internal(err)
+	}
+	if appErr := checkVehicle(place, vehicle); appErr != nil {
+		return nil, appErr
+	}
+	booking.VehicleID = vehicle.ID
+	if _, appErr := priceBooking(booking, place); appErr != nil {
+		return nil, appErr
+	}
+
+	created, err := s.repo.Create(ctx, booking, place, opts)
+	switch {
+	case stderrors.Is(err, utils.ErrIdempotencyKeyReused):
+		return nil, errors.New(http.StatusUnprocessableEntity, "Idempotency-Key was already used with a different request")
+	case stderrors.Is(err, utils.ErrInvalidHold):
+		return nil, errors.BadRequest(err.Error())
+	case stderrors.Is(err, utils.ErrNoFreeSpots):
+		return nil, errors.New(http.StatusConflict, "No free parking spots for the requested period")
+	case stderrors.Is(err, domain.ErrParkingUnavailable):
+		return nil, errors.New(http.StatusConflict, "Parking place does not take bookings")
+	case err != nil:
+		return nil, errors.Internal(err)
+	}
+
+	// The charge was stored with the booking; deliver it now so the result
+	// reflects the payment outcome. If the payment service is unreachable the
+	// booking stays Waiting and the charge is retried later.
+	s.payments.ProcessBooking(ctx, created.ID)
+
+	settled, err := s.repo.GetByID(ctx, created.ID)
+	if err != nil {
+		return nil, errors.Internal(err)
+	}
+	if settled == nil {
+		return created, nil
+	}
+	if settled.Status == domain.BookingStatusCanceled {
+		return nil, errors.BadRequest("payment processing failed: insufficient funds")
+	}
+	return settled, nil
+}
+
+// QuoteBooking prices a booking of the parking place for the time range the
+// way CreateBooking would, without creating or holding anything. The vehicle
+// is only checked against the place when the booking names one.
+func (s *BookingService) QuoteBooking(ctx context.Context, booking *domain.Booking,
+	user *domain.User) (*domain.Price, *errors.AppError) {
+	if user == nil {
+		return nil, errors.New(http.StatusForbidden, "You don't have permission to get a quote")
+	}
+
+	booking.UserID = user.ID
+	if err := booking.IsValid(); err != nil {
+		return nil, errors.Validation(err.Error())
+	}
+	if err := utils.ValidateDateRange(&booking.DateFrom, &booking.DateTo); err != nil {
+		return nil, errors.BadRequest(err.Error())
+	}
+
+	place, appErr := getBookablePlace(ctx, s.parking, booking.ParkingPlaceID)
+	if appErr != nil {
+		return nil, appErr
+	}
+	if booking.VehicleID != 0 {
+		vehicle, err := s.vehicles.Resolve(ctx, user.ID, booking.VehicleID)
+		if stderrors.Is(err, domain.ErrVehicleNotFound) {
+			return nil, errors.BadRequest(err.Error())
+		}
+		if err != nil {
+			return nil, errors.Internal(err)
+		}
+		if appErr := checkVehicle(place, vehicle); appErr != nil {
+			return nil, appErr
+		}
+	}
+
+	price, appErr := priceBooking(booking, place)
+	if appErr != nil {
+		return nil, appErr
+	}
+	return &price, nil
+}
+
+func (s *BookingService) GetBooking(ctx context.Context, id int64, user *domain.User) (*domain.Booking, *errors.AppError) {
+	booking, appErr := s.getBooking(ctx, id)
+	if appErr != nil {
+		return nil, appErr
+	}
+	if appErr := authorize(ctx, s.parking, booking.UserID, booking.ParkingPlaceID, user,
+		"You don't have permission to get this booking"); appErr != nil {
+		return nil, appErr
+	}
+	return booking, nil
+}
+
+// ListBookings returns a page of the bookings the user may see: admins see
+// every booking, drivers their own and owners those at their parking places.
+func (s *BookingService) ListBookings(ctx context.Context, filters BookingFilters,
+	user *domain.User) ([]*domain.Booking, string, *errors.AppError) {
+	for _, status := range filters.Statuses {
+		if !status.IsValid() {
+			return nil, "", errors.BadRequest(fmt.Sprintf("%s: %s", utils.ErrInvalidStatus, status))
+		}
+	}
+	if filters.DateFrom != nil && filters.DateTo != nil && !filters.DateFrom.Before(*filters.DateTo) {
+		return nil, "", errors.BadRequest(fmt.Sprintf("%s: date_from must be before date_to", utils.ErrInvalidDateRange))
+	}
+
+	forbidden := errors.New(http.StatusForbidden, "You don't have permission to get this bookings")
+	switch {
+	case user == nil:
+		return nil, "", forbidden
+	case user.IsAdmin():
+	case user.IsDriver():
+		if filters.UserID != nil && *filters.UserID != user.ID {
+			return nil, "", errors.New(http.StatusForbidden, "You can only view your own bookings")
+		}
+		filters.UserID = &user.ID
+	case user.IsOwner():
+		owned, err := s.parking.GetOwnerParkingIDs(ctx, user.ID)
+		if err != nil {
+			return nil, "", errors.Internal(err)
+		}
+		if filters.ParkingPlaceIDs == nil {
+			filters.ParkingPlaceIDs = owned
+			break
+		}
+		isOwned := make(map[int64]bool, len(owned))
+		for _, id := range owned {
+			isOwned[id] = true
+		}
+		for _, id := range filters.ParkingPlaceIDs {
+			if isOwned[id] {
+				continue
+			}
+			if _, appErr := getParkingPlace(ctx, s.parking, id); appErr != nil {
+				return nil, "", appErr
+			}
+			return nil, "", forbidden
+		}
+	default:
+		return nil, "", forbidden
+	}
+
+	bookings, next, err := s.repo.GetAll(ctx, filters)
+	if stderrors.Is(err, utils.ErrInvalidCursor) || stderrors.Is(err, utils.ErrInvalidStatus) ||
+		stderrors.Is(err, utils.ErrInvalidDateRange) || stderrors.Is(err, utils.ErrInvalidSort) {
+		return nil, "", errors.BadRequest(err.Error())
+	}
+	if err != nil {
+		return nil, "", errors.Internal(err)
+	}
+	return bookings, next, nil
+}
+
+// UpdateBooking applies the changes of a driver, owner or admin to a booking.
+// Users may only cancel a booking themselves; every other status is reached
+// through payment or the scheduler. A booking moved to other dates or another
+// place is re-priced from the hourly rate, and the change is rolled back if
+// the driver cannot pay the difference.
+func (s *BookingService) UpdateBooking(ctx context.Context, id int64, changes BookingChanges,
+	user *domain.User) (*domain.Booking, *errors.AppError) {
+	if changes.Status != nil && !changes.Status.SettableByUser() {
+		return nil, errors.BadRequest(fmt.Sprintf("status %s is set automatically by payment service or scheduler",
+			*changes.Status))
+	}
+
+	current, appErr := s.getBooking(ctx, id)
+	if appErr != nil {
+		return nil, appErr
+	}
+	if appErr := authorize(ctx, s.parking, current.UserID, current.ParkingPlaceID, user,
+		"You don't have permission to update this booking"); appErr != nil {
+		return nil, appErr
+	}
+	ctx = audit.WithActor(ctx, domain.UserActor(user))
+
+	next := *current
+	if changes.DateFrom != nil {
+		next.DateFrom = *changes.DateFrom
+	}
+	if changes.DateTo != nil {
+		next.DateTo = *changes.DateTo
+	}
+	if changes.ParkingPlaceID != nil {
+		if err := utils.ValidateParkingPlaceID(changes.ParkingPlaceID); err != nil {
+			return nil, errors.BadRequest(err.Error())
+		}
+		next.ParkingPlaceID = *changes.ParkingPlaceID
+	}
+	if changes.UserID != nil && *changes.UserID != "" {
+		next.UserID = *changes.UserID
+	}
+	if changes.Status != nil {
+		if err := domain.ValidateTransition(current.Status, *changes.Status); err != nil {
+			return nil, errors.BadRequest(err.Error())
+		}
+		next.Status = *changes.Status
+	}
+
+	update := repository.BookingUpdate{Booking: &next}
+	if current.Reschedules(&next) {
+		if err := utils.ValidateDateRange(&next.DateFrom, &next.DateTo); err != nil {
+			return nil, errors.BadRequest(err.Error())
+		}
+		place, appErr := getBookablePlace(ctx, s.parking, next.ParkingPlaceID)
+		if appErr != nil {
+			return nil, appErr
+		}
+		if _, appErr := priceBooking(&next, place); appErr != nil {
+			return nil, appErr
+		}
+		update.Place = place
+		update.PreviousOwnerID = place.OwnerID
+		if next.ParkingPlaceID != current.ParkingPlaceID && next.VehicleID != 0 {
+			vehicle, err := s.vehicles.Get(ctx, next.VehicleID)
+			if err != nil {
+				return nil, errors.Internal(err)
+			}
+			if appErr := checkVehicle(place, vehicle); appErr != nil {
+				return nil, appErr
+			}
+		}
+		if next.ParkingPlaceID != current.ParkingPlaceID && next.Status.OccupiesSpot() {
+			previous, err := s.parking.GetParkingPlace(ctx, current.ParkingPlaceID)
+			if err != nil {
+				return nil, errors.Internal(err)
+			}
+			update.PreviousOwnerID = previous.OwnerID
+		}
+	}
+	// Canceling a paid booking refunds what the cancellation policy allows,
+	// based on the booking as it was paid.
+	if current.Status == domain.BookingStatusConfirmed && next.Status == domain.BookingStatusCanceled {
+		cancellation, appErr := s.decideCancellation(ctx, current)
+		if appErr != nil {
+			return nil, appErr
+		}
+		update.Cancellation = cancellation
+	}
+
+	updated, err := s.repo.Update(ctx, current, update)
+	if appErr := writeError(err); appErr != nil {
+		return nil, appErr
+	}
+
+	// Canceling a paid booking enqueues its refund and rescheduling it the price
+	// adjustment; try to deliver them right away.
+	s.payments.ProcessBooking(ctx, id)
+
+	settled, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.Internal(err)
+	}
+	if settled != nil && (settled.Reschedules(updated) || settled.FullCost != updated.FullCost) {
+		return nil, errors.BadRequest("payment for the booking change failed")
+	}
+	return updated, nil
+}
+
+// ExtendBooking moves the end of a Confirmed or Active booking of the driver
+// later, to the end or by the duration the extension gives. The whole stay is
+// repriced at the current hourly rate of the place and the difference to what
+// was paid is charged; the extension is rolled back if the driver cannot pay
+// for it.
+func (s *BookingService) ExtendBooking(ctx context.Context, id int64, extension BookingExtension,
+	user *domain.User) (*domain.Booking, *errors.AppError) {
+	if (extension.DateTo == nil) == (extension.Duration == nil) {
+		return nil, errors.BadRequest(domain.ErrInvalidExtension.Error())
+	}
+
+	current, appErr := s.getBooking(ctx, id)
+	if appErr != nil {
+		return nil, appErr
+	}
+	forbidden := errors.New(http.StatusForbidden, "You don't have permission to extend this booking")
+	if user == nil || (!user.IsAdmin() && (!user.IsDriver() || current.UserID != user.ID)) {
+		return nil, forbidden
+	}
+	ctx = audit.WithActor(ctx, domain.UserActor(user))
+
+	if !current.Status.Extendable() {
+		return nil, errors.New(http.StatusConflict, domain.ErrBookingNotExtendable.Error())
+	}
+	end := current.DateTo
+	if extension.Duration != nil {
+		end = end.Add(*extension.Duration)
+	} else {
+		end = *extension.DateTo
+	}
+	if !end.After(current.DateTo) {
+		return nil, errors.BadRequest(fmt.Sprintf("%s: the new date_to must be after the current one",
+			utils.ErrInvalidDateRange))
+	}
+	if !end.After(time.Now()) {
+		return nil, errors.BadRequest(fmt.Sprintf("%s: date_to", utils.ErrDateInPast))
+	}
+	if err := utils.ValidateDateRange(&current.DateFrom, &end); err != nil {
+		return nil, errors.BadRequest(err.Error())
+	}
+
+	place, appErr := getBookablePlace(ctx, s.parking, current.ParkingPlaceID)
+	if appErr != nil {
+		return nil, appErr
+	}
+	next := *current
+	next.Extend(int64(place.HourlyRate), end)
+	if err := utils.ValidateFullCost(next.FullCost); err != nil {
+		return nil, errors.BadRequest("calculated cost exceeds maximum")
+	}
+
+	extended, err := s.repo.Extend(ctx, current, &next, place)
+	if appErr := writeError(err); appErr != nil {
+		return nil, appErr
+	}
+
+	// The charge of the added time was stored with the extension; deliver it
+	// now so a declined payment is reported instead of the extension.
+	s.payments.ProcessBooking(ctx, id)
+
+	settled, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.Internal(err)
+	}
+	if settled != nil && (!settled.DateTo.Equal(extended.DateTo) || settled.FullCost != extended.FullCost) {
+		return nil, errors.BadRequest("payment for the booking extension failed")
+	}
+	return extended, nil
+}
+
+// DeleteBooking removes a booking. A paid booking is refunded as its parking
+// place's cancellation policy allows.
+func (s *BookingService) DeleteBooking(ctx context.Context, id int64, user *domain.User) *errors.AppError {
+	current, appErr := s.getBooking(ctx, id)
+	if appErr != nil {
+		return appErr
+	}
+	if appErr := authorize(ctx, s.parking, current.UserID, current.ParkingPlaceID, user,
+		"You don't have permission to delete this booking"); appErr != nil {
+		return appErr
+	}
+	ctx = audit.WithActor(ctx, domain.UserActor(user))
+
+	var cancellation *repository.Cancellation
+	if current.Status == domain.BookingStatusConfirmed || current.Status == domain.BookingStatusActive {
+		cancellation, appErr = s.decideCancellation(ctx, current)
+		if appErr != nil {
+			return appErr
+		}
+	}
+	if appErr := writeError(s.repo.Delete(ctx, current, cancellation)); appErr != nil {
+		return appErr
+	}
+
+	// Deleting a paid booking enqueues its refund; try to deliver it right away.
+	s.payments.ProcessBooking(ctx, id)
+	return nil
+}
+
+// BookingHistory returns every change of the booking, oldest first. It stays
+// available after the booking is deleted, to the driver who booked it, the
+// owners of the parking places it was at and admins.
+func (s *BookingService) BookingHistory(ctx context.Context, id int64,
+	user *domain.User) ([]*domain.BookingEvent, *errors.AppError) {
+	events, err := s.repo.History(ctx, id)
+	if err != nil {
+		return nil, errors.Internal(err)
+	}
+	if len(events) == 0 {
+		// Bookings made before the history was kept have none; they are
+		// checked like any other read.
+		if _, appErr := s.GetBooking(ctx, id, user); appErr != nil {
+			return nil, appErr
+		}
+		return events, nil
+	}
+
+	forbidden := errors.New(http.StatusForbidden, "You don't have permission to get the history of this booking")
+	switch {
+	case user == nil:
+		return nil, forbidden
+	case user.IsAdmin():
+		return events, nil
+	case user.IsDriver():
+		if events[len(events)-1].UserID != user.ID {
+			return nil, forbidden
+		}
+		return events, nil
+	case user.IsOwner():
+		owned, err := s.parking.GetOwnerParkingIDs(ctx, user.ID)
+		if err != nil {
+			return nil, errors.Internal(err)
+		}
+		for _, event := range events {
+			if contains(owned, event.ParkingPlaceID) {
+				return events, nil
+			}
+		}
+		return nil, forbidden
+	default:
+		return nil, forbidden
+	}
+}
+
+func (s *BookingService) getBooking(ctx context.Context, id int64) (*domain.Booking, *errors.AppError) {
+	booking, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.Internal(err)
+	}
+	if booking == nil {
+		return nil, errors.New(http.StatusNotFound, fmt.Sprintf("Booking with id %d not found", id))
+	}
+	return booking, nil
+}
+
+func getParkingPlace(ctx context.Context, parking ParkingClient, id int64) (*domain.ParkingPlace, *errors.AppError) {
+	place, err := parking.GetParkingPlace(ctx, id)
+	if stderrors.Is(err, domain.ErrParkingNotFound) {
+		return nil, errors.New(http.StatusNotFound, fmt.Sprintf("Parking place with id %d not found", id))
+	}
+	if err != nil {
+		return nil, errors.Internal(err)
+	}
+	return place, nil
+}
+
+// getBookablePlace returns the parking place if it takes new bookings; deleted
+// and suspended places do not.
+func getBookablePlace(ctx context.Context, parking ParkingClient, id int64) (*domain.ParkingPlace, *errors.AppError) {
+	place, appErr := getParkingPlace(ctx, parking, id)
+	if appErr != nil {
+		return nil, appErr
+	}
+	if !place.Status.AcceptsBookings() {
+		return nil, errors.New(http.StatusConflict, fmt.Sprintf("Parking place %d does not take bookings", id))
+	}
+	return place, nil
+}
+
+// priceBooking sets the cost of the booking at the hourly rate of the place
+// and returns its itemized price.
+func priceBooking(booking *domain.Booking, place *domain.ParkingPlace) (domain.Price, *errors.AppError) {
+	price := booking.CalculateCost(int64(place.HourlyRate))
+	if err := utils.ValidateFullCost(booking.FullCost); err != nil {
+		return price, errors.BadRequest("calculated cost exceeds maximum")
+	}
+	return price, nil
+}
+
+// checkVehicle answers with a bad request unless the parking place takes the
+// size of the vehicle. A vehicle that was removed since is not checked.
+func checkVehicle(place *domain.ParkingPlace, vehicle *domain.Vehicle) *errors.AppError {
+	if vehicle == nil || place.AcceptsVehicle(vehicle.Size) {
+		return nil
+	}
+	return errors.BadRequest(fmt.Sprintf("%s: %s", domain.ErrVehicleNotAllowed, vehicle.Size))
+}
+
+// authorize lets admins, the driver a booking or series is for and the owner
+// of its parking place through and answers everyone else with message.
+func authorize(ctx context.Context, parking ParkingClient, driverID string, parkingPlaceID int64,
+	user *domain.User, message string) *errors.AppError {
+	forbidden := errors.New(http.StatusForbidden, message)
+	switch {
+	case user == nil:
+		return forbidden
+	case user.IsAdmin():
+		return nil
+	case user.IsDriver():
+		if driverID != user.ID {
+			return forbidden
+		}
+		return nil
+	case user.IsOwner():
+		place, err := parking.GetParkingPlace(ctx, parkingPlaceID)
+		if stderrors.Is(err, domain.ErrParkingNotFound) {
+			return forbidden
+		}
+		if err != nil {
+			return errors.Internal(err)
+		}
+		if place.OwnerID != user.ID {
+			return forbidden
+		}
+		return nil
+	default:
+		return forbidden
+	}
+}
+
+// decideCancellation applies the current cancellation policy of the booking's
+// parking place to a cancellation made now.
+func (s *BookingService) decideCancellation(ctx context.Context,
+	booking *domain.Booking) (*repository.Cancellation, *errors.AppError) {
+	place, err := s.parking.GetParkingPlace(ctx, booking.ParkingPlaceID)
+	if err != nil {
+		return nil, errors.Internal(fmt.Errorf("failed to get cancellation policy: %w", err))
+	}
+	cancellation := cancellationUnder(place, booking, time.Now().UTC())
+	return &cancellation, nil
+}
+
+// cancellationUnder applies the cancellation policy of place, or the default
+// one when it has none, to a cancellation of booking made at now.
+func cancellationUnder(place *domain.ParkingPlace, booking *domain.Booking, now time.Time) repository.Cancellation {
+	policy := domain.DefaultCancellationPolicy
+	if place.CancellationPolicy != nil {
+		policy = *place.CancellationPolicy
+	}
+	return repository.Cancellation{
+		PolicyVersion: policy.Version,
+		FeePercent:    policy.FeePercent(booking.Status, booking.DateFrom, now),
+	}
+}
+
+// writeError maps the errors of repository writes.
+func writeError(err error) *errors.AppError {
+	switch {
+	case err == nil:
+		return nil
+	case stderrors.Is(err, utils.ErrNoFreeSpots):
+		return errors.New(http.StatusConflict, "No free parking spots for the requested period")
+	case stderrors.Is(err, domain.ErrParkingUnavailable):
+		return errors.New(http.StatusConflict, "Parking place does not take bookings")
+	case stderrors.Is(err, utils.ErrBookingChanged):
+		return errors.New(http.StatusConflict, "Booking was changed concurrently, retry the request")
+	case stderrors.Is(err, domain.ErrBookingNotFound):
+		return errors.NotFound("booking")
+	default:
+		return errors.Internal(err)
+	}
+}
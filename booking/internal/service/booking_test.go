@@ -0,0 +1,516 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/h4x4d/parking_net/booking/internal/fake"
+	"github.com/h4x4d/parking_net/booking/internal/repository"
+	"github.com/h4x4d/parking_net/pkg/domain"
+	"github.com/h4x4d/parking_net/pkg/errors"
+)
+
+const (
+	hourlyRate     = 100
+	initialBalance = 10_000
+)
+
+var (
+	driver      = &domain.User{ID: "driver-1", Role: domain.UserRoleDriver}
+	otherDriver = &domain.User{ID: "driver-2", Role: domain.UserRoleDriver}
+	poorDriver  = &domain.User{ID: "driver-3", Role: domain.UserRoleDriver}
+	owner       = &domain.User{ID: "owner-1", Role: domain.UserRoleOwner}
+	otherOwner  = &domain.User{ID: "owner-2", Role: domain.UserRoleOwner}
+	admin       = &domain.User{ID: "admin-1", Role: domain.UserRoleAdmin}
+)
+
+// Parking places of the fixture.
+const (
+	singleSpot = iota + 1
+	manySpots
+	suspended
+	motorcyclesOnly
+	strictPolicy
+	unknownPlace = 404
+)
+
+type fixture struct {
+	service  *BookingService
+	repo     *repository.MemoryBookingRepository
+	vehicles *repository.MemoryVehicleRepository
+	parking  *fake.ParkingClient
+	payments *fake.Payments
+}
+
+// newFixture returns a service whose drivers each have one small car and enough
+// money for their bookings, except poorDriver who has none.
+func newFixture(t *testing.T) *fixture {
+	t.Helper()
+	repo := repository.NewMemoryBookingRepository()
+	vehicles := repository.NewMemoryVehicleRepository(repo)
+	parking := fake.NewParkingClient(
+		&domain.ParkingPlace{ID: singleSpot, OwnerID: owner.ID, Capacity: 1, HourlyRate: hourlyRate,
+			Status: domain.ParkingStatusActive},
+		&domain.ParkingPlace{ID: manySpots, OwnerID: otherOwner.ID, Capacity: 5, HourlyRate: hourlyRate,
+			Status: domain.ParkingStatusActive},
+		&domain.ParkingPlace{ID: suspended, OwnerID: owner.ID, Capacity: 5, HourlyRate: hourlyRate,
+			Status: domain.ParkingStatusSuspended},
+		&domain.ParkingPlace{ID: motorcyclesOnly, OwnerID: owner.ID, Capacity: 5, HourlyRate: hourlyRate,
+			Status: domain.ParkingStatusActive, AllowedVehicleSizes: []domain.VehicleSize{domain.VehicleSizeMotorcycle}},
+		&domain.ParkingPlace{ID: strictPolicy, OwnerID: owner.ID, Capacity: 5, HourlyRate: hourlyRate,
+			Status: domain.ParkingStatusActive, CancellationPolicy: &domain.CancellationPolicy{
+				Version: 1, FreeCancellationHours: 48, LateCancellationFeePercent: 50}},
+	)
+	payments := fake.NewPayments(repo)
+	for _, user := range []*domain.User{driver, otherDriver, poorDriver} {
+		_, err := vehicles.Create(context.Background(), &domain.Vehicle{UserID: user.ID, Plate: "A" + user.ID,
+			Country: "RU", Size: domain.VehicleSizeSmall})
+		if err != nil {
+			t.Fatalf("create vehicle: %v", err)
+		}
+	}
+	payments.SetBalance(driver.ID, initialBalance)
+	payments.SetBalance(otherDriver.ID, initialBalance)
+	return &fixture{
+		service:  NewBookingService(repo, vehicles, parking, payments),
+		repo:     repo,
+		vehicles: vehicles,
+		parking:  parking,
+		payments: payments,
+	}
+}
+
+// start is the beginning of the day after tomorrow, far enough ahead for free
+// cancellation under every policy of the fixture but strictPolicy's.
+func start() time.Time {
+	return time.Now().UTC().Truncate(time.Hour).Add(72 * time.Hour)
+}
+
+// book creates a paid booking of hours hours at the place for the driver.
+func (f *fixture) book(t *testing.T, user *domain.User, placeID int64, from time.Time, hours int) *domain.Booking {
+	t.Helper()
+	booking, appErr := f.service.CreateBooking(context.Background(), &domain.Booking{
+		ParkingPlaceID: placeID,
+		DateFrom:       from,
+		DateTo:         from.Add(time.Duration(hours) * time.Hour),
+	}, CreateOptions{}, user)
+	if appErr != nil {
+		t.Fatalf("create booking: %v", appErr)
+	}
+	return booking
+}
+
+// takeSpots books spots of the place for the two hours from from.
+func takeSpots(t *testing.T, f *fixture, placeID int64, from time.Time, spots int) {
+	t.Helper()
+	for range spots {
+		f.book(t, otherDriver, placeID, from, 2)
+	}
+}
+
+func code(appErr *errors.AppError) int {
+	if appErr == nil {
+		return http.StatusOK
+	}
+	return appErr.Code
+}
+
+func TestCreateBooking(t *testing.T) {
+	from := start()
+	tests := []struct {
+		name    string
+		user    *domain.User
+		placeID int64
+		from    time.Time
+		to      time.Time
+		setup   func(t *testing.T, f *fixture)
+		want    int
+	}{
+		{name: "driver books a free spot", user: driver, placeID: singleSpot, from: from, to: from.Add(2 * time.Hour),
+			want: http.StatusOK},
+		{name: "anonymous", user: nil, placeID: singleSpot, from: from, to: from.Add(time.Hour),
+			want: http.StatusForbidden},
+		{name: "owner", user: owner, placeID: singleSpot, from: from, to: from.Add(time.Hour),
+			want: http.StatusForbidden},
+		{name: "admin", user: admin, placeID: singleSpot, from: from, to: from.Add(time.Hour),
+			want: http.StatusForbidden},
+		{name: "date_to before date_from", user: driver, placeID: singleSpot, from: from, to: from.Add(-time.Hour),
+			want: http.StatusBadRequest},
+		{name: "unknown parking place", user: driver, placeID: unknownPlace, from: from, to: from.Add(time.Hour),
+			want: http.StatusNotFound},
+		{name: "suspended parking place", user: driver, placeID: suspended, from: from, to: from.Add(time.Hour),
+			want: http.StatusConflict},
+		{name: "vehicle too big", user: driver, placeID: motorcyclesOnly, from: from, to: from.Add(time.Hour),
+			want: http.StatusBadRequest},
+		{name: "driver cannot pay", user: poorDriver, placeID: singleSpot, from: from, to: from.Add(time.Hour),
+			want: http.StatusBadRequest},
+		{name: "spot taken", user: driver, placeID: singleSpot, from: from, to: from.Add(2 * time.Hour),
+			setup: func(t *testing.T, f *fixture) { f.book(t, otherDriver, singleSpot, from.Add(time.Hour), 2) },
+			want:  http.StatusConflict},
+		{name: "every spot taken", user: driver, placeID: manySpots, from: from, to: from.Add(2 * time.Hour),
+			setup: func(t *testing.T, f *fixture) { takeSpots(t, f, manySpots, from, 5) },
+			want:  http.StatusConflict},
+		{name: "last spot left", user: driver, placeID: manySpots, from: from, to: from.Add(2 * time.Hour),
+			setup: func(t *testing.T, f *fixture) { takeSpots(t, f, manySpots, from, 4) },
+			want:  http.StatusOK},
+		{name: "spots taken one after another", user: driver, placeID: manySpots, from: from,
+			to: from.Add(4 * time.Hour),
+			setup: func(t *testing.T, f *fixture) {
+				takeSpots(t, f, manySpots, from, 4)
+				takeSpots(t, f, manySpots, from.Add(2*time.Hour), 4)
+			},
+			want: http.StatusOK},
+		{name: "spot freed right before", user: driver, placeID: singleSpot, from: from, to: from.Add(2 * time.Hour),
+			setup: func(t *testing.T, f *fixture) { f.book(t, otherDriver, singleSpot, from.Add(-2*time.Hour), 2) },
+			want:  http.StatusOK},
+		{name: "spot freed by a canceled booking", user: driver, placeID: singleSpot, from: from,
+			to: from.Add(2 * time.Hour),
+			setup: func(t *testing.T, f *fixture) {
+				taken := f.book(t, otherDriver, singleSpot, from, 2)
+				if appErr := f.service.DeleteBooking(context.Background(), taken.ID, otherDriver); appErr != nil {
+					t.Fatalf("delete booking: %v", appErr)
+				}
+			},
+			want: http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture(t)
+			if tt.setup != nil {
+				tt.setup(t, f)
+			}
+			booking, appErr := f.service.CreateBooking(context.Background(), &domain.Booking{
+				ParkingPlaceID: tt.placeID,
+				DateFrom:       tt.from,
+				DateTo:         tt.to,
+			}, CreateOptions{}, tt.user)
+			if got := code(appErr); got != tt.want {
+				t.Fatalf("CreateBooking() code = %d, want %d (%v)", got, tt.want, appErr)
+			}
+			if appErr != nil {
+				return
+			}
+			cost := int64(tt.to.Sub(tt.from).Hours()) * hourlyRate
+			if booking.Status != domain.BookingStatusConfirmed || booking.FullCost != cost {
+				t.Errorf("booking status = %s, cost = %d, want Confirmed and %d", booking.Status, booking.FullCost, cost)
+			}
+			if booking.UserID != tt.user.ID || booking.VehicleID == 0 {
+				t.Errorf("booking user = %q, vehicle = %d, want %q and the driver's vehicle",
+					booking.UserID, booking.VehicleID, tt.user.ID)
+			}
+		})
+	}
+}
+
+func TestCreateBookingChargesDriver(t *testing.T) {
+	f := newFixture(t)
+	f.book(t, driver, singleSpot, start(), 3)
+
+	if got := f.payments.Balance(driver.ID); got != initialBalance-3*hourlyRate {
+		t.Errorf("driver balance = %d, want %d", got, initialBalance-3*hourlyRate)
+	}
+	if got := f.payments.Balance(owner.ID); got != 3*hourlyRate {
+		t.Errorf("owner balance = %d, want %d", got, 3*hourlyRate)
+	}
+}
+
+func TestGetBookingPermissions(t *testing.T) {
+	tests := []struct {
+		name string
+		user *domain.User
+		want int
+	}{
+		{"driver of the booking", driver, http.StatusOK},
+		{"owner of the parking place", owner, http.StatusOK},
+		{"admin", admin, http.StatusOK},
+		{"another driver", otherDriver, http.StatusForbidden},
+		{"another owner", otherOwner, http.StatusForbidden},
+		{"anonymous", nil, http.StatusForbidden},
+	}
+	f := newFixture(t)
+	booking := f.book(t, driver, singleSpot, start(), 2)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, appErr := f.service.GetBooking(context.Background(), booking.ID, tt.user)
+			if got := code(appErr); got != tt.want {
+				t.Errorf("GetBooking() code = %d, want %d (%v)", got, tt.want, appErr)
+			}
+		})
+	}
+
+	if _, appErr := f.service.GetBooking(context.Background(), booking.ID+1, admin); code(appErr) != http.StatusNotFound {
+		t.Errorf("GetBooking() of a missing booking code = %d, want %d", code(appErr), http.StatusNotFound)
+	}
+}
+
+func TestUpdateBooking(t *testing.T) {
+	from := start()
+	canceled := domain.BookingStatusCanceled
+	completed := domain.BookingStatusCompleted
+	later := from.Add(4 * time.Hour)
+	longer := from.Add(5 * time.Hour)
+	otherPlace := int64(manySpots)
+	missingPlace := int64(unknownPlace)
+
+	tests := []struct {
+		name     string
+		user     *domain.User
+		changes  BookingChanges
+		setup    func(t *testing.T, f *fixture)
+		want     int
+		wantCost int64
+	}{
+		{name: "driver extends the stay", user: driver, changes: BookingChanges{DateTo: &longer},
+			want: http.StatusOK, wantCost: 5 * hourlyRate},
+		{name: "driver moves to another place", user: driver, changes: BookingChanges{ParkingPlaceID: &otherPlace},
+			want: http.StatusOK, wantCost: 2 * hourlyRate},
+		{name: "driver cancels", user: driver, changes: BookingChanges{Status: &canceled},
+			want: http.StatusOK, wantCost: 2 * hourlyRate},
+		{name: "owner of the parking place cancels", user: owner, changes: BookingChanges{Status: &canceled},
+			want: http.StatusOK, wantCost: 2 * hourlyRate},
+		{name: "admin reschedules", user: admin, changes: BookingChanges{DateFrom: &later, DateTo: &longer},
+			want: http.StatusOK, wantCost: hourlyRate},
+		{name: "another driver", user: otherDriver, changes: BookingChanges{Status: &canceled},
+			want: http.StatusForbidden},
+		{name: "another owner", user: otherOwner, changes: BookingChanges{Status: &canceled},
+			want: http.StatusForbidden},
+		{name: "anonymous", user: nil, changes: BookingChanges{Status: &canceled},
+			want: http.StatusForbidden},
+		{name: "status set by the scheduler", user: admin, changes: BookingChanges{Status: &completed},
+			want: http.StatusBadRequest},
+		{name: "date_to before date_from", user: driver, changes: BookingChanges{DateFrom: &longer, DateTo: &later},
+			want: http.StatusBadRequest},
+		{name: "unknown parking place", user: driver, changes: BookingChanges{ParkingPlaceID: &missingPlace},
+			want: http.StatusNotFound},
+		{name: "spot taken in the new period", user: driver, changes: BookingChanges{DateTo: &longer},
+			setup: func(t *testing.T, f *fixture) { f.book(t, otherDriver, singleSpot, from.Add(3*time.Hour), 1) },
+			want:  http.StatusConflict},
+		{name: "driver cannot pay the difference", user: driver, changes: BookingChanges{DateTo: &longer},
+			setup: func(t *testing.T, f *fixture) { f.payments.SetBalance(driver.ID, 0) },
+			want:  http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture(t)
+			booking := f.book(t, driver, singleSpot, from, 2)
+			if tt.setup != nil {
+				tt.setup(t, f)
+			}
+			updated, appErr := f.service.UpdateBooking(context.Background(), booking.ID, tt.changes, tt.user)
+			if got := code(appErr); got != tt.want {
+				t.Fatalf("UpdateBooking() code = %d, want %d (%v)", got, tt.want, appErr)
+			}
+
+			stored, _ := f.repo.GetByID(context.Background(), booking.ID)
+			if appErr != nil {
+				if stored.Reschedules(booking) || stored.FullCost != booking.FullCost || stored.Status != booking.Status {
+					t.Errorf("rejected update changed the booking to %+v", stored)
+				}
+				return
+			}
+			if updated.FullCost != tt.wantCost {
+				t.Errorf("updated cost = %d, want %d", updated.FullCost, tt.wantCost)
+			}
+			if tt.changes.Status == nil && f.payments.Balance(driver.ID) != initialBalance-tt.wantCost {
+				t.Errorf("driver balance = %d, want %d", f.payments.Balance(driver.ID), initialBalance-tt.wantCost)
+			}
+		})
+	}
+}
+
+func TestCancelBookingTwiceRefundsOnce(t *testing.T) {
+	f := newFixture(t)
+	booking := f.book(t, driver, singleSpot, start(), 2)
+	canceled := domain.BookingStatusCanceled
+
+	for i := 0; i < 2; i++ {
+		_, appErr := f.service.UpdateBooking(context.Background(), booking.ID, BookingChanges{Status: &canceled}, driver)
+		if appErr != nil {
+			t.Fatalf("cancel booking: %v", appErr)
+		}
+	}
+	if got := f.payments.Balance(driver.ID); got != initialBalance {
+		t.Errorf("driver balance = %d, want %d", got, initialBalance)
+	}
+	if got := f.payments.Balance(owner.ID); got != 0 {
+		t.Errorf("owner balance = %d, want 0", got)
+	}
+}
+
+func TestExtendBooking(t *testing.T) {
+	from := start()
+	hour := time.Hour
+	earlier := from.Add(time.Hour)
+	past := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name      string
+		user      *domain.User
+		extension BookingExtension
+		setup     func(t *testing.T, f *fixture, booking *domain.Booking)
+		want      int
+	}{
+		{name: "driver extends by an hour", user: driver, extension: BookingExtension{Duration: &hour},
+			want: http.StatusOK},
+		{name: "admin extends", user: admin, extension: BookingExtension{Duration: &hour},
+			want: http.StatusOK},
+		{name: "owner of the parking place", user: owner, extension: BookingExtension{Duration: &hour},
+			want: http.StatusForbidden},
+		{name: "another driver", user: otherDriver, extension: BookingExtension{Duration: &hour},
+			want: http.StatusForbidden},
+		{name: "neither date_to nor duration", user: driver, extension: BookingExtension{},
+			want: http.StatusBadRequest},
+		{name: "both date_to and duration", user: driver, extension: BookingExtension{DateTo: &earlier, Duration: &hour},
+			want: http.StatusBadRequest},
+		{name: "date_to not after the current end", user: driver, extension: BookingExtension{DateTo: &earlier},
+			want: http.StatusBadRequest},
+		{name: "date_to in the past", user: admin, extension: BookingExtension{DateTo: &past},
+			want: http.StatusBadRequest},
+		{name: "spot taken right after", user: driver, extension: BookingExtension{Duration: &hour},
+			setup: func(t *testing.T, f *fixture, booking *domain.Booking) {
+				f.book(t, otherDriver, singleSpot, booking.DateTo, 1)
+			},
+			want: http.StatusConflict},
+		{name: "canceled booking", user: driver, extension: BookingExtension{Duration: &hour},
+			setup: func(t *testing.T, f *fixture, booking *domain.Booking) {
+				canceled := domain.BookingStatusCanceled
+				_, appErr := f.service.UpdateBooking(context.Background(), booking.ID,
+					BookingChanges{Status: &canceled}, driver)
+				if appErr != nil {
+					t.Fatalf("cancel booking: %v", appErr)
+				}
+			},
+			want: http.StatusConflict},
+		{name: "driver cannot pay the added hour", user: driver, extension: BookingExtension{Duration: &hour},
+			setup: func(t *testing.T, f *fixture, booking *domain.Booking) { f.payments.SetBalance(driver.ID, 0) },
+			want:  http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture(t)
+			booking := f.book(t, driver, singleSpot, from, 2)
+			if tt.setup != nil {
+				tt.setup(t, f, booking)
+			}
+			extended, appErr := f.service.ExtendBooking(context.Background(), booking.ID, tt.extension, tt.user)
+			if got := code(appErr); got != tt.want {
+				t.Fatalf("ExtendBooking() code = %d, want %d (%v)", got, tt.want, appErr)
+			}
+
+			stored, _ := f.repo.GetByID(context.Background(), booking.ID)
+			if appErr != nil {
+				if !stored.DateTo.Equal(booking.DateTo) || stored.FullCost != booking.FullCost {
+					t.Errorf("rejected extension changed the booking to end %s for %d", stored.DateTo, stored.FullCost)
+				}
+				return
+			}
+			if !extended.DateTo.Equal(booking.DateTo.Add(hour)) || extended.FullCost != 3*hourlyRate {
+				t.Errorf("extended booking ends %s for %d, want %s for %d", extended.DateTo, extended.FullCost,
+					booking.DateTo.Add(hour), 3*hourlyRate)
+			}
+			if got := f.payments.Balance(driver.ID); got != initialBalance-3*hourlyRate {
+				t.Errorf("driver balance = %d, want %d", got, initialBalance-3*hourlyRate)
+			}
+		})
+	}
+}
+
+// TestExtendBookingBySeconds extends a booking twice by less than the time a
+// unit buys: neither step is worth a unit on its own, both together are.
+func TestExtendBookingBySeconds(t *testing.T) {
+	f := newFixture(t)
+	booking := f.book(t, driver, singleSpot, start(), 2)
+	step := 30 * time.Second
+
+	wantCosts := []int64{2 * hourlyRate, 2*hourlyRate + 1}
+	for i, want := range wantCosts {
+		extended, appErr := f.service.ExtendBooking(context.Background(), booking.ID, BookingExtension{Duration: &step},
+			driver)
+		if appErr != nil {
+			t.Fatalf("extension %d: %v", i+1, appErr)
+		}
+		if extended.FullCost != want {
+			t.Errorf("extension %d costs %d in total, want %d", i+1, extended.FullCost, want)
+		}
+	}
+	if got := f.payments.Balance(driver.ID); got != initialBalance-wantCosts[len(wantCosts)-1] {
+		t.Errorf("driver balance = %d, want %d", got, initialBalance-wantCosts[len(wantCosts)-1])
+	}
+}
+
+func TestDeleteBooking(t *testing.T) {
+	tests := []struct {
+		name       string
+		user       *domain.User
+		placeID    int64
+		from       time.Time
+		want       int
+		wantRefund int64
+	}{
+		{name: "driver deletes ahead of time", user: driver, placeID: singleSpot, from: start(),
+			want: http.StatusOK, wantRefund: 2 * hourlyRate},
+		{name: "owner of the parking place deletes", user: owner, placeID: singleSpot, from: start(),
+			want: http.StatusOK, wantRefund: 2 * hourlyRate},
+		{name: "admin deletes", user: admin, placeID: singleSpot, from: start(),
+			want: http.StatusOK, wantRefund: 2 * hourlyRate},
+		{name: "late cancellation keeps the fee", user: driver, placeID: strictPolicy,
+			from: time.Now().UTC().Add(24 * time.Hour), want: http.StatusOK, wantRefund: hourlyRate},
+		{name: "free cancellation under a policy", user: driver, placeID: strictPolicy,
+			from: time.Now().UTC().Add(72 * time.Hour), want: http.StatusOK, wantRefund: 2 * hourlyRate},
+		{name: "another driver", user: otherDriver, placeID: singleSpot, from: start(),
+			want: http.StatusForbidden},
+		{name: "another owner", user: otherOwner, placeID: singleSpot, from: start(),
+			want: http.StatusForbidden},
+		{name: "anonymous", user: nil, placeID: singleSpot, from: start(),
+			want: http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture(t)
+			booking := f.book(t, driver, tt.placeID, tt.from, 2)
+			appErr := f.service.DeleteBooking(context.Background(), booking.ID, tt.user)
+			if got := code(appErr); got != tt.want {
+				t.Fatalf("DeleteBooking() code = %d, want %d (%v)", got, tt.want, appErr)
+			}
+
+			stored, _ := f.repo.GetByID(context.Background(), booking.ID)
+			if appErr != nil {
+				if stored == nil {
+					t.Error("rejected delete removed the booking")
+				}
+				return
+			}
+			if stored != nil {
+				t.Error("deleted booking is still stored")
+			}
+			paid := int64(initialBalance - 2*hourlyRate)
+			if got := f.payments.Balance(driver.ID); got != paid+tt.wantRefund {
+				t.Errorf("driver balance = %d, want %d", got, paid+tt.wantRefund)
+			}
+		})
+	}
+}
+
+func TestDeleteBookingIsRecorded(t *testing.T) {
+	f := newFixture(t)
+	booking := f.book(t, driver, singleSpot, start(), 2)
+	if appErr := f.service.DeleteBooking(context.Background(), booking.ID, driver); appErr != nil {
+		t.Fatalf("delete booking: %v", appErr)
+	}
+
+	if appErr := f.service.DeleteBooking(context.Background(), booking.ID, driver); code(appErr) != http.StatusNotFound {
+		t.Errorf("deleting again code = %d, want %d", code(appErr), http.StatusNotFound)
+	}
+	events, appErr := f.service.BookingHistory(context.Background(), booking.ID, driver)
+	if appErr != nil {
+		t.Fatalf("BookingHistory() = %v", appErr)
+	}
+	if last := events[len(events)-1]; last.Type != domain.BookingEventCanceled {
+		t.Errorf("last event = %s, want %s", last.Type, domain.BookingEventCanceled)
+	}
+	if _, appErr := f.service.BookingHistory(context.Background(), booking.ID, otherDriver); code(appErr) != http.StatusForbidden {
+		t.Errorf("history of another driver code = %d, want %d", code(appErr), http.StatusForbidden)
+	}
+}
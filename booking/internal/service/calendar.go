@@ -0,0 +1,170 @@
+package service
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/h4x4d/parking_net/booking/internal/calendar"
+	"github.com/h4x4d/parking_net/booking/internal/repository"
+	"github.com/h4x4d/parking_net/pkg/domain"
+	"github.com/h4x4d/parking_net/pkg/errors"
+)
+
+// calendarHistory is how long bookings stay in a feed after they ended.
+const calendarHistory = 30 * 24 * time.Hour
+
+type CalendarService struct {
+	calendar repository.CalendarRepository
+	parking  ParkingClient
+}
+
+func NewCalendarService(calendar repository.CalendarRepository, parking ParkingClient) *CalendarService {
+	return &CalendarService{calendar: calendar, parking: parking}
+}
+
+// FeedToken is a newly issued calendar feed token. It is shown once; only its
+// hash is stored.
+type FeedToken struct {
+	Token     string
+	CreatedAt time.Time
+}
+
+// Calendar is the content of a calendar feed.
+type Calendar struct {
+	Name   string
+	Events []calendar.Event
+}
+
+// CreateFeed issues a calendar feed token for the driver or owner and revokes
+// the one issued before.
+func (s *CalendarService) CreateFeed(ctx context.Context, user *domain.User) (*FeedToken, *errors.AppError) {
+	if user == nil || (!user.IsDriver() && !user.IsOwner()) {
+		return nil, errors.New(http.StatusForbidden, "Only drivers and owners have a calendar feed")
+	}
+	token, createdAt, err := s.calendar.CreateFeed(ctx, user.ID, user.Role)
+	if err != nil {
+		return nil, errors.Internal(err)
+	}
+	return &FeedToken{Token: token, CreatedAt: createdAt}, nil
+}
+
+func (s *CalendarService) RevokeFeed(ctx context.Context, user *domain.User) *errors.AppError {
+	if user == nil {
+		return errors.New(http.StatusForbidden, "You don't have permission to revoke a calendar feed")
+	}
+	revoked, err := s.calendar.RevokeFeed(ctx, user.ID)
+	if err != nil {
+		return errors.Internal(err)
+	}
+	if !revoked {
+		return errors.New(http.StatusNotFound, "You have no calendar feed")
+	}
+	return nil
+}
+
+// GetFeed returns the calendar a feed token shows: the bookings of a driver,
+// or those at the parking places of an owner, up to calendarHistory after they
+// ended. The token is the only credential, so parkingPlaceID narrows an owner
+// feed to one of their own places only.
+func (s *CalendarService) GetFeed(ctx context.Context, token string, parkingPlaceID *int64,
+	now time.Time) (*Calendar, *errors.AppError) {
+	feed, err := s.calendar.GetFeed(ctx, token)
+	if err != nil {
+		return nil, errors.Internal(err)
+	}
+	if feed == nil {
+		return nil, errors.New(http.StatusNotFound, "Calendar feed not found")
+	}
+
+	var userID *string
+	var placeIDs []int64
+	forOwner := domain.UserRole(feed.Role) == domain.UserRoleOwner
+	name := "Parking bookings"
+	if forOwner {
+		name = "Bookings at my parking places"
+		placeIDs, err = s.parking.GetOwnerParkingIDs(ctx, feed.UserID)
+		if err != nil {
+			return nil, errors.Internal(err)
+		}
+		if parkingPlaceID != nil {
+			if !contains(placeIDs, *parkingPlaceID) {
+				return nil, errors.New(http.StatusForbidden, fmt.Sprintf("Parking place %d is not yours", *parkingPlaceID))
+			}
+			placeIDs = []int64{*parkingPlaceID}
+		}
+	} else {
+		userID = &feed.UserID
+		if parkingPlaceID != nil {
+			placeIDs = []int64{*parkingPlaceID}
+		}
+	}
+
+	bookings, err := s.calendar.Bookings(ctx, userID, placeIDs, now.Add(-calendarHistory))
+	if err != nil {
+		return nil, errors.Internal(err)
+	}
+
+	places := make(map[int64]*domain.ParkingPlace)
+	events := make([]calendar.Event, 0, len(bookings))
+	for _, booking := range bookings {
+		place, ok := places[booking.ParkingPlaceID]
+		if !ok {
+			place, err = s.parking.GetParkingPlace(ctx, booking.ParkingPlaceID)
+			if err != nil {
+				// A deleted or unreachable place still shows up by its ID.
+				if !stderrors.Is(err, domain.ErrParkingNotFound) {
+					slog.Warn("failed to get parking place for calendar feed",
+						slog.Int64("parking_place_id", booking.ParkingPlaceID),
+						slog.String("error", err.Error()),
+					)
+				}
+				place = nil
+			}
+			places[booking.ParkingPlaceID] = place
+		}
+		events = append(events, calendarEvent(booking, place, forOwner))
+	}
+	return &Calendar{Name: name, Events: events}, nil
+}
+
+func calendarEvent(booking *repository.CalendarBooking, place *domain.ParkingPlace, forOwner bool) calendar.Event {
+	placeName := fmt.Sprintf("Parking place %d", booking.ParkingPlaceID)
+	location := ""
+	if place != nil {
+		placeName = place.Name
+		location = place.Address
+		if place.City != "" {
+			location += ", " + place.City
+		}
+	}
+
+	summary := "Parking at " + placeName
+	if forOwner {
+		summary = fmt.Sprintf("Booking %d at %s", booking.ID, placeName)
+	}
+
+	status := calendar.StatusConfirmed
+	switch booking.Status {
+	case domain.BookingStatusWaiting:
+		status = calendar.StatusTentative
+	case domain.BookingStatusCanceled, domain.BookingStatusExpired:
+		status = calendar.StatusCancelled
+	}
+
+	return calendar.Event{
+		UID:          fmt.Sprintf("booking-%d@parking-net", booking.ID),
+		Sequence:     booking.Revision,
+		Start:        booking.DateFrom,
+		End:          booking.DateTo,
+		Summary:      summary,
+		Location:     location,
+		Description:  fmt.Sprintf("Booking %d, status %s, cost %d", booking.ID, booking.Status, booking.FullCost),
+		Status:       status,
+		Created:      booking.CreatedAt,
+		LastModified: booking.UpdatedAt,
+	}
+}
@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/h4x4d/parking_net/booking/internal/calendar"
+	"github.com/h4x4d/parking_net/booking/internal/repository"
+	"github.com/h4x4d/parking_net/pkg/domain"
+)
+
+// stubCalendar has one feed per token and filters its bookings like the
+// database does.
+type stubCalendar struct {
+	feeds    map[string]*repository.CalendarFeed
+	bookings []*repository.CalendarBooking
+}
+
+func (r *stubCalendar) CreateFeed(ctx context.Context, userID string, role domain.UserRole) (string, time.Time, error) {
+	token := "token-" + userID
+	r.feeds[token] = &repository.CalendarFeed{UserID: userID, Role: string(role)}
+	return token, time.Now(), nil
+}
+
+func (r *stubCalendar) RevokeFeed(ctx context.Context, userID string) (bool, error) {
+	token := "token-" + userID
+	_, ok := r.feeds[token]
+	delete(r.feeds, token)
+	return ok, nil
+}
+
+func (r *stubCalendar) GetFeed(ctx context.Context, token string) (*repository.CalendarFeed, error) {
+	return r.feeds[token], nil
+}
+
+func (r *stubCalendar) Bookings(ctx context.Context, userID *string, parkingPlaceIDs []int64,
+	since time.Time) ([]*repository.CalendarBooking, error) {
+	bookings := make([]*repository.CalendarBooking, 0)
+	for _, booking := range r.bookings {
+		if !booking.DateTo.After(since) || (userID != nil && booking.UserID != *userID) ||
+			(parkingPlaceIDs != nil && !contains(parkingPlaceIDs, booking.ParkingPlaceID)) {
+			continue
+		}
+		bookings = append(bookings, booking)
+	}
+	return bookings, nil
+}
+
+func TestGetCalendarFeed(t *testing.T) {
+	now := time.Now().UTC()
+	repo := &stubCalendar{
+		feeds: make(map[string]*repository.CalendarFeed),
+		bookings: []*repository.CalendarBooking{
+			{ID: 1, UserID: driver.ID, ParkingPlaceID: singleSpot, DateFrom: now, DateTo: now.Add(time.Hour),
+				Status: domain.BookingStatusConfirmed},
+			{ID: 2, UserID: otherDriver.ID, ParkingPlaceID: manySpots, DateFrom: now, DateTo: now.Add(time.Hour),
+				Status: domain.BookingStatusCanceled},
+			{ID: 3, UserID: driver.ID, ParkingPlaceID: manySpots, DateFrom: now.Add(-60 * 24 * time.Hour),
+				DateTo: now.Add(-59 * 24 * time.Hour), Status: domain.BookingStatusCompleted},
+		},
+	}
+	svc := NewCalendarService(repo, newFixture(t).parking)
+	for _, user := range []*domain.User{driver, otherOwner} {
+		if _, appErr := svc.CreateFeed(context.Background(), user); appErr != nil {
+			t.Fatalf("create feed of %s: %v", user.ID, appErr)
+		}
+	}
+	if _, appErr := svc.CreateFeed(context.Background(), admin); code(appErr) != http.StatusForbidden {
+		t.Errorf("admin feed: code = %d, want %d", code(appErr), http.StatusForbidden)
+	}
+
+	place := func(id int64) *int64 { return &id }
+	tests := []struct {
+		name   string
+		token  string
+		place  *int64
+		want   int
+		events []string
+	}{
+		{name: "driver sees their recent bookings", token: "token-" + driver.ID, want: http.StatusOK,
+			events: []string{"booking-1@parking-net"}},
+		{name: "owner sees bookings at their places", token: "token-" + otherOwner.ID, want: http.StatusOK,
+			events: []string{"booking-2@parking-net"}},
+		{name: "owner narrows to their place", token: "token-" + otherOwner.ID, place: place(manySpots),
+			want: http.StatusOK, events: []string{"booking-2@parking-net"}},
+		{name: "owner asks for another place", token: "token-" + otherOwner.ID, place: place(singleSpot),
+			want: http.StatusForbidden},
+		{name: "unknown token", token: "token-" + owner.ID, want: http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			feed, appErr := svc.GetFeed(context.Background(), tt.token, tt.place, now)
+			if got := code(appErr); got != tt.want {
+				t.Fatalf("code = %d, want %d (%v)", got, tt.want, appErr)
+			}
+			if appErr != nil {
+				return
+			}
+			uids := make([]string, 0, len(feed.Events))
+			for _, event := range feed.Events {
+				uids = append(uids, event.UID)
+			}
+			if strings.Join(uids, ",") != strings.Join(tt.events, ",") {
+				t.Errorf("events = %v, want %v", uids, tt.events)
+			}
+		})
+	}
+
+	feed, _ := svc.GetFeed(context.Background(), "token-"+otherOwner.ID, nil, now)
+	if feed.Events[0].Status != calendar.StatusCancelled {
+		t.Errorf("canceled booking shows as %q, want %q", feed.Events[0].Status, calendar.StatusCancelled)
+	}
+}
@@ -0,0 +1,246 @@
+package service
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/h4x4d/parking_net/booking/internal/audit"
+	"github.com/h4x4d/parking_net/booking/internal/repository"
+	"github.com/h4x4d/parking_net/booking/internal/utils"
+	"github.com/h4x4d/parking_net/pkg/domain"
+	"github.com/h4x4d/parking_net/pkg/errors"
+)
+
+type SeriesService struct {
+	series   repository.SeriesRepository
+	bookings repository.BookingRepository
+	vehicles repository.VehicleRepository
+	parking  ParkingClient
+	payments PaymentProcessor
+}
+
+func NewSeriesService(series repository.SeriesRepository, bookings repository.BookingRepository,
+	vehicles repository.VehicleRepository, parking ParkingClient, payments PaymentProcessor) *SeriesService {
+	return &SeriesService{series: series, bookings: bookings, vehicles: vehicles, parking: parking,
+		payments: payments}
+}
+
+// CreateSeries books every occurrence of the series for the driver at the
+// hourly rate of the parking place. A series billed per occurrence is charged
+// for its first occurrence right away and for every other one
+// domain.OccurrenceChargeLead before it starts; an upfront series is charged
+// for all of them at once. Occurrences without a free spot are reported as
+// conflicts instead of failing the series, unless none is left. A series
+// billed up front whose payment fails ends up Canceled and is reported as a
+// bad request.
+func (s *SeriesService) CreateSeries(ctx context.Context, series *domain.BookingSeries,
+	user *domain.User) (*domain.BookingSeries, *errors.AppError) {
+	if user == nil || !user.IsDriver() {
+		return nil, errors.New(http.StatusForbidden, "Only drivers can create booking series")
+	}
+	ctx = audit.WithActor(ctx, domain.UserActor(user))
+
+	series.UserID = user.ID
+	series.DateFrom = series.DateFrom.UTC()
+	series.DateTo = series.DateTo.UTC()
+	series.Until = series.Until.UTC()
+	if series.Billing == "" {
+		series.Billing = domain.SeriesBillingPerOccurrence
+	}
+	if err := utils.ValidateParkingPlaceID(&series.ParkingPlaceID); err != nil {
+		return nil, errors.BadRequest(err.Error())
+	}
+	if err := utils.ValidateDateRange(&series.DateFrom, &series.DateTo); err != nil {
+		return nil, errors.BadRequest(err.Error())
+	}
+	if err := utils.ValidateSeriesEnd(series.DateFrom, series.Until); err != nil {
+		return nil, errors.BadRequest(err.Error())
+	}
+	periods, err := series.Occurrences(utils.MaxSeriesOccurrences)
+	if err != nil {
+		return nil, errors.BadRequest(err.Error())
+	}
+
+	place, appErr := getBookablePlace(ctx, s.parking, series.ParkingPlaceID)
+	if appErr != nil {
+		return nil, appErr
+	}
+	vehicle, err := s.vehicles.Resolve(ctx, user.ID, series.VehicleID)
+	if stderrors.Is(err, domain.ErrVehicleNotFound) || stderrors.Is(err, domain.ErrVehicleRequired) {
+		return nil, errors.BadRequest(err.Error())
+	}
+	if err != nil {
+		return nil, errors.Internal(err)
+	}
+	if appErr := checkVehicle(place, vehicle); appErr != nil {
+		return nil, appErr
+	}
+	series.VehicleID = vehicle.ID
+
+	occurrences := make([]*domain.Booking, 0, len(periods))
+	for _, period := range periods {
+		if err := utils.ValidateDateRange(&period.From, &period.To); err != nil {
+			return nil, errors.BadRequest(err.Error())
+		}
+		occurrence := &domain.Booking{ParkingPlaceID: series.ParkingPlaceID, DateFrom: period.From, DateTo: period.To}
+		if _, appErr := priceBooking(occurrence, place); appErr != nil {
+			return nil, appErr
+		}
+		occurrences = append(occurrences, occurrence)
+	}
+
+	created, err := s.series.Create(ctx, series, occurrences, place)
+	if stderrors.Is(err, utils.ErrNoFreeSpots) {
+		return nil, errors.New(http.StatusConflict, "No free parking spots for any occurrence of the series")
+	}
+	if stderrors.Is(err, domain.ErrParkingUnavailable) {
+		return nil, errors.New(http.StatusConflict, "Parking place does not take bookings")
+	}
+	if err != nil {
+		return nil, errors.Internal(err)
+	}
+
+	// The charge due now is enqueued with the first booking; deliver it so the
+	// result shows whether the series was paid.
+	s.payments.ProcessBooking(ctx, created.Bookings[0].ID)
+
+	settled, err := s.series.Get(ctx, created.ID)
+	if err != nil {
+		return nil, errors.Internal(err)
+	}
+	if settled == nil {
+		return created, nil
+	}
+	if settled.Status == domain.SeriesStatusCanceled {
+		return nil, errors.BadRequest("payment for the booking series failed")
+	}
+	settled.Conflicts = created.Conflicts
+	return settled, nil
+}
+
+func (s *SeriesService) GetSeries(ctx context.Context, id int64, user *domain.User) (*domain.BookingSeries, *errors.AppError) {
+	series, appErr := s.getSeries(ctx, id)
+	if appErr != nil {
+		return nil, appErr
+	}
+	if appErr := authorize(ctx, s.parking, series.UserID, series.ParkingPlaceID, user,
+		"You don't have permission to view this booking series"); appErr != nil {
+		return nil, appErr
+	}
+	return series, nil
+}
+
+// CancelSeries cancels every occurrence of the series that has not started
+// yet. Paid occurrences are refunded as the current cancellation policy of the
+// parking place allows; one paid since the series was read fails the request
+// with a conflict.
+func (s *SeriesService) CancelSeries(ctx context.Context, id int64,
+	user *domain.User) (*domain.BookingSeries, *errors.AppError) {
+	series, appErr := s.getSeries(ctx, id)
+	if appErr != nil {
+		return nil, appErr
+	}
+	if appErr := authorize(ctx, s.parking, series.UserID, series.ParkingPlaceID, user,
+		"You don't have permission to cancel this booking series"); appErr != nil {
+		return nil, appErr
+	}
+	ctx = audit.WithActor(ctx, domain.UserActor(user))
+
+	cancellations, appErr := s.decideCancellations(ctx, series.ParkingPlaceID, series.Bookings)
+	if appErr != nil {
+		return nil, appErr
+	}
+	canceled, err := s.series.Cancel(ctx, id, cancellations)
+	if appErr := writeError(err); appErr != nil {
+		return nil, appErr
+	}
+	// Canceling paid occurrences enqueues their refunds; deliver them now.
+	for _, bookingID := range canceled {
+		s.payments.ProcessBooking(ctx, bookingID)
+	}
+	return s.getSeries(ctx, id)
+}
+
+// CancelOccurrence cancels one booking of the series and leaves the other
+// occurrences as they are.
+func (s *SeriesService) CancelOccurrence(ctx context.Context, seriesID int64, bookingID int64,
+	user *domain.User) (*domain.Booking, *errors.AppError) {
+	booking, err := s.bookings.GetByID(ctx, bookingID)
+	if err != nil {
+		return nil, errors.Internal(err)
+	}
+	if booking == nil || booking.SeriesID != seriesID {
+		return nil, errors.New(http.StatusNotFound,
+			fmt.Sprintf("Booking with id %d not found in series %d", bookingID, seriesID))
+	}
+	if appErr := authorize(ctx, s.parking, booking.UserID, booking.ParkingPlaceID, user,
+		"You don't have permission to cancel this booking"); appErr != nil {
+		return nil, appErr
+	}
+	ctx = audit.WithActor(ctx, domain.UserActor(user))
+
+	cancellations, appErr := s.decideCancellations(ctx, booking.ParkingPlaceID, []*domain.Booking{booking})
+	if appErr != nil {
+		return nil, appErr
+	}
+	var cancellation *repository.Cancellation
+	if decided, ok := cancellations[bookingID]; ok {
+		cancellation = &decided
+	}
+	err = s.series.CancelOccurrence(ctx, seriesID, bookingID, cancellation)
+	if stderrors.Is(err, domain.ErrInvalidStatusTransition) {
+		return nil, errors.BadRequest(fmt.Sprintf("Booking with status %s cannot be canceled", booking.Status))
+	}
+	if appErr := writeError(err); appErr != nil {
+		return nil, appErr
+	}
+	// A paid occurrence is refunded through the outbox; deliver it right away.
+	s.payments.ProcessBooking(ctx, bookingID)
+
+	canceled, err := s.bookings.GetByID(ctx, bookingID)
+	if err != nil {
+		return nil, errors.Internal(err)
+	}
+	if canceled == nil {
+		return nil, errors.New(http.StatusNotFound, fmt.Sprintf("Booking with id %d not found", bookingID))
+	}
+	return canceled, nil
+}
+
+// decideCancellations applies the current cancellation policy of the parking
+// place to a cancellation made now of every paid booking among bookings, all
+// of them at that place.
+func (s *SeriesService) decideCancellations(ctx context.Context, parkingPlaceID int64,
+	bookings []*domain.Booking) (map[int64]repository.Cancellation, *errors.AppError) {
+	cancellations := make(map[int64]repository.Cancellation)
+	now := time.Now().UTC()
+	var place *domain.ParkingPlace
+	for _, booking := range bookings {
+		if booking.Status != domain.BookingStatusConfirmed {
+			continue
+		}
+		if place == nil {
+			var err error
+			place, err = s.parking.GetParkingPlace(ctx, parkingPlaceID)
+			if err != nil {
+				return nil, errors.Internal(fmt.Errorf("failed to get cancellation policy: %w", err))
+			}
+		}
+		cancellations[booking.ID] = cancellationUnder(place, booking, now)
+	}
+	return cancellations, nil
+}
+
+func (s *SeriesService) getSeries(ctx context.Context, id int64) (*domain.BookingSeries, *errors.AppError) {
+	series, err := s.series.Get(ctx, id)
+	if err != nil {
+		return nil, errors.Internal(err)
+	}
+	if series == nil {
+		return nil, errors.New(http.StatusNotFound, fmt.Sprintf("Booking series with id %d not found", id))
+	}
+	return series, nil
+}
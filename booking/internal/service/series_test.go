@@ -0,0 +1,264 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/h4x4d/parking_net/booking/internal/repository"
+	"github.com/h4x4d/parking_net/booking/internal/utils"
+	"github.com/h4x4d/parking_net/pkg/domain"
+	"github.com/h4x4d/parking_net/pkg/errors"
+)
+
+// stubSeries books every occurrence it is given, or none when full.
+type stubSeries struct {
+	full   bool
+	series map[int64]*domain.BookingSeries
+}
+
+func newStubSeries() *stubSeries {
+	return &stubSeries{series: make(map[int64]*domain.BookingSeries)}
+}
+
+func (r *stubSeries) Create(ctx context.Context, series *domain.BookingSeries, occurrences []*domain.Booking,
+	place *domain.ParkingPlace) (*domain.BookingSeries, error) {
+	if r.full {
+		return nil, utils.ErrNoFreeSpots
+	}
+	created := *series
+	created.ID = int64(len(r.series) + 1)
+	created.Status = domain.SeriesStatusActive
+	created.Bookings = occurrences
+	r.series[created.ID] = &created
+	return &created, nil
+}
+
+func (r *stubSeries) Get(ctx context.Context, id int64) (*domain.BookingSeries, error) {
+	return r.series[id], nil
+}
+
+func (r *stubSeries) Cancel(ctx context.Context, id int64,
+	cancellations map[int64]repository.Cancellation) ([]int64, error) {
+	r.series[id].Status = domain.SeriesStatusCanceled
+	return nil, nil
+}
+
+func (r *stubSeries) CancelOccurrence(ctx context.Context, seriesID int64, bookingID int64,
+	cancellation *repository.Cancellation) error {
+	return nil
+}
+
+func newSeriesService(t *testing.T, series *stubSeries) *SeriesService {
+	f := newFixture(t)
+	return NewSeriesService(series, f.repo, f.vehicles, f.parking, f.payments)
+}
+
+func weekly(placeID int64, weeks int) *domain.BookingSeries {
+	from := start()
+	return &domain.BookingSeries{
+		ParkingPlaceID: placeID,
+		DateFrom:       from,
+		DateTo:         from.Add(2 * time.Hour),
+		RRule:          "FREQ=WEEKLY",
+		Until:          from.AddDate(0, 0, 7*(weeks-1)),
+	}
+}
+
+func TestCreateSeries(t *testing.T) {
+	badRule := weekly(singleSpot, 3)
+	badRule.RRule = "FREQ=DAILY"
+	tests := []struct {
+		name   string
+		user   *domain.User
+		series *domain.BookingSeries
+		full   bool
+		want   int
+	}{
+		{name: "driver books three weeks", user: driver, series: weekly(singleSpot, 3), want: http.StatusOK},
+		{name: "owner", user: owner, series: weekly(singleSpot, 3), want: http.StatusForbidden},
+		{name: "unsupported rule", user: driver, series: badRule, want: http.StatusBadRequest},
+		{name: "suspended parking place", user: driver, series: weekly(suspended, 3), want: http.StatusConflict},
+		{name: "vehicle too big", user: driver, series: weekly(motorcyclesOnly, 3), want: http.StatusBadRequest},
+		{name: "every occurrence taken", user: driver, series: weekly(singleSpot, 3), full: true,
+			want: http.StatusConflict},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newStubSeries()
+			repo.full = tt.full
+			created, appErr := newSeriesService(t, repo).CreateSeries(context.Background(), tt.series, tt.user)
+			if got := code(appErr); got != tt.want {
+				t.Fatalf("code = %d, want %d (%v)", got, tt.want, appErr)
+			}
+			if appErr != nil {
+				return
+			}
+			if len(created.Bookings) != 3 {
+				t.Fatalf("booked %d occurrences, want 3", len(created.Bookings))
+			}
+			for _, booking := range created.Bookings {
+				if booking.FullCost != 2*hourlyRate {
+					t.Errorf("occurrence costs %d, want %d", booking.FullCost, 2*hourlyRate)
+				}
+			}
+			if created.Billing != domain.SeriesBillingPerOccurrence {
+				t.Errorf("billing = %q, want %q", created.Billing, domain.SeriesBillingPerOccurrence)
+			}
+		})
+	}
+}
+
+func TestGetSeriesPermissions(t *testing.T) {
+	repo := newStubSeries()
+	svc := newSeriesService(t, repo)
+	series, appErr := svc.CreateSeries(context.Background(), weekly(singleSpot, 2), driver)
+	if appErr != nil {
+		t.Fatalf("create series: %v", appErr)
+	}
+
+	tests := []struct {
+		name string
+		user *domain.User
+		id   int64
+		want int
+	}{
+		{"driver of the series", driver, series.ID, http.StatusOK},
+		{"another driver", otherDriver, series.ID, http.StatusForbidden},
+		{"owner of the parking place", owner, series.ID, http.StatusOK},
+		{"owner of another parking place", otherOwner, series.ID, http.StatusForbidden},
+		{"admin", admin, series.ID, http.StatusOK},
+		{"missing series", admin, series.ID + 1, http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, appErr := svc.GetSeries(context.Background(), tt.id, tt.user)
+			if got := code(appErr); got != tt.want {
+				t.Errorf("get: code = %d, want %d (%v)", got, tt.want, appErr)
+			}
+		})
+	}
+}
+
+func TestCreateSeriesBilling(t *testing.T) {
+	tests := []struct {
+		name     string
+		user     *domain.User
+		billing  domain.SeriesBilling
+		want     int
+		statuses []domain.BookingStatus
+		paid     int64
+	}{
+		{name: "per occurrence charges the first occurrence only", user: driver,
+			billing: domain.SeriesBillingPerOccurrence, want: http.StatusOK,
+			statuses: []domain.BookingStatus{domain.BookingStatusConfirmed, domain.BookingStatusWaiting,
+				domain.BookingStatusWaiting},
+			paid: 2 * hourlyRate},
+		{name: "per occurrence keeps the rest when the first is not paid", user: poorDriver,
+			billing: domain.SeriesBillingPerOccurrence, want: http.StatusOK,
+			statuses: []domain.BookingStatus{domain.BookingStatusCanceled, domain.BookingStatusWaiting,
+				domain.BookingStatusWaiting}},
+		{name: "upfront charges every occurrence", user: driver, billing: domain.SeriesBillingUpfront,
+			want: http.StatusOK,
+			statuses: []domain.BookingStatus{domain.BookingStatusConfirmed, domain.BookingStatusConfirmed,
+				domain.BookingStatusConfirmed},
+			paid: 3 * 2 * hourlyRate},
+		{name: "upfront cancels the series when it is not paid", user: poorDriver,
+			billing: domain.SeriesBillingUpfront, want: http.StatusBadRequest,
+			statuses: []domain.BookingStatus{domain.BookingStatusCanceled, domain.BookingStatusCanceled,
+				domain.BookingStatusCanceled}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture(t)
+			repo := repository.NewMemorySeriesRepository(f.repo)
+			svc := NewSeriesService(repo, f.repo, f.vehicles, f.parking, f.payments)
+			balance := f.payments.Balance(tt.user.ID)
+
+			series := weekly(manySpots, 3)
+			series.Billing = tt.billing
+			_, appErr := svc.CreateSeries(context.Background(), series, tt.user)
+			if got := code(appErr); got != tt.want {
+				t.Fatalf("code = %d, want %d (%v)", got, tt.want, appErr)
+			}
+
+			stored, err := repo.Get(context.Background(), 1)
+			if err != nil || stored == nil {
+				t.Fatalf("get series: %v, %v", stored, err)
+			}
+			if len(stored.Bookings) != len(tt.statuses) {
+				t.Fatalf("booked %d occurrences, want %d", len(stored.Bookings), len(tt.statuses))
+			}
+			for i, booking := range stored.Bookings {
+				if booking.Status != tt.statuses[i] {
+					t.Errorf("occurrence %d is %s, want %s", i+1, booking.Status, tt.statuses[i])
+				}
+			}
+			if paid := balance - f.payments.Balance(tt.user.ID); paid != tt.paid {
+				t.Errorf("driver paid %d, want %d", paid, tt.paid)
+			}
+		})
+	}
+}
+
+// TestCancelSeriesRefunds cancels a paid series at strictPolicy whose first
+// occurrence is within the policy's free cancellation window.
+func TestCancelSeriesRefunds(t *testing.T) {
+	tests := []struct {
+		name     string
+		cancel   func(svc *SeriesService, series *domain.BookingSeries) *errors.AppError
+		refunded int64
+	}{
+		{
+			name: "series",
+			cancel: func(svc *SeriesService, series *domain.BookingSeries) *errors.AppError {
+				_, appErr := svc.CancelSeries(context.Background(), series.ID, driver)
+				return appErr
+			},
+			// Half of the late first occurrence, all of the other two.
+			refunded: hourlyRate + 2*2*hourlyRate,
+		},
+		{
+			name: "late occurrence",
+			cancel: func(svc *SeriesService, series *domain.BookingSeries) *errors.AppError {
+				_, appErr := svc.CancelOccurrence(context.Background(), series.ID, series.Bookings[0].ID, driver)
+				return appErr
+			},
+			refunded: hourlyRate,
+		},
+		{
+			name: "early occurrence",
+			cancel: func(svc *SeriesService, series *domain.BookingSeries) *errors.AppError {
+				_, appErr := svc.CancelOccurrence(context.Background(), series.ID, series.Bookings[1].ID, driver)
+				return appErr
+			},
+			refunded: 2 * hourlyRate,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture(t)
+			repo := repository.NewMemorySeriesRepository(f.repo)
+			svc := NewSeriesService(repo, f.repo, f.vehicles, f.parking, f.payments)
+
+			series := weekly(strictPolicy, 3)
+			series.DateFrom = time.Now().UTC().Truncate(time.Hour).Add(24 * time.Hour)
+			series.DateTo = series.DateFrom.Add(2 * time.Hour)
+			series.Until = series.DateFrom.AddDate(0, 0, 14)
+			series.Billing = domain.SeriesBillingUpfront
+			created, appErr := svc.CreateSeries(context.Background(), series, driver)
+			if appErr != nil {
+				t.Fatalf("create series: %v", appErr)
+			}
+			balance := f.payments.Balance(driver.ID)
+
+			if appErr := tt.cancel(svc, created); appErr != nil {
+				t.Fatalf("cancel: %v", appErr)
+			}
+			if refunded := f.payments.Balance(driver.ID) - balance; refunded != tt.refunded {
+				t.Errorf("driver got %d back, want %d", refunded, tt.refunded)
+			}
+		})
+	}
+}
@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/h4x4d/parking_net/booking/internal/repository"
+	"github.com/h4x4d/parking_net/booking/internal/utils"
+	"github.com/h4x4d/parking_net/pkg/domain"
+	"github.com/h4x4d/parking_net/pkg/errors"
+)
+
+type VehicleService struct {
+	vehicles repository.VehicleRepository
+	bookings repository.BookingRepository
+	parking  ParkingClient
+}
+
+func NewVehicleService(vehicles repository.VehicleRepository, bookings repository.BookingRepository,
+	parking ParkingClient) *VehicleService {
+	return &VehicleService{vehicles: vehicles, bookings: bookings, parking: parking}
+}
+
+// PlateBooking is a booking found by the license plate of its vehicle.
+type PlateBooking struct {
+	Booking *domain.Booking
+	Vehicle *domain.Vehicle
+}
+
+// RegisterVehicle adds a vehicle for the driver, with the plate and country
+// normalized.
+func (s *VehicleService) RegisterVehicle(ctx context.Context, vehicle *domain.Vehicle,
+	user *domain.User) (*domain.Vehicle, *errors.AppError) {
+	if user == nil || !user.IsDriver() {
+		return nil, errors.New(http.StatusForbidden, "Only drivers can register vehicles")
+	}
+
+	vehicle.UserID = user.ID
+	vehicle.Normalize()
+	if err := vehicle.IsValid(); err != nil {
+		return nil, errors.BadRequest(err.Error())
+	}
+
+	created, err := s.vehicles.Create(ctx, vehicle)
+	if stderrors.Is(err, utils.ErrVehicleExists) || stderrors.Is(err, utils.ErrTooManyVehicles) {
+		return nil, errors.New(http.StatusConflict, err.Error())
+	}
+	if err != nil {
+		return nil, errors.Internal(err)
+	}
+	return created, nil
+}
+
+func (s *VehicleService) ListVehicles(ctx context.Context, user *domain.User) ([]*domain.Vehicle, *errors.AppError) {
+	if user == nil || !user.IsDriver() {
+		return nil, errors.New(http.StatusForbidden, "Only drivers have vehicles")
+	}
+	vehicles, err := s.vehicles.List(ctx, user.ID)
+	if err != nil {
+		return nil, errors.Internal(err)
+	}
+	return vehicles, nil
+}
+
+// RemoveVehicle removes a vehicle of the driver. Admins may remove any vehicle.
+func (s *VehicleService) RemoveVehicle(ctx context.Context, id int64, user *domain.User) *errors.AppError {
+	vehicle, err := s.vehicles.Get(ctx, id)
+	if err != nil {
+		return errors.Internal(err)
+	}
+	if vehicle == nil {
+		return errors.New(http.StatusNotFound, fmt.Sprintf("Vehicle with id %d not found", id))
+	}
+	if user == nil || (!user.IsAdmin() && vehicle.UserID != user.ID) {
+		return errors.New(http.StatusForbidden, "You don't have permission to remove this vehicle")
+	}
+
+	err = s.vehicles.Delete(ctx, id)
+	switch {
+	case stderrors.Is(err, domain.ErrVehicleNotFound):
+		return errors.New(http.StatusNotFound, fmt.Sprintf("Vehicle with id %d not found", id))
+	case stderrors.Is(err, utils.ErrVehicleInUse):
+		return errors.New(http.StatusConflict, err.Error())
+	case err != nil:
+		return errors.Internal(err)
+	}
+	return nil
+}
+
+// FindBookingsByPlate returns the bookings a vehicle with the plate may enter
+// with now: Confirmed ones whose check-in window is open and Active ones,
+// including those staying past their end. Owners only see the bookings at their
+// parking places; parkingPlaceID narrows the search to one place.
+func (s *VehicleService) FindBookingsByPlate(ctx context.Context, plate string, parkingPlaceID *int64,
+	user *domain.User) ([]*PlateBooking, *errors.AppError) {
+	plate = domain.NormalizePlate(plate)
+	if err := domain.ValidatePlate(plate); err != nil {
+		return nil, errors.BadRequest(err.Error())
+	}
+
+	now := time.Now().UTC()
+	checkInOpen := now.Add(domain.EarlyCheckIn)
+	filters := repository.BookingFilters{
+		Statuses: []domain.BookingStatus{domain.BookingStatusConfirmed, domain.BookingStatusActive},
+		DateTo:   &checkInOpen,
+		Sort:     repository.SortDateFromDesc,
+		Limit:    utils.MaxBookingsPage,
+	}
+	if parkingPlaceID != nil {
+		filters.ParkingPlaceIDs = []int64{*parkingPlaceID}
+	}
+
+	forbidden := errors.New(http.StatusForbidden, "Only parking owners can look up plates")
+	switch {
+	case user == nil:
+		return nil, forbidden
+	case user.IsAdmin():
+	case user.IsOwner():
+		owned, err := s.parking.GetOwnerParkingIDs(ctx, user.ID)
+		if err != nil {
+			return nil, errors.Internal(err)
+		}
+		if parkingPlaceID != nil && !contains(owned, *parkingPlaceID) {
+			return nil, errors.New(http.StatusForbidden, fmt.Sprintf("Parking place %d is not yours", *parkingPlaceID))
+		}
+		if parkingPlaceID == nil {
+			filters.ParkingPlaceIDs = owned
+		}
+	default:
+		return nil, forbidden
+	}
+
+	vehicles, err := s.vehicles.FindByPlate(ctx, plate)
+	if err != nil {
+		return nil, errors.Internal(err)
+	}
+	byID := make(map[int64]*domain.Vehicle, len(vehicles))
+	filters.VehicleIDs = make([]int64, 0, len(vehicles))
+	for _, vehicle := range vehicles {
+		byID[vehicle.ID] = vehicle
+		filters.VehicleIDs = append(filters.VehicleIDs, vehicle.ID)
+	}
+
+	bookings, _, err := s.bookings.GetAll(ctx, filters)
+	if err != nil {
+		return nil, errors.Internal(err)
+	}
+	found := make([]*PlateBooking, 0, len(bookings))
+	for _, booking := range bookings {
+		if booking.Status == domain.BookingStatusConfirmed && !now.Before(booking.DateTo) {
+			continue
+		}
+		found = append(found, &PlateBooking{Booking: booking, Vehicle: byID[booking.VehicleID]})
+	}
+	return found, nil
+}
+
+func contains(ids []int64, id int64) bool {
+	for _, candidate := range ids {
+		if candidate == id {
+			return true
+		}
+	}
+	return false
+}
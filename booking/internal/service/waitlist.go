@@ -0,0 +1,238 @@
+package service
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/h4x4d/parking_net/booking/internal/audit"
+	"github.com/h4x4d/parking_net/booking/internal/repository"
+	"github.com/h4x4d/parking_net/booking/internal/utils"
+	"github.com/h4x4d/parking_net/pkg/domain"
+	"github.com/h4x4d/parking_net/pkg/errors"
+)
+
+type WaitlistService struct {
+	waitlist repository.WaitlistRepository
+	vehicles repository.VehicleRepository
+	parking  ParkingClient
+	payments PaymentProcessor
+}
+
+func NewWaitlistService(waitlist repository.WaitlistRepository, vehicles repository.VehicleRepository,
+	parking ParkingClient, payments PaymentProcessor) *WaitlistService {
+	return &WaitlistService{waitlist: waitlist, vehicles: vehicles, parking: parking, payments: payments}
+}
+
+// Join queues the driver for a spot of the parking place during a window that
+// has not started yet, for a vehicle the place takes. Suspended places take
+// entries too; they wait until the place takes bookings again.
+func (s *WaitlistService) Join(ctx context.Context, entry *domain.WaitlistEntry,
+	user *domain.User) (*domain.WaitlistEntry, *errors.AppError) {
+	if user == nil || !user.IsDriver() {
+		return nil, errors.New(http.StatusForbidden, "Only drivers can join a waitlist")
+	}
+
+	entry.UserID = user.ID
+	entry.DateFrom = entry.DateFrom.UTC()
+	entry.DateTo = entry.DateTo.UTC()
+	if err := utils.ValidateParkingPlaceID(&entry.ParkingPlaceID); err != nil {
+		return nil, errors.BadRequest(err.Error())
+	}
+	if err := utils.ValidateDateRange(&entry.DateFrom, &entry.DateTo); err != nil {
+		return nil, errors.BadRequest(err.Error())
+	}
+	if !entry.DateFrom.After(time.Now()) {
+		return nil, errors.BadRequest(fmt.Sprintf("%s: the window has already started", utils.ErrDateInPast))
+	}
+
+	place, appErr := getParkingPlace(ctx, s.parking, entry.ParkingPlaceID)
+	if appErr != nil {
+		return nil, appErr
+	}
+	vehicle, err := s.vehicles.Resolve(ctx, user.ID, entry.VehicleID)
+	if stderrors.Is(err, domain.ErrVehicleNotFound) || stderrors.Is(err, domain.ErrVehicleRequired) {
+		return nil, errors.BadRequest(err.Error())
+	}
+	if err != nil {
+		return nil, errors.Internal(err)
+	}
+	if appErr := checkVehicle(place, vehicle); appErr != nil {
+		return nil, appErr
+	}
+	entry.VehicleID = vehicle.ID
+
+	created, err := s.waitlist.Join(ctx, entry)
+	if stderrors.Is(err, utils.ErrTooManyWaitlistEntries) {
+		return nil, errors.BadRequest(err.Error())
+	}
+	if err != nil {
+		return nil, errors.Internal(err)
+	}
+	return created, nil
+}
+
+func (s *WaitlistService) List(ctx context.Context, user *domain.User) ([]*domain.WaitlistEntry, *errors.AppError) {
+	if user == nil || !user.IsDriver() {
+		return nil, errors.New(http.StatusForbidden, "Only drivers have a waitlist")
+	}
+	entries, err := s.waitlist.List(ctx, user.ID)
+	if err != nil {
+		return nil, errors.Internal(err)
+	}
+	return entries, nil
+}
+
+// Leave closes a Queued or Offered entry of the driver, releasing the spot of
+// an open offer. Admins may close any entry.
+func (s *WaitlistService) Leave(ctx context.Context, id int64, user *domain.User) (*domain.WaitlistEntry, *errors.AppError) {
+	entry, appErr := s.getEntry(ctx, id)
+	if appErr != nil {
+		return nil, appErr
+	}
+	if user == nil || (entry.UserID != user.ID && !user.IsAdmin()) {
+		return nil, errors.New(http.StatusForbidden, "You don't have permission to leave this waitlist entry")
+	}
+
+	left, err := s.waitlist.Leave(ctx, id)
+	if stderrors.Is(err, utils.ErrWaitlistEntryClosed) {
+		return nil, errors.BadRequest(err.Error())
+	}
+	if err != nil {
+		return nil, errors.Internal(err)
+	}
+	return left, nil
+}
+
+// AcceptOffer books the spot the open offer of the entry reserved at the
+// hourly rate of the place and charges for it right away. Only the driver who
+// queued may accept, since the spot is booked in their name.
+func (s *WaitlistService) AcceptOffer(ctx context.Context, id int64,
+	user *domain.User) (*domain.WaitlistEntry, *errors.AppError) {
+	entry, appErr := s.getEntry(ctx, id)
+	if appErr != nil {
+		return nil, appErr
+	}
+	if user == nil || entry.UserID != user.ID {
+		return nil, errors.New(http.StatusForbidden, "You don't have permission to accept this offer")
+	}
+	ctx = audit.WithActor(ctx, domain.UserActor(user))
+
+	place, appErr := getParkingPlace(ctx, s.parking, entry.ParkingPlaceID)
+	if appErr != nil {
+		return nil, appErr
+	}
+	if !place.Status.AcceptsBookings() {
+		return nil, errors.BadRequest(domain.ErrParkingUnavailable.Error())
+	}
+	// The place may have restricted vehicle sizes since the driver joined.
+	vehicle, err := s.vehicles.Get(ctx, entry.VehicleID)
+	if err != nil {
+		return nil, errors.Internal(err)
+	}
+	if appErr := checkVehicle(place, vehicle); appErr != nil {
+		return nil, appErr
+	}
+	booking := &domain.Booking{ParkingPlaceID: entry.ParkingPlaceID, DateFrom: entry.DateFrom, DateTo: entry.DateTo}
+	if _, appErr := priceBooking(booking, place); appErr != nil {
+		return nil, appErr
+	}
+
+	accepted, err := s.waitlist.AcceptOffer(ctx, id, booking, place)
+	if stderrors.Is(err, utils.ErrNoWaitlistOffer) || stderrors.Is(err, utils.ErrNoFreeSpots) {
+		return nil, errors.BadRequest(err.Error())
+	}
+	if stderrors.Is(err, domain.ErrParkingUnavailable) {
+		return nil, errors.New(http.StatusConflict, "Parking place does not take bookings")
+	}
+	if err != nil {
+		return nil, errors.Internal(err)
+	}
+	// Deliver the charge of the new booking right away instead of waiting for
+	// the relay.
+	s.payments.ProcessBooking(ctx, accepted.BookingID)
+	return accepted, nil
+}
+
+// ExpireOffers closes the entries whose window has started and the offers that
+// were not accepted in time, so their spots go to the next entries. The
+// expired offers are returned so their drivers can be told.
+func (s *WaitlistService) ExpireOffers(ctx context.Context, now time.Time) ([]*domain.WaitlistEntry, *errors.AppError) {
+	expired, err := s.waitlist.Expire(ctx, now)
+	if err != nil {
+		return nil, errors.Internal(err)
+	}
+	return expired, nil
+}
+
+// MatchQueue walks up to limit Queued entries in the order they joined and
+// hands a free spot to every entry whose window has one: auto-accept entries
+// are booked at the hourly rate of the place, the others get an offer that
+// reserves the spot for offerTTL. Entries of places that do not take bookings,
+// or for vehicles the place no longer takes, keep waiting. The charges of the
+// new bookings are delivered by the relay. The entries matched before a
+// failure are returned with it.
+func (s *WaitlistService) MatchQueue(ctx context.Context, offerTTL time.Duration,
+	limit int) ([]*domain.WaitlistEntry, *errors.AppError) {
+	queued, err := s.waitlist.Queued(ctx, limit)
+	if err != nil {
+		return nil, errors.Internal(err)
+	}
+
+	places := make(map[int64]*domain.ParkingPlace)
+	matched := make([]*domain.WaitlistEntry, 0)
+	for _, entry := range queued {
+		if ctx.Err() != nil {
+			break
+		}
+		place, ok := places[entry.ParkingPlaceID]
+		if !ok {
+			var appErr *errors.AppError
+			place, appErr = getParkingPlace(ctx, s.parking, entry.ParkingPlaceID)
+			if appErr != nil {
+				return matched, appErr
+			}
+			places[entry.ParkingPlaceID] = place
+		}
+		if !place.Status.AcceptsBookings() {
+			continue
+		}
+		vehicle, err := s.vehicles.Get(ctx, entry.VehicleID)
+		if err != nil {
+			return matched, errors.Internal(err)
+		}
+		if checkVehicle(place, vehicle) != nil {
+			continue
+		}
+
+		var booking *domain.Booking
+		if entry.AutoAccept {
+			booking = &domain.Booking{ParkingPlaceID: entry.ParkingPlaceID, DateFrom: entry.DateFrom,
+				DateTo: entry.DateTo}
+			if _, appErr := priceBooking(booking, place); appErr != nil {
+				return matched, appErr
+			}
+		}
+		next, err := s.waitlist.Match(ctx, entry, booking, place, time.Now().UTC().Add(offerTTL))
+		if err != nil {
+			return matched, errors.Internal(err)
+		}
+		if next != nil {
+			matched = append(matched, next)
+		}
+	}
+	return matched, nil
+}
+
+func (s *WaitlistService) getEntry(ctx context.Context, id int64) (*domain.WaitlistEntry, *errors.AppError) {
+	entry, err := s.waitlist.Get(ctx, id)
+	if err != nil {
+		return nil, errors.Internal(err)
+	}
+	if entry == nil {
+		return nil, errors.New(http.StatusNotFound, fmt.Sprintf("Waitlist entry with id %d not found", id))
+	}
+	return entry, nil
+}
@@ -0,0 +1,262 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/h4x4d/parking_net/booking/internal/repository"
+	"github.com/h4x4d/parking_net/booking/internal/utils"
+	"github.com/h4x4d/parking_net/pkg/domain"
+)
+
+// stubWaitlist keeps entries in memory and books accepted offers in the
+// booking repository of the fixture.
+type stubWaitlist struct {
+	bookings repository.BookingRepository
+	entries  map[int64]*domain.WaitlistEntry
+}
+
+func newStubWaitlist(bookings repository.BookingRepository) *stubWaitlist {
+	return &stubWaitlist{bookings: bookings, entries: make(map[int64]*domain.WaitlistEntry)}
+}
+
+func (r *stubWaitlist) Join(ctx context.Context, entry *domain.WaitlistEntry) (*domain.WaitlistEntry, error) {
+	created := *entry
+	created.ID = int64(len(r.entries) + 1)
+	created.Status = domain.WaitlistStatusQueued
+	r.entries[created.ID] = &created
+	return &created, nil
+}
+
+func (r *stubWaitlist) List(ctx context.Context, userID string) ([]*domain.WaitlistEntry, error) {
+	entries := make([]*domain.WaitlistEntry, 0)
+	for _, entry := range r.entries {
+		if entry.UserID == userID {
+			entries = append(entries, entry)
+		}
+	}
+	return entries, nil
+}
+
+func (r *stubWaitlist) Get(ctx context.Context, id int64) (*domain.WaitlistEntry, error) {
+	return r.entries[id], nil
+}
+
+func (r *stubWaitlist) Leave(ctx context.Context, id int64) (*domain.WaitlistEntry, error) {
+	entry := r.entries[id]
+	if entry.Status != domain.WaitlistStatusQueued && entry.Status != domain.WaitlistStatusOffered {
+		return nil, utils.ErrWaitlistEntryClosed
+	}
+	entry.Status = domain.WaitlistStatusLeft
+	return entry, nil
+}
+
+func (r *stubWaitlist) AcceptOffer(ctx context.Context, id int64, booking *domain.Booking,
+	place *domain.ParkingPlace) (*domain.WaitlistEntry, error) {
+	entry := r.entries[id]
+	if entry.Status != domain.WaitlistStatusOffered {
+		return nil, utils.ErrNoWaitlistOffer
+	}
+	booking.UserID = entry.UserID
+	booking.VehicleID = entry.VehicleID
+	created, err := r.bookings.Create(ctx, booking, place, repository.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	entry.Status = domain.WaitlistStatusBooked
+	entry.BookingID = created.ID
+	return entry, nil
+}
+
+func (r *stubWaitlist) Expire(ctx context.Context, now time.Time) ([]*domain.WaitlistEntry, error) {
+	expired := make([]*domain.WaitlistEntry, 0)
+	for _, entry := range r.entries {
+		if entry.Status == domain.WaitlistStatusOffered && !entry.OfferExpiresAt.After(now) {
+			entry.Status = domain.WaitlistStatusExpired
+			expired = append(expired, entry)
+		}
+	}
+	return expired, nil
+}
+
+func (r *stubWaitlist) Queued(ctx context.Context, limit int) ([]*domain.WaitlistEntry, error) {
+	queued := make([]*domain.WaitlistEntry, 0)
+	for id := int64(1); id <= int64(len(r.entries)) && len(queued) < limit; id++ {
+		if entry := r.entries[id]; entry.Status == domain.WaitlistStatusQueued {
+			copied := *entry
+			queued = append(queued, &copied)
+		}
+	}
+	return queued, nil
+}
+
+func (r *stubWaitlist) Match(ctx context.Context, entry *domain.WaitlistEntry, booking *domain.Booking,
+	place *domain.ParkingPlace, offerExpiresAt time.Time) (*domain.WaitlistEntry, error) {
+	stored := r.entries[entry.ID]
+	if stored.Status != domain.WaitlistStatusQueued {
+		return nil, nil
+	}
+	if booking == nil {
+		stored.Status = domain.WaitlistStatusOffered
+		stored.OfferExpiresAt = &offerExpiresAt
+		return stored, nil
+	}
+	booking.UserID = entry.UserID
+	booking.VehicleID = entry.VehicleID
+	created, err := r.bookings.Create(ctx, booking, place, repository.CreateOptions{})
+	if errors.Is(err, utils.ErrNoFreeSpots) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	stored.Status = domain.WaitlistStatusBooked
+	stored.BookingID = created.ID
+	return stored, nil
+}
+
+func newWaitlistService(f *fixture, waitlist *stubWaitlist) *WaitlistService {
+	return NewWaitlistService(waitlist, f.vehicles, f.parking, f.payments)
+}
+
+func window(placeID int64) *domain.WaitlistEntry {
+	return &domain.WaitlistEntry{ParkingPlaceID: placeID, DateFrom: start(), DateTo: start().Add(2 * time.Hour)}
+}
+
+func TestJoinWaitlist(t *testing.T) {
+	started := window(singleSpot)
+	started.DateFrom = time.Now().Add(-time.Hour)
+	started.DateTo = time.Now().Add(time.Hour)
+	tests := []struct {
+		name  string
+		user  *domain.User
+		entry *domain.WaitlistEntry
+		want  int
+	}{
+		{name: "driver", user: driver, entry: window(singleSpot), want: http.StatusOK},
+		{name: "suspended parking place", user: driver, entry: window(suspended), want: http.StatusOK},
+		{name: "owner", user: owner, entry: window(singleSpot), want: http.StatusForbidden},
+		{name: "window started", user: driver, entry: started, want: http.StatusBadRequest},
+		{name: "vehicle too big", user: driver, entry: window(motorcyclesOnly), want: http.StatusBadRequest},
+		{name: "unknown parking place", user: driver, entry: window(unknownPlace), want: http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture(t)
+			entry, appErr := newWaitlistService(f, newStubWaitlist(f.repo)).Join(context.Background(), tt.entry, tt.user)
+			if got := code(appErr); got != tt.want {
+				t.Fatalf("code = %d, want %d (%v)", got, tt.want, appErr)
+			}
+			if appErr == nil && (entry.UserID != tt.user.ID || entry.VehicleID == 0) {
+				t.Errorf("entry of %q with vehicle %d, want %q with their vehicle", entry.UserID, entry.VehicleID,
+					tt.user.ID)
+			}
+		})
+	}
+}
+
+func TestAcceptWaitlistOffer(t *testing.T) {
+	tests := []struct {
+		name   string
+		user   *domain.User
+		status domain.WaitlistStatus
+		want   int
+	}{
+		{"driver of the entry", driver, domain.WaitlistStatusOffered, http.StatusOK},
+		{"another driver", otherDriver, domain.WaitlistStatusOffered, http.StatusForbidden},
+		{"admin", admin, domain.WaitlistStatusOffered, http.StatusForbidden},
+		{"no open offer", driver, domain.WaitlistStatusQueued, http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture(t)
+			waitlist := newStubWaitlist(f.repo)
+			svc := newWaitlistService(f, waitlist)
+			entry, appErr := svc.Join(context.Background(), window(singleSpot), driver)
+			if appErr != nil {
+				t.Fatalf("join: %v", appErr)
+			}
+			waitlist.entries[entry.ID].Status = tt.status
+
+			accepted, appErr := svc.AcceptOffer(context.Background(), entry.ID, tt.user)
+			if got := code(appErr); got != tt.want {
+				t.Fatalf("code = %d, want %d (%v)", got, tt.want, appErr)
+			}
+			if appErr != nil {
+				return
+			}
+			booking, err := f.repo.GetByID(context.Background(), accepted.BookingID)
+			if err != nil || booking == nil {
+				t.Fatalf("get booking %d: %v", accepted.BookingID, err)
+			}
+			if booking.Status != domain.BookingStatusConfirmed || booking.FullCost != 2*hourlyRate {
+				t.Errorf("booking is %s for %d, want Confirmed for %d", booking.Status, booking.FullCost, 2*hourlyRate)
+			}
+		})
+	}
+
+	f := newFixture(t)
+	_, appErr := newWaitlistService(f, newStubWaitlist(f.repo)).AcceptOffer(context.Background(), 1, driver)
+	if got := code(appErr); got != http.StatusNotFound {
+		t.Errorf("missing entry: code = %d, want %d", got, http.StatusNotFound)
+	}
+}
+
+func TestMatchWaitlistQueue(t *testing.T) {
+	f := newFixture(t)
+	waitlist := newStubWaitlist(f.repo)
+	svc := newWaitlistService(f, waitlist)
+	takeSpots(t, f, singleSpot, start(), 1)
+
+	join := func(entry *domain.WaitlistEntry) *domain.WaitlistEntry {
+		t.Helper()
+		joined, appErr := svc.Join(context.Background(), entry, driver)
+		if appErr != nil {
+			t.Fatalf("join: %v", appErr)
+		}
+		return joined
+	}
+	autoAccept := func(placeID int64) *domain.WaitlistEntry {
+		entry := window(placeID)
+		entry.AutoAccept = true
+		return entry
+	}
+	booked := join(autoAccept(manySpots))
+	offered := join(window(manySpots))
+	full := join(autoAccept(singleSpot))
+	closed := join(window(suspended))
+	// The place restricted vehicle sizes after the driver joined.
+	restricted := join(window(manySpots))
+	restricted.ParkingPlaceID = motorcyclesOnly
+
+	matched, appErr := svc.MatchQueue(context.Background(), 15*time.Minute, 10)
+	if appErr != nil {
+		t.Fatalf("match: %v", appErr)
+	}
+	if len(matched) != 2 {
+		t.Errorf("matched %d entries, want 2", len(matched))
+	}
+	want := map[*domain.WaitlistEntry]domain.WaitlistStatus{
+		booked:     domain.WaitlistStatusBooked,
+		offered:    domain.WaitlistStatusOffered,
+		full:       domain.WaitlistStatusQueued,
+		closed:     domain.WaitlistStatusQueued,
+		restricted: domain.WaitlistStatusQueued,
+	}
+	for entry, status := range want {
+		if got := waitlist.entries[entry.ID].Status; got != status {
+			t.Errorf("entry at parking place %d is %s, want %s", entry.ParkingPlaceID, got, status)
+		}
+	}
+
+	booking, err := f.repo.GetByID(context.Background(), waitlist.entries[booked.ID].BookingID)
+	if err != nil || booking == nil {
+		t.Fatalf("get booking: %v", err)
+	}
+	if booking.FullCost != 2*hourlyRate || booking.UserID != driver.ID {
+		t.Errorf("booked %+v, want a booking of %s for %d", booking, driver.ID, 2*hourlyRate)
+	}
+}
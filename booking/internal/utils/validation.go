@@ -9,25 +9,46 @@ import (
 )
 
 const (
-	MaxBookingID     = 9223372036854775807
-	MinBookingID     = 1
-	MaxFullCost      = 1000000000000
-	MinFullCost      = 0
-	MaxHoursDuration = 8760
-	MinHoursDuration = 0
-	MaxStringLength  = 500
-	MinStringLength  = 1
+	MaxBookingID         = 9223372036854775807
+	MinBookingID         = 1
+	MaxFullCost          = 1000000000000
+	MinFullCost          = 0
+	MaxHoursDuration     = 8760
+	MinHoursDuration     = 0
+	MaxStringLength      = 500
+	MinStringLength      = 1
+	MaxAvailabilitySlots = 744
+	MaxSeriesOccurrences = 366
+	MaxWaitlistEntries   = 20
+	MaxActiveHolds       = 3
+	DefaultBookingsPage  = 50
+	MaxBookingsPage      = 200
 )
 
 var (
-	ErrInvalidBookingID     = errors.New("invalid booking ID")
-	ErrInvalidParkingPlaceID = errors.New("invalid parking place ID")
-	ErrInvalidUserID        = errors.New("invalid user ID")
-	ErrInvalidFullCost      = errors.New("invalid full cost")
-	ErrInvalidDateRange     = errors.New("invalid date range")
-	ErrDateTooFarInFuture   = errors.New("date too far in future")
-	ErrDateInPast           = errors.New("date cannot be in the past")
-	ErrInvalidStringLength  = errors.New("invalid string length")
+	ErrInvalidBookingID       = errors.New("invalid booking ID")
+	ErrInvalidParkingPlaceID  = errors.New("invalid parking place ID")
+	ErrInvalidUserID          = errors.New("invalid user ID")
+	ErrInvalidFullCost        = errors.New("invalid full cost")
+	ErrInvalidDateRange       = errors.New("invalid date range")
+	ErrDateTooFarInFuture     = errors.New("date too far in future")
+	ErrDateInPast             = errors.New("date cannot be in the past")
+	ErrInvalidStringLength    = errors.New("invalid string length")
+	ErrNoFreeSpots            = errors.New("no free parking spots for requested period")
+	ErrTooManySlots           = errors.New("too many availability slots")
+	ErrIdempotencyKeyReused   = errors.New("idempotency key was already used with a different request")
+	ErrTooManyWaitlistEntries = errors.New("too many open waitlist entries")
+	ErrWaitlistEntryClosed    = errors.New("waitlist entry is no longer open")
+	ErrNoWaitlistOffer        = errors.New("waitlist entry has no open offer")
+	ErrTooManyHolds           = errors.New("too many active holds")
+	ErrInvalidHold            = errors.New("hold is expired, used or does not cover the booking")
+	ErrInvalidCursor          = errors.New("invalid cursor")
+	ErrInvalidSort            = errors.New("invalid sort key")
+	ErrInvalidStatus          = errors.New("invalid booking status")
+	ErrBookingChanged         = errors.New("booking was changed concurrently")
+	ErrTooManyVehicles        = errors.New("too many vehicles")
+	ErrVehicleExists          = errors.New("vehicle is already registered")
+	ErrVehicleInUse           = errors.New("vehicle has bookings or waitlist entries that are not over")
 )
 
 func ValidateBookingID(bookingID int64) error {
@@ -96,6 +117,29 @@ func ValidateDateRange(dateFrom, dateTo *time.Time) error {
 	return nil
 }
 
+func ValidateAvailabilityWindow(dateFrom, dateTo time.Time, step time.Duration) error {
+	if !dateFrom.Before(dateTo) {
+		return fmt.Errorf("%w: from must be before to", ErrInvalidDateRange)
+	}
+	if step <= 0 {
+		return fmt.Errorf("%w: slot length must be positive", ErrInvalidDateRange)
+	}
+	if dateTo.Sub(dateFrom)/step > MaxAvailabilitySlots {
+		return fmt.Errorf("%w: at most %d slots can be requested", ErrTooManySlots, MaxAvailabilitySlots)
+	}
+	return nil
+}
+
+func ValidateSeriesEnd(dateFrom, until time.Time) error {
+	if until.Before(dateFrom) {
+		return fmt.Errorf("%w: until must not be before date_from", ErrInvalidDateRange)
+	}
+	if until.After(time.Now().AddDate(1, 0, 0)) {
+		return fmt.Errorf("%w: cannot be more than 1 year in the future", ErrDateTooFarInFuture)
+	}
+	return nil
+}
+
 func ValidateString(str string, fieldName string) error {
 	length := utf8.RuneCountInString(str)
 	if length < MinStringLength {
@@ -113,4 +157,3 @@ func SanitizeError(err error) error {
 	}
 	return fmt.Errorf("operation failed")
 }
-
@@ -1,5 +1,5 @@
 import { describe, it, expect } from 'vitest'
-import { API_ENDPOINTS, PARKING_TYPES, BOOKING_STATUSES, USER_ROLES } from '../api'
+import { API_ENDPOINTS, PARKING_TYPES, VEHICLE_SIZES, BOOKING_STATUSES, USER_ROLES } from '../api'
 
 describe('API Configuration', () => {
   it('has AUTH endpoints', () => {
@@ -14,6 +14,9 @@ describe('API Configuration', () => {
     expect(API_ENDPOINTS.PARKING.LIST).toBe('/parking')
     expect(API_ENDPOINTS.PARKING.DETAIL(1)).toBe('/parking/1')
     expect(API_ENDPOINTS.PARKING.CREATE).toBe('/parking')
+    expect(API_ENDPOINTS.PARKING.REVIEWS(1)).toBe('/parking/1/reviews')
+    expect(API_ENDPOINTS.PARKING.REVIEW_REPLY(1, 2)).toBe('/parking/1/reviews/2/reply')
+    expect(API_ENDPOINTS.PARKING.REVIEW_MODERATION(1, 2)).toBe('/parking/1/reviews/2/moderation')
   })
 
   it('has BOOKING endpoints', () => {
@@ -21,6 +24,14 @@ describe('API Configuration', () => {
     expect(API_ENDPOINTS.BOOKING.LIST).toBe('/booking')
     expect(API_ENDPOINTS.BOOKING.DETAIL(1)).toBe('/booking/1')
     expect(API_ENDPOINTS.BOOKING.CREATE).toBe('/booking')
+    expect(API_ENDPOINTS.BOOKING.AVAILABILITY).toBe('/booking/availability')
+    expect(API_ENDPOINTS.BOOKING.QUOTE).toBe('/booking/quote')
+    expect(API_ENDPOINTS.BOOKING.CHECK_IN(1)).toBe('/booking/1/check-in')
+    expect(API_ENDPOINTS.BOOKING.CHECK_OUT(1)).toBe('/booking/1/check-out')
+    expect(API_ENDPOINTS.BOOKING.EXTEND(1)).toBe('/booking/1/extend')
+    expect(API_ENDPOINTS.BOOKING.HISTORY(1)).toBe('/booking/1/history')
+    expect(API_ENDPOINTS.BOOKING.VEHICLES).toBe('/booking/vehicles')
+    expect(API_ENDPOINTS.BOOKING.VEHICLE(1)).toBe('/booking/vehicles/1')
   })
 
   it('has PARKING_TYPES constants', () => {
@@ -30,10 +41,21 @@ describe('API Configuration', () => {
     expect(PARKING_TYPES.MULTI_LEVEL).toBe('multi-level')
   })
 
+  it('has VEHICLE_SIZES constants', () => {
+    expect(VEHICLE_SIZES.MOTORCYCLE).toBe('motorcycle')
+    expect(VEHICLE_SIZES.SMALL).toBe('small')
+    expect(VEHICLE_SIZES.MEDIUM).toBe('medium')
+    expect(VEHICLE_SIZES.LARGE).toBe('large')
+  })
+
   it('has BOOKING_STATUSES constants', () => {
     expect(BOOKING_STATUSES.WAITING).toBe('Waiting')
     expect(BOOKING_STATUSES.CONFIRMED).toBe('Confirmed')
+    expect(BOOKING_STATUSES.ACTIVE).toBe('Active')
+    expect(BOOKING_STATUSES.COMPLETED).toBe('Completed')
     expect(BOOKING_STATUSES.CANCELED).toBe('Canceled')
+    expect(BOOKING_STATUSES.EXPIRED).toBe('Expired')
+    expect(BOOKING_STATUSES.NO_SHOW).toBe('NoShow')
   })
 
   it('has USER_ROLES constants', () => {
@@ -15,6 +15,9 @@ export const API_ENDPOINTS = {
     CREATE: '/parking',
     UPDATE: (id) => `/parking/${id}`,
     DELETE: (id) => `/parking/${id}`,
+    REVIEWS: (id) => `/parking/${id}/reviews`,
+    REVIEW_REPLY: (id, reviewId) => `/parking/${id}/reviews/${reviewId}/reply`,
+    REVIEW_MODERATION: (id, reviewId) => `/parking/${id}/reviews/${reviewId}/moderation`,
   },
   BOOKING: {
     BASE: API_BASE_URL,
@@ -23,6 +26,14 @@ export const API_ENDPOINTS = {
     CREATE: '/booking',
     UPDATE: (id) => `/booking/${id}`,
     DELETE: (id) => `/booking/${id}`,
+    AVAILABILITY: '/booking/availability',
+    QUOTE: '/booking/quote',
+    CHECK_IN: (id) => `/booking/${id}/check-in`,
+    CHECK_OUT: (id) => `/booking/${id}/check-out`,
+    EXTEND: (id) => `/booking/${id}/extend`,
+    HISTORY: (id) => `/booking/${id}/history`,
+    VEHICLES: '/booking/vehicles',
+    VEHICLE: (id) => `/booking/vehicles/${id}`,
   },
   PAYMENT: {
     BASE: API_BASE_URL,
@@ -42,10 +53,21 @@ export const PARKING_TYPES = {
   MULTI_LEVEL: 'multi-level',
 }
 
+export const VEHICLE_SIZES = {
+  MOTORCYCLE: 'motorcycle',
+  SMALL: 'small',
+  MEDIUM: 'medium',
+  LARGE: 'large',
+}
+
 export const BOOKING_STATUSES = {
   WAITING: 'Waiting',
   CONFIRMED: 'Confirmed',
+  ACTIVE: 'Active',
+  COMPLETED: 'Completed',
   CANCELED: 'Canceled',
+  EXPIRED: 'Expired',
+  NO_SHOW: 'NoShow',
 }
 
 export const USER_ROLES = {
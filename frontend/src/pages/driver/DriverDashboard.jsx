@@ -38,8 +38,8 @@ const DriverDashboard = () => {
       if (Array.isArray(bookings)) {
         setTotalBookings(bookings.length)
 
-        // Count active bookings (Confirmed or Waiting)
-        const active = bookings.filter(b => b.status === 'Confirmed' || b.status === 'Waiting').length
+        // Count active bookings (Waiting, Confirmed or Active)
+        const active = bookings.filter(b => ['Waiting', 'Confirmed', 'Active'].includes(b.status)).length
         setActiveBookings(active)
 
         // Calculate total spent from confirmed bookings
@@ -1,6 +1,6 @@
 import { useState, useEffect } from 'react'
 import { useNavigate } from 'react-router-dom'
-import { Calendar, MapPin, DollarSign, Trash2 } from 'lucide-react'
+import { Calendar, MapPin, DollarSign, Trash2, Star, Clock } from 'lucide-react'
 import { bookingService } from '../../services/bookingService'
 import { parkingService } from '../../services/parkingService'
 import { BOOKING_STATUSES } from '../../config/api'
@@ -18,6 +18,14 @@ const MyBookings = () => {
   const [loading, setLoading] = useState(true)
   const [error, setError] = useState('')
   const [deleteLoading, setDeleteLoading] = useState(null)
+  const [attendanceLoading, setAttendanceLoading] = useState(null)
+  const [reviewing, setReviewing] = useState(null)
+  const [reviewForm, setReviewForm] = useState({ rating: 5, text: '' })
+  const [reviewLoading, setReviewLoading] = useState(false)
+  const [reviewed, setReviewed] = useState({})
+  const [extending, setExtending] = useState(null)
+  const [extendMinutes, setExtendMinutes] = useState(60)
+  const [extendLoading, setExtendLoading] = useState(false)
 
   useEffect(() => {
     loadBookings()
@@ -68,13 +76,71 @@ const MyBookings = () => {
     }
   }
 
+  const handleAttendance = async (bookingId, checkOut) => {
+    setAttendanceLoading(bookingId)
+    setError('')
+    try {
+      const updated = checkOut
+        ? await bookingService.checkOut(bookingId)
+        : await bookingService.checkIn(bookingId)
+      setBookings(bookings.map((b) => (b.booking_id === bookingId ? updated : b)))
+    } catch (err) {
+      setError(err.message || 'Failed to update booking')
+    } finally {
+      setAttendanceLoading(null)
+    }
+  }
+
+  const handleExtend = async (e, bookingId) => {
+    e.preventDefault()
+    setExtendLoading(true)
+    setError('')
+    try {
+      const updated = await bookingService.extendBooking(bookingId, {
+        duration_minutes: parseInt(extendMinutes),
+      })
+      setBookings(bookings.map((b) => (b.booking_id === bookingId ? updated : b)))
+      setExtending(null)
+    } catch (err) {
+      setError(err.message || 'Failed to extend booking')
+    } finally {
+      setExtendLoading(false)
+    }
+  }
+
+  const handleReview = async (e, booking) => {
+    e.preventDefault()
+    setReviewLoading(true)
+    setError('')
+    try {
+      await parkingService.createReview(booking.parking_place_id, {
+        booking_id: booking.booking_id,
+        rating: parseInt(reviewForm.rating),
+        text: reviewForm.text,
+      })
+      setReviewed({ ...reviewed, [booking.booking_id]: true })
+      setReviewing(null)
+      setReviewForm({ rating: 5, text: '' })
+    } catch (err) {
+      setError(err.message || 'Failed to submit review')
+    } finally {
+      setReviewLoading(false)
+    }
+  }
+
   const getStatusBadgeClass = (status) => {
     switch (status) {
       case BOOKING_STATUSES.CONFIRMED:
         return 'badge-confirmed'
       case BOOKING_STATUSES.WAITING:
         return 'badge-waiting'
+      case BOOKING_STATUSES.ACTIVE:
+        return 'badge-active'
+      case BOOKING_STATUSES.COMPLETED:
+        return 'badge-completed'
       case BOOKING_STATUSES.CANCELED:
+      case BOOKING_STATUSES.EXPIRED:
+      case BOOKING_STATUSES.NO_SHOW:
         return 'badge-canceled'
       default:
         return 'badge bg-gray-100 text-gray-800'
@@ -163,10 +229,135 @@ const MyBookings = () => {
                         </div>
                       </div>
                     </div>
+
+                    {(booking.checked_in_at || booking.checked_out_at) && (
+                      <div className="grid grid-cols-1 md:grid-cols-3 gap-3 text-sm text-gray-600 mt-3">
+                        {booking.checked_in_at && (
+                          <div>
+                            <p className="font-medium">{t('booking.checkedInAt')}:</p>
+                            <p>{formatDateTime(booking.checked_in_at, i18n.language)}</p>
+                          </div>
+                        )}
+                        {booking.checked_out_at && (
+                          <div>
+                            <p className="font-medium">{t('booking.checkedOutAt')}:</p>
+                            <p>{formatDateTime(booking.checked_out_at, i18n.language)}</p>
+                          </div>
+                        )}
+                        {booking.overtime_cost > 0 && (
+                          <div>
+                            <p className="font-medium">{t('booking.overtimeCost')}:</p>
+                            <p className="font-bold text-red-600">${(booking.overtime_cost / 100).toFixed(2)}</p>
+                          </div>
+                        )}
+                      </div>
+                    )}
+
+                    {extending === booking.booking_id && (
+                      <form onSubmit={(e) => handleExtend(e, booking.booking_id)} className="mt-4 space-y-2">
+                        <p className="text-sm font-medium text-gray-700">{t('booking.extendTitle')}</p>
+                        <select
+                          value={extendMinutes}
+                          onChange={(e) => setExtendMinutes(e.target.value)}
+                          className="input-field"
+                          aria-label={t('booking.extendBy')}
+                        >
+                          {[30, 60, 120, 240].map((minutes) => (
+                            <option key={minutes} value={minutes}>
+                              {t('booking.extendMinutes', { minutes })}
+                            </option>
+                          ))}
+                        </select>
+                        <p className="text-xs text-gray-500">{t('booking.extendHint')}</p>
+                        <div className="flex space-x-2">
+                          <button type="submit" disabled={extendLoading} className="btn-primary">
+                            {extendLoading ? <LoadingSpinner size="small" /> : t('booking.extend')}
+                          </button>
+                          <button type="button" onClick={() => setExtending(null)} className="btn-secondary">
+                            {t('actions.cancel')}
+                          </button>
+                        </div>
+                      </form>
+                    )}
+                    {reviewed[booking.booking_id] && (
+                      <p className="text-sm text-green-700 mt-3">{t('review.thanks')}</p>
+                    )}
+                    {reviewing === booking.booking_id && (
+                      <form onSubmit={(e) => handleReview(e, booking)} className="mt-4 space-y-2">
+                        <p className="text-sm font-medium text-gray-700">{t('review.title')}</p>
+                        <select
+                          value={reviewForm.rating}
+                          onChange={(e) => setReviewForm({ ...reviewForm, rating: e.target.value })}
+                          className="input-field"
+                          aria-label={t('review.rating')}
+                        >
+                          {[5, 4, 3, 2, 1].map((rating) => (
+                            <option key={rating} value={rating}>
+                              {'★'.repeat(rating)}
+                            </option>
+                          ))}
+                        </select>
+                        <textarea
+                          value={reviewForm.text}
+                          onChange={(e) => setReviewForm({ ...reviewForm, text: e.target.value })}
+                          placeholder={t('review.textPlaceholder')}
+                          aria-label={t('review.text')}
+                          className="input-field"
+                          maxLength={2000}
+                          rows={3}
+                        />
+                        <div className="flex space-x-2">
+                          <button type="submit" disabled={reviewLoading} className="btn-primary">
+                            {reviewLoading ? <LoadingSpinner size="small" /> : t('review.submit')}
+                          </button>
+                          <button type="button" onClick={() => setReviewing(null)} className="btn-secondary">
+                            {t('actions.cancel')}
+                          </button>
+                        </div>
+                      </form>
+                    )}
                   </div>
 
                   {booking.status !== BOOKING_STATUSES.CANCELED && (
-                    <div className="mt-4 md:mt-0 md:ml-4">
+                    <div className="mt-4 md:mt-0 md:ml-4 flex flex-col space-y-2">
+                      {(booking.status === BOOKING_STATUSES.CONFIRMED || booking.status === BOOKING_STATUSES.ACTIVE) && (
+                        <button
+                          onClick={() =>
+                            handleAttendance(booking.booking_id, booking.status === BOOKING_STATUSES.ACTIVE)
+                          }
+                          disabled={attendanceLoading === booking.booking_id}
+                          className="btn-primary w-full md:w-auto"
+                        >
+                          {attendanceLoading === booking.booking_id ? (
+                            <LoadingSpinner size="small" />
+                          ) : booking.status === BOOKING_STATUSES.ACTIVE ? (
+                            t('booking.checkOut')
+                          ) : (
+                            t('booking.checkIn')
+                          )}
+                        </button>
+                      )}
+                      {(booking.status === BOOKING_STATUSES.CONFIRMED || booking.status === BOOKING_STATUSES.ACTIVE) &&
+                        extending !== booking.booking_id && (
+                          <button
+                            onClick={() => setExtending(booking.booking_id)}
+                            className="btn-secondary w-full md:w-auto flex items-center justify-center space-x-2"
+                          >
+                            <Clock className="w-4 h-4" />
+                            <span>{t('booking.extend')}</span>
+                          </button>
+                        )}
+                      {booking.status === BOOKING_STATUSES.COMPLETED &&
+                        !reviewed[booking.booking_id] &&
+                        reviewing !== booking.booking_id && (
+                          <button
+                            onClick={() => setReviewing(booking.booking_id)}
+                            className="btn-primary w-full md:w-auto flex items-center justify-center space-x-2"
+                          >
+                            <Star className="w-4 h-4" />
+                            <span>{t('review.leave')}</span>
+                          </button>
+                        )}
                       <button
                         onClick={() => handleCancelBooking(booking.booking_id)}
                         disabled={deleteLoading === booking.booking_id}
@@ -1,9 +1,9 @@
-import { useState, useEffect } from 'react'
-import { Search, MapPin, DollarSign, Car as CarIcon } from 'lucide-react'
+import { useState, useEffect, useRef } from 'react'
+import { Search, MapPin, DollarSign, Car as CarIcon, Star, Navigation, X } from 'lucide-react'
 import { useTranslation } from 'react-i18next'
 import { parkingService } from '../../services/parkingService'
 import { bookingService } from '../../services/bookingService'
-import { PARKING_TYPES } from '../../config/api'
+import { PARKING_TYPES, VEHICLE_SIZES } from '../../config/api'
 import LoadingSpinner from '../../components/LoadingSpinner'
 import { format } from 'date-fns'
 
@@ -13,7 +13,13 @@ const SearchParking = () => {
     city: '',
     name: '',
     parking_type: '',
+    min_rating: '',
+    sort: '',
+    lat: null,
+    lon: null,
+    radius_m: 5000,
   })
+  const [locating, setLocating] = useState(false)
   const [parkings, setParkings] = useState([])
   const [loading, setLoading] = useState(false)
   const [error, setError] = useState('')
@@ -25,11 +31,102 @@ const SearchParking = () => {
   const [bookingLoading, setBookingLoading] = useState(false)
   const [bookingSuccess, setBookingSuccess] = useState(false)
   const [bookingError, setBookingError] = useState('')
+  const [weekAvailability, setWeekAvailability] = useState(null)
+  const [periodFree, setPeriodFree] = useState(null)
+  const [quote, setQuote] = useState(null)
+  const [quoteLoading, setQuoteLoading] = useState(false)
+  const [reviews, setReviews] = useState([])
+  const [vehicles, setVehicles] = useState([])
+  const [vehicleId, setVehicleId] = useState('')
+  const [newVehicle, setNewVehicle] = useState({
+    plate: '',
+    country: '',
+    size: VEHICLE_SIZES.MEDIUM,
+    electric: false,
+  })
+  const [vehicleLoading, setVehicleLoading] = useState(false)
+  // Retries of the same booking request reuse its key, so the server never
+  // creates (and charges) the same booking twice.
+  const lastAttempt = useRef({ request: null, key: null })
 
   useEffect(() => {
     searchParkings()
   }, [])
 
+  useEffect(() => {
+    setWeekAvailability(null)
+    if (!selectedParking) return
+    const from = new Date()
+    from.setHours(0, 0, 0, 0)
+    const to = new Date(from)
+    to.setDate(to.getDate() + 7)
+    bookingService
+      .getAvailability(selectedParking.id, from.toISOString(), to.toISOString(), 'day')
+      .then(setWeekAvailability)
+      .catch(() => setWeekAvailability(null))
+  }, [selectedParking])
+
+  useEffect(() => {
+    setReviews([])
+    if (!selectedParking?.review_count) return
+    parkingService
+      .getReviews(selectedParking.id, { limit: 3 })
+      .then((data) => setReviews(Array.isArray(data) ? data : []))
+      .catch(() => setReviews([]))
+  }, [selectedParking])
+
+  useEffect(() => {
+    if (!selectedParking) return
+    bookingService
+      .getVehicles()
+      .then((data) => {
+        setVehicles(data)
+        const fitting = data.filter((vehicle) => takesVehicle(selectedParking, vehicle))
+        setVehicleId(fitting.length ? String(fitting[0].vehicle_id) : '')
+      })
+      .catch(() => setVehicles([]))
+  }, [selectedParking])
+
+  useEffect(() => {
+    setPeriodFree(null)
+    if (!selectedParking || !bookingData.date_from || !bookingData.date_to) return
+    const from = new Date(bookingData.date_from)
+    const to = new Date(bookingData.date_to)
+    if (to <= from) return
+    bookingService
+      .getAvailability(selectedParking.id, from.toISOString(), to.toISOString(), 'hour')
+      .then((data) => {
+        const slots = data?.slots || []
+        setPeriodFree(slots.length ? Math.min(...slots.map((slot) => slot.free || 0)) : null)
+      })
+      .catch(() => setPeriodFree(null))
+  }, [selectedParking, bookingData.date_from, bookingData.date_to])
+
+  // The quote comes from the server, which prices it exactly like the booking
+  // it would create.
+  useEffect(() => {
+    setQuote(null)
+    if (!selectedParking || !bookingData.date_from || !bookingData.date_to) return
+    const from = new Date(bookingData.date_from)
+    const to = new Date(bookingData.date_to)
+    if (to <= from) return
+    let current = true
+    setQuoteLoading(true)
+    bookingService
+      .quoteBooking({
+        parking_place_id: selectedParking.id,
+        date_from: from.toISOString(),
+        date_to: to.toISOString(),
+        ...(vehicleId ? { vehicle_id: parseInt(vehicleId) } : {}),
+      })
+      .then((data) => current && setQuote(data))
+      .catch(() => current && setQuote(null))
+      .finally(() => current && setQuoteLoading(false))
+    return () => {
+      current = false
+    }
+  }, [selectedParking, bookingData.date_from, bookingData.date_to, vehicleId])
+
   const searchParkings = async () => {
     setLoading(true)
     setError('')
@@ -56,6 +153,66 @@ const SearchParking = () => {
     searchParkings()
   }
 
+  const handleNearMe = () => {
+    if (!navigator.geolocation) {
+      setError(t('parking.locationUnavailable'))
+      return
+    }
+    setLocating(true)
+    setError('')
+    navigator.geolocation.getCurrentPosition(
+      (position) => {
+        setFilters({
+          ...filters,
+          lat: position.coords.latitude,
+          lon: position.coords.longitude,
+          sort: 'distance',
+        })
+        setLocating(false)
+      },
+      () => {
+        setError(t('parking.locationUnavailable'))
+        setLocating(false)
+      }
+    )
+  }
+
+  // Sorting by distance needs a location, so it is dropped along with it.
+  const clearLocation = () => {
+    setFilters({
+      ...filters,
+      lat: null,
+      lon: null,
+      sort: filters.sort === 'distance' ? '' : filters.sort,
+    })
+  }
+
+  const formatDistance = (meters) =>
+    meters < 1000
+      ? t('parking.distanceMeters', { distance: Math.round(meters) })
+      : t('parking.distanceKilometers', { distance: (meters / 1000).toFixed(1) })
+
+  // An empty list of allowed sizes means the parking takes every vehicle.
+  const takesVehicle = (parking, vehicle) =>
+    !parking.allowed_vehicle_sizes?.length || parking.allowed_vehicle_sizes.includes(vehicle.size)
+
+  const handleAddVehicle = async () => {
+    setVehicleLoading(true)
+    setBookingError('')
+    try {
+      const created = await bookingService.createVehicle(newVehicle)
+      setVehicles([...vehicles, created])
+      if (takesVehicle(selectedParking, created)) {
+        setVehicleId(String(created.vehicle_id))
+      }
+      setNewVehicle({ plate: '', country: '', size: VEHICLE_SIZES.MEDIUM, electric: false })
+    } catch (err) {
+      setBookingError(err.message || err.data?.error_message || t('messages.loadFailed'))
+    } finally {
+      setVehicleLoading(false)
+    }
+  }
+
   const handleBooking = async (e) => {
     e.preventDefault()
     if (!selectedParking) return
@@ -81,6 +238,11 @@ const SearchParking = () => {
       return
     }
 
+    if (!vehicleId) {
+      setBookingError(t('booking.errorVehicleRequired'))
+      return
+    }
+
     setBookingLoading(true)
     setBookingError('')
     setBookingSuccess(false)
@@ -97,9 +259,16 @@ const SearchParking = () => {
         parking_place_id: selectedParking.id,
         date_from: formatDateToISO(bookingData.date_from),
         date_to: formatDateToISO(bookingData.date_to),
+        vehicle_id: parseInt(vehicleId),
+      }
+
+      const request = JSON.stringify(formattedData)
+      if (lastAttempt.current.request !== request) {
+        lastAttempt.current = { request, key: crypto.randomUUID() }
       }
 
-      await bookingService.createBooking(formattedData)
+      await bookingService.createBooking(formattedData, lastAttempt.current.key)
+      lastAttempt.current = { request: null, key: null }
       setBookingSuccess(true)
       setSelectedParking(null)
       setBookingData({ date_from: '', date_to: '' })
@@ -136,7 +305,7 @@ const SearchParking = () => {
 
       {/* Search Form */}
       <form onSubmit={handleSearch} className="card mb-8">
-        <div className="grid grid-cols-1 md:grid-cols-4 gap-4">
+        <div className="grid grid-cols-1 md:grid-cols-3 gap-4">
           <div>
             <label className="block text-sm font-medium text-gray-700 mb-2">
               {t('parking.city')}
@@ -184,6 +353,84 @@ const SearchParking = () => {
             </select>
           </div>
 
+          <div>
+            <label className="block text-sm font-medium text-gray-700 mb-2">
+              {t('parking.minRating')}
+            </label>
+            <select
+              name="min_rating"
+              value={filters.min_rating}
+              onChange={handleFilterChange}
+              className="input-field"
+            >
+              <option value="">{t('parking.anyRating')}</option>
+              {[3, 4, 4.5].map((rating) => (
+                <option key={rating} value={rating}>
+                  {t('parking.ratingAtLeast', { rating })}
+                </option>
+              ))}
+            </select>
+          </div>
+
+          <div>
+            <label className="block text-sm font-medium text-gray-700 mb-2">
+              {t('parking.sortBy')}
+            </label>
+            <select
+              name="sort"
+              value={filters.sort}
+              onChange={handleFilterChange}
+              className="input-field"
+            >
+              <option value="">{t('parking.sortDefault')}</option>
+              <option value="-rating">{t('parking.sortRatingDesc')}</option>
+              <option value="rating">{t('parking.sortRatingAsc')}</option>
+              {filters.lat !== null && (
+                <option value="distance">{t('parking.sortDistance')}</option>
+              )}
+            </select>
+          </div>
+
+          <div>
+            <label className="block text-sm font-medium text-gray-700 mb-2">
+              {t('parking.location')}
+            </label>
+            {filters.lat === null ? (
+              <button
+                type="button"
+                onClick={handleNearMe}
+                disabled={locating}
+                className="btn-secondary w-full"
+              >
+                <Navigation className="w-4 h-4 inline mr-2" />
+                {locating ? t('parking.locating') : t('parking.nearMe')}
+              </button>
+            ) : (
+              <div className="flex space-x-2">
+                <select
+                  name="radius_m"
+                  value={filters.radius_m}
+                  onChange={handleFilterChange}
+                  className="input-field"
+                >
+                  {[1000, 5000, 10000, 25000].map((radius) => (
+                    <option key={radius} value={radius}>
+                      {t('parking.withinKilometers', { distance: radius / 1000 })}
+                    </option>
+                  ))}
+                </select>
+                <button
+                  type="button"
+                  onClick={clearLocation}
+                  className="btn-secondary"
+                  title={t('parking.clearLocation')}
+                >
+                  <X className="w-4 h-4" />
+                </button>
+              </div>
+            )}
+          </div>
+
           <div className="flex items-end">
             <button type="submit" disabled={loading} className="btn-primary w-full">
               <Search className="w-4 h-4 inline mr-2" />
@@ -228,6 +475,11 @@ const SearchParking = () => {
                   <p className="text-sm text-gray-600 flex items-center mt-1">
                     <MapPin className="w-4 h-4 mr-1 flex-shrink-0" />
                     <span className="truncate">{parking.city}</span>
+                    {parking.distance_m != null && (
+                      <span className="ml-2 flex-shrink-0 text-primary-700">
+                        {formatDistance(parking.distance_m)}
+                      </span>
+                    )}
                   </p>
                 </div>
                 <span className="badge bg-primary-100 text-primary-800 flex-shrink-0">
@@ -239,6 +491,13 @@ const SearchParking = () => {
                 <p className="text-sm text-gray-600 truncate">
                   <strong>{t('parking.address')}:</strong> {parking.address}
                 </p>
+                <p className="text-sm text-gray-600 flex items-center">
+                  <Star className="w-4 h-4 mr-1 text-yellow-500" />
+                  <strong className="mr-2">{t('parking.rating')}:</strong>
+                  {parking.review_count > 0
+                    ? `${parking.rating.toFixed(1)} (${t('parking.reviewCount', { count: parking.review_count })})`
+                    : t('parking.noReviews')}
+                </p>
                 <p className="text-sm text-gray-600 flex items-center">
                   <DollarSign className="w-4 h-4 mr-1" />
                   <strong className="mr-2">{t('parking.rate')}:</strong> ${(parking.hourly_rate / 100).toFixed(2)} {t('parking.perHour')}
@@ -247,6 +506,12 @@ const SearchParking = () => {
                   <CarIcon className="w-4 h-4 mr-1" />
                   <strong className="mr-2">{t('parking.capacity')}:</strong> {parking.capacity} {t('parking.spots')}
                 </p>
+                {parking.allowed_vehicle_sizes?.length > 0 && (
+                  <p className="text-sm text-gray-600">
+                    <strong>{t('parking.allowedVehicleSizes')}:</strong>{' '}
+                    {parking.allowed_vehicle_sizes.map((size) => t(`vehicleSizes.${size}`)).join(', ')}
+                  </p>
+                )}
               </div>
 
               <button
@@ -274,7 +539,121 @@ const SearchParking = () => {
               </div>
             )}
 
+            {weekAvailability?.slots?.length > 0 && (
+              <div className="mb-4">
+                <p className="text-sm font-medium text-gray-700 mb-2">{t('booking.nextDays')}</p>
+                <div className="grid grid-cols-7 gap-1">
+                  {weekAvailability.slots.map((slot) => (
+                    <div
+                      key={slot.date_from}
+                      className={`text-center rounded p-1 text-xs ${
+                        slot.free > 0 ? 'bg-green-50 text-green-700' : 'bg-red-50 text-red-700'
+                      }`}
+                    >
+                      <div>{format(new Date(slot.date_from), 'dd.MM')}</div>
+                      <div className="font-semibold">{slot.free || 0}</div>
+                    </div>
+                  ))}
+                </div>
+              </div>
+            )}
+
+            {reviews.length > 0 && (
+              <div className="mb-4 space-y-2">
+                <p className="text-sm font-medium text-gray-700">
+                  {t('parking.rating')}: {selectedParking.rating.toFixed(1)} (
+                  {t('parking.reviewCount', { count: selectedParking.review_count })})
+                </p>
+                {reviews.map((review) => (
+                  <div key={review.id} className="text-sm text-gray-600 border-l-2 border-yellow-400 pl-2">
+                    <p className="text-yellow-500">{'★'.repeat(review.rating)}</p>
+                    {review.text && <p>{review.text}</p>}
+                    {review.reply && (
+                      <p className="text-gray-500 mt-1">
+                        <strong>{t('review.ownerReply')}:</strong> {review.reply}
+                      </p>
+                    )}
+                  </div>
+                ))}
+              </div>
+            )}
+
             <form onSubmit={handleBooking} className="space-y-4">
+              <div>
+                <label className="block text-sm font-medium text-gray-700 mb-2">
+                  {t('booking.vehicle')}
+                </label>
+                {vehicles.length > 0 && (
+                  <select
+                    value={vehicleId}
+                    onChange={(e) => setVehicleId(e.target.value)}
+                    className="input-field"
+                    required
+                  >
+                    <option value="">{t('booking.selectVehicle')}</option>
+                    {vehicles.map((vehicle) => (
+                      <option
+                        key={vehicle.vehicle_id}
+                        value={vehicle.vehicle_id}
+                        disabled={!takesVehicle(selectedParking, vehicle)}
+                      >
+                        {vehicle.plate} ({vehicle.country}, {t(`vehicleSizes.${vehicle.size}`)})
+                      </option>
+                    ))}
+                  </select>
+                )}
+                {vehicles.length === 0 && (
+                  <div className="space-y-2">
+                    <p className="text-sm text-gray-600">{t('booking.noVehicles')}</p>
+                    <div className="grid grid-cols-2 gap-2">
+                      <input
+                        type="text"
+                        value={newVehicle.plate}
+                        onChange={(e) => setNewVehicle({ ...newVehicle, plate: e.target.value })}
+                        placeholder={t('booking.plate')}
+                        className="input-field"
+                        maxLength={20}
+                      />
+                      <input
+                        type="text"
+                        value={newVehicle.country}
+                        onChange={(e) => setNewVehicle({ ...newVehicle, country: e.target.value })}
+                        placeholder={t('booking.country')}
+                        className="input-field"
+                        maxLength={2}
+                      />
+                      <select
+                        value={newVehicle.size}
+                        onChange={(e) => setNewVehicle({ ...newVehicle, size: e.target.value })}
+                        className="input-field"
+                      >
+                        {Object.values(VEHICLE_SIZES).map((size) => (
+                          <option key={size} value={size}>
+                            {t(`vehicleSizes.${size}`)}
+                          </option>
+                        ))}
+                      </select>
+                      <label className="flex items-center space-x-2 text-sm text-gray-700">
+                        <input
+                          type="checkbox"
+                          checked={newVehicle.electric}
+                          onChange={(e) => setNewVehicle({ ...newVehicle, electric: e.target.checked })}
+                        />
+                        <span>{t('booking.electric')}</span>
+                      </label>
+                    </div>
+                    <button
+                      type="button"
+                      onClick={handleAddVehicle}
+                      className="btn-secondary w-full"
+                      disabled={vehicleLoading || !newVehicle.plate || !newVehicle.country}
+                    >
+                      {vehicleLoading ? <LoadingSpinner size="small" /> : t('booking.addVehicle')}
+                    </button>
+                  </div>
+                )}
+              </div>
+
               <div>
                 <label className="block text-sm font-medium text-gray-700 mb-2">
                   {t('booking.startDateTime')}
@@ -312,28 +691,36 @@ const SearchParking = () => {
                   <span className="text-sm font-medium text-gray-700">{t('booking.hourlyRate')}:</span>
                   <span className="text-lg font-bold text-blue-600">${(selectedParking.hourly_rate / 100).toFixed(2)}/{t('booking.hour')}</span>
                 </div>
-                {bookingData.date_from && bookingData.date_to && (() => {
-                  const start = new Date(bookingData.date_from)
-                  const end = new Date(bookingData.date_to)
-                  const hours = Math.max(0, (end - start) / (1000 * 60 * 60))
-                  const estimatedCost = (Math.ceil(hours) * selectedParking.hourly_rate / 100).toFixed(2)
-
-                  if (hours > 0) {
-                    return (
-                      <>
-                        <div className="flex items-center justify-between text-sm text-gray-600 mt-1">
-                          <span>{t('booking.duration')}:</span>
-                          <span className="font-medium">{Math.ceil(hours)} {Math.ceil(hours) === 1 ? t('booking.hour') : t('booking.hours')}</span>
-                        </div>
-                        <div className="flex items-center justify-between mt-2 pt-2 border-t border-blue-300">
-                          <span className="text-sm font-semibold text-gray-700">{t('booking.estimatedCost')}:</span>
-                          <span className="text-xl font-bold text-blue-700">${estimatedCost}</span>
+                {quoteLoading && (
+                  <p className="text-sm text-gray-600 mt-1">{t('booking.calculating')}</p>
+                )}
+                {quote && !quoteLoading && (
+                  <>
+                    <div className="flex items-center justify-between text-sm text-gray-600 mt-1">
+                      <span>{t('booking.duration')}:</span>
+                      <span className="font-medium">{(quote.hours || 0).toFixed(2)} {t('booking.hours')}</span>
+                    </div>
+                    {(quote.items || [])
+                      .filter((item) => item.kind !== 'base')
+                      .map((item, index) => (
+                        <div key={index} className="flex items-center justify-between text-sm text-gray-600 mt-1">
+                          <span>{item.kind === 'rounding' ? t('booking.rounding') : item.description}:</span>
+                          <span className="font-medium">${((item.amount || 0) / 100).toFixed(4)}</span>
                         </div>
-                      </>
-                    )
-                  }
-                  return null
-                })()}
+                      ))}
+                    <div className="flex items-center justify-between mt-2 pt-2 border-t border-blue-300">
+                      <span className="text-sm font-semibold text-gray-700">{t('booking.totalCost')}:</span>
+                      <span className="text-xl font-bold text-blue-700">${((quote.total || 0) / 100).toFixed(2)}</span>
+                    </div>
+                  </>
+                )}
+                {periodFree !== null && (
+                  <p className={`text-sm mt-2 ${periodFree > 0 ? 'text-green-700' : 'text-red-700'}`}>
+                    {periodFree > 0
+                      ? t('booking.freeSpots', { free: periodFree, capacity: selectedParking.capacity })
+                      : t('booking.noFreeSpots')}
+                  </p>
+                )}
                 <p className="text-xs text-gray-500 mt-2">
                   * {t('booking.minimumDuration')}
                 </p>
@@ -355,7 +742,7 @@ const SearchParking = () => {
                 <button
                   type="submit"
                   className="btn-primary flex-1"
-                  disabled={bookingLoading}
+                  disabled={bookingLoading || periodFree === 0}
                 >
                   {bookingLoading ? <LoadingSpinner size="small" /> : t('booking.confirmBooking')}
                 </button>
@@ -3,7 +3,7 @@ import { Plus, MapPin, DollarSign, Car as CarIcon, Edit2, Trash2, Calendar } fro
 import { useNavigate } from 'react-router-dom'
 import { useTranslation } from 'react-i18next'
 import { parkingService } from '../../services/parkingService'
-import { PARKING_TYPES } from '../../config/api'
+import { PARKING_TYPES, VEHICLE_SIZES } from '../../config/api'
 import { useAuth } from '../../context/AuthContext'
 import LoadingSpinner from '../../components/LoadingSpinner'
 
@@ -24,6 +24,11 @@ const MyParkings = () => {
     parking_type: PARKING_TYPES.OUTDOOR,
     hourly_rate: '',
     capacity: '',
+    free_cancellation_hours: '',
+    late_cancellation_fee_percent: '',
+    allowed_vehicle_sizes: [],
+    latitude: '',
+    longitude: '',
   })
   const [formLoading, setFormLoading] = useState(false)
   const [success, setSuccess] = useState('')
@@ -59,6 +64,11 @@ const MyParkings = () => {
         parking_type: parking.parking_type,
         hourly_rate: (parking.hourly_rate / 100).toFixed(2), // Convert cents to dollars for editing
         capacity: parking.capacity,
+        free_cancellation_hours: parking.cancellation_policy?.free_cancellation_hours ?? '',
+        late_cancellation_fee_percent: parking.cancellation_policy?.late_cancellation_fee_percent ?? '',
+        allowed_vehicle_sizes: parking.allowed_vehicle_sizes || [],
+        latitude: parking.latitude ?? '',
+        longitude: parking.longitude ?? '',
       })
     } else {
       setEditingParking(null)
@@ -69,6 +79,11 @@ const MyParkings = () => {
         parking_type: PARKING_TYPES.OUTDOOR,
         hourly_rate: '',
         capacity: '',
+        free_cancellation_hours: '',
+        late_cancellation_fee_percent: '',
+        allowed_vehicle_sizes: [],
+        latitude: '',
+        longitude: '',
       })
     }
     setShowModal(true)
@@ -84,9 +99,20 @@ const MyParkings = () => {
       parking_type: PARKING_TYPES.OUTDOOR,
       hourly_rate: '',
       capacity: '',
+      free_cancellation_hours: '',
+      late_cancellation_fee_percent: '',
+      allowed_vehicle_sizes: [],
+      latitude: '',
+      longitude: '',
     })
   }
 
+  const hasCancellationPolicy = () =>
+    formData.free_cancellation_hours !== '' || formData.late_cancellation_fee_percent !== ''
+
+  // The server takes both coordinates or neither.
+  const hasLocation = () => formData.latitude !== '' || formData.longitude !== ''
+
   const validateForm = () => {
     const errors = {}
 
@@ -112,6 +138,28 @@ const MyParkings = () => {
       errors.capacity = t('validation.numberTooLarge')
     }
 
+    if (hasCancellationPolicy()) {
+      const freeHours = parseInt(formData.free_cancellation_hours || '0')
+      if (isNaN(freeHours) || freeHours < 0 || freeHours > 8760) {
+        errors.free_cancellation_hours = t('validation.freeCancellationHoursInvalid')
+      }
+      const feePercent = parseInt(formData.late_cancellation_fee_percent || '0')
+      if (isNaN(feePercent) || feePercent < 0 || feePercent > 100) {
+        errors.late_cancellation_fee_percent = t('validation.cancellationFeeInvalid')
+      }
+    }
+
+    if (hasLocation()) {
+      const latitude = parseFloat(formData.latitude)
+      if (isNaN(latitude) || latitude < -90 || latitude > 90) {
+        errors.latitude = t('validation.latitudeInvalid')
+      }
+      const longitude = parseFloat(formData.longitude)
+      if (isNaN(longitude) || longitude < -180 || longitude > 180) {
+        errors.longitude = t('validation.longitudeInvalid')
+      }
+    }
+
     setFormErrors(errors)
     return Object.keys(errors).length === 0
   }
@@ -128,11 +176,22 @@ const MyParkings = () => {
     setFormLoading(true)
 
     try {
+      const { free_cancellation_hours, late_cancellation_fee_percent, latitude, longitude, ...fields } = formData
       const parkingData = {
-        ...formData,
+        ...fields,
         hourly_rate: Math.round(parseFloat(formData.hourly_rate) * 100), // Convert dollars to cents
         capacity: parseInt(formData.capacity),
       }
+      if (hasCancellationPolicy()) {
+        parkingData.cancellation_policy = {
+          free_cancellation_hours: parseInt(free_cancellation_hours || '0'),
+          late_cancellation_fee_percent: parseInt(late_cancellation_fee_percent || '0'),
+        }
+      }
+      if (hasLocation()) {
+        parkingData.latitude = parseFloat(latitude)
+        parkingData.longitude = parseFloat(longitude)
+      }
 
       if (editingParking) {
         await parkingService.updateParking(editingParking.id, parkingData)
@@ -248,6 +307,21 @@ const MyParkings = () => {
                   <CarIcon className="w-4 h-4 mr-1" />
                   <strong className="mr-2">{t('parking.capacity')}:</strong> {parking.capacity} {t('parking.spots')}
                 </p>
+                {parking.allowed_vehicle_sizes?.length > 0 && (
+                  <p className="text-sm text-gray-600">
+                    <strong>{t('parking.allowedVehicleSizes')}:</strong>{' '}
+                    {parking.allowed_vehicle_sizes.map((size) => t(`vehicleSizes.${size}`)).join(', ')}
+                  </p>
+                )}
+                {parking.cancellation_policy && (
+                  <p className="text-sm text-gray-600">
+                    <strong>{t('parking.cancellationPolicy')}:</strong>{' '}
+                    {t('parking.cancellationPolicySummary', {
+                      hours: parking.cancellation_policy.free_cancellation_hours || 0,
+                      fee: parking.cancellation_policy.late_cancellation_fee_percent || 0,
+                    })}
+                  </p>
+                )}
               </div>
 
               <div className="flex space-x-2">
@@ -418,6 +492,113 @@ const MyParkings = () => {
                 </div>
               </div>
 
+              <div>
+                <h3 className="text-sm font-semibold text-gray-900 mb-2">
+                  {t('parking.location')}
+                </h3>
+                <div className="grid grid-cols-2 gap-4">
+                  {['latitude', 'longitude'].map((field) => (
+                    <div key={field}>
+                      <label className="block text-sm font-medium text-gray-700 mb-2">
+                        {t(`parking.${field}`)}
+                      </label>
+                      <input
+                        type="number"
+                        step="any"
+                        value={formData[field]}
+                        onChange={(e) => {
+                          setFormData({ ...formData, [field]: e.target.value })
+                          if (formErrors[field]) setFormErrors({ ...formErrors, [field]: '' })
+                        }}
+                        className={`input-field ${formErrors[field] ? 'border-red-500' : ''}`}
+                        min={field === 'latitude' ? -90 : -180}
+                        max={field === 'latitude' ? 90 : 180}
+                      />
+                      {formErrors[field] && (
+                        <p className="text-red-500 text-sm mt-1">{formErrors[field]}</p>
+                      )}
+                    </div>
+                  ))}
+                </div>
+                <p className="mt-1 text-xs text-gray-500">{t('parking.locationHint')}</p>
+              </div>
+
+              <div>
+                <h3 className="text-sm font-semibold text-gray-900 mb-2">
+                  {t('parking.allowedVehicleSizes')}
+                </h3>
+                <div className="flex flex-wrap gap-4">
+                  {Object.values(VEHICLE_SIZES).map((size) => (
+                    <label key={size} className="flex items-center space-x-2 text-sm text-gray-700">
+                      <input
+                        type="checkbox"
+                        checked={formData.allowed_vehicle_sizes.includes(size)}
+                        onChange={(e) =>
+                          setFormData({
+                            ...formData,
+                            allowed_vehicle_sizes: e.target.checked
+                              ? [...formData.allowed_vehicle_sizes, size]
+                              : formData.allowed_vehicle_sizes.filter((allowed) => allowed !== size),
+                          })
+                        }
+                      />
+                      <span>{t(`vehicleSizes.${size}`)}</span>
+                    </label>
+                  ))}
+                </div>
+                <p className="mt-1 text-xs text-gray-500">{t('parking.allowedVehicleSizesHint')}</p>
+              </div>
+
+              <div>
+                <h3 className="text-sm font-semibold text-gray-900 mb-2">
+                  {t('parking.cancellationPolicy')}
+                </h3>
+                <div className="grid grid-cols-1 md:grid-cols-2 gap-4">
+                  <div>
+                    <label className="block text-sm font-medium text-gray-700 mb-2">
+                      {t('parking.freeCancellationHours')}
+                    </label>
+                    <input
+                      type="number"
+                      value={formData.free_cancellation_hours}
+                      onChange={(e) => {
+                        setFormData({ ...formData, free_cancellation_hours: e.target.value })
+                        if (formErrors.free_cancellation_hours) setFormErrors({ ...formErrors, free_cancellation_hours: '' })
+                      }}
+                      className={`input-field ${formErrors.free_cancellation_hours ? 'border-red-500' : ''}`}
+                      min="0"
+                      max="8760"
+                    />
+                    {formErrors.free_cancellation_hours && (
+                      <p className="text-red-500 text-sm mt-1">{formErrors.free_cancellation_hours}</p>
+                    )}
+                  </div>
+
+                  <div>
+                    <label className="block text-sm font-medium text-gray-700 mb-2">
+                      {t('parking.lateCancellationFee')}
+                    </label>
+                    <input
+                      type="number"
+                      value={formData.late_cancellation_fee_percent}
+                      onChange={(e) => {
+                        setFormData({ ...formData, late_cancellation_fee_percent: e.target.value })
+                        if (formErrors.late_cancellation_fee_percent) setFormErrors({ ...formErrors, late_cancellation_fee_percent: '' })
+                      }}
+                      className={`input-field ${formErrors.late_cancellation_fee_percent ? 'border-red-500' : ''}`}
+                      min="0"
+                      max="100"
+                    />
+                    {formErrors.late_cancellation_fee_percent && (
+                      <p className="text-red-500 text-sm mt-1">{formErrors.late_cancellation_fee_percent}</p>
+                    )}
+                  </div>
+                </div>
+                <p className="mt-1 text-xs text-gray-500">
+                  {t('parking.cancellationPolicyHint')}
+                </p>
+              </div>
+
               <div className="flex space-x-3 pt-4">
                 <button
                   type="button"
@@ -38,32 +38,17 @@ const OwnerDashboard = () => {
 
       setTotalParkings(Array.isArray(parkings) ? parkings.length : 0)
 
-      // Load bookings for all owner's parkings and calculate revenue
+      // The owner view of the booking list covers every parking of the owner
       if (Array.isArray(parkings) && parkings.length > 0) {
-        let totalActive = 0
-        let revenue = 0
-
-        for (const parking of parkings) {
-          try {
-            const bookings = await bookingService.getBookings({ parking_place_id: parking.id })
-            if (Array.isArray(bookings)) {
-              // Count active bookings
-              const active = bookings.filter(b => b.status === 'Confirmed' || b.status === 'Waiting').length
-              totalActive += active
-
-              // Calculate revenue from confirmed bookings
-              const confirmedBookings = bookings.filter(b => b.status === 'Confirmed')
-              for (const booking of confirmedBookings) {
-                if (booking.full_cost) {
-                  revenue += booking.full_cost
-                }
-              }
-            }
-          } catch (err) {
-            // Ignore errors for individual parkings
-          }
-        }
-        setActiveBookings(totalActive)
+        const bookings = await bookingService.getBookings({
+          status: ['Waiting', 'Confirmed', 'Active'],
+        })
+        setActiveBookings(bookings.length)
+
+        // Calculate revenue from confirmed bookings
+        const revenue = bookings
+          .filter(b => b.status === 'Confirmed')
+          .reduce((sum, b) => sum + (b.full_cost || 0), 0)
         setTotalRevenue((revenue / 100).toFixed(2))
       }
     } catch (err) {
@@ -65,13 +65,34 @@ const ParkingBookings = () => {
     }
   }
 
+  const handleAttendance = async (bookingId, checkOut) => {
+    setUpdateLoading(bookingId)
+    setError('')
+    try {
+      const updated = checkOut
+        ? await bookingService.checkOut(bookingId)
+        : await bookingService.checkIn(bookingId)
+      setBookings(bookings.map((b) => (b.booking_id === bookingId ? updated : b)))
+    } catch (err) {
+      setError(err.message || 'Failed to update booking')
+    } finally {
+      setUpdateLoading(null)
+    }
+  }
+
   const getStatusBadgeClass = (status) => {
     switch (status) {
       case BOOKING_STATUSES.CONFIRMED:
         return 'badge-confirmed'
       case BOOKING_STATUSES.WAITING:
         return 'badge-waiting'
+      case BOOKING_STATUSES.ACTIVE:
+        return 'badge-active'
+      case BOOKING_STATUSES.COMPLETED:
+        return 'badge-completed'
       case BOOKING_STATUSES.CANCELED:
+      case BOOKING_STATUSES.EXPIRED:
+      case BOOKING_STATUSES.NO_SHOW:
         return 'badge-canceled'
       default:
         return 'badge bg-gray-100 text-gray-800'
@@ -180,8 +201,51 @@ const ParkingBookings = () => {
                       </div>
                     </div>
                   </div>
+
+                  {(booking.checked_in_at || booking.checked_out_at) && (
+                    <div className="grid grid-cols-1 md:grid-cols-3 gap-3 text-sm text-gray-600 mt-3">
+                      {booking.checked_in_at && (
+                        <div>
+                          <p className="font-medium">{t('booking.checkedInAt')}:</p>
+                          <p>{formatDateTime(booking.checked_in_at, i18n.language)}</p>
+                        </div>
+                      )}
+                      {booking.checked_out_at && (
+                        <div>
+                          <p className="font-medium">{t('booking.checkedOutAt')}:</p>
+                          <p>{formatDateTime(booking.checked_out_at, i18n.language)}</p>
+                        </div>
+                      )}
+                      {booking.overtime_cost > 0 && (
+                        <div>
+                          <p className="font-medium">{t('booking.overtimeCost')}:</p>
+                          <p className="font-bold text-red-600">${(booking.overtime_cost / 100).toFixed(2)}</p>
+                        </div>
+                      )}
+                    </div>
+                  )}
                 </div>
 
+                {(booking.status === BOOKING_STATUSES.CONFIRMED || booking.status === BOOKING_STATUSES.ACTIVE) && (
+                  <div className="mt-4 md:mt-0 md:ml-4">
+                    <button
+                      onClick={() =>
+                        handleAttendance(booking.booking_id, booking.status === BOOKING_STATUSES.ACTIVE)
+                      }
+                      disabled={updateLoading === booking.booking_id}
+                      className="btn-primary whitespace-nowrap"
+                    >
+                      {updateLoading === booking.booking_id ? (
+                        <LoadingSpinner size="small" />
+                      ) : booking.status === BOOKING_STATUSES.ACTIVE ? (
+                        t('booking.checkOut')
+                      ) : (
+                        t('booking.checkIn')
+                      )}
+                    </button>
+                  </div>
+                )}
+
                 {booking.status === BOOKING_STATUSES.WAITING && (
                   <div className="mt-4 md:mt-0 md:ml-4 flex flex-col space-y-2">
                     <button
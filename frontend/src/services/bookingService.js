@@ -4,13 +4,27 @@ import { API_ENDPOINTS } from '../config/api'
 export const bookingService = {
   getBookings: async (filters = {}) => {
     const params = new URLSearchParams()
-    if (filters.parking_place_id) params.append('parking_place_id', filters.parking_place_id)
+    const parkingPlaceIds = [].concat(filters.parking_place_id ?? [])
+    parkingPlaceIds.forEach((id) => params.append('parking_place_id', id))
+    const statuses = [].concat(filters.status ?? [])
+    statuses.forEach((status) => params.append('status', status))
     if (filters.user_id) params.append('user_id', filters.user_id)
+    if (filters.date_from) params.append('date_from', filters.date_from)
+    if (filters.date_to) params.append('date_to', filters.date_to)
+    if (filters.sort) params.append('sort', filters.sort)
 
-    const response = await bookingApi.get(
-      `${API_ENDPOINTS.BOOKING.LIST}${params.toString() ? `?${params.toString()}` : ''}`
-    )
-    return response.data
+    // The API returns one page at a time; follow the cursors to get every booking.
+    const bookings = []
+    let cursor = null
+    do {
+      if (cursor) params.set('cursor', cursor)
+      const response = await bookingApi.get(
+        `${API_ENDPOINTS.BOOKING.LIST}${params.toString() ? `?${params.toString()}` : ''}`
+      )
+      bookings.push(...(response.data || []))
+      cursor = response.headers?.['x-next-cursor']
+    } while (cursor)
+    return bookings
   },
 
   getBookingById: async (id) => {
@@ -18,8 +32,9 @@ export const bookingService = {
     return response.data
   },
 
-  createBooking: async (bookingData) => {
-    const response = await bookingApi.post(API_ENDPOINTS.BOOKING.CREATE, bookingData)
+  createBooking: async (bookingData, idempotencyKey) => {
+    const config = idempotencyKey ? { headers: { 'Idempotency-Key': idempotencyKey } } : undefined
+    const response = await bookingApi.post(API_ENDPOINTS.BOOKING.CREATE, bookingData, config)
     return response.data
   },
 
@@ -28,8 +43,60 @@ export const bookingService = {
     return response.data
   },
 
+  getAvailability: async (parkingPlaceId, from, to, granularity = 'hour') => {
+    const params = new URLSearchParams({
+      parking_place_id: parkingPlaceId,
+      from,
+      to,
+      granularity,
+    })
+    const response = await bookingApi.get(`${API_ENDPOINTS.BOOKING.AVAILABILITY}?${params.toString()}`)
+    return response.data
+  },
+
+  checkIn: async (id) => {
+    const response = await bookingApi.post(API_ENDPOINTS.BOOKING.CHECK_IN(id))
+    return response.data
+  },
+
+  checkOut: async (id) => {
+    const response = await bookingApi.post(API_ENDPOINTS.BOOKING.CHECK_OUT(id))
+    return response.data
+  },
+
+  // Pass either { date_to } or { duration_minutes }.
+  extendBooking: async (id, extension) => {
+    const response = await bookingApi.post(API_ENDPOINTS.BOOKING.EXTEND(id), extension)
+    return response.data
+  },
+
   deleteBooking: async (id) => {
     const response = await bookingApi.delete(API_ENDPOINTS.BOOKING.DELETE(id))
     return response.data
   },
+
+  quoteBooking: async (quoteData) => {
+    const response = await bookingApi.post(API_ENDPOINTS.BOOKING.QUOTE, quoteData)
+    return response.data
+  },
+
+  getBookingHistory: async (id) => {
+    const response = await bookingApi.get(API_ENDPOINTS.BOOKING.HISTORY(id))
+    return response.data || []
+  },
+
+  getVehicles: async () => {
+    const response = await bookingApi.get(API_ENDPOINTS.BOOKING.VEHICLES)
+    return response.data || []
+  },
+
+  createVehicle: async (vehicleData) => {
+    const response = await bookingApi.post(API_ENDPOINTS.BOOKING.VEHICLES, vehicleData)
+    return response.data
+  },
+
+  deleteVehicle: async (id) => {
+    const response = await bookingApi.delete(API_ENDPOINTS.BOOKING.VEHICLE(id))
+    return response.data
+  },
 }
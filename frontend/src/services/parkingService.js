@@ -8,6 +8,13 @@ export const parkingService = {
     if (filters.name) params.append('name', filters.name)
     if (filters.parking_type) params.append('parking_type', filters.parking_type)
     if (filters.owner_id) params.append('owner_id', filters.owner_id)
+    if (filters.min_rating) params.append('min_rating', filters.min_rating)
+    if (filters.lat != null && filters.lon != null) {
+      params.append('lat', filters.lat)
+      params.append('lon', filters.lon)
+      if (filters.radius_m) params.append('radius_m', filters.radius_m)
+    }
+    if (filters.sort) params.append('sort', filters.sort)
 
     const response = await parkingApi.get(
       `${API_ENDPOINTS.PARKING.LIST}${params.toString() ? `?${params.toString()}` : ''}`
@@ -34,4 +41,30 @@ export const parkingService = {
     const response = await parkingApi.delete(API_ENDPOINTS.PARKING.DELETE(id))
     return response.data
   },
+
+  getReviews: async (id, { limit, offset } = {}) => {
+    const params = new URLSearchParams()
+    if (limit) params.append('limit', limit)
+    if (offset) params.append('offset', offset)
+
+    const response = await parkingApi.get(
+      `${API_ENDPOINTS.PARKING.REVIEWS(id)}${params.toString() ? `?${params.toString()}` : ''}`
+    )
+    return response.data
+  },
+
+  createReview: async (id, reviewData) => {
+    const response = await parkingApi.post(API_ENDPOINTS.PARKING.REVIEWS(id), reviewData)
+    return response.data
+  },
+
+  replyToReview: async (id, reviewId, reply) => {
+    const response = await parkingApi.put(API_ENDPOINTS.PARKING.REVIEW_REPLY(id, reviewId), { reply })
+    return response.data
+  },
+
+  moderateReview: async (id, reviewId, status, reason) => {
+    const response = await parkingApi.put(API_ENDPOINTS.PARKING.REVIEW_MODERATION(id, reviewId), { status, reason })
+    return response.data
+  },
 }
@@ -0,0 +1,27 @@
+package database_service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/h4x4d/parking_net/pkg/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+// GetCancellationPolicy returns the latest cancellation policy of the parking
+// place, or the default policy if the owner has not set one.
+func (ds *DatabaseService) GetCancellationPolicy(ctx context.Context, parkingPlaceID int64) (*domain.CancellationPolicy, error) {
+	policy := new(domain.CancellationPolicy)
+	err := ds.pool.QueryRow(ctx,
+		`SELECT version, free_cancellation_hours, late_cancellation_fee_percent FROM cancellation_policies
+		WHERE parking_place_id = $1 ORDER BY version DESC LIMIT 1`,
+		parkingPlaceID).Scan(&policy.Version, &policy.FreeCancellationHours, &policy.LateCancellationFeePercent)
+	if errors.Is(err, pgx.ErrNoRows) {
+		*policy = domain.DefaultCancellationPolicy
+		return policy, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
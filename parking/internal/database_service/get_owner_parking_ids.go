@@ -0,0 +1,24 @@
+package database_service
+
+import (
+	"context"
+)
+
+// GetOwnerParkingIDs returns the IDs of every parking place of the owner.
+func (ds *DatabaseService) GetOwnerParkingIDs(ctx context.Context, ownerID string) ([]int64, error) {
+	rows, err := ds.pool.Query(ctx, "SELECT id FROM parking_places WHERE owner_id = $1 ORDER BY id", ownerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := make([]int64, 0)
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
@@ -7,7 +7,8 @@ import (
 
 func (ds *DatabaseService) GetById(parkingPlaceID int64) (*models.ParkingPlace, error) {
 	parkingRow, errGet := ds.pool.Query(context.Background(),
-		"SELECT * FROM parking_places WHERE id = $1", parkingPlaceID)
+		`SELECT id, name, city, address, parking_type, hourly_rate, capacity, owner_id, status, allowed_vehicle_sizes
+		FROM parking_places WHERE id = $1`, parkingPlaceID)
 	if errGet != nil {
 		return nil, errGet
 	}
@@ -22,7 +23,7 @@ func (ds *DatabaseService) GetById(parkingPlaceID int64) (*models.ParkingPlace,
 
 	err := parkingRow.Scan(&parkingPlace.ID, parkingPlace.Name, parkingPlace.City,
 		parkingPlace.Address, &parkingPlace.ParkingType, &parkingPlace.HourlyRate, 
-		&parkingPlace.Capacity, &parkingPlace.OwnerID)
+		&parkingPlace.Capacity, &parkingPlace.OwnerID, &parkingPlace.Status, &parkingPlace.AllowedVehicleSizes)
 	parkingRow.Close()
 
 	return parkingPlace, err
@@ -5,14 +5,18 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/h4x4d/parking_net/parking/internal/grpc/client"
 	"github.com/h4x4d/parking_net/parking/internal/handlers"
+	"github.com/h4x4d/parking_net/parking/internal/notifier"
 	"github.com/h4x4d/parking_net/parking/internal/repository"
+	"github.com/h4x4d/parking_net/parking/internal/scheduler"
 	"github.com/h4x4d/parking_net/parking/internal/service"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 type Container struct {
 	ParkingHandler *handlers.ParkingHandler
+	ClosureWorker  *scheduler.ClosureWorker
 }
 
 func NewContainer() (*Container, error) {
@@ -30,14 +34,20 @@ func NewContainer() (*Container, error) {
 	}
 
 	repo := repository.NewPostgresParkingRepository(pool)
-	svc := service.NewParkingService(repo)
+	bookings := client.NewBookingClient()
+	svc := service.NewParkingService(repo, bookings)
+	closures := service.NewClosureService(repo, repository.NewPostgresClosureRepository(pool), bookings,
+		client.NewPaymentClient(), notifier.NewNotifier())
 
-	parkingHandler, err := handlers.NewParkingHandler(svc)
+	reviews := service.NewReviewService(repo, repository.NewPostgresReviewRepository(pool), bookings)
+
+	parkingHandler, err := handlers.NewParkingHandler(svc, closures, reviews)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create parking handler: %w", err)
 	}
 
 	return &Container{
 		ParkingHandler: parkingHandler,
+		ClosureWorker:  scheduler.NewClosureWorker(closures),
 	}, nil
 }
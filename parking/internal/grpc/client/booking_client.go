@@ -0,0 +1,173 @@
+package client
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/h4x4d/parking_net/parking/internal/grpc/gen"
+	"github.com/h4x4d/parking_net/parking/internal/grpc/utils"
+	"github.com/h4x4d/parking_net/pkg/domain"
+	"go.opentelemetry.io/otel"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// BookingClient asks the booking service about the bookings of parking places.
+type BookingClient struct{}
+
+func NewBookingClient() *BookingClient {
+	return &BookingClient{}
+}
+
+// GetFutureOccupancy returns how many bookings of the parking place have not
+// ended yet and the most of them that take a spot at the same time.
+func (c *BookingClient) GetFutureOccupancy(ctx context.Context, parkingPlaceID int64) (int64, int64, error) {
+	conn, err := utils.ConnectToBooking()
+	if err != nil {
+		return 0, 0, err
+	}
+	defer conn.Close()
+
+	tracer := otel.Tracer("Parking")
+	childCtx, span := tracer.Start(ctx, "parking request count overlapping bookings")
+	defer span.End()
+
+	internalToken := os.Getenv("INTERNAL_SERVICE_TOKEN")
+	if internalToken != "" {
+		childCtx = metadata.AppendToOutgoingContext(childCtx, "authorization", "Bearer "+internalToken)
+	}
+
+	resp, err := gen.NewBookingClient(conn).CountOverlapping(childCtx, &gen.CountOverlappingRequest{
+		ParkingPlaceId: parkingPlaceID,
+		DateFrom:       time.Now().Unix(),
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	return resp.Count, resp.Peak, nil
+}
+
+// ListActiveBookings returns the bookings of the parking place that take a spot
+// and have not ended yet; checked-in bookings end when their driver checks out.
+func (c *BookingClient) ListActiveBookings(ctx context.Context, parkingPlaceID int64) ([]*domain.Booking, error) {
+	conn, err := utils.ConnectToBooking()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	tracer := otel.Tracer("Parking")
+	childCtx, span := tracer.Start(ctx, "parking request list active bookings")
+	defer span.End()
+
+	internalToken := os.Getenv("INTERNAL_SERVICE_TOKEN")
+	if internalToken != "" {
+		childCtx = metadata.AppendToOutgoingContext(childCtx, "authorization", "Bearer "+internalToken)
+	}
+
+	resp, err := gen.NewBookingClient(conn).ListActiveBookingsForPlace(childCtx, &gen.PlaceBookingsRequest{
+		ParkingPlaceId: parkingPlaceID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toDomainBookings(resp.Bookings), nil
+}
+
+// CancelBookings cancels the Waiting and Confirmed bookings of the parking place
+// that have not ended and returns them; refunding them is up to the caller.
+func (c *BookingClient) CancelBookings(ctx context.Context, parkingPlaceID int64, reason string) ([]*domain.Booking, error) {
+	conn, err := utils.ConnectToBooking()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	tracer := otel.Tracer("Parking")
+	childCtx, span := tracer.Start(ctx, "parking request cancel bookings")
+	defer span.End()
+
+	internalToken := os.Getenv("INTERNAL_SERVICE_TOKEN")
+	if internalToken != "" {
+		childCtx = metadata.AppendToOutgoingContext(childCtx, "authorization", "Bearer "+internalToken)
+	}
+
+	resp, err := gen.NewBookingClient(conn).CancelBookingsForPlace(childCtx, &gen.CancelPlaceBookingsRequest{
+		ParkingPlaceId: parkingPlaceID,
+		Reason:         reason,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toDomainBookings(resp.Bookings), nil
+}
+
+// ReopenBookings lets a parking place closed by CancelBookings take bookings
+// again.
+func (c *BookingClient) ReopenBookings(ctx context.Context, parkingPlaceID int64) error {
+	conn, err := utils.ConnectToBooking()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	tracer := otel.Tracer("Parking")
+	childCtx, span := tracer.Start(ctx, "parking request reopen bookings")
+	defer span.End()
+
+	internalToken := os.Getenv("INTERNAL_SERVICE_TOKEN")
+	if internalToken != "" {
+		childCtx = metadata.AppendToOutgoingContext(childCtx, "authorization", "Bearer "+internalToken)
+	}
+
+	_, err = gen.NewBookingClient(conn).ReopenPlace(childCtx, &gen.PlaceBookingsRequest{
+		ParkingPlaceId: parkingPlaceID,
+	})
+	return err
+}
+
+// GetBooking returns nil without an error when the booking does not exist.
+func (c *BookingClient) GetBooking(ctx context.Context, bookingID int64) (*domain.Booking, error) {
+	conn, err := utils.ConnectToBooking()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	tracer := otel.Tracer("Parking")
+	childCtx, span := tracer.Start(ctx, "parking request get booking")
+	defer span.End()
+
+	internalToken := os.Getenv("INTERNAL_SERVICE_TOKEN")
+	if internalToken != "" {
+		childCtx = metadata.AppendToOutgoingContext(childCtx, "authorization", "Bearer "+internalToken)
+	}
+
+	resp, err := gen.NewBookingClient(conn).GetBooking(childCtx, &gen.BookingRequest{Id: bookingID})
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toDomainBookings([]*gen.BookingResponse{resp})[0], nil
+}
+
+func toDomainBookings(bookings []*gen.BookingResponse) []*domain.Booking {
+	result := make([]*domain.Booking, 0, len(bookings))
+	for _, booking := range bookings {
+		result = append(result, &domain.Booking{
+			ID:             booking.Id,
+			DateFrom:       time.Unix(booking.DateFrom, 0).UTC(),
+			DateTo:         time.Unix(booking.DateTo, 0).UTC(),
+			ParkingPlaceID: booking.ParkingPlaceId,
+			FullCost:       booking.FullCost,
+			Status:         domain.BookingStatus(booking.Status),
+			UserID:         booking.UserId,
+			SeriesID:       booking.SeriesId,
+		})
+	}
+	return result
+}
@@ -0,0 +1,64 @@
+package client
+
+import (
+	"context"
+	"os"
+
+	"github.com/h4x4d/parking_net/parking/internal/grpc/gen"
+	"github.com/h4x4d/parking_net/parking/internal/grpc/utils"
+	"github.com/h4x4d/parking_net/pkg/domain"
+	"go.opentelemetry.io/otel"
+	"google.golang.org/grpc/metadata"
+)
+
+// PaymentClient refunds bookings through the payment service.
+type PaymentClient struct{}
+
+func NewPaymentClient() *PaymentClient {
+	return &PaymentClient{}
+}
+
+// RefundBooking returns everything the driver paid for the booking and still
+// has not got back. It reports RefundNone when there is nothing to refund and
+// RefundDeclined with the reason when the payment service refuses the refund.
+func (c *PaymentClient) RefundBooking(ctx context.Context, bookingID int64, driverID string, ownerID string,
+	reason string) (domain.RefundStatus, int64, string, error) {
+	conn, err := utils.ConnectToPayment()
+	if err != nil {
+		return "", 0, "", err
+	}
+	defer conn.Close()
+
+	tracer := otel.Tracer("Parking")
+	childCtx, span := tracer.Start(ctx, "parking request refund booking")
+	defer span.End()
+
+	internalToken := os.Getenv("INTERNAL_SERVICE_TOKEN")
+	if internalToken != "" {
+		childCtx = metadata.AppendToOutgoingContext(childCtx, "authorization", "Bearer "+internalToken)
+	}
+
+	client := gen.NewPaymentClient(conn)
+	charge, err := client.GetBookingCharge(childCtx, &gen.BookingChargeRequest{BookingId: bookingID})
+	if err != nil {
+		return "", 0, "", err
+	}
+	if !charge.Charged || charge.Amount <= 0 {
+		return domain.RefundNone, 0, "", nil
+	}
+
+	resp, err := client.ProcessRefund(childCtx, &gen.RefundRequest{
+		BookingId: bookingID,
+		DriverId:  driverID,
+		OwnerId:   ownerID,
+		Amount:    charge.Amount,
+		Reason:    reason,
+	})
+	if err != nil {
+		return "", 0, "", err
+	}
+	if resp.Status != "completed" {
+		return domain.RefundDeclined, 0, resp.Message, nil
+	}
+	return domain.RefundDone, charge.Amount, "", nil
+}
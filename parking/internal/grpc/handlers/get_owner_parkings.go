@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/h4x4d/parking_net/parking/internal/grpc/gen"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func (serverApi *GRPCServer) GetOwnerParkingPlaces(
+	ctx context.Context, in *gen.OwnerParkingPlacesRequest) (*gen.OwnerParkingPlacesResponse, error) {
+
+	if err := serverApi.validateInternalRequest(ctx); err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "authentication failed")
+	}
+
+	if in.OwnerId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid owner ID")
+	}
+
+	tracer := otel.Tracer("Parking")
+	md, _ := metadata.FromIncomingContext(ctx)
+	if len(md.Get("x-trace-id")) > 0 {
+		traceId, err := trace.TraceIDFromHex(md.Get("x-trace-id")[0])
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid trace ID")
+		}
+		spanContext := trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID: traceId,
+		})
+		ctx = trace.ContextWithSpanContext(ctx, spanContext)
+	} else {
+		ctx = context.Background()
+	}
+	ctx, span := tracer.Start(ctx, "get owner parking places")
+	defer span.End()
+
+	ids, err := serverApi.Database.GetOwnerParkingIDs(ctx, in.OwnerId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get parking places")
+	}
+	return &gen.OwnerParkingPlacesResponse{Ids: ids}, nil
+}
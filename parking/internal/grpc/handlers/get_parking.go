@@ -64,7 +64,7 @@ func (serverApi *GRPCServer) GetParkingPlace(
 	} else {
 		ctx = context.Background()
 	}
-	_, span := tracer.Start(ctx, "get parking place")
+	ctx, span := tracer.Start(ctx, "get parking place")
 	defer span.End()
 
 	parkingPlace, err := serverApi.Database.GetById(in.Id)
@@ -75,6 +75,11 @@ func (serverApi *GRPCServer) GetParkingPlace(
 		return nil, status.Errorf(codes.NotFound, "parking place not found")
 	}
 
+	policy, err := serverApi.Database.GetCancellationPolicy(ctx, parkingPlace.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get cancellation policy")
+	}
+
 	name := ""
 	if parkingPlace.Name != nil {
 		name = *parkingPlace.Name
@@ -89,14 +94,21 @@ func (serverApi *GRPCServer) GetParkingPlace(
 	}
 
 	return &gen.ParkingPlaceResponse{
-		Id:          parkingPlace.ID,
-		Name:        name,
-		City:        city,
-		Address:     address,
-		ParkingType: parkingPlace.ParkingType,
-		HourlyRate:  parkingPlace.HourlyRate,
-		Capacity:    parkingPlace.Capacity,
-		OwnerId:     parkingPlace.OwnerID,
+		Id:                  parkingPlace.ID,
+		Name:                name,
+		City:                city,
+		Address:             address,
+		ParkingType:         parkingPlace.ParkingType,
+		HourlyRate:          parkingPlace.HourlyRate,
+		Capacity:            parkingPlace.Capacity,
+		OwnerId:             parkingPlace.OwnerID,
+		Status:              parkingPlace.Status,
+		AllowedVehicleSizes: parkingPlace.AllowedVehicleSizes,
+		CancellationPolicy: &gen.CancellationPolicy{
+			Version:                    policy.Version,
+			FreeCancellationHours:      policy.FreeCancellationHours,
+			LateCancellationFeePercent: policy.LateCancellationFeePercent,
+		},
 	}, nil
 }
 
@@ -0,0 +1,22 @@
+package utils
+
+import (
+	"os"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func ConnectToBooking() (*grpc.ClientConn, error) {
+	address := os.Getenv("BOOKING_GRPC_ADDRESS")
+	if address == "" {
+		address = "booking:50053"
+	}
+
+	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+
+	return conn, nil
+}
@@ -0,0 +1,197 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/h4x4d/parking_net/parking/internal/models"
+	"github.com/h4x4d/parking_net/parking/internal/restapi/operations/parking"
+	"github.com/h4x4d/parking_net/parking/internal/utils"
+	"github.com/h4x4d/parking_net/pkg/domain"
+	"github.com/h4x4d/parking_net/pkg/errors"
+)
+
+func (h *ParkingHandler) SuspendParking(params parking.SuspendParkingParams, principal *models.User) middleware.Responder {
+	var responder middleware.Responder
+	defer utils.CatchPanic(&responder)
+
+	ctx, span := h.tracer.Start(context.Background(), "suspend_parking")
+	defer span.End()
+	traceID := fmt.Sprintf("%s", span.SpanContext().TraceID())
+
+	if principal == nil {
+		errCode := int64(403)
+		slog.Error("failed to suspend parking",
+			slog.String("trace_id", traceID),
+			slog.Int64("parking_id", params.ParkingID),
+			slog.Int("status_code", 403),
+			slog.String("error", "user not authenticated"),
+		)
+		responder = parking.NewSuspendParkingForbidden().WithPayload(&models.Error{
+			ErrorMessage:    "User not authenticated",
+			ErrorStatusCode: &errCode,
+		})
+		return responder
+	}
+
+	id := params.ParkingID
+	domainUser := ToDomainUser(principal)
+
+	reason := ""
+	if params.Object != nil {
+		reason = params.Object.Reason
+	}
+	closure, appErr := h.closures.CloseParking(ctx, id, domain.ClosureSuspend, reason, domainUser)
+	if appErr != nil {
+		responder = h.handleOperationError(appErr, "failed to suspend parking", traceID, domainUser.ID,
+			map[int]func(*models.Error) middleware.Responder{
+				404: func(errorModel *models.Error) middleware.Responder {
+					return parking.NewSuspendParkingNotFound().WithPayload(errorModel)
+				},
+				403: func(errorModel *models.Error) middleware.Responder {
+					return parking.NewSuspendParkingForbidden().WithPayload(errorModel)
+				},
+			})
+		return responder
+	}
+
+	slog.Info("parking suspended",
+		slog.String("trace_id", traceID),
+		slog.Int64("parking_id", id),
+		slog.String("user_id", domainUser.ID),
+		slog.Int64("closure_id", closure.ID),
+		slog.String("step", string(closure.Step)),
+	)
+
+	responder = parking.NewSuspendParkingOK().WithPayload(ToAPIParkingClosure(closure))
+	return responder
+}
+
+func (h *ParkingHandler) ResumeParking(params parking.ResumeParkingParams, principal *models.User) middleware.Responder {
+	var responder middleware.Responder
+	defer utils.CatchPanic(&responder)
+
+	ctx, span := h.tracer.Start(context.Background(), "resume_parking")
+	defer span.End()
+	traceID := fmt.Sprintf("%s", span.SpanContext().TraceID())
+
+	if principal == nil {
+		errCode := int64(403)
+		slog.Error("failed to resume parking",
+			slog.String("trace_id", traceID),
+			slog.Int64("parking_id", params.ParkingID),
+			slog.Int("status_code", 403),
+			slog.String("error", "user not authenticated"),
+		)
+		responder = parking.NewResumeParkingForbidden().WithPayload(&models.Error{
+			ErrorMessage:    "User not authenticated",
+			ErrorStatusCode: &errCode,
+		})
+		return responder
+	}
+
+	id := params.ParkingID
+	domainUser := ToDomainUser(principal)
+
+	resumed, appErr := h.closures.ReopenParking(ctx, id, domainUser)
+	if appErr != nil {
+		responder = h.handleOperationError(appErr, "failed to resume parking", traceID, domainUser.ID,
+			map[int]func(*models.Error) middleware.Responder{
+				404: func(errorModel *models.Error) middleware.Responder {
+					return parking.NewResumeParkingNotFound().WithPayload(errorModel)
+				},
+				403: func(errorModel *models.Error) middleware.Responder {
+					return parking.NewResumeParkingForbidden().WithPayload(errorModel)
+				},
+				409: func(errorModel *models.Error) middleware.Responder {
+					return parking.NewResumeParkingConflict().WithPayload(errorModel)
+				},
+			})
+		return responder
+	}
+
+	slog.Info("parking resumed",
+		slog.String("trace_id", traceID),
+		slog.Int64("parking_id", id),
+		slog.String("user_id", domainUser.ID),
+	)
+
+	responder = parking.NewResumeParkingOK().WithPayload(ToAPIParking(resumed))
+	return responder
+}
+
+func (h *ParkingHandler) GetParkingClosure(params parking.GetParkingClosureParams, principal *models.User) middleware.Responder {
+	var responder middleware.Responder
+	defer utils.CatchPanic(&responder)
+
+	ctx, span := h.tracer.Start(context.Background(), "get_parking_closure")
+	defer span.End()
+	traceID := fmt.Sprintf("%s", span.SpanContext().TraceID())
+
+	if principal == nil {
+		errCode := int64(403)
+		slog.Error("failed to get parking closure",
+			slog.String("trace_id", traceID),
+			slog.Int64("parking_id", params.ParkingID),
+			slog.Int("status_code", 403),
+			slog.String("error", "user not authenticated"),
+		)
+		responder = parking.NewGetParkingClosureForbidden().WithPayload(&models.Error{
+			ErrorMessage:    "User not authenticated",
+			ErrorStatusCode: &errCode,
+		})
+		return responder
+	}
+
+	domainUser := ToDomainUser(principal)
+
+	closure, appErr := h.closures.GetClosure(ctx, params.ParkingID, domainUser)
+	if appErr != nil {
+		responder = h.handleOperationError(appErr, "failed to get parking closure", traceID, domainUser.ID,
+			map[int]func(*models.Error) middleware.Responder{
+				404: func(errorModel *models.Error) middleware.Responder {
+					return parking.NewGetParkingClosureNotFound().WithPayload(errorModel)
+				},
+				403: func(errorModel *models.Error) middleware.Responder {
+					return parking.NewGetParkingClosureForbidden().WithPayload(errorModel)
+				},
+			})
+		return responder
+	}
+
+	slog.Info("get parking closure",
+		slog.String("trace_id", traceID),
+		slog.Int64("parking_id", params.ParkingID),
+		slog.Int64("closure_id", closure.ID),
+		slog.Int("status_code", 200),
+	)
+
+	responder = parking.NewGetParkingClosureOK().WithPayload(ToAPIParkingClosure(closure))
+	return responder
+}
+
+// handleOperationError answers with the responder of the operation for the status
+// code; like the other operations without a 500 response, anything the
+// operation does not declare is answered as a 403.
+func (h *ParkingHandler) handleOperationError(appErr *errors.AppError, context string, traceID string, userID string,
+	responders map[int]func(*models.Error) middleware.Responder) middleware.Responder {
+	slog.Error(context,
+		slog.String("trace_id", traceID),
+		slog.String("user_id", userID),
+		slog.String("error", appErr.Error()),
+		slog.Int("status_code", appErr.Code),
+	)
+
+	statusCode := int64(appErr.Code)
+	errorModel := &models.Error{
+		ErrorMessage:    appErr.Message,
+		ErrorStatusCode: &statusCode,
+	}
+
+	if respond, ok := responders[appErr.Code]; ok {
+		return respond(errorModel)
+	}
+	return responders[403](errorModel)
+}
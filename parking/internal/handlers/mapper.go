@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"github.com/go-openapi/strfmt"
 	"github.com/h4x4d/parking_net/parking/internal/models"
 	"github.com/h4x4d/parking_net/pkg/domain"
 )
@@ -21,6 +22,8 @@ func ToDomainParking(api *models.ParkingPlace) *domain.ParkingPlace {
 	if api.ParkingType != "" {
 		p.Type = domain.ParkingType(api.ParkingType)
 	}
+	p.CancellationPolicy = ToDomainCancellationPolicy(api.CancellationPolicy)
+	p.AllowedVehicleSizes = ToDomainVehicleSizes(api.AllowedVehicleSizes)
 	
 	return p
 }
@@ -50,24 +53,114 @@ func ToDomainParkingUpdate(api *models.ParkingPlace) *domain.ParkingPlace {
 	if api.Capacity != 0 {
 		p.Capacity = int(api.Capacity)
 	}
+	p.CancellationPolicy = ToDomainCancellationPolicy(api.CancellationPolicy)
+	p.AllowedVehicleSizes = ToDomainVehicleSizes(api.AllowedVehicleSizes)
 	
 	return p
 }
 
+func ToDomainVehicleSizes(api []string) []domain.VehicleSize {
+	sizes := make([]domain.VehicleSize, 0, len(api))
+	for _, size := range api {
+		sizes = append(sizes, domain.VehicleSize(size))
+	}
+	return sizes
+}
+
+func ToDomainCancellationPolicy(api *models.CancellationPolicy) *domain.CancellationPolicy {
+	if api == nil {
+		return nil
+	}
+
+	return &domain.CancellationPolicy{
+		FreeCancellationHours:      api.FreeCancellationHours,
+		LateCancellationFeePercent: api.LateCancellationFeePercent,
+	}
+}
+
 func ToAPIParking(d *domain.ParkingPlace) *models.ParkingPlace {
 	if d == nil {
 		return nil
 	}
 	
 	return &models.ParkingPlace{
-		ID:          d.ID,
-		Name:        stringPtr(d.Name),
-		City:        stringPtr(d.City),
-		Address:     stringPtr(d.Address),
-		ParkingType: string(d.Type),
-		HourlyRate:  int64(d.HourlyRate),
-		Capacity:    int64(d.Capacity),
-		OwnerID:     d.OwnerID,
+		ID:                  d.ID,
+		Name:                stringPtr(d.Name),
+		City:                stringPtr(d.City),
+		Address:             stringPtr(d.Address),
+		ParkingType:         string(d.Type),
+		HourlyRate:          int64(d.HourlyRate),
+		Capacity:            int64(d.Capacity),
+		OwnerID:             d.OwnerID,
+		Status:              string(d.Status),
+		CancellationPolicy:  ToAPICancellationPolicy(d.CancellationPolicy),
+		AllowedVehicleSizes: ToAPIVehicleSizes(d.AllowedVehicleSizes),
+		Rating:              d.Rating,
+		ReviewCount:         d.ReviewCount,
+		Latitude:            latitude(d.Location),
+		Longitude:           longitude(d.Location),
+		DistanceM:           d.DistanceMeters,
+	}
+}
+
+// ToDomainLocation returns the location of the parking place, nil when the
+// request sets neither coordinate.
+func ToDomainLocation(api *models.ParkingPlace) (*domain.GeoPoint, error) {
+	return domain.NewGeoPoint(api.Latitude, api.Longitude)
+}
+
+func latitude(location *domain.GeoPoint) *float64 {
+	if location == nil {
+		return nil
+	}
+	return &location.Latitude
+}
+
+func longitude(location *domain.GeoPoint) *float64 {
+	if location == nil {
+		return nil
+	}
+	return &location.Longitude
+}
+
+func ToAPIVehicleSizes(d []domain.VehicleSize) []string {
+	sizes := make([]string, 0, len(d))
+	for _, size := range d {
+		sizes = append(sizes, string(size))
+	}
+	return sizes
+}
+
+func ToAPIParkingClosure(d *domain.ParkingClosure) *models.ParkingClosure {
+	if d == nil {
+		return nil
+	}
+
+	refunds := d.Refunds()
+	return &models.ParkingClosure{
+		ID:               d.ID,
+		ParkingPlaceID:   d.ParkingPlaceID,
+		Kind:             string(d.Kind),
+		Reason:           d.Reason,
+		Step:             string(d.Step),
+		LastError:        d.LastError,
+		BookingsCanceled: int64(len(d.Bookings)),
+		RefundsCompleted: int64(refunds[domain.RefundDone]),
+		RefundsDeclined:  int64(refunds[domain.RefundDeclined]),
+		CreatedAt:        strfmt.DateTime(d.CreatedAt),
+		UpdatedAt:        strfmt.DateTime(d.UpdatedAt),
+	}
+}
+
+func ToAPICancellationPolicy(d *domain.CancellationPolicy) *models.CancellationPolicy {
+	if d == nil {
+		return nil
+	}
+
+	return &models.CancellationPolicy{
+		Version:                    d.Version,
+		FreeCancellationHours:      d.FreeCancellationHours,
+		LateCancellationFeePercent: d.LateCancellationFeePercent,
 	}
 }
 
@@ -79,6 +172,54 @@ func ToAPIParkingList(domains []*domain.ParkingPlace) []*models.ParkingPlace {
 	return result
 }
 
+func ToDomainReview(api *models.ReviewRequest) *domain.Review {
+	if api == nil {
+		return nil
+	}
+
+	review := &domain.Review{Text: api.Text}
+	if api.BookingID != nil {
+		review.BookingID = *api.BookingID
+	}
+	if api.Rating != nil {
+		review.Rating = *api.Rating
+	}
+	return review
+}
+
+func ToAPIReview(d *domain.Review) *models.Review {
+	if d == nil {
+		return nil
+	}
+
+	review := &models.Review{
+		ID:               d.ID,
+		ParkingPlaceID:   d.ParkingPlaceID,
+		BookingID:        d.BookingID,
+		UserID:           d.UserID,
+		Rating:           d.Rating,
+		Text:             d.Text,
+		Reply:            d.Reply,
+		Status:           string(d.Status),
+		ModerationReason: d.ModerationReason,
+		CreatedAt:        strfmt.DateTime(d.CreatedAt),
+		UpdatedAt:        strfmt.DateTime(d.UpdatedAt),
+	}
+	if d.RepliedAt != nil {
+		repliedAt := strfmt.DateTime(*d.RepliedAt)
+		review.RepliedAt = &repliedAt
+	}
+	return review
+}
+
+func ToAPIReviewList(domains []*domain.Review) []*models.Review {
+	result := make([]*models.Review, 0, len(domains))
+	for _, d := range domains {
+		result = append(result, ToAPIReview(d))
+	}
+	return result
+}
+
 func ToDomainUser(api *models.User) *domain.User {
 	if api == nil {
 		return nil
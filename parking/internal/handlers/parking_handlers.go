@@ -18,19 +18,24 @@ import (
 )
 
 type ParkingHandler struct {
-	service *service.ParkingService
-	tracer  trace.Tracer
+	service  *service.ParkingService
+	closures *service.ClosureService
+	reviews  *service.ReviewService
+	tracer   trace.Tracer
 }
 
-func NewParkingHandler(svc *service.ParkingService) (*ParkingHandler, error) {
+func NewParkingHandler(svc *service.ParkingService, closures *service.ClosureService,
+	reviews *service.ReviewService) (*ParkingHandler, error) {
 	tracer, err := jaeger.InitTracer("Parking")
 	if err != nil {
 		return nil, err
 	}
 
 	return &ParkingHandler{
-		service: svc,
-		tracer:  tracer,
+		service:  svc,
+		closures: closures,
+		reviews:  reviews,
+		tracer:   tracer,
 	}, nil
 }
 
@@ -78,6 +83,13 @@ func (h *ParkingHandler) CreateParking(params parking.CreateParkingParams, princ
 	domainParking := ToDomainParking(params.Object)
 	domainUser := ToDomainUser(principal)
 
+	location, err := ToDomainLocation(params.Object)
+	if err != nil {
+		responder = h.handleError(errors.Validation(err.Error()), "failed to create parking", traceID, domainUser.ID)
+		return responder
+	}
+	domainParking.Location = location
+
 	created, appErr := h.service.CreateParking(ctx, domainParking, domainUser)
 	if appErr != nil {
 		responder = h.handleError(appErr, "failed to create parking", traceID, domainUser.ID)
@@ -145,7 +157,11 @@ func (h *ParkingHandler) GetParkings(params parking.GetParkingsParams) middlewar
 	defer span.End()
 	traceID := fmt.Sprintf("%s", span.SpanContext().TraceID())
 
-	filters := h.buildFilters(params)
+	filters, err := h.buildFilters(params)
+	if err != nil {
+		responder = h.handleError(errors.Validation(err.Error()), "failed to get parkings", traceID, "")
+		return responder
+	}
 
 	parkings, appErr := h.service.GetParkings(ctx, filters)
 	if appErr != nil {
@@ -206,6 +222,13 @@ func (h *ParkingHandler) UpdateParking(params parking.UpdateParkingParams, princ
 	domainParking := ToDomainParkingUpdate(params.Object)
 	domainUser := ToDomainUser(principal)
 
+	location, err := ToDomainLocation(params.Object)
+	if err != nil {
+		responder = h.handleUpdateError(errors.Validation(err.Error()), "failed to update parking", traceID, domainUser.ID)
+		return responder
+	}
+	domainParking.Location = location
+
 	appErr := h.service.UpdateParking(ctx, id, domainParking, domainUser)
 	if appErr != nil {
 		responder = h.handleUpdateError(appErr, "failed to update parking", traceID, domainUser.ID)
@@ -254,7 +277,11 @@ func (h *ParkingHandler) DeleteParking(params parking.DeleteParkingParams, princ
 	id := params.ParkingID
 	domainUser := ToDomainUser(principal)
 
-	appErr := h.service.DeleteParking(ctx, id, domainUser)
+	reason := ""
+	if params.Reason != nil {
+		reason = *params.Reason
+	}
+	closure, appErr := h.closures.CloseParking(ctx, id, domain.ClosureDelete, reason, domainUser)
 	if appErr != nil {
 		responder = h.handleDeleteError(appErr, "failed to delete parking", traceID, domainUser.ID)
 		return responder
@@ -264,8 +291,19 @@ func (h *ParkingHandler) DeleteParking(params parking.DeleteParkingParams, princ
 		slog.String("trace_id", traceID),
 		slog.Int64("parking_id", id),
 		slog.String("user_id", domainUser.ID),
+		slog.Int64("closure_id", closure.ID),
+		slog.String("step", string(closure.Step)),
 	)
 
+	if closure.Step != domain.ClosureStepDone {
+		responder = parking.NewDeleteParkingOK().WithPayload(&models.Result{
+			Status: "pending",
+			Message: fmt.Sprintf("Parking place %d no longer takes bookings and is deleted once its bookings are settled",
+				id),
+		})
+		return responder
+	}
+
 	responder = parking.NewDeleteParkingOK().WithPayload(&models.Result{
 		Status:  "success",
 		Message: fmt.Sprintf("Parking place %d deleted successfully", id),
@@ -273,7 +311,7 @@ func (h *ParkingHandler) DeleteParking(params parking.DeleteParkingParams, princ
 	return responder
 }
 
-func (h *ParkingHandler) buildFilters(params parking.GetParkingsParams) repository.ParkingFilters {
+func (h *ParkingHandler) buildFilters(params parking.GetParkingsParams) (repository.ParkingFilters, error) {
 	filters := repository.ParkingFilters{}
 
 	if params.City != nil {
@@ -289,8 +327,23 @@ func (h *ParkingHandler) buildFilters(params parking.GetParkingsParams) reposito
 	if params.OwnerID != nil {
 		filters.OwnerID = params.OwnerID
 	}
+	if params.MinRating != nil {
+		filters.MinRating = params.MinRating
+	}
+	if params.Sort != nil {
+		filters.Sort = repository.ParkingSort(*params.Sort)
+	}
+
+	near, err := domain.NewGeoPoint(params.Lat, params.Lon)
+	if err != nil {
+		return filters, err
+	}
+	filters.Near = near
+	if params.RadiusM != nil {
+		filters.RadiusMeters = *params.RadiusM
+	}
 
-	return filters
+	return filters, nil
 }
 
 func (h *ParkingHandler) handleError(appErr *errors.AppError, context string, traceID string, userID string) middleware.Responder {
@@ -342,6 +395,8 @@ func (h *ParkingHandler) handleUpdateError(appErr *errors.AppError, context stri
 		return parking.NewUpdateParkingBadRequest().WithPayload(errorModel)
 	case 403:
 		return parking.NewUpdateParkingForbidden().WithPayload(errorModel)
+	case 409:
+		return parking.NewUpdateParkingConflict().WithPayload(errorModel)
 	default:
 		return parking.NewUpdateParkingBadRequest().WithPayload(errorModel)
 	}
@@ -0,0 +1,252 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/h4x4d/parking_net/parking/internal/models"
+	"github.com/h4x4d/parking_net/parking/internal/restapi/operations/parking"
+	"github.com/h4x4d/parking_net/parking/internal/utils"
+	"github.com/h4x4d/parking_net/pkg/domain"
+)
+
+func (h *ParkingHandler) GetParkingReviews(params parking.GetParkingReviewsParams) middleware.Responder {
+	var responder middleware.Responder
+	defer utils.CatchPanic(&responder)
+
+	ctx, span := h.tracer.Start(context.Background(), "get_parking_reviews")
+	defer span.End()
+	traceID := fmt.Sprintf("%s", span.SpanContext().TraceID())
+
+	var limit, offset int64
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	if params.Offset != nil {
+		offset = *params.Offset
+	}
+
+	reviews, appErr := h.reviews.GetReviews(ctx, params.ParkingID, limit, offset)
+	if appErr != nil {
+		if appErr.Code == 404 {
+			statusCode := int64(404)
+			slog.Error("failed to get parking reviews",
+				slog.String("trace_id", traceID),
+				slog.Int64("parking_id", params.ParkingID),
+				slog.Int("status_code", 404),
+				slog.String("error", appErr.Message),
+			)
+			responder = parking.NewGetParkingReviewsNotFound().WithPayload(&models.Error{
+				ErrorMessage:    appErr.Message,
+				ErrorStatusCode: &statusCode,
+			})
+			return responder
+		}
+		responder = h.handleError(appErr, "failed to get parking reviews", traceID, "")
+		return responder
+	}
+
+	slog.Info("get parking reviews",
+		slog.String("trace_id", traceID),
+		slog.Int64("parking_id", params.ParkingID),
+		slog.Int("count", len(reviews)),
+		slog.Int("status_code", 200),
+	)
+
+	responder = parking.NewGetParkingReviewsOK().WithPayload(ToAPIReviewList(reviews))
+	return responder
+}
+
+func (h *ParkingHandler) CreateParkingReview(params parking.CreateParkingReviewParams, principal *models.User) middleware.Responder {
+	var responder middleware.Responder
+	defer utils.CatchPanic(&responder)
+
+	ctx, span := h.tracer.Start(context.Background(), "create_parking_review")
+	defer span.End()
+	traceID := fmt.Sprintf("%s", span.SpanContext().TraceID())
+
+	if principal == nil {
+		errCode := int64(403)
+		slog.Error("failed to create parking review",
+			slog.String("trace_id", traceID),
+			slog.Int64("parking_id", params.ParkingID),
+			slog.Int("status_code", 403),
+			slog.String("error", "user not authenticated"),
+		)
+		responder = parking.NewCreateParkingReviewForbidden().WithPayload(&models.Error{
+			ErrorMessage:    "User not authenticated",
+			ErrorStatusCode: &errCode,
+		})
+		return responder
+	}
+
+	if params.Object == nil {
+		errCode := int64(400)
+		slog.Error("failed to create parking review",
+			slog.String("trace_id", traceID),
+			slog.Int64("parking_id", params.ParkingID),
+			slog.String("user_id", principal.UserID),
+			slog.Int("status_code", 400),
+			slog.String("error", "missing request body"),
+		)
+		responder = parking.NewCreateParkingReviewBadRequest().WithPayload(&models.Error{
+			ErrorMessage:    "Invalid request: missing required fields",
+			ErrorStatusCode: &errCode,
+		})
+		return responder
+	}
+
+	domainUser := ToDomainUser(principal)
+
+	review, appErr := h.reviews.CreateReview(ctx, params.ParkingID, ToDomainReview(params.Object), domainUser)
+	if appErr != nil {
+		responder = h.handleOperationError(appErr, "failed to create parking review", traceID, domainUser.ID,
+			map[int]func(*models.Error) middleware.Responder{
+				400: func(errorModel *models.Error) middleware.Responder {
+					return parking.NewCreateParkingReviewBadRequest().WithPayload(errorModel)
+				},
+				403: func(errorModel *models.Error) middleware.Responder {
+					return parking.NewCreateParkingReviewForbidden().WithPayload(errorModel)
+				},
+				404: func(errorModel *models.Error) middleware.Responder {
+					return parking.NewCreateParkingReviewNotFound().WithPayload(errorModel)
+				},
+				409: func(errorModel *models.Error) middleware.Responder {
+					return parking.NewCreateParkingReviewConflict().WithPayload(errorModel)
+				},
+			})
+		return responder
+	}
+
+	slog.Info("parking review created",
+		slog.String("trace_id", traceID),
+		slog.Int64("parking_id", params.ParkingID),
+		slog.Int64("review_id", review.ID),
+		slog.Int64("booking_id", review.BookingID),
+		slog.String("user_id", domainUser.ID),
+	)
+
+	responder = parking.NewCreateParkingReviewOK().WithPayload(ToAPIReview(review))
+	return responder
+}
+
+func (h *ParkingHandler) ReplyParkingReview(params parking.ReplyParkingReviewParams, principal *models.User) middleware.Responder {
+	var responder middleware.Responder
+	defer utils.CatchPanic(&responder)
+
+	ctx, span := h.tracer.Start(context.Background(), "reply_parking_review")
+	defer span.End()
+	traceID := fmt.Sprintf("%s", span.SpanContext().TraceID())
+
+	if principal == nil {
+		errCode := int64(403)
+		slog.Error("failed to reply to parking review",
+			slog.String("trace_id", traceID),
+			slog.Int64("review_id", params.ReviewID),
+			slog.Int("status_code", 403),
+			slog.String("error", "user not authenticated"),
+		)
+		responder = parking.NewReplyParkingReviewForbidden().WithPayload(&models.Error{
+			ErrorMessage:    "User not authenticated",
+			ErrorStatusCode: &errCode,
+		})
+		return responder
+	}
+
+	reply := ""
+	if params.Object != nil && params.Object.Reply != nil {
+		reply = *params.Object.Reply
+	}
+	domainUser := ToDomainUser(principal)
+
+	review, appErr := h.reviews.ReplyToReview(ctx, params.ParkingID, params.ReviewID, reply, domainUser)
+	if appErr != nil {
+		responder = h.handleOperationError(appErr, "failed to reply to parking review", traceID, domainUser.ID,
+			map[int]func(*models.Error) middleware.Responder{
+				400: func(errorModel *models.Error) middleware.Responder {
+					return parking.NewReplyParkingReviewBadRequest().WithPayload(errorModel)
+				},
+				403: func(errorModel *models.Error) middleware.Responder {
+					return parking.NewReplyParkingReviewForbidden().WithPayload(errorModel)
+				},
+				404: func(errorModel *models.Error) middleware.Responder {
+					return parking.NewReplyParkingReviewNotFound().WithPayload(errorModel)
+				},
+			})
+		return responder
+	}
+
+	slog.Info("parking review replied",
+		slog.String("trace_id", traceID),
+		slog.Int64("parking_id", params.ParkingID),
+		slog.Int64("review_id", review.ID),
+		slog.String("user_id", domainUser.ID),
+	)
+
+	responder = parking.NewReplyParkingReviewOK().WithPayload(ToAPIReview(review))
+	return responder
+}
+
+func (h *ParkingHandler) ModerateParkingReview(params parking.ModerateParkingReviewParams, principal *models.User) middleware.Responder {
+	var responder middleware.Responder
+	defer utils.CatchPanic(&responder)
+
+	ctx, span := h.tracer.Start(context.Background(), "moderate_parking_review")
+	defer span.End()
+	traceID := fmt.Sprintf("%s", span.SpanContext().TraceID())
+
+	if principal == nil {
+		errCode := int64(403)
+		slog.Error("failed to moderate parking review",
+			slog.String("trace_id", traceID),
+			slog.Int64("review_id", params.ReviewID),
+			slog.Int("status_code", 403),
+			slog.String("error", "user not authenticated"),
+		)
+		responder = parking.NewModerateParkingReviewForbidden().WithPayload(&models.Error{
+			ErrorMessage:    "User not authenticated",
+			ErrorStatusCode: &errCode,
+		})
+		return responder
+	}
+
+	var status domain.ReviewStatus
+	reason := ""
+	if params.Object != nil {
+		if params.Object.Status != nil {
+			status = domain.ReviewStatus(*params.Object.Status)
+		}
+		reason = params.Object.Reason
+	}
+	domainUser := ToDomainUser(principal)
+
+	review, appErr := h.reviews.ModerateReview(ctx, params.ParkingID, params.ReviewID, status, reason, domainUser)
+	if appErr != nil {
+		responder = h.handleOperationError(appErr, "failed to moderate parking review", traceID, domainUser.ID,
+			map[int]func(*models.Error) middleware.Responder{
+				400: func(errorModel *models.Error) middleware.Responder {
+					return parking.NewModerateParkingReviewBadRequest().WithPayload(errorModel)
+				},
+				403: func(errorModel *models.Error) middleware.Responder {
+					return parking.NewModerateParkingReviewForbidden().WithPayload(errorModel)
+				},
+				404: func(errorModel *models.Error) middleware.Responder {
+					return parking.NewModerateParkingReviewNotFound().WithPayload(errorModel)
+				},
+			})
+		return responder
+	}
+
+	slog.Info("parking review moderated",
+		slog.String("trace_id", traceID),
+		slog.Int64("parking_id", params.ParkingID),
+		slog.Int64("review_id", review.ID),
+		slog.String("status", string(review.Status)),
+		slog.String("user_id", domainUser.ID),
+	)
+
+	responder = parking.NewModerateParkingReviewOK().WithPayload(ToAPIReview(review))
+	return responder
+}
@@ -8,6 +8,7 @@ package models
 import (
 	"context"
 	"encoding/json"
+	"strconv"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
@@ -25,6 +26,12 @@ type ParkingPlace struct {
 	// Required: true
 	Address *string `json:"address"`
 
+	// vehicle size classes the place takes; empty takes every size
+	AllowedVehicleSizes []string `json:"allowed_vehicle_sizes,omitempty"`
+
+	// cancellation policy
+	CancellationPolicy *CancellationPolicy `json:"cancellation_policy,omitempty"`
+
 	// total number of parking spots
 	Capacity int64 `json:"capacity,omitempty"`
 
@@ -33,12 +40,27 @@ type ParkingPlace struct {
 	// Required: true
 	City *string `json:"city"`
 
+	// distance in meters from lat and lon of a nearby search
+	DistanceM *float64 `json:"distance_m,omitempty"`
+
 	// hourly parking rate
 	HourlyRate int64 `json:"hourly_rate,omitempty"`
 
 	// id
 	ID int64 `json:"id,omitempty"`
 
+	// WGS 84 latitude in degrees, set together with longitude
+	// Example: 55.7539
+	// Maximum: 90
+	// Minimum: -90
+	Latitude *float64 `json:"latitude,omitempty"`
+
+	// WGS 84 longitude in degrees, set together with latitude
+	// Example: 37.6208
+	// Maximum: 180
+	// Minimum: -180
+	Longitude *float64 `json:"longitude,omitempty"`
+
 	// name
 	// Example: Central Parking
 	// Required: true
@@ -50,6 +72,16 @@ type ParkingPlace struct {
 	// type of parking facility
 	// Enum: ["outdoor","covered","underground","multi-level"]
 	ParkingType string `json:"parking_type,omitempty"`
+
+	// average rating of the published reviews, 0 without any
+	Rating float64 `json:"rating,omitempty"`
+
+	// number of published reviews
+	ReviewCount int64 `json:"review_count,omitempty"`
+
+	// only Active parking places take bookings
+	// Enum: ["Active","Suspended","Closing"]
+	Status string `json:"status,omitempty"`
 }
 
 // Validate validates this parking place
@@ -60,10 +92,26 @@ func (m *ParkingPlace) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateAllowedVehicleSizes(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCancellationPolicy(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateCity(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateLatitude(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateLongitude(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateName(formats); err != nil {
 		res = append(res, err)
 	}
@@ -72,6 +120,10 @@ func (m *ParkingPlace) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -87,6 +139,61 @@ func (m *ParkingPlace) validateAddress(formats strfmt.Registry) error {
 	return nil
 }
 
+var parkingPlaceAllowedVehicleSizesItemsEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["motorcycle","small","medium","large"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		parkingPlaceAllowedVehicleSizesItemsEnum = append(parkingPlaceAllowedVehicleSizesItemsEnum, v)
+	}
+}
+
+func (m *ParkingPlace) validateAllowedVehicleSizesItemsEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, parkingPlaceAllowedVehicleSizesItemsEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ParkingPlace) validateAllowedVehicleSizes(formats strfmt.Registry) error {
+	if swag.IsZero(m.AllowedVehicleSizes) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.AllowedVehicleSizes); i++ {
+
+		// value enum
+		if err := m.validateAllowedVehicleSizesItemsEnum("allowed_vehicle_sizes"+"."+strconv.Itoa(i), "body", m.AllowedVehicleSizes[i]); err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+func (m *ParkingPlace) validateCancellationPolicy(formats strfmt.Registry) error {
+	if swag.IsZero(m.CancellationPolicy) { // not required
+		return nil
+	}
+
+	if m.CancellationPolicy != nil {
+		if err := m.CancellationPolicy.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("cancellation_policy")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("cancellation_policy")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *ParkingPlace) validateCity(formats strfmt.Registry) error {
 
 	if err := validate.Required("city", "body", m.City); err != nil {
@@ -96,6 +203,38 @@ func (m *ParkingPlace) validateCity(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *ParkingPlace) validateLatitude(formats strfmt.Registry) error {
+	if swag.IsZero(m.Latitude) { // not required
+		return nil
+	}
+
+	if err := validate.Minimum("latitude", "body", *m.Latitude, -90, false); err != nil {
+		return err
+	}
+
+	if err := validate.Maximum("latitude", "body", *m.Latitude, 90, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ParkingPlace) validateLongitude(formats strfmt.Registry) error {
+	if swag.IsZero(m.Longitude) { // not required
+		return nil
+	}
+
+	if err := validate.Minimum("longitude", "body", *m.Longitude, -180, false); err != nil {
+		return err
+	}
+
+	if err := validate.Maximum("longitude", "body", *m.Longitude, 180, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *ParkingPlace) validateName(formats strfmt.Registry) error {
 
 	if err := validate.Required("name", "body", m.Name); err != nil {
@@ -153,8 +292,83 @@ func (m *ParkingPlace) validateParkingType(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this parking place based on context it is used
+var parkingPlaceTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["Active","Suspended","Closing"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		parkingPlaceTypeStatusPropEnum = append(parkingPlaceTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// ParkingPlaceStatusActive captures enum value "Active"
+	ParkingPlaceStatusActive string = "Active"
+
+	// ParkingPlaceStatusSuspended captures enum value "Suspended"
+	ParkingPlaceStatusSuspended string = "Suspended"
+
+	// ParkingPlaceStatusClosing captures enum value "Closing"
+	ParkingPlaceStatusClosing string = "Closing"
+)
+
+// prop value enum
+func (m *ParkingPlace) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, parkingPlaceTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ParkingPlace) validateStatus(formats strfmt.Registry) error {
+	if swag.IsZero(m.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateStatusEnum("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this parking place based on the context it is used
 func (m *ParkingPlace) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateCancellationPolicy(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ParkingPlace) contextValidateCancellationPolicy(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.CancellationPolicy != nil {
+
+		if swag.IsZero(m.CancellationPolicy) { // not required
+			return nil
+		}
+
+		if err := m.CancellationPolicy.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("cancellation_policy")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("cancellation_policy")
+			}
+			return err
+		}
+	}
+
 	return nil
 }
 
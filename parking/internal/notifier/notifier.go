@@ -0,0 +1,52 @@
+package notifier
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/h4x4d/parking_net/pkg/client"
+	pkg_models "github.com/h4x4d/parking_net/pkg/models"
+	"github.com/h4x4d/parking_net/pkg/notification"
+)
+
+// Notifier sends Telegram notifications to users through the notification
+// service.
+type Notifier struct {
+	kafkaConn *notification.KafkaConnection
+	keyCloak  *client.Client
+}
+
+// NewNotifier connects to Kafka and Keycloak from the environment. Without
+// either of them notifications are dropped with a warning.
+func NewNotifier() *Notifier {
+	conn, err := notification.NewEnvKafkaConnection()
+	if err != nil {
+		slog.Warn("failed to connect to Kafka, notifications are disabled", "error", err)
+		conn = nil
+	}
+	keyCloak, err := client.NewClient()
+	if err != nil {
+		slog.Warn("failed to initialize Keycloak client, notifications are disabled", "error", err)
+		keyCloak = nil
+	}
+	return &Notifier{kafkaConn: conn, keyCloak: keyCloak}
+}
+
+// Notify sends the notification to the user. Users whose Telegram ID cannot be
+// found are skipped, so only a failed send is reported.
+func (n *Notifier) Notify(ctx context.Context, userID string, name string, text string) error {
+	if n.kafkaConn == nil || n.keyCloak == nil {
+		slog.Warn("notifications are not available, dropping notification", "user_id", userID, "name", name)
+		return nil
+	}
+	tgId, err := n.keyCloak.GetTelegramId(ctx, userID)
+	if err != nil || tgId <= 0 {
+		slog.Warn("failed to get telegram ID, skipping notification", "user_id", userID, "error", err)
+		return nil
+	}
+	return n.kafkaConn.SendNotification(pkg_models.Notification{
+		Name:       name,
+		Text:       text,
+		TelegramID: tgId,
+	})
+}
@@ -0,0 +1,265 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/h4x4d/parking_net/pkg/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const closureColumns = `SELECT id, parking_place_id, owner_id, kind, reason, step, COALESCE(last_error, ''),
+		created_at, updated_at FROM parking_closures`
+
+type PostgresClosureRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewPostgresClosureRepository(pool *pgxpool.Pool) ClosureRepository {
+	return &PostgresClosureRepository{pool: pool}
+}
+
+func (r *PostgresClosureRepository) Start(ctx context.Context, parkingPlaceID int64, kind domain.ClosureKind,
+	reason string) (*domain.ParkingClosure, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var ownerID string
+	err = tx.QueryRow(ctx, "SELECT COALESCE(owner_id, '') FROM parking_places WHERE id = $1 FOR UPDATE",
+		parkingPlaceID).Scan(&ownerID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrParkingNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock parking place: %w", err)
+	}
+
+	closure, err := scanClosure(tx.QueryRow(ctx,
+		closureColumns+" WHERE parking_place_id = $1 AND step <> 'Done'", parkingPlaceID))
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		closure, err = scanClosure(tx.QueryRow(ctx,
+			`INSERT INTO parking_closures (parking_place_id, owner_id, kind, reason) VALUES ($1, $2, $3, $4)
+			RETURNING id, parking_place_id, owner_id, kind, reason, step, '', created_at, updated_at`,
+			parkingPlaceID, ownerID, string(kind), reason))
+		if err != nil {
+			return nil, fmt.Errorf("failed to start closure: %w", err)
+		}
+	case err != nil:
+		return nil, fmt.Errorf("failed to get open closure: %w", err)
+	case kind == domain.ClosureDelete && closure.Kind != domain.ClosureDelete:
+		_, err = tx.Exec(ctx,
+			"UPDATE parking_closures SET kind = $2, reason = $3, updated_at = CURRENT_TIMESTAMP WHERE id = $1",
+			closure.ID, string(kind), reason)
+		if err != nil {
+			return nil, fmt.Errorf("failed to turn closure into a delete: %w", err)
+		}
+		closure.Kind = kind
+		closure.Reason = reason
+	}
+
+	status := domain.ParkingStatusSuspended
+	if closure.Kind == domain.ClosureDelete {
+		status = domain.ParkingStatusClosing
+	}
+	if _, err := tx.Exec(ctx, "UPDATE parking_places SET status = $2 WHERE id = $1", parkingPlaceID,
+		string(status)); err != nil {
+		return nil, fmt.Errorf("failed to update parking place status: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return r.GetByID(ctx, closure.ID)
+}
+
+func (r *PostgresClosureRepository) GetByID(ctx context.Context, id int64) (*domain.ParkingClosure, error) {
+	return r.getOne(ctx, closureColumns+" WHERE id = $1", id)
+}
+
+func (r *PostgresClosureRepository) GetLatest(ctx context.Context, parkingPlaceID int64) (*domain.ParkingClosure, error) {
+	return r.getOne(ctx, closureColumns+" WHERE parking_place_id = $1 ORDER BY id DESC LIMIT 1", parkingPlaceID)
+}
+
+func (r *PostgresClosureRepository) ListOpen(ctx context.Context) ([]*domain.ParkingClosure, error) {
+	rows, err := r.pool.Query(ctx, closureColumns+" WHERE step <> 'Done' ORDER BY id")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get open closures: %w", err)
+	}
+	closures := make([]*domain.ParkingClosure, 0)
+	for rows.Next() {
+		closure, err := scanClosure(rows)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+		closures = append(closures, closure)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, closure := range closures {
+		if closure.Bookings, err = r.getBookings(ctx, closure.ID); err != nil {
+			return nil, err
+		}
+	}
+	return closures, nil
+}
+
+func (r *PostgresClosureRepository) AddBookings(ctx context.Context, closureID int64,
+	bookings []*domain.ClosureBooking) error {
+	if len(bookings) == 0 {
+		return nil
+	}
+	batch := &pgx.Batch{}
+	for _, booking := range bookings {
+		batch.Queue(`INSERT INTO parking_closure_bookings (closure_id, booking_id, driver_id) VALUES ($1, $2, $3)
+			ON CONFLICT (closure_id, booking_id) DO NOTHING`,
+			closureID, booking.BookingID, booking.DriverID)
+	}
+	if err := r.pool.SendBatch(ctx, batch).Close(); err != nil {
+		return fmt.Errorf("failed to record closure bookings: %w", err)
+	}
+	return nil
+}
+
+func (r *PostgresClosureRepository) SetStep(ctx context.Context, closureID int64, step domain.ClosureStep) error {
+	_, err := r.pool.Exec(ctx,
+		"UPDATE parking_closures SET step = $2, last_error = NULL, updated_at = CURRENT_TIMESTAMP WHERE id = $1",
+		closureID, string(step))
+	if err != nil {
+		return fmt.Errorf("failed to advance closure: %w", err)
+	}
+	return nil
+}
+
+func (r *PostgresClosureRepository) SetError(ctx context.Context, closureID int64, cause error) error {
+	var message *string
+	if cause != nil {
+		text := cause.Error()
+		message = &text
+	}
+	_, err := r.pool.Exec(ctx,
+		"UPDATE parking_closures SET last_error = $2, updated_at = CURRENT_TIMESTAMP WHERE id = $1",
+		closureID, message)
+	if err != nil {
+		return fmt.Errorf("failed to record closure error: %w", err)
+	}
+	return nil
+}
+
+func (r *PostgresClosureRepository) SaveRefund(ctx context.Context, closureID int64, bookingID int64,
+	refund domain.RefundStatus, amount int64) error {
+	_, err := r.pool.Exec(ctx,
+		`UPDATE parking_closure_bookings SET refund = $3, refunded_amount = $4
+		WHERE closure_id = $1 AND booking_id = $2`,
+		closureID, bookingID, string(refund), amount)
+	if err != nil {
+		return fmt.Errorf("failed to record refund of booking %d: %w", bookingID, err)
+	}
+	return nil
+}
+
+func (r *PostgresClosureRepository) MarkNotified(ctx context.Context, closureID int64, bookingID int64) error {
+	_, err := r.pool.Exec(ctx,
+		"UPDATE parking_closure_bookings SET notified = TRUE WHERE closure_id = $1 AND booking_id = $2",
+		closureID, bookingID)
+	if err != nil {
+		return fmt.Errorf("failed to record notification of booking %d: %w", bookingID, err)
+	}
+	return nil
+}
+
+func (r *PostgresClosureRepository) Finish(ctx context.Context, closure *domain.ParkingClosure) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if closure.Kind == domain.ClosureDelete {
+		if _, err := tx.Exec(ctx, "DELETE FROM parking_places WHERE id = $1", closure.ParkingPlaceID); err != nil {
+			return fmt.Errorf("failed to delete parking place: %w", err)
+		}
+	}
+	_, err = tx.Exec(ctx,
+		"UPDATE parking_closures SET step = $2, last_error = NULL, updated_at = CURRENT_TIMESTAMP WHERE id = $1",
+		closure.ID, string(domain.ClosureStepDone))
+	if err != nil {
+		return fmt.Errorf("failed to finish closure: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+func (r *PostgresClosureRepository) Reopen(ctx context.Context, parkingPlaceID int64) (bool, error) {
+	result, err := r.pool.Exec(ctx,
+		`UPDATE parking_places SET status = $2 WHERE id = $1 AND status = $3
+		AND NOT EXISTS (SELECT 1 FROM parking_closures WHERE parking_place_id = $1 AND step <> 'Done')`,
+		parkingPlaceID, string(domain.ParkingStatusActive), string(domain.ParkingStatusSuspended))
+	if err != nil {
+		return false, fmt.Errorf("failed to reopen parking place: %w", err)
+	}
+	return result.RowsAffected() > 0, nil
+}
+
+func (r *PostgresClosureRepository) getOne(ctx context.Context, query string, args ...any) (*domain.ParkingClosure, error) {
+	closure, err := scanClosure(r.pool.QueryRow(ctx, query, args...))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get closure: %w", err)
+	}
+	if closure.Bookings, err = r.getBookings(ctx, closure.ID); err != nil {
+		return nil, err
+	}
+	return closure, nil
+}
+
+func (r *PostgresClosureRepository) getBookings(ctx context.Context, closureID int64) ([]*domain.ClosureBooking, error) {
+	rows, err := r.pool.Query(ctx,
+		`SELECT booking_id, driver_id, refund, refunded_amount, notified FROM parking_closure_bookings
+		WHERE closure_id = $1 ORDER BY booking_id`,
+		closureID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get closure bookings: %w", err)
+	}
+	defer rows.Close()
+
+	bookings := make([]*domain.ClosureBooking, 0)
+	for rows.Next() {
+		var booking domain.ClosureBooking
+		var refund string
+		if err := rows.Scan(&booking.BookingID, &booking.DriverID, &refund, &booking.RefundedAmount,
+			&booking.Notified); err != nil {
+			return nil, err
+		}
+		booking.Refund = domain.RefundStatus(refund)
+		bookings = append(bookings, &booking)
+	}
+	return bookings, rows.Err()
+}
+
+func scanClosure(row pgx.Row) (*domain.ParkingClosure, error) {
+	var closure domain.ParkingClosure
+	var kind, step string
+	err := row.Scan(&closure.ID, &closure.ParkingPlaceID, &closure.OwnerID, &kind, &closure.Reason, &step,
+		&closure.LastError, &closure.CreatedAt, &closure.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	closure.Kind = domain.ClosureKind(kind)
+	closure.Step = domain.ClosureStep(step)
+	return &closure, nil
+}
@@ -10,7 +10,6 @@ type ParkingRepository interface {
 	GetByID(ctx context.Context, id int64) (*domain.ParkingPlace, error)
 	GetAll(ctx context.Context, filters ParkingFilters) ([]*domain.ParkingPlace, error)
 	Update(ctx context.Context, parking *domain.ParkingPlace) error
-	Delete(ctx context.Context, id int64, ownerID string) error
 	Exists(ctx context.Context, id int64) (bool, error)
 	GetByOwnerID(ctx context.Context, ownerID string) ([]*domain.ParkingPlace, error)
 }
@@ -20,5 +19,70 @@ type ParkingFilters struct {
 	Name       *string
 	ParkingType *domain.ParkingType
 	OwnerID    *string
+	// MinRating keeps the places rated at least this; unrated places are left out.
+	MinRating *float64
+	// Near keeps the places within RadiusMeters of the point and sets their
+	// distance to it; places without a location are left out.
+	Near         *domain.GeoPoint
+	RadiusMeters float64
+	Sort         ParkingSort
 }
 
+// ParkingSort orders the parking places of a search; the zero value keeps the
+// database order.
+type ParkingSort string
+
+const (
+	// SortRatingAsc and SortRatingDesc order by rating and then by review
+	// count; places without reviews come last either way.
+	SortRatingAsc  ParkingSort = "rating"
+	SortRatingDesc ParkingSort = "-rating"
+	// SortDistance orders the places of a nearby search nearest first.
+	SortDistance ParkingSort = "distance"
+)
+
+
+// ClosureRepository stores parking closures and their progress. The parking
+// place row is locked while a closure starts or finishes, so its status always
+// matches the open closure.
+type ClosureRepository interface {
+	// Start marks the parking place Closing or Suspended and opens a closure of
+	// it. If the place already has an open closure, that one is returned, turned
+	// into a delete when kind asks for it. A missing place fails with
+	// domain.ErrParkingNotFound.
+	Start(ctx context.Context, parkingPlaceID int64, kind domain.ClosureKind, reason string) (*domain.ParkingClosure, error)
+	// GetByID returns nil without an error when the closure does not exist.
+	GetByID(ctx context.Context, id int64) (*domain.ParkingClosure, error)
+	// GetLatest returns the most recent closure of the parking place, or nil.
+	GetLatest(ctx context.Context, parkingPlaceID int64) (*domain.ParkingClosure, error)
+	ListOpen(ctx context.Context) ([]*domain.ParkingClosure, error)
+	// AddBookings records bookings to compensate, keeping those already recorded.
+	AddBookings(ctx context.Context, closureID int64, bookings []*domain.ClosureBooking) error
+	SetStep(ctx context.Context, closureID int64, step domain.ClosureStep) error
+	// SetError records why the closure stopped; nil clears it.
+	SetError(ctx context.Context, closureID int64, cause error) error
+	SaveRefund(ctx context.Context, closureID int64, bookingID int64, refund domain.RefundStatus, amount int64) error
+	MarkNotified(ctx context.Context, closureID int64, bookingID int64) error
+	// Finish marks the closure Done and deletes its parking place if it is a
+	// delete; a suspended place stays Suspended.
+	Finish(ctx context.Context, closure *domain.ParkingClosure) error
+	// Reopen makes a Suspended parking place without an open closure Active
+	// again, reporting false when there was no such place.
+	Reopen(ctx context.Context, parkingPlaceID int64) (bool, error)
+}
+
+// ReviewRepository stores the reviews of parking places. Ratings of parking
+// places are computed from the published reviews when places are read.
+type ReviewRepository interface {
+	// Create fails with domain.ErrReviewExists when the booking was reviewed.
+	Create(ctx context.Context, review *domain.Review) (*domain.Review, error)
+	// GetByID returns nil without an error when the review does not exist.
+	GetByID(ctx context.Context, id int64) (*domain.Review, error)
+	// ListPublished returns the published reviews of the parking place, newest
+	// first.
+	ListPublished(ctx context.Context, parkingPlaceID int64, limit int64, offset int64) ([]*domain.Review, error)
+	// SetReply stores the owner's reply, replacing an earlier one.
+	SetReply(ctx context.Context, id int64, reply string) (*domain.Review, error)
+	SetStatus(ctx context.Context, id int64, status domain.ReviewStatus, reason string,
+		moderatorID string) (*domain.Review, error)
+}
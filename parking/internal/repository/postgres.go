@@ -12,6 +12,38 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// parkingColumns selects a parking place together with its latest cancellation
+// policy, whose columns are NULL when the owner has not set one, the rating of
+// its published reviews, NULL when it has none, and the distance computed by
+// the %s expression. Use selectParkings to fill it in.
+const parkingColumns = `SELECT p.id, p.name, p.city, p.address, p.parking_type, p.hourly_rate, p.capacity, p.owner_id,
+		p.status, p.allowed_vehicle_sizes, p.latitude, p.longitude, cp.version, cp.free_cancellation_hours,
+		cp.late_cancellation_fee_percent, rv.rating, rv.review_count, d.distance_m
+		FROM parking_places p
+		LEFT JOIN LATERAL (
+			SELECT version, free_cancellation_hours, late_cancellation_fee_percent
+			FROM cancellation_policies WHERE parking_place_id = p.id
+			ORDER BY version DESC LIMIT 1
+		) cp ON TRUE
+		LEFT JOIN LATERAL (
+			SELECT ROUND(AVG(rating), 2)::FLOAT8 AS rating, COUNT(*) AS review_count
+			FROM parking_reviews WHERE parking_place_id = p.id AND status = 'Published'
+		) rv ON TRUE
+		CROSS JOIN LATERAL (SELECT %s AS distance_m) d`
+
+// haversineDistance is the distance in meters from the point $1, $2 (latitude
+// and longitude in degrees) to a parking place, NULL for places without a
+// location.
+const haversineDistance = `2 * 6371008.8 * ASIN(SQRT(LEAST(1,
+		POWER(SIN(RADIANS(p.latitude - $1) / 2), 2)
+		+ COS(RADIANS($1)) * COS(RADIANS(p.latitude)) * POWER(SIN(RADIANS(p.longitude - $2) / 2), 2))))`
+
+// selectParkings selects parking places as parkingColumns does, with distance
+// as the expression of their distance.
+func selectParkings(distance string) string {
+	return fmt.Sprintf(parkingColumns, distance)
+}
+
 type PostgresParkingRepository struct {
 	pool *pgxpool.Pool
 }
@@ -49,10 +81,18 @@ func (r *PostgresParkingRepository) Create(ctx context.Context, parking *domain.
 		return nil, fmt.Errorf("invalid capacity")
 	}
 
-	query := `INSERT INTO parking_places (name, city, address, parking_type, hourly_rate, capacity, owner_id)
-		VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id`
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction")
+	}
+	defer tx.Rollback(ctx)
+
+	latitude, longitude := coordinates(parking.Location)
+	query := `INSERT INTO parking_places (name, city, address, parking_type, hourly_rate, capacity, owner_id,
+		allowed_vehicle_sizes, latitude, longitude)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) RETURNING id`
 
-	err := r.pool.QueryRow(ctx, query,
+	err = tx.QueryRow(ctx, query,
 		parking.Name,
 		parking.City,
 		parking.Address,
@@ -60,33 +100,32 @@ func (r *PostgresParkingRepository) Create(ctx context.Context, parking *domain.
 		parking.HourlyRate,
 		parking.Capacity,
 		parking.OwnerID,
+		vehicleSizes(parking.AllowedVehicleSizes),
+		latitude,
+		longitude,
 	).Scan(&parking.ID)
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to create parking place")
 	}
 
+	if parking.CancellationPolicy != nil {
+		if err := savePolicy(ctx, tx, parking.ID, parking.CancellationPolicy); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to create parking place")
+	}
+
 	return parking, nil
 }
 
 func (r *PostgresParkingRepository) GetByID(ctx context.Context, id int64) (*domain.ParkingPlace, error) {
-	query := `SELECT id, name, city, address, parking_type, hourly_rate, capacity, owner_id
-		FROM parking_places WHERE id = $1`
-
-	var parking domain.ParkingPlace
-	var parkingType string
-
-	err := r.pool.QueryRow(ctx, query, id).Scan(
-		&parking.ID,
-		&parking.Name,
-		&parking.City,
-		&parking.Address,
-		&parkingType,
-		&parking.HourlyRate,
-		&parking.Capacity,
-		&parking.OwnerID,
-	)
+	query := selectParkings("NULL::FLOAT8") + ` WHERE p.id = $1`
 
+	parking, err := scanParking(r.pool.QueryRow(ctx, query, id))
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) || err.Error() == "no rows in result set" {
 			return nil, nil
@@ -94,43 +133,69 @@ func (r *PostgresParkingRepository) GetByID(ctx context.Context, id int64) (*dom
 		return nil, fmt.Errorf("failed to get parking place by id")
 	}
 
-	parking.Type = domain.ParkingType(parkingType)
-	return &parking, nil
+	return parking, nil
 }
 
 func (r *PostgresParkingRepository) GetAll(ctx context.Context, filters ParkingFilters) ([]*domain.ParkingPlace, error) {
-	query := `SELECT id, name, city, address, parking_type, hourly_rate, capacity, owner_id
-		FROM parking_places`
+	query := selectParkings("NULL::FLOAT8")
 
 	var clauses []string
 	var args []interface{}
 	argIndex := 1
 
+	if filters.Near != nil {
+		// The bounding box lets the location index skip far places before the
+		// exact distance is computed for the rest.
+		query = selectParkings(haversineDistance)
+		southWest, northEast := filters.Near.BoundingBox(filters.RadiusMeters)
+		clauses = append(clauses,
+			"p.latitude BETWEEN $3 AND $4",
+			"p.longitude BETWEEN $5 AND $6",
+			"d.distance_m <= $7")
+		args = append(args, filters.Near.Latitude, filters.Near.Longitude,
+			southWest.Latitude, northEast.Latitude, southWest.Longitude, northEast.Longitude, filters.RadiusMeters)
+		argIndex = len(args) + 1
+	}
+
 	if filters.City != nil {
-		clauses = append(clauses, fmt.Sprintf("city = $%d", argIndex))
+		clauses = append(clauses, fmt.Sprintf("p.city = $%d", argIndex))
 		args = append(args, *filters.City)
 		argIndex++
 	}
 	if filters.Name != nil {
-		clauses = append(clauses, fmt.Sprintf("name ILIKE $%d", argIndex))
+		clauses = append(clauses, fmt.Sprintf("p.name ILIKE $%d", argIndex))
 		args = append(args, "%"+*filters.Name+"%")
 		argIndex++
 	}
 	if filters.ParkingType != nil {
-		clauses = append(clauses, fmt.Sprintf("parking_type = $%d", argIndex))
+		clauses = append(clauses, fmt.Sprintf("p.parking_type = $%d", argIndex))
 		args = append(args, string(*filters.ParkingType))
 		argIndex++
 	}
 	if filters.OwnerID != nil {
-		clauses = append(clauses, fmt.Sprintf("owner_id = $%d", argIndex))
+		clauses = append(clauses, fmt.Sprintf("p.owner_id = $%d", argIndex))
 		args = append(args, *filters.OwnerID)
 		argIndex++
 	}
+	if filters.MinRating != nil {
+		clauses = append(clauses, fmt.Sprintf("rv.rating >= $%d", argIndex))
+		args = append(args, *filters.MinRating)
+		argIndex++
+	}
 
 	if len(clauses) > 0 {
 		query += " WHERE " + strings.Join(clauses, " AND ")
 	}
 
+	switch filters.Sort {
+	case SortRatingAsc:
+		query += " ORDER BY rv.rating ASC NULLS LAST, rv.review_count DESC, p.id"
+	case SortRatingDesc:
+		query += " ORDER BY rv.rating DESC NULLS LAST, rv.review_count DESC, p.id"
+	case SortDistance:
+		query += " ORDER BY d.distance_m, p.id"
+	}
+
 	rows, err := r.pool.Query(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query parking places")
@@ -139,25 +204,12 @@ func (r *PostgresParkingRepository) GetAll(ctx context.Context, filters ParkingF
 
 	var parkings []*domain.ParkingPlace
 	for rows.Next() {
-		var parking domain.ParkingPlace
-		var parkingType string
-
-		err := rows.Scan(
-			&parking.ID,
-			&parking.Name,
-			&parking.City,
-			&parking.Address,
-			&parkingType,
-			&parking.HourlyRate,
-			&parking.Capacity,
-			&parking.OwnerID,
-		)
+		parking, err := scanParking(rows)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan parking place")
 		}
 
-		parking.Type = domain.ParkingType(parkingType)
-		parkings = append(parkings, &parking)
+		parkings = append(parkings, parking)
 	}
 
 	if err := rows.Err(); err != nil {
@@ -212,11 +264,19 @@ func (r *PostgresParkingRepository) Update(ctx context.Context, parking *domain.
 		}
 	}
 
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update parking place")
+	}
+	defer tx.Rollback(ctx)
+
+	latitude, longitude := coordinates(parking.Location)
 	query := `UPDATE parking_places 
-		SET name = $1, city = $2, address = $3, parking_type = $4, hourly_rate = $5, capacity = $6
+		SET name = $1, city = $2, address = $3, parking_type = $4, hourly_rate = $5, capacity = $6,
+		allowed_vehicle_sizes = $9, latitude = $10, longitude = $11
 		WHERE id = $7 AND owner_id = $8`
 
-	result, err := r.pool.Exec(ctx, query,
+	result, err := tx.Exec(ctx, query,
 		parking.Name,
 		parking.City,
 		parking.Address,
@@ -225,6 +285,9 @@ func (r *PostgresParkingRepository) Update(ctx context.Context, parking *domain.
 		parking.Capacity,
 		parking.ID,
 		parking.OwnerID,
+		vehicleSizes(parking.AllowedVehicleSizes),
+		latitude,
+		longitude,
 	)
 
 	if err != nil {
@@ -235,6 +298,16 @@ func (r *PostgresParkingRepository) Update(ctx context.Context, parking *domain.
 		return fmt.Errorf("parking place not found or access denied")
 	}
 
+	if parking.CancellationPolicy != nil {
+		if err := savePolicy(ctx, tx, parking.ID, parking.CancellationPolicy); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to update parking place")
+	}
+
 	return nil
 }
 
@@ -254,17 +327,100 @@ func (r *PostgresParkingRepository) GetByOwnerID(ctx context.Context, ownerID st
 	return r.GetAll(ctx, ParkingFilters{OwnerID: &ownerID})
 }
 
-func (r *PostgresParkingRepository) Delete(ctx context.Context, id int64, ownerID string) error {
-	query := `DELETE FROM parking_places WHERE id = $1 AND owner_id = $2`
+// savePolicy stores policy as the next version of the parking place's
+// cancellation policy, unless it matches the latest version. The caller must
+// hold a lock on the parking place row, so concurrent saves cannot race for the
+// same version. policy.Version is set to the version in effect.
+func savePolicy(ctx context.Context, tx pgx.Tx, parkingID int64, policy *domain.CancellationPolicy) error {
+	var version, freeHours, feePercent int64
+	err := tx.QueryRow(ctx,
+		`SELECT version, free_cancellation_hours, late_cancellation_fee_percent FROM cancellation_policies
+		WHERE parking_place_id = $1 ORDER BY version DESC LIMIT 1`,
+		parkingID).Scan(&version, &freeHours, &feePercent)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("failed to get cancellation policy")
+	}
+	if err == nil && freeHours == policy.FreeCancellationHours && feePercent == policy.LateCancellationFeePercent {
+		policy.Version = version
+		return nil
+	}
 
-	result, err := r.pool.Exec(ctx, query, id, ownerID)
+	policy.Version = version + 1
+	_, err = tx.Exec(ctx,
+		`INSERT INTO cancellation_policies (parking_place_id, version, free_cancellation_hours, late_cancellation_fee_percent)
+		VALUES ($1, $2, $3, $4)`,
+		parkingID, policy.Version, policy.FreeCancellationHours, policy.LateCancellationFeePercent)
 	if err != nil {
-		return fmt.Errorf("failed to delete parking place")
+		return fmt.Errorf("failed to save cancellation policy")
 	}
+	return nil
+}
 
-	if result.RowsAffected() == 0 {
-		return fmt.Errorf("parking place not found or access denied")
+func scanParking(row pgx.Row) (*domain.ParkingPlace, error) {
+	var parking domain.ParkingPlace
+	var parkingType, status string
+	var sizes []string
+	var version, freeHours, feePercent *int64
+	var latitude, longitude, rating *float64
+
+	err := row.Scan(
+		&parking.ID,
+		&parking.Name,
+		&parking.City,
+		&parking.Address,
+		&parkingType,
+		&parking.HourlyRate,
+		&parking.Capacity,
+		&parking.OwnerID,
+		&status,
+		&sizes,
+		&latitude,
+		&longitude,
+		&version,
+		&freeHours,
+		&feePercent,
+		&rating,
+		&parking.ReviewCount,
+		&parking.DistanceMeters,
+	)
+	if err != nil {
+		return nil, err
 	}
 
-	return nil
+	parking.Type = domain.ParkingType(parkingType)
+	parking.Status = domain.ParkingStatus(status)
+	if rating != nil {
+		parking.Rating = *rating
+	}
+	if latitude != nil && longitude != nil {
+		parking.Location = &domain.GeoPoint{Latitude: *latitude, Longitude: *longitude}
+	}
+	for _, size := range sizes {
+		parking.AllowedVehicleSizes = append(parking.AllowedVehicleSizes, domain.VehicleSize(size))
+	}
+	if version != nil {
+		parking.CancellationPolicy = &domain.CancellationPolicy{
+			Version:                    *version,
+			FreeCancellationHours:      *freeHours,
+			LateCancellationFeePercent: *feePercent,
+		}
+	}
+	return &parking, nil
+}
+
+// coordinates returns the columns of a location, both nil when it is not set.
+func coordinates(location *domain.GeoPoint) (*float64, *float64) {
+	if location == nil {
+		return nil, nil
+	}
+	return &location.Latitude, &location.Longitude
+}
+
+// vehicleSizes is never nil, so the column stays an empty array rather than NULL.
+func vehicleSizes(sizes []domain.VehicleSize) []string {
+	result := make([]string, 0, len(sizes))
+	for _, size := range sizes {
+		result = append(result, string(size))
+	}
+	return result
 }
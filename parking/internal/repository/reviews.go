@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/h4x4d/parking_net/pkg/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const reviewReturning = `RETURNING id, parking_place_id, booking_id, user_id, rating, text, COALESCE(reply, ''),
+		replied_at, status, COALESCE(moderation_reason, ''), created_at, updated_at`
+
+const reviewColumns = `SELECT id, parking_place_id, booking_id, user_id, rating, text, COALESCE(reply, ''),
+		replied_at, status, COALESCE(moderation_reason, ''), created_at, updated_at FROM parking_reviews`
+
+type PostgresReviewRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewPostgresReviewRepository(pool *pgxpool.Pool) ReviewRepository {
+	return &PostgresReviewRepository{pool: pool}
+}
+
+func (r *PostgresReviewRepository) Create(ctx context.Context, review *domain.Review) (*domain.Review, error) {
+	created, err := scanReview(r.pool.QueryRow(ctx,
+		`INSERT INTO parking_reviews (parking_place_id, booking_id, user_id, rating, text)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (booking_id) DO NOTHING `+reviewReturning,
+		review.ParkingPlaceID, review.BookingID, review.UserID, review.Rating, review.Text))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrReviewExists
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create review: %w", err)
+	}
+	return created, nil
+}
+
+func (r *PostgresReviewRepository) GetByID(ctx context.Context, id int64) (*domain.Review, error) {
+	return r.getOne(ctx, reviewColumns+" WHERE id = $1", id)
+}
+
+func (r *PostgresReviewRepository) ListPublished(ctx context.Context, parkingPlaceID int64, limit int64,
+	offset int64) ([]*domain.Review, error) {
+	rows, err := r.pool.Query(ctx,
+		reviewColumns+` WHERE parking_place_id = $1 AND status = 'Published'
+		ORDER BY created_at DESC, id DESC LIMIT $2 OFFSET $3`,
+		parkingPlaceID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reviews: %w", err)
+	}
+	defer rows.Close()
+
+	reviews := make([]*domain.Review, 0)
+	for rows.Next() {
+		review, err := scanReview(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan review: %w", err)
+		}
+		reviews = append(reviews, review)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get reviews: %w", err)
+	}
+	return reviews, nil
+}
+
+func (r *PostgresReviewRepository) SetReply(ctx context.Context, id int64, reply string) (*domain.Review, error) {
+	return r.getOne(ctx,
+		`UPDATE parking_reviews SET reply = $2, replied_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1 `+reviewReturning,
+		id, reply)
+}
+
+func (r *PostgresReviewRepository) SetStatus(ctx context.Context, id int64, status domain.ReviewStatus, reason string,
+	moderatorID string) (*domain.Review, error) {
+	return r.getOne(ctx,
+		`UPDATE parking_reviews SET status = $2, moderation_reason = NULLIF($3, ''), moderated_by = $4,
+		updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1 `+reviewReturning,
+		id, string(status), reason, moderatorID)
+}
+
+func (r *PostgresReviewRepository) getOne(ctx context.Context, query string, args ...any) (*domain.Review, error) {
+	review, err := scanReview(r.pool.QueryRow(ctx, query, args...))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get review: %w", err)
+	}
+	return review, nil
+}
+
+func scanReview(row pgx.Row) (*domain.Review, error) {
+	var review domain.Review
+	var status string
+	err := row.Scan(&review.ID, &review.ParkingPlaceID, &review.BookingID, &review.UserID, &review.Rating,
+		&review.Text, &review.Reply, &review.RepliedAt, &status, &review.ModerationReason, &review.CreatedAt,
+		&review.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	review.Status = domain.ReviewStatus(status)
+	return &review, nil
+}
@@ -88,9 +88,20 @@ func configureAPI(api *operations.ParkingsParkingAPI) http.Handler {
 	api.ParkingGetParkingsHandler = parking.GetParkingsHandlerFunc(container.ParkingHandler.GetParkings)
 	api.ParkingUpdateParkingHandler = parking.UpdateParkingHandlerFunc(container.ParkingHandler.UpdateParking)
 	api.ParkingDeleteParkingHandler = parking.DeleteParkingHandlerFunc(container.ParkingHandler.DeleteParking)
+	api.ParkingSuspendParkingHandler = parking.SuspendParkingHandlerFunc(container.ParkingHandler.SuspendParking)
+	api.ParkingResumeParkingHandler = parking.ResumeParkingHandlerFunc(container.ParkingHandler.ResumeParking)
+	api.ParkingGetParkingClosureHandler = parking.GetParkingClosureHandlerFunc(container.ParkingHandler.GetParkingClosure)
+	api.ParkingGetParkingReviewsHandler = parking.GetParkingReviewsHandlerFunc(container.ParkingHandler.GetParkingReviews)
+	api.ParkingCreateParkingReviewHandler = parking.CreateParkingReviewHandlerFunc(container.ParkingHandler.CreateParkingReview)
+	api.ParkingReplyParkingReviewHandler = parking.ReplyParkingReviewHandlerFunc(container.ParkingHandler.ReplyParkingReview)
+	api.ParkingModerateParkingReviewHandler = parking.ModerateParkingReviewHandlerFunc(container.ParkingHandler.ModerateParkingReview)
+
+	container.ClosureWorker.Start()
 
 	api.PreServerShutdown = func() {}
-	api.ServerShutdown = func() {}
+	api.ServerShutdown = func() {
+		container.ClosureWorker.Stop()
+	}
 
 	return setupGlobalMiddleware(api.Serve(setupMiddlewares))
 }
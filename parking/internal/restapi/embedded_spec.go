@@ -82,6 +82,53 @@ func init() {
             "description": "Filter parking places by owner ID (for owners to get their own parkings)",
             "name": "owner_id",
             "in": "query"
+          },
+          {
+            "maximum": 5,
+            "minimum": 1,
+            "type": "number",
+            "format": "double",
+            "description": "Only parking places rated at least this; places without reviews are left out",
+            "name": "min_rating",
+            "in": "query"
+          },
+          {
+            "maximum": 90,
+            "minimum": -90,
+            "type": "number",
+            "format": "double",
+            "description": "Latitude of the point to search near, together with lon",
+            "name": "lat",
+            "in": "query"
+          },
+          {
+            "maximum": 180,
+            "minimum": -180,
+            "type": "number",
+            "format": "double",
+            "description": "Longitude of the point to search near, together with lat",
+            "name": "lon",
+            "in": "query"
+          },
+          {
+            "maximum": 100000,
+            "minimum": 1,
+            "type": "number",
+            "format": "double",
+            "description": "Search radius around lat and lon in meters, 5000 by default. Places without a location are left out",
+            "name": "radius_m",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "rating",
+              "-rating",
+              "distance"
+            ],
+            "type": "string",
+            "description": "Sort key; a leading minus sorts in descending order. Places without reviews come last. Searches near lat and lon sort by distance unless told otherwise",
+            "name": "sort",
+            "in": "query"
           }
         ],
         "responses": {
@@ -94,6 +141,12 @@ func init() {
               }
             }
           },
+          "400": {
+            "description": "Incorrect search parameters",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
           "404": {
             "description": "Suitable parking places not found",
             "schema": {
@@ -251,6 +304,12 @@ func init() {
             "schema": {
               "$ref": "#/definitions/Error"
             }
+          },
+          "409": {
+            "description": "Capacity is below the spots already booked or the place is being deleted",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
           }
         }
       },
@@ -260,6 +319,7 @@ func init() {
             "api_key": []
           }
         ],
+        "description": "Stops new bookings, cancels, refunds and notifies the bookings that have not ended and then deletes the place. Status is \"pending\" when the closure could not finish yet; it is resumed in the background.",
         "produces": [
           "application/json"
         ],
@@ -276,6 +336,13 @@ func init() {
             "name": "parking_id",
             "in": "path",
             "required": true
+          },
+          {
+            "maxLength": 500,
+            "type": "string",
+            "description": "Told to the drivers whose bookings are canceled",
+            "name": "reason",
+            "in": "query"
           }
         ],
         "responses": {
@@ -299,235 +366,98 @@ func init() {
           }
         }
       }
-    }
-  },
-  "definitions": {
-    "Error": {
-      "type": "object",
-      "required": [
-        "error_status_code"
-      ],
-      "properties": {
-        "error_message": {
-          "type": "string"
-        },
-        "error_status_code": {
-          "type": "integer"
-        }
-      }
-    },
-    "ParkingPlace": {
-      "type": "object",
-      "required": [
-        "name",
-        "city",
-        "address"
-      ],
-      "properties": {
-        "address": {
-          "type": "string",
-          "example": "Red Square №1"
-        },
-        "capacity": {
-          "description": "total number of parking spots",
-          "type": "integer",
-          "format": "int64"
-        },
-        "city": {
-          "type": "string",
-          "example": "Moscow"
-        },
-        "hourly_rate": {
-          "description": "hourly parking rate",
-          "type": "integer",
-          "format": "int64"
-        },
-        "id": {
-          "type": "integer",
-          "format": "int64"
-        },
-        "name": {
-          "type": "string",
-          "example": "Central Parking"
-        },
-        "owner_id": {
-          "type": "string"
-        },
-        "parking_type": {
-          "description": "type of parking facility",
-          "type": "string",
-          "enum": [
-            "outdoor",
-            "covered",
-            "underground",
-            "multi-level"
-          ]
-        }
-      }
     },
-    "Result": {
-      "type": "object",
-      "properties": {
-        "message": {
-          "type": "string"
-        },
-        "status": {
-          "type": "string"
-        }
-      }
-    }
-  },
-  "securityDefinitions": {
-    "api_key": {
-      "type": "apiKey",
-      "name": "api_key",
-      "in": "header"
-    }
-  },
-  "tags": [
-    {
-      "description": "Parking operations",
-      "name": "parking"
-    },
-    {
-      "description": "Inner operations",
-      "name": "instruments"
-    }
-  ]
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "schemes": [
-    "http"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "description": "Parking Net Go project | Parking svc",
-    "title": "parkings.parking",
-    "version": "0.3.0"
-  },
-  "paths": {
-    "/metrics": {
+    "/parking/{parking_id}/closure": {
       "get": {
-        "security": [],
-        "produces": [
-          "application/json"
-        ],
-        "tags": [
-          "instruments"
-        ],
-        "summary": "Prometheus metrics",
-        "responses": {
-          "200": {
-            "description": "ok"
+        "security": [
+          {
+            "api_key": []
           }
-        }
-      }
-    },
-    "/parking": {
-      "get": {
+        ],
         "produces": [
           "application/json"
         ],
         "tags": [
           "parking"
         ],
-        "summary": "Get suitable parking places",
-        "operationId": "get_parkings",
+        "summary": "Get the progress of the latest deletion or suspension of a parking place",
+        "operationId": "get_parking_closure",
         "parameters": [
           {
-            "type": "string",
-            "name": "city",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "name": "name",
-            "in": "query"
-          },
-          {
-            "enum": [
-              "outdoor",
-              "covered",
-              "underground",
-              "multi-level"
-            ],
-            "type": "string",
-            "name": "parking_type",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "Filter parking places by owner ID (for owners to get their own parkings)",
-            "name": "owner_id",
-            "in": "query"
+            "type": "integer",
+            "format": "int64",
+            "name": "parking_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "successful operation",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/ParkingPlace"
-              }
+              "$ref": "#/definitions/ParkingClosure"
+            }
+          },
+          "403": {
+            "description": "No access",
+            "schema": {
+              "$ref": "#/definitions/Error"
             }
           },
           "404": {
-            "description": "Suitable parking places not found",
+            "description": "Parking place was never deleted or suspended",
             "schema": {
               "$ref": "#/definitions/Error"
             }
           }
         }
-      },
+      }
+    },
+    "/parking/{parking_id}/resume": {
       "post": {
         "security": [
           {
             "api_key": []
           }
         ],
-        "consumes": [
-          "application/json"
-        ],
         "produces": [
           "application/json"
         ],
         "tags": [
           "parking"
         ],
-        "summary": "Create parking place",
-        "operationId": "create_parking",
+        "summary": "Let a suspended parking place take bookings again",
+        "operationId": "resume_parking",
         "parameters": [
           {
-            "name": "object",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/ParkingPlace"
-            }
+            "type": "integer",
+            "format": "int64",
+            "name": "parking_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "successful operation",
             "schema": {
-              "type": "object",
-              "properties": {
-                "id": {
-                  "type": "integer",
-                  "format": "int64"
-                }
-              }
+              "$ref": "#/definitions/ParkingPlace"
             }
           },
-          "400": {
-            "description": "Incorrect data",
+          "403": {
+            "description": "No access",
             "schema": {
               "$ref": "#/definitions/Error"
             }
           },
-          "403": {
-            "description": "No access",
+          "404": {
+            "description": "Parking place not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Parking place is not suspended or its closure has not finished",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -535,7 +465,7 @@ func init() {
         }
       }
     },
-    "/parking/{parking_id}": {
+    "/parking/{parking_id}/reviews": {
       "get": {
         "produces": [
           "application/json"
@@ -543,24 +473,42 @@ func init() {
         "tags": [
           "parking"
         ],
-        "summary": "Get parking place info by id",
-        "operationId": "get_parking_by_id",
+        "summary": "Get the published reviews of a parking place, newest first",
+        "operationId": "get_parking_reviews",
         "parameters": [
           {
             "type": "integer",
             "format": "int64",
-            "description": "ID of parking place to return",
             "name": "parking_id",
             "in": "path",
             "required": true
+          },
+          {
+            "maximum": 100,
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "default": 20,
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "default": 0,
+            "name": "offset",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "successful operation",
             "schema": {
-              "$ref": "#/definitions/ParkingPlace"
-            }
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Review"
+              }
+            }
           },
           "404": {
             "description": "Parking place not found",
@@ -570,12 +518,83 @@ func init() {
           }
         }
       },
+      "post": {
+        "security": [
+          {
+            "api_key": []
+          }
+        ],
+        "description": "Each completed booking of the driver can be reviewed once.",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "parking"
+        ],
+        "summary": "Review a parking place after a completed booking there",
+        "operationId": "create_parking_review",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "parking_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "object",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ReviewRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/Review"
+            }
+          },
+          "400": {
+            "description": "Incorrect data",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "No access",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Parking place or booking not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "The booking is not completed or was already reviewed",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/parking/{parking_id}/reviews/{review_id}/moderation": {
       "put": {
         "security": [
           {
             "api_key": []
           }
         ],
+        "description": "Admins only. Hidden reviews are not listed and do not count towards the rating.",
         "consumes": [
           "application/json"
         ],
@@ -585,23 +604,29 @@ func init() {
         "tags": [
           "parking"
         ],
-        "summary": "Update parking place",
-        "operationId": "update_parking",
+        "summary": "Hide a review or publish it again",
+        "operationId": "moderate_parking_review",
         "parameters": [
           {
             "type": "integer",
             "format": "int64",
-            "description": "ID of parking place to change",
             "name": "parking_id",
             "in": "path",
             "required": true
           },
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "review_id",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "object",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/ParkingPlace"
+              "$ref": "#/definitions/ReviewModeration"
             }
           }
         ],
@@ -609,7 +634,7 @@ func init() {
           "200": {
             "description": "successful operation",
             "schema": {
-              "$ref": "#/definitions/ParkingPlace"
+              "$ref": "#/definitions/Review"
             }
           },
           "400": {
@@ -625,42 +650,126 @@ func init() {
             }
           },
           "404": {
-            "description": "No such element",
+            "description": "Review not found",
             "schema": {
               "$ref": "#/definitions/Error"
             }
           }
         }
-      },
-      "delete": {
+      }
+    },
+    "/parking/{parking_id}/reviews/{review_id}/reply": {
+      "put": {
         "security": [
           {
             "api_key": []
           }
         ],
+        "description": "A new reply replaces the earlier one.",
+        "consumes": [
+          "application/json"
+        ],
         "produces": [
           "application/json"
         ],
         "tags": [
           "parking"
         ],
-        "summary": "Delete parking place",
-        "operationId": "delete_parking",
+        "summary": "Reply to a review of a parking place as its owner",
+        "operationId": "reply_parking_review",
         "parameters": [
           {
             "type": "integer",
             "format": "int64",
-            "description": "ID of parking place to delete",
             "name": "parking_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "review_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "object",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ReviewReply"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "successful operation",
             "schema": {
-              "$ref": "#/definitions/Result"
+              "$ref": "#/definitions/Review"
+            }
+          },
+          "400": {
+            "description": "Incorrect data",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "No access",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Parking place or review not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/parking/{parking_id}/suspend": {
+      "post": {
+        "security": [
+          {
+            "api_key": []
+          }
+        ],
+        "description": "Stops new bookings and cancels, refunds and notifies the bookings that have not ended. The place is kept and can be resumed.",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "parking"
+        ],
+        "summary": "Suspend parking place",
+        "operationId": "suspend_parking",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "parking_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "object",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ClosureRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/ParkingClosure"
             }
           },
           "403": {
@@ -680,6 +789,39 @@ func init() {
     }
   },
   "definitions": {
+    "CancellationPolicy": {
+      "type": "object",
+      "properties": {
+        "free_cancellation_hours": {
+          "description": "cancellations at least this many hours before the start are refunded in full",
+          "type": "integer",
+          "format": "int64",
+          "maximum": 8760
+        },
+        "late_cancellation_fee_percent": {
+          "description": "share of the price kept on later cancellations; nothing is refunded once the booking has started",
+          "type": "integer",
+          "format": "int64",
+          "maximum": 100
+        },
+        "version": {
+          "description": "incremented on every change of the policy",
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        }
+      }
+    },
+    "ClosureRequest": {
+      "type": "object",
+      "properties": {
+        "reason": {
+          "description": "told to the drivers whose bookings are canceled",
+          "type": "string",
+          "maxLength": 500
+        }
+      }
+    },
     "Error": {
       "type": "object",
       "required": [
@@ -694,6 +836,63 @@ func init() {
         }
       }
     },
+    "ParkingClosure": {
+      "type": "object",
+      "properties": {
+        "bookings_canceled": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "kind": {
+          "type": "string",
+          "enum": [
+            "delete",
+            "suspend"
+          ]
+        },
+        "last_error": {
+          "description": "why the closure stopped at its step; it is retried in the background",
+          "type": "string"
+        },
+        "parking_place_id": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "reason": {
+          "type": "string"
+        },
+        "refunds_completed": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "refunds_declined": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "step": {
+          "description": "the closure is finished once it is Done",
+          "type": "string",
+          "enum": [
+            "Canceling",
+            "Refunding",
+            "Notifying",
+            "Done"
+          ]
+        },
+        "updated_at": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
     "ParkingPlace": {
       "type": "object",
       "required": [
@@ -706,6 +905,22 @@ func init() {
           "type": "string",
           "example": "Red Square №1"
         },
+        "allowed_vehicle_sizes": {
+          "description": "vehicle size classes the place takes; empty takes every size",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "enum": [
+              "motorcycle",
+              "small",
+              "medium",
+              "large"
+            ]
+          }
+        },
+        "cancellation_policy": {
+          "$ref": "#/definitions/CancellationPolicy"
+        },
         "capacity": {
           "description": "total number of parking spots",
           "type": "integer",
@@ -715,6 +930,13 @@ func init() {
           "type": "string",
           "example": "Moscow"
         },
+        "distance_m": {
+          "description": "distance in meters from lat and lon of a nearby search",
+          "type": "number",
+          "format": "double",
+          "x-nullable": true,
+          "readOnly": true
+        },
         "hourly_rate": {
           "description": "hourly parking rate",
           "type": "integer",
@@ -724,6 +946,24 @@ func init() {
           "type": "integer",
           "format": "int64"
         },
+        "latitude": {
+          "description": "WGS 84 latitude in degrees, set together with longitude",
+          "type": "number",
+          "format": "double",
+          "maximum": 90,
+          "minimum": -90,
+          "x-nullable": true,
+          "example": 55.7539
+        },
+        "longitude": {
+          "description": "WGS 84 longitude in degrees, set together with latitude",
+          "type": "number",
+          "format": "double",
+          "maximum": 180,
+          "minimum": -180,
+          "x-nullable": true,
+          "example": 37.6208
+        },
         "name": {
           "type": "string",
           "example": "Central Parking"
@@ -740,6 +980,28 @@ func init() {
             "underground",
             "multi-level"
           ]
+        },
+        "rating": {
+          "description": "average rating of the published reviews, 0 without any",
+          "type": "number",
+          "format": "double",
+          "readOnly": true
+        },
+        "review_count": {
+          "description": "number of published reviews",
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "status": {
+          "description": "only Active parking places take bookings",
+          "type": "string",
+          "enum": [
+            "Active",
+            "Suspended",
+            "Closing"
+          ],
+          "readOnly": true
         }
       }
     },
@@ -753,6 +1015,1249 @@ func init() {
           "type": "string"
         }
       }
+    },
+    "Review": {
+      "type": "object",
+      "properties": {
+        "booking_id": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "moderation_reason": {
+          "description": "why an admin last changed the status",
+          "type": "string"
+        },
+        "parking_place_id": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "rating": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "replied_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "reply": {
+          "description": "the owner's answer, empty until they reply",
+          "type": "string"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "Published",
+            "Hidden"
+          ]
+        },
+        "text": {
+          "type": "string"
+        },
+        "updated_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "user_id": {
+          "type": "string"
+        }
+      }
+    },
+    "ReviewModeration": {
+      "type": "object",
+      "required": [
+        "status"
+      ],
+      "properties": {
+        "reason": {
+          "type": "string",
+          "maxLength": 500
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "Published",
+            "Hidden"
+          ]
+        }
+      }
+    },
+    "ReviewReply": {
+      "type": "object",
+      "required": [
+        "reply"
+      ],
+      "properties": {
+        "reply": {
+          "type": "string",
+          "maxLength": 2000,
+          "minLength": 1
+        }
+      }
+    },
+    "ReviewRequest": {
+      "type": "object",
+      "required": [
+        "booking_id",
+        "rating"
+      ],
+      "properties": {
+        "booking_id": {
+          "description": "completed booking of the driver at the parking place",
+          "type": "integer",
+          "format": "int64"
+        },
+        "rating": {
+          "type": "integer",
+          "format": "int64",
+          "maximum": 5,
+          "minimum": 1
+        },
+        "text": {
+          "type": "string",
+          "maxLength": 2000
+        }
+      }
+    }
+  },
+  "securityDefinitions": {
+    "api_key": {
+      "type": "apiKey",
+      "name": "api_key",
+      "in": "header"
+    }
+  },
+  "tags": [
+    {
+      "description": "Parking operations",
+      "name": "parking"
+    },
+    {
+      "description": "Inner operations",
+      "name": "instruments"
+    }
+  ]
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "schemes": [
+    "http"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "description": "Parking Net Go project | Parking svc",
+    "title": "parkings.parking",
+    "version": "0.3.0"
+  },
+  "paths": {
+    "/metrics": {
+      "get": {
+        "security": [],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "instruments"
+        ],
+        "summary": "Prometheus metrics",
+        "responses": {
+          "200": {
+            "description": "ok"
+          }
+        }
+      }
+    },
+    "/parking": {
+      "get": {
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "parking"
+        ],
+        "summary": "Get suitable parking places",
+        "operationId": "get_parkings",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "city",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "name",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "outdoor",
+              "covered",
+              "underground",
+              "multi-level"
+            ],
+            "type": "string",
+            "name": "parking_type",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Filter parking places by owner ID (for owners to get their own parkings)",
+            "name": "owner_id",
+            "in": "query"
+          },
+          {
+            "maximum": 5,
+            "minimum": 1,
+            "type": "number",
+            "format": "double",
+            "description": "Only parking places rated at least this; places without reviews are left out",
+            "name": "min_rating",
+            "in": "query"
+          },
+          {
+            "maximum": 90,
+            "minimum": -90,
+            "type": "number",
+            "format": "double",
+            "description": "Latitude of the point to search near, together with lon",
+            "name": "lat",
+            "in": "query"
+          },
+          {
+            "maximum": 180,
+            "minimum": -180,
+            "type": "number",
+            "format": "double",
+            "description": "Longitude of the point to search near, together with lat",
+            "name": "lon",
+            "in": "query"
+          },
+          {
+            "maximum": 100000,
+            "minimum": 1,
+            "type": "number",
+            "format": "double",
+            "description": "Search radius around lat and lon in meters, 5000 by default. Places without a location are left out",
+            "name": "radius_m",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "rating",
+              "-rating",
+              "distance"
+            ],
+            "type": "string",
+            "description": "Sort key; a leading minus sorts in descending order. Places without reviews come last. Searches near lat and lon sort by distance unless told otherwise",
+            "name": "sort",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/ParkingPlace"
+              }
+            }
+          },
+          "400": {
+            "description": "Incorrect search parameters",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Suitable parking places not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "api_key": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "parking"
+        ],
+        "summary": "Create parking place",
+        "operationId": "create_parking",
+        "parameters": [
+          {
+            "name": "object",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ParkingPlace"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "id": {
+                  "type": "integer",
+                  "format": "int64"
+                }
+              }
+            }
+          },
+          "400": {
+            "description": "Incorrect data",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "No access",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/parking/{parking_id}": {
+      "get": {
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "parking"
+        ],
+        "summary": "Get parking place info by id",
+        "operationId": "get_parking_by_id",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "ID of parking place to return",
+            "name": "parking_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/ParkingPlace"
+            }
+          },
+          "404": {
+            "description": "Parking place not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "put": {
+        "security": [
+          {
+            "api_key": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "parking"
+        ],
+        "summary": "Update parking place",
+        "operationId": "update_parking",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "ID of parking place to change",
+            "name": "parking_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "object",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ParkingPlace"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/ParkingPlace"
+            }
+          },
+          "400": {
+            "description": "Incorrect data",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "No access",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "No such element",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Capacity is below the spots already booked or the place is being deleted",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "security": [
+          {
+            "api_key": []
+          }
+        ],
+        "description": "Stops new bookings, cancels, refunds and notifies the bookings that have not ended and then deletes the place. Status is \"pending\" when the closure could not finish yet; it is resumed in the background.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "parking"
+        ],
+        "summary": "Delete parking place",
+        "operationId": "delete_parking",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "ID of parking place to delete",
+            "name": "parking_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "maxLength": 500,
+            "type": "string",
+            "description": "Told to the drivers whose bookings are canceled",
+            "name": "reason",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/Result"
+            }
+          },
+          "403": {
+            "description": "No access",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Parking place not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/parking/{parking_id}/closure": {
+      "get": {
+        "security": [
+          {
+            "api_key": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "parking"
+        ],
+        "summary": "Get the progress of the latest deletion or suspension of a parking place",
+        "operationId": "get_parking_closure",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "parking_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/ParkingClosure"
+            }
+          },
+          "403": {
+            "description": "No access",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Parking place was never deleted or suspended",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/parking/{parking_id}/resume": {
+      "post": {
+        "security": [
+          {
+            "api_key": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "parking"
+        ],
+        "summary": "Let a suspended parking place take bookings again",
+        "operationId": "resume_parking",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "parking_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/ParkingPlace"
+            }
+          },
+          "403": {
+            "description": "No access",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Parking place not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Parking place is not suspended or its closure has not finished",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/parking/{parking_id}/reviews": {
+      "get": {
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "parking"
+        ],
+        "summary": "Get the published reviews of a parking place, newest first",
+        "operationId": "get_parking_reviews",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "parking_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "maximum": 100,
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "default": 20,
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "integer",
+            "format": "int64",
+            "default": 0,
+            "name": "offset",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Review"
+              }
+            }
+          },
+          "404": {
+            "description": "Parking place not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "api_key": []
+          }
+        ],
+        "description": "Each completed booking of the driver can be reviewed once.",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "parking"
+        ],
+        "summary": "Review a parking place after a completed booking there",
+        "operationId": "create_parking_review",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "parking_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "object",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ReviewRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/Review"
+            }
+          },
+          "400": {
+            "description": "Incorrect data",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "No access",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Parking place or booking not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "The booking is not completed or was already reviewed",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/parking/{parking_id}/reviews/{review_id}/moderation": {
+      "put": {
+        "security": [
+          {
+            "api_key": []
+          }
+        ],
+        "description": "Admins only. Hidden reviews are not listed and do not count towards the rating.",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "parking"
+        ],
+        "summary": "Hide a review or publish it again",
+        "operationId": "moderate_parking_review",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "parking_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "review_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "object",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ReviewModeration"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/Review"
+            }
+          },
+          "400": {
+            "description": "Incorrect data",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "No access",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Review not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/parking/{parking_id}/reviews/{review_id}/reply": {
+      "put": {
+        "security": [
+          {
+            "api_key": []
+          }
+        ],
+        "description": "A new reply replaces the earlier one.",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "parking"
+        ],
+        "summary": "Reply to a review of a parking place as its owner",
+        "operationId": "reply_parking_review",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "parking_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "review_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "object",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ReviewReply"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/Review"
+            }
+          },
+          "400": {
+            "description": "Incorrect data",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "No access",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Parking place or review not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/parking/{parking_id}/suspend": {
+      "post": {
+        "security": [
+          {
+            "api_key": []
+          }
+        ],
+        "description": "Stops new bookings and cancels, refunds and notifies the bookings that have not ended. The place is kept and can be resumed.",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "parking"
+        ],
+        "summary": "Suspend parking place",
+        "operationId": "suspend_parking",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "parking_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "object",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ClosureRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/ParkingClosure"
+            }
+          },
+          "403": {
+            "description": "No access",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Parking place not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "CancellationPolicy": {
+      "type": "object",
+      "properties": {
+        "free_cancellation_hours": {
+          "description": "cancellations at least this many hours before the start are refunded in full",
+          "type": "integer",
+          "format": "int64",
+          "maximum": 8760,
+          "minimum": 0
+        },
+        "late_cancellation_fee_percent": {
+          "description": "share of the price kept on later cancellations; nothing is refunded once the booking has started",
+          "type": "integer",
+          "format": "int64",
+          "maximum": 100,
+          "minimum": 0
+        },
+        "version": {
+          "description": "incremented on every change of the policy",
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        }
+      }
+    },
+    "ClosureRequest": {
+      "type": "object",
+      "properties": {
+        "reason": {
+          "description": "told to the drivers whose bookings are canceled",
+          "type": "string",
+          "maxLength": 500
+        }
+      }
+    },
+    "Error": {
+      "type": "object",
+      "required": [
+        "error_status_code"
+      ],
+      "properties": {
+        "error_message": {
+          "type": "string"
+        },
+        "error_status_code": {
+          "type": "integer"
+        }
+      }
+    },
+    "ParkingClosure": {
+      "type": "object",
+      "properties": {
+        "bookings_canceled": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "kind": {
+          "type": "string",
+          "enum": [
+            "delete",
+            "suspend"
+          ]
+        },
+        "last_error": {
+          "description": "why the closure stopped at its step; it is retried in the background",
+          "type": "string"
+        },
+        "parking_place_id": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "reason": {
+          "type": "string"
+        },
+        "refunds_completed": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "refunds_declined": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "step": {
+          "description": "the closure is finished once it is Done",
+          "type": "string",
+          "enum": [
+            "Canceling",
+            "Refunding",
+            "Notifying",
+            "Done"
+          ]
+        },
+        "updated_at": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "ParkingPlace": {
+      "type": "object",
+      "required": [
+        "name",
+        "city",
+        "address"
+      ],
+      "properties": {
+        "address": {
+          "type": "string",
+          "example": "Red Square №1"
+        },
+        "allowed_vehicle_sizes": {
+          "description": "vehicle size classes the place takes; empty takes every size",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "enum": [
+              "motorcycle",
+              "small",
+              "medium",
+              "large"
+            ]
+          }
+        },
+        "cancellation_policy": {
+          "$ref": "#/definitions/CancellationPolicy"
+        },
+        "capacity": {
+          "description": "total number of parking spots",
+          "type": "integer",
+          "format": "int64"
+        },
+        "city": {
+          "type": "string",
+          "example": "Moscow"
+        },
+        "distance_m": {
+          "description": "distance in meters from lat and lon of a nearby search",
+          "type": "number",
+          "format": "double",
+          "x-nullable": true,
+          "readOnly": true
+        },
+        "hourly_rate": {
+          "description": "hourly parking rate",
+          "type": "integer",
+          "format": "int64"
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "latitude": {
+          "description": "WGS 84 latitude in degrees, set together with longitude",
+          "type": "number",
+          "format": "double",
+          "maximum": 90,
+          "minimum": -90,
+          "x-nullable": true,
+          "example": 55.7539
+        },
+        "longitude": {
+          "description": "WGS 84 longitude in degrees, set together with latitude",
+          "type": "number",
+          "format": "double",
+          "maximum": 180,
+          "minimum": -180,
+          "x-nullable": true,
+          "example": 37.6208
+        },
+        "name": {
+          "type": "string",
+          "example": "Central Parking"
+        },
+        "owner_id": {
+          "type": "string"
+        },
+        "parking_type": {
+          "description": "type of parking facility",
+          "type": "string",
+          "enum": [
+            "outdoor",
+            "covered",
+            "underground",
+            "multi-level"
+          ]
+        },
+        "rating": {
+          "description": "average rating of the published reviews, 0 without any",
+          "type": "number",
+          "format": "double",
+          "readOnly": true
+        },
+        "review_count": {
+          "description": "number of published reviews",
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "status": {
+          "description": "only Active parking places take bookings",
+          "type": "string",
+          "enum": [
+            "Active",
+            "Suspended",
+            "Closing"
+          ],
+          "readOnly": true
+        }
+      }
+    },
+    "Result": {
+      "type": "object",
+      "properties": {
+        "message": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "Review": {
+      "type": "object",
+      "properties": {
+        "booking_id": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "moderation_reason": {
+          "description": "why an admin last changed the status",
+          "type": "string"
+        },
+        "parking_place_id": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "rating": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "replied_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "reply": {
+          "description": "the owner's answer, empty until they reply",
+          "type": "string"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "Published",
+            "Hidden"
+          ]
+        },
+        "text": {
+          "type": "string"
+        },
+        "updated_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "user_id": {
+          "type": "string"
+        }
+      }
+    },
+    "ReviewModeration": {
+      "type": "object",
+      "required": [
+        "status"
+      ],
+      "properties": {
+        "reason": {
+          "type": "string",
+          "maxLength": 500
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "Published",
+            "Hidden"
+          ]
+        }
+      }
+    },
+    "ReviewReply": {
+      "type": "object",
+      "required": [
+        "reply"
+      ],
+      "properties": {
+        "reply": {
+          "type": "string",
+          "maxLength": 2000,
+          "minLength": 1
+        }
+      }
+    },
+    "ReviewRequest": {
+      "type": "object",
+      "required": [
+        "booking_id",
+        "rating"
+      ],
+      "properties": {
+        "booking_id": {
+          "description": "completed booking of the driver at the parking place",
+          "type": "integer",
+          "format": "int64"
+        },
+        "rating": {
+          "type": "integer",
+          "format": "int64",
+          "maximum": 5,
+          "minimum": 1
+        },
+        "text": {
+          "type": "string",
+          "maxLength": 2000
+        }
+      }
     }
   },
   "securityDefinitions": {
@@ -35,6 +35,8 @@ func NewDeleteParking(ctx *middleware.Context, handler DeleteParkingHandler) *De
 	DeleteParking swagger:route DELETE /parking/{parking_id} parking deleteParking
 
 Delete parking place
+
+Stops new bookings, cancels, refunds and notifies the bookings that have not ended and then deletes the place. Status is "pending" when the closure could not finish yet; it is resumed in the background.
 */
 type DeleteParking struct {
 	Context *middleware.Context
@@ -9,9 +9,11 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // NewDeleteParkingParams creates a new DeleteParkingParams object
@@ -36,6 +38,11 @@ type DeleteParkingParams struct {
 	  In: path
 	*/
 	ParkingID int64
+	/*Told to the drivers whose bookings are canceled
+	  Max Length: 500
+	  In: query
+	*/
+	Reason *string
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -47,10 +54,17 @@ func (o *DeleteParkingParams) BindRequest(r *http.Request, route *middleware.Mat
 
 	o.HTTPRequest = r
 
+	qs := runtime.Values(r.URL.Query())
+
 	rParkingID, rhkParkingID, _ := route.Params.GetOK("parking_id")
 	if err := o.bindParkingID(rParkingID, rhkParkingID, route.Formats); err != nil {
 		res = append(res, err)
 	}
+
+	qReason, qhkReason, _ := qs.GetOK("reason")
+	if err := o.bindReason(qReason, qhkReason, route.Formats); err != nil {
+		res = append(res, err)
+	}
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -75,3 +89,35 @@ func (o *DeleteParkingParams) bindParkingID(rawData []string, hasKey bool, forma
 
 	return nil
 }
+
+// bindReason binds and validates parameter Reason from query.
+func (o *DeleteParkingParams) bindReason(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Reason = &raw
+
+	if err := o.validateReason(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateReason carries on validations for parameter Reason
+func (o *DeleteParkingParams) validateReason(formats strfmt.Registry) error {
+
+	if err := validate.MaxLength("reason", "query", *o.Reason, 500); err != nil {
+		return err
+	}
+
+	return nil
+}
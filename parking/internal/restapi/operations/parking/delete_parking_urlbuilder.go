@@ -18,6 +18,8 @@ import (
 type DeleteParkingURL struct {
 	ParkingID int64
 
+	Reason *string
+
 	_basePath string
 	// avoid unkeyed usage
 	_ struct{}
@@ -54,6 +56,18 @@ func (o *DeleteParkingURL) Build() (*url.URL, error) {
 	_basePath := o._basePath
 	_result.Path = golangswaggerpaths.Join(_basePath, _path)
 
+	qs := make(url.Values)
+
+	var reasonQ string
+	if o.Reason != nil {
+		reasonQ = *o.Reason
+	}
+	if reasonQ != "" {
+		qs.Set("reason", reasonQ)
+	}
+
+	_result.RawQuery = qs.Encode()
+
 	return &_result, nil
 }
 
@@ -12,6 +12,7 @@ import (
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 	"github.com/go-openapi/validate"
 )
 
@@ -36,6 +37,24 @@ type GetParkingsParams struct {
 	  In: query
 	*/
 	City *string
+	/*Latitude of the point to search near, together with lon
+	  Maximum: 90
+	  Minimum: -90
+	  In: query
+	*/
+	Lat *float64
+	/*Longitude of the point to search near, together with lat
+	  Maximum: 180
+	  Minimum: -180
+	  In: query
+	*/
+	Lon *float64
+	/*Only parking places rated at least this; places without reviews are left out
+	  Maximum: 5
+	  Minimum: 1
+	  In: query
+	*/
+	MinRating *float64
 	/*
 	  In: query
 	*/
@@ -48,6 +67,16 @@ type GetParkingsParams struct {
 	  In: query
 	*/
 	ParkingType *string
+	/*Search radius around lat and lon in meters, 5000 by default. Places without a location are left out
+	  Maximum: 100000
+	  Minimum: 1
+	  In: query
+	*/
+	RadiusM *float64
+	/*Sort key; a leading minus sorts in descending order. Places without reviews come last. Searches near lat and lon sort by distance unless told otherwise
+	  In: query
+	*/
+	Sort *string
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -66,6 +95,21 @@ func (o *GetParkingsParams) BindRequest(r *http.Request, route *middleware.Match
 		res = append(res, err)
 	}
 
+	qLat, qhkLat, _ := qs.GetOK("lat")
+	if err := o.bindLat(qLat, qhkLat, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qLon, qhkLon, _ := qs.GetOK("lon")
+	if err := o.bindLon(qLon, qhkLon, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qMinRating, qhkMinRating, _ := qs.GetOK("min_rating")
+	if err := o.bindMinRating(qMinRating, qhkMinRating, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qName, qhkName, _ := qs.GetOK("name")
 	if err := o.bindName(qName, qhkName, route.Formats); err != nil {
 		res = append(res, err)
@@ -80,6 +124,16 @@ func (o *GetParkingsParams) BindRequest(r *http.Request, route *middleware.Match
 	if err := o.bindParkingType(qParkingType, qhkParkingType, route.Formats); err != nil {
 		res = append(res, err)
 	}
+
+	qRadiusM, qhkRadiusM, _ := qs.GetOK("radius_m")
+	if err := o.bindRadiusM(qRadiusM, qhkRadiusM, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qSort, qhkSort, _ := qs.GetOK("sort")
+	if err := o.bindSort(qSort, qhkSort, route.Formats); err != nil {
+		res = append(res, err)
+	}
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -104,6 +158,129 @@ func (o *GetParkingsParams) bindCity(rawData []string, hasKey bool, formats strf
 	return nil
 }
 
+// bindLat binds and validates parameter Lat from query.
+func (o *GetParkingsParams) bindLat(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertFloat64(raw)
+	if err != nil {
+		return errors.InvalidType("lat", "query", "float64", raw)
+	}
+	o.Lat = &value
+
+	if err := o.validateLat(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateLat carries on validations for parameter Lat
+func (o *GetParkingsParams) validateLat(formats strfmt.Registry) error {
+
+	if err := validate.Minimum("lat", "query", *o.Lat, -90, false); err != nil {
+		return err
+	}
+
+	if err := validate.Maximum("lat", "query", *o.Lat, 90, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindLon binds and validates parameter Lon from query.
+func (o *GetParkingsParams) bindLon(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertFloat64(raw)
+	if err != nil {
+		return errors.InvalidType("lon", "query", "float64", raw)
+	}
+	o.Lon = &value
+
+	if err := o.validateLon(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateLon carries on validations for parameter Lon
+func (o *GetParkingsParams) validateLon(formats strfmt.Registry) error {
+
+	if err := validate.Minimum("lon", "query", *o.Lon, -180, false); err != nil {
+		return err
+	}
+
+	if err := validate.Maximum("lon", "query", *o.Lon, 180, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindMinRating binds and validates parameter MinRating from query.
+func (o *GetParkingsParams) bindMinRating(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertFloat64(raw)
+	if err != nil {
+		return errors.InvalidType("min_rating", "query", "float64", raw)
+	}
+	o.MinRating = &value
+
+	if err := o.validateMinRating(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateMinRating carries on validations for parameter MinRating
+func (o *GetParkingsParams) validateMinRating(formats strfmt.Registry) error {
+
+	if err := validate.Minimum("min_rating", "query", *o.MinRating, 1, false); err != nil {
+		return err
+	}
+
+	if err := validate.Maximum("min_rating", "query", *o.MinRating, 5, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // bindName binds and validates parameter Name from query.
 func (o *GetParkingsParams) bindName(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -171,3 +348,76 @@ func (o *GetParkingsParams) validateParkingType(formats strfmt.Registry) error {
 
 	return nil
 }
+
+// bindRadiusM binds and validates parameter RadiusM from query.
+func (o *GetParkingsParams) bindRadiusM(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertFloat64(raw)
+	if err != nil {
+		return errors.InvalidType("radius_m", "query", "float64", raw)
+	}
+	o.RadiusM = &value
+
+	if err := o.validateRadiusM(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateRadiusM carries on validations for parameter RadiusM
+func (o *GetParkingsParams) validateRadiusM(formats strfmt.Registry) error {
+
+	if err := validate.Minimum("radius_m", "query", *o.RadiusM, 1, false); err != nil {
+		return err
+	}
+
+	if err := validate.Maximum("radius_m", "query", *o.RadiusM, 100000, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindSort binds and validates parameter Sort from query.
+func (o *GetParkingsParams) bindSort(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Sort = &raw
+
+	if err := o.validateSort(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateSort carries on validations for parameter Sort
+func (o *GetParkingsParams) validateSort(formats strfmt.Registry) error {
+
+	if err := validate.EnumCase("sort", "query", *o.Sort, []interface{}{"rating", "-rating", "distance"}, true); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -61,6 +61,51 @@ func (o *GetParkingsOK) WriteResponse(rw http.ResponseWriter, producer runtime.P
 	}
 }
 
+// GetParkingsBadRequestCode is the HTTP code returned for type GetParkingsBadRequest
+const GetParkingsBadRequestCode int = 400
+
+/*
+GetParkingsBadRequest Incorrect search parameters
+
+swagger:response getParkingsBadRequest
+*/
+type GetParkingsBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetParkingsBadRequest creates GetParkingsBadRequest with default headers values
+func NewGetParkingsBadRequest() *GetParkingsBadRequest {
+
+	return &GetParkingsBadRequest{}
+}
+
+// WithPayload adds the payload to the get parkings bad request response
+func (o *GetParkingsBadRequest) WithPayload(payload *models.Error) *GetParkingsBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get parkings bad request response
+func (o *GetParkingsBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetParkingsBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // GetParkingsNotFoundCode is the HTTP code returned for type GetParkingsNotFound
 const GetParkingsNotFoundCode int = 404
 
@@ -9,14 +9,21 @@ import (
 	"errors"
 	"net/url"
 	golangswaggerpaths "path"
+
+	"github.com/go-openapi/swag"
 )
 
 // GetParkingsURL generates an URL for the get parkings operation
 type GetParkingsURL struct {
 	City        *string
+	Lat         *float64
+	Lon         *float64
+	MinRating   *float64
 	Name        *string
 	OwnerID     *string
 	ParkingType *string
+	RadiusM     *float64
+	Sort        *string
 
 	_basePath string
 	// avoid unkeyed usage
@@ -57,6 +64,30 @@ func (o *GetParkingsURL) Build() (*url.URL, error) {
 		qs.Set("city", cityQ)
 	}
 
+	var latQ string
+	if o.Lat != nil {
+		latQ = swag.FormatFloat64(*o.Lat)
+	}
+	if latQ != "" {
+		qs.Set("lat", latQ)
+	}
+
+	var lonQ string
+	if o.Lon != nil {
+		lonQ = swag.FormatFloat64(*o.Lon)
+	}
+	if lonQ != "" {
+		qs.Set("lon", lonQ)
+	}
+
+	var minRatingQ string
+	if o.MinRating != nil {
+		minRatingQ = swag.FormatFloat64(*o.MinRating)
+	}
+	if minRatingQ != "" {
+		qs.Set("min_rating", minRatingQ)
+	}
+
 	var nameQ string
 	if o.Name != nil {
 		nameQ = *o.Name
@@ -81,6 +112,22 @@ func (o *GetParkingsURL) Build() (*url.URL, error) {
 		qs.Set("parking_type", parkingTypeQ)
 	}
 
+	var radiusMQ string
+	if o.RadiusM != nil {
+		radiusMQ = swag.FormatFloat64(*o.RadiusM)
+	}
+	if radiusMQ != "" {
+		qs.Set("radius_m", radiusMQ)
+	}
+
+	var sortQ string
+	if o.Sort != nil {
+		sortQ = *o.Sort
+	}
+	if sortQ != "" {
+		qs.Set("sort", sortQ)
+	}
+
 	_result.RawQuery = qs.Encode()
 
 	return &_result, nil
@@ -192,3 +192,48 @@ func (o *UpdateParkingNotFound) WriteResponse(rw http.ResponseWriter, producer r
 		}
 	}
 }
+
+// UpdateParkingConflictCode is the HTTP code returned for type UpdateParkingConflict
+const UpdateParkingConflictCode int = 409
+
+/*
+UpdateParkingConflict Capacity is below the spots already booked
+
+swagger:response updateParkingConflict
+*/
+type UpdateParkingConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewUpdateParkingConflict creates UpdateParkingConflict with default headers values
+func NewUpdateParkingConflict() *UpdateParkingConflict {
+
+	return &UpdateParkingConflict{}
+}
+
+// WithPayload adds the payload to the update parking conflict response
+func (o *UpdateParkingConflict) WithPayload(payload *models.Error) *UpdateParkingConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update parking conflict response
+func (o *UpdateParkingConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateParkingConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
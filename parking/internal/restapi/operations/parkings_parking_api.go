@@ -52,15 +52,36 @@ func NewParkingsParkingAPI(spec *loads.Document) *ParkingsParkingAPI {
 		ParkingCreateParkingHandler: parking.CreateParkingHandlerFunc(func(params parking.CreateParkingParams, principal *models.User) middleware.Responder {
 			return middleware.NotImplemented("operation parking.CreateParking has not yet been implemented")
 		}),
+		ParkingCreateParkingReviewHandler: parking.CreateParkingReviewHandlerFunc(func(params parking.CreateParkingReviewParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation parking.CreateParkingReview has not yet been implemented")
+		}),
 		ParkingDeleteParkingHandler: parking.DeleteParkingHandlerFunc(func(params parking.DeleteParkingParams, principal *models.User) middleware.Responder {
 			return middleware.NotImplemented("operation parking.DeleteParking has not yet been implemented")
 		}),
 		ParkingGetParkingByIDHandler: parking.GetParkingByIDHandlerFunc(func(params parking.GetParkingByIDParams) middleware.Responder {
 			return middleware.NotImplemented("operation parking.GetParkingByID has not yet been implemented")
 		}),
+		ParkingGetParkingClosureHandler: parking.GetParkingClosureHandlerFunc(func(params parking.GetParkingClosureParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation parking.GetParkingClosure has not yet been implemented")
+		}),
+		ParkingGetParkingReviewsHandler: parking.GetParkingReviewsHandlerFunc(func(params parking.GetParkingReviewsParams) middleware.Responder {
+			return middleware.NotImplemented("operation parking.GetParkingReviews has not yet been implemented")
+		}),
 		ParkingGetParkingsHandler: parking.GetParkingsHandlerFunc(func(params parking.GetParkingsParams) middleware.Responder {
 			return middleware.NotImplemented("operation parking.GetParkings has not yet been implemented")
 		}),
+		ParkingModerateParkingReviewHandler: parking.ModerateParkingReviewHandlerFunc(func(params parking.ModerateParkingReviewParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation parking.ModerateParkingReview has not yet been implemented")
+		}),
+		ParkingReplyParkingReviewHandler: parking.ReplyParkingReviewHandlerFunc(func(params parking.ReplyParkingReviewParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation parking.ReplyParkingReview has not yet been implemented")
+		}),
+		ParkingResumeParkingHandler: parking.ResumeParkingHandlerFunc(func(params parking.ResumeParkingParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation parking.ResumeParking has not yet been implemented")
+		}),
+		ParkingSuspendParkingHandler: parking.SuspendParkingHandlerFunc(func(params parking.SuspendParkingParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation parking.SuspendParking has not yet been implemented")
+		}),
 		ParkingUpdateParkingHandler: parking.UpdateParkingHandlerFunc(func(params parking.UpdateParkingParams, principal *models.User) middleware.Responder {
 			return middleware.NotImplemented("operation parking.UpdateParking has not yet been implemented")
 		}),
@@ -118,12 +139,26 @@ type ParkingsParkingAPI struct {
 	InstrumentsGetMetricsHandler instruments.GetMetricsHandler
 	// ParkingCreateParkingHandler sets the operation handler for the create parking operation
 	ParkingCreateParkingHandler parking.CreateParkingHandler
+	// ParkingCreateParkingReviewHandler sets the operation handler for the create parking review operation
+	ParkingCreateParkingReviewHandler parking.CreateParkingReviewHandler
 	// ParkingDeleteParkingHandler sets the operation handler for the delete parking operation
 	ParkingDeleteParkingHandler parking.DeleteParkingHandler
 	// ParkingGetParkingByIDHandler sets the operation handler for the get parking by id operation
 	ParkingGetParkingByIDHandler parking.GetParkingByIDHandler
+	// ParkingGetParkingClosureHandler sets the operation handler for the get parking closure operation
+	ParkingGetParkingClosureHandler parking.GetParkingClosureHandler
+	// ParkingGetParkingReviewsHandler sets the operation handler for the get parking reviews operation
+	ParkingGetParkingReviewsHandler parking.GetParkingReviewsHandler
 	// ParkingGetParkingsHandler sets the operation handler for the get parkings operation
 	ParkingGetParkingsHandler parking.GetParkingsHandler
+	// ParkingModerateParkingReviewHandler sets the operation handler for the moderate parking review operation
+	ParkingModerateParkingReviewHandler parking.ModerateParkingReviewHandler
+	// ParkingReplyParkingReviewHandler sets the operation handler for the reply parking review operation
+	ParkingReplyParkingReviewHandler parking.ReplyParkingReviewHandler
+	// ParkingResumeParkingHandler sets the operation handler for the resume parking operation
+	ParkingResumeParkingHandler parking.ResumeParkingHandler
+	// ParkingSuspendParkingHandler sets the operation handler for the suspend parking operation
+	ParkingSuspendParkingHandler parking.SuspendParkingHandler
 	// ParkingUpdateParkingHandler sets the operation handler for the update parking operation
 	ParkingUpdateParkingHandler parking.UpdateParkingHandler
 
@@ -213,15 +248,36 @@ func (o *ParkingsParkingAPI) Validate() error {
 	if o.ParkingCreateParkingHandler == nil {
 		unregistered = append(unregistered, "parking.CreateParkingHandler")
 	}
+	if o.ParkingCreateParkingReviewHandler == nil {
+		unregistered = append(unregistered, "parking.CreateParkingReviewHandler")
+	}
 	if o.ParkingDeleteParkingHandler == nil {
 		unregistered = append(unregistered, "parking.DeleteParkingHandler")
 	}
 	if o.ParkingGetParkingByIDHandler == nil {
 		unregistered = append(unregistered, "parking.GetParkingByIDHandler")
 	}
+	if o.ParkingGetParkingClosureHandler == nil {
+		unregistered = append(unregistered, "parking.GetParkingClosureHandler")
+	}
+	if o.ParkingGetParkingReviewsHandler == nil {
+		unregistered = append(unregistered, "parking.GetParkingReviewsHandler")
+	}
 	if o.ParkingGetParkingsHandler == nil {
 		unregistered = append(unregistered, "parking.GetParkingsHandler")
 	}
+	if o.ParkingModerateParkingReviewHandler == nil {
+		unregistered = append(unregistered, "parking.ModerateParkingReviewHandler")
+	}
+	if o.ParkingReplyParkingReviewHandler == nil {
+		unregistered = append(unregistered, "parking.ReplyParkingReviewHandler")
+	}
+	if o.ParkingResumeParkingHandler == nil {
+		unregistered = append(unregistered, "parking.ResumeParkingHandler")
+	}
+	if o.ParkingSuspendParkingHandler == nil {
+		unregistered = append(unregistered, "parking.SuspendParkingHandler")
+	}
 	if o.ParkingUpdateParkingHandler == nil {
 		unregistered = append(unregistered, "parking.UpdateParkingHandler")
 	}
@@ -332,6 +388,10 @@ func (o *ParkingsParkingAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/parking"] = parking.NewCreateParking(o.context, o.ParkingCreateParkingHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/parking/{parking_id}/reviews"] = parking.NewCreateParkingReview(o.context, o.ParkingCreateParkingReviewHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
@@ -343,10 +403,34 @@ func (o *ParkingsParkingAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/parking/{parking_id}/closure"] = parking.NewGetParkingClosure(o.context, o.ParkingGetParkingClosureHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/parking/{parking_id}/reviews"] = parking.NewGetParkingReviews(o.context, o.ParkingGetParkingReviewsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/parking"] = parking.NewGetParkings(o.context, o.ParkingGetParkingsHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
+	o.handlers["PUT"]["/parking/{parking_id}/reviews/{review_id}/moderation"] = parking.NewModerateParkingReview(o.context, o.ParkingModerateParkingReviewHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/parking/{parking_id}/reviews/{review_id}/reply"] = parking.NewReplyParkingReview(o.context, o.ParkingReplyParkingReviewHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/parking/{parking_id}/resume"] = parking.NewResumeParking(o.context, o.ParkingResumeParkingHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/parking/{parking_id}/suspend"] = parking.NewSuspendParking(o.context, o.ParkingSuspendParkingHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
 	o.handlers["PUT"]["/parking/{parking_id}"] = parking.NewUpdateParking(o.context, o.ParkingUpdateParkingHandler)
 }
 
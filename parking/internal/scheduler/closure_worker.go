@@ -0,0 +1,78 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/h4x4d/parking_net/parking/internal/service"
+)
+
+const defaultClosureInterval = time.Minute
+
+// ClosureWorker resumes the parking closures that stopped at a failing service,
+// such as booking or payment being unavailable when an owner deleted a place.
+type ClosureWorker struct {
+	closures *service.ClosureService
+	interval time.Duration
+	cancel   context.CancelFunc
+	done     chan struct{}
+}
+
+func NewClosureWorker(closures *service.ClosureService) *ClosureWorker {
+	interval := defaultClosureInterval
+	if raw := os.Getenv("PARKING_CLOSURE_INTERVAL"); raw != "" {
+		parsed, err := time.ParseDuration(raw)
+		if err != nil || parsed <= 0 {
+			slog.Warn("invalid PARKING_CLOSURE_INTERVAL, using default",
+				"value", raw, "default", defaultClosureInterval.String())
+		} else {
+			interval = parsed
+		}
+	}
+	return &ClosureWorker{closures: closures, interval: interval}
+}
+
+func (w *ClosureWorker) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	w.cancel = cancel
+	w.done = make(chan struct{})
+
+	go func() {
+		defer close(w.done)
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				w.run(ctx)
+			}
+		}
+	}()
+	slog.Info("parking closure worker started", slog.String("interval", w.interval.String()))
+}
+
+// Stop cancels the worker and waits for the current run to finish.
+func (w *ClosureWorker) Stop() {
+	if w.cancel == nil {
+		return
+	}
+	w.cancel()
+	<-w.done
+	slog.Info("parking closure worker stopped")
+}
+
+// run leaves alone the closures that moved within the last interval, since a
+// request may still be running them.
+func (w *ClosureWorker) run(ctx context.Context) {
+	defer func() {
+		if r := recover(); r != nil {
+			slog.Error("parking closure worker panic", "error", r)
+		}
+	}()
+	w.closures.ResumeOpen(ctx, w.interval)
+}
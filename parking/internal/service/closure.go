@@ -0,0 +1,348 @@
+package service
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/h4x4d/parking_net/parking/internal/repository"
+	"github.com/h4x4d/parking_net/parking/internal/utils"
+	"github.com/h4x4d/parking_net/pkg/domain"
+	"github.com/h4x4d/parking_net/pkg/errors"
+)
+
+// PaymentClient refunds bookings canceled by a closure.
+type PaymentClient interface {
+	// RefundBooking returns what the driver paid for the booking and has not got
+	// back yet, with the amount or, when declined, the reason.
+	RefundBooking(ctx context.Context, bookingID int64, driverID string, ownerID string,
+		reason string) (refund domain.RefundStatus, amount int64, declined string, err error)
+}
+
+type Notifier interface {
+	Notify(ctx context.Context, userID string, name string, text string) error
+}
+
+// ClosureService deletes and suspends parking places. Both stop new bookings
+// at once and then run a closure: cancel the bookings that have not ended,
+// refund them, notify the drivers and the owner, and finally delete the place
+// or leave it suspended. Every step is recorded, so a closure interrupted by a
+// failing service is resumed later from where it stopped. A place is deleted
+// only once the drivers checked in there have checked out.
+type ClosureService struct {
+	parkings repository.ParkingRepository
+	closures repository.ClosureRepository
+	bookings BookingClient
+	payments PaymentClient
+	notifier Notifier
+}
+
+func NewClosureService(parkings repository.ParkingRepository, closures repository.ClosureRepository,
+	bookings BookingClient, payments PaymentClient, notifier Notifier) *ClosureService {
+	return &ClosureService{
+		parkings: parkings,
+		closures: closures,
+		bookings: bookings,
+		payments: payments,
+		notifier: notifier,
+	}
+}
+
+// CloseParking starts deleting or suspending the parking place and runs the
+// closure as far as it gets. A closure that cannot finish now is returned at
+// the step it stopped at and resumed in the background.
+func (s *ClosureService) CloseParking(ctx context.Context, id int64, kind domain.ClosureKind, reason string,
+	user *domain.User) (*domain.ParkingClosure, *errors.AppError) {
+	if !user.IsOwner() && !user.IsAdmin() {
+		return nil, errors.ErrForbidden
+	}
+
+	existing, err := s.parkings.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.Internal(utils.SanitizeError(err))
+	}
+	if existing == nil {
+		return nil, errors.NotFound("parking place")
+	}
+	if !user.IsAdmin() && existing.OwnerID != user.ID {
+		return nil, errors.ErrForbidden
+	}
+
+	if reason == "" {
+		reason = fmt.Sprintf("the parking place was %s by its owner", kindVerb(kind))
+	}
+	closure, err := s.closures.Start(ctx, id, kind, reason)
+	if stderrors.Is(err, domain.ErrParkingNotFound) {
+		return nil, errors.NotFound("parking place")
+	}
+	if err != nil {
+		return nil, errors.Internal(utils.SanitizeError(err))
+	}
+
+	if err := s.Run(ctx, closure); err != nil {
+		slog.Warn("parking closure interrupted, it will be resumed",
+			slog.Int64("closure_id", closure.ID),
+			slog.Int64("parking_id", id),
+			slog.String("step", string(closure.Step)),
+			slog.String("error", err.Error()),
+		)
+	}
+
+	latest, err := s.closures.GetByID(ctx, closure.ID)
+	if err != nil {
+		return nil, errors.Internal(utils.SanitizeError(err))
+	}
+	return latest, nil
+}
+
+// ReopenParking lets a suspended parking place take bookings again once its
+// closure has finished. The place is reopened before its bookings, so none are
+// taken while a closure still runs; a request that failed in between is
+// repeated on the place that is already active.
+func (s *ClosureService) ReopenParking(ctx context.Context, id int64,
+	user *domain.User) (*domain.ParkingPlace, *errors.AppError) {
+	if !user.IsOwner() && !user.IsAdmin() {
+		return nil, errors.ErrForbidden
+	}
+
+	existing, err := s.parkings.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.Internal(utils.SanitizeError(err))
+	}
+	if existing == nil {
+		return nil, errors.NotFound("parking place")
+	}
+	if !user.IsAdmin() && existing.OwnerID != user.ID {
+		return nil, errors.ErrForbidden
+	}
+
+	if existing.Status != domain.ParkingStatusActive {
+		reopened, err := s.closures.Reopen(ctx, id)
+		if err != nil {
+			return nil, errors.Internal(utils.SanitizeError(err))
+		}
+		if !reopened {
+			return nil, errors.New(http.StatusConflict, "parking place is not suspended or its closure is still running")
+		}
+	}
+	if err := s.bookings.ReopenBookings(ctx, id); err != nil {
+		return nil, errors.Internal(utils.SanitizeError(err))
+	}
+
+	existing.Status = domain.ParkingStatusActive
+	return existing, nil
+}
+
+// GetClosure returns the latest closure of the parking place, which may already
+// be deleted.
+func (s *ClosureService) GetClosure(ctx context.Context, id int64,
+	user *domain.User) (*domain.ParkingClosure, *errors.AppError) {
+	if !user.IsOwner() && !user.IsAdmin() {
+		return nil, errors.ErrForbidden
+	}
+
+	closure, err := s.closures.GetLatest(ctx, id)
+	if err != nil {
+		return nil, errors.Internal(utils.SanitizeError(err))
+	}
+	if closure == nil {
+		return nil, errors.NotFound("parking closure")
+	}
+	if !user.IsAdmin() && closure.OwnerID != user.ID {
+		return nil, errors.ErrForbidden
+	}
+	return closure, nil
+}
+
+// ResumeOpen carries on every closure that has not finished and has not moved
+// for at least idle, which keeps it away from closures a request is running.
+func (s *ClosureService) ResumeOpen(ctx context.Context, idle time.Duration) {
+	closures, err := s.closures.ListOpen(ctx)
+	if err != nil {
+		slog.Error("failed to get open parking closures", slog.String("error", err.Error()))
+		return
+	}
+	for _, closure := range closures {
+		if time.Since(closure.UpdatedAt) < idle {
+			continue
+		}
+		if err := s.Run(ctx, closure); err != nil {
+			slog.Warn("failed to resume parking closure",
+				slog.Int64("closure_id", closure.ID),
+				slog.String("step", string(closure.Step)),
+				slog.String("error", err.Error()),
+			)
+		}
+	}
+}
+
+// Run carries the closure on from its current step until it is done. It can be
+// called again after it failed; the error is recorded with the closure.
+func (s *ClosureService) Run(ctx context.Context, closure *domain.ParkingClosure) error {
+	for closure.Step != domain.ClosureStepDone {
+		var err error
+		switch closure.Step {
+		case domain.ClosureStepCanceling:
+			err = s.cancelBookings(ctx, closure)
+		case domain.ClosureStepRefunding:
+			err = s.refundBookings(ctx, closure)
+		case domain.ClosureStepNotifying:
+			err = s.notifyUsers(ctx, closure)
+		default:
+			err = fmt.Errorf("unknown closure step %q", closure.Step)
+		}
+		if err != nil {
+			if recordErr := s.closures.SetError(ctx, closure.ID, err); recordErr != nil {
+				slog.Error("failed to record parking closure error", slog.String("error", recordErr.Error()))
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// cancelBookings records the bookings to compensate before canceling them, so
+// the ones a crash interrupted after the cancel are still refunded on resume.
+// Bookings already checked in keep their spot and are left alone.
+func (s *ClosureService) cancelBookings(ctx context.Context, closure *domain.ParkingClosure) error {
+	active, err := s.bookings.ListActiveBookings(ctx, closure.ParkingPlaceID)
+	if err != nil {
+		return fmt.Errorf("failed to get bookings: %w", err)
+	}
+	pending := make([]*domain.ClosureBooking, 0, len(active))
+	for _, booking := range active {
+		if booking.Status == domain.BookingStatusWaiting || booking.Status == domain.BookingStatusConfirmed {
+			pending = append(pending, &domain.ClosureBooking{BookingID: booking.ID, DriverID: booking.UserID})
+		}
+	}
+	if err := s.closures.AddBookings(ctx, closure.ID, pending); err != nil {
+		return err
+	}
+
+	canceled, err := s.bookings.CancelBookings(ctx, closure.ParkingPlaceID, closure.Reason)
+	if err != nil {
+		return fmt.Errorf("failed to cancel bookings: %w", err)
+	}
+	pending = pending[:0]
+	for _, booking := range canceled {
+		pending = append(pending, &domain.ClosureBooking{BookingID: booking.ID, DriverID: booking.UserID})
+	}
+	if err := s.closures.AddBookings(ctx, closure.ID, pending); err != nil {
+		return err
+	}
+
+	if err := s.closures.SetStep(ctx, closure.ID, domain.ClosureStepRefunding); err != nil {
+		return err
+	}
+	refreshed, err := s.closures.GetByID(ctx, closure.ID)
+	if err != nil {
+		return err
+	}
+	*closure = *refreshed
+	return nil
+}
+
+// refundBookings refunds every booking of the closure in full. A refund the
+// payment service declines is recorded and reported to the owner instead of
+// blocking the closure.
+func (s *ClosureService) refundBookings(ctx context.Context, closure *domain.ParkingClosure) error {
+	reason := fmt.Sprintf("parking place %d %s: %s", closure.ParkingPlaceID, kindVerb(closure.Kind), closure.Reason)
+	for _, booking := range closure.Bookings {
+		if booking.Refund != domain.RefundPending {
+			continue
+		}
+		refund, amount, declined, err := s.payments.RefundBooking(ctx, booking.BookingID, booking.DriverID,
+			closure.OwnerID, reason)
+		if err != nil {
+			return fmt.Errorf("failed to refund booking %d: %w", booking.BookingID, err)
+		}
+		if refund == domain.RefundDeclined {
+			slog.Warn("refund of canceled booking declined",
+				slog.Int64("closure_id", closure.ID),
+				slog.Int64("booking_id", booking.BookingID),
+				slog.String("reason", declined),
+			)
+		}
+		if err := s.closures.SaveRefund(ctx, closure.ID, booking.BookingID, refund, amount); err != nil {
+			return err
+		}
+		booking.Refund = refund
+		booking.RefundedAmount = amount
+	}
+
+	if err := s.closures.SetStep(ctx, closure.ID, domain.ClosureStepNotifying); err != nil {
+		return err
+	}
+	closure.Step = domain.ClosureStepNotifying
+	return nil
+}
+
+// notifyUsers tells every driver what happened to their booking and money, then
+// the owner how the closure went, and finishes the closure. A delete waits here
+// until no driver is checked in at the place any more.
+func (s *ClosureService) notifyUsers(ctx context.Context, closure *domain.ParkingClosure) error {
+	for _, booking := range closure.Bookings {
+		if booking.Notified {
+			continue
+		}
+		text := fmt.Sprintf("Your booking with booking_id %d was canceled because parking place %d was %s: %s. ",
+			booking.BookingID, closure.ParkingPlaceID, kindVerb(closure.Kind), closure.Reason)
+		switch booking.Refund {
+		case domain.RefundDone:
+			text += fmt.Sprintf("%d was refunded to your balance.", booking.RefundedAmount)
+		case domain.RefundDeclined:
+			text += "The refund could not be made yet, please contact support."
+		default:
+			text += "Nothing had been charged; a payment still in progress is returned automatically."
+		}
+		if err := s.notifier.Notify(ctx, booking.DriverID, "Booking canceled", text); err != nil {
+			return fmt.Errorf("failed to notify driver of booking %d: %w", booking.BookingID, err)
+		}
+		if err := s.closures.MarkNotified(ctx, closure.ID, booking.BookingID); err != nil {
+			return err
+		}
+		booking.Notified = true
+	}
+
+	if closure.Kind == domain.ClosureDelete {
+		active, err := s.bookings.ListActiveBookings(ctx, closure.ParkingPlaceID)
+		if err != nil {
+			return fmt.Errorf("failed to get bookings: %w", err)
+		}
+		checkedIn := 0
+		for _, booking := range active {
+			if booking.Status == domain.BookingStatusActive {
+				checkedIn++
+			}
+		}
+		if checkedIn > 0 {
+			return fmt.Errorf("%d drivers are still checked in", checkedIn)
+		}
+	}
+
+	refunds := closure.Refunds()
+	text := fmt.Sprintf("Parking place %d was %s. %d bookings were canceled, %d refunded",
+		closure.ParkingPlaceID, kindVerb(closure.Kind), len(closure.Bookings), refunds[domain.RefundDone])
+	if declined := refunds[domain.RefundDeclined]; declined > 0 {
+		text += fmt.Sprintf(", %d refunds were declined and need your attention", declined)
+	}
+	if err := s.notifier.Notify(ctx, closure.OwnerID, "Parking place "+kindVerb(closure.Kind), text+"."); err != nil {
+		return fmt.Errorf("failed to notify owner: %w", err)
+	}
+
+	if err := s.closures.Finish(ctx, closure); err != nil {
+		return err
+	}
+	closure.Step = domain.ClosureStepDone
+	return nil
+}
+
+func kindVerb(kind domain.ClosureKind) string {
+	if kind == domain.ClosureDelete {
+		return "deleted"
+	}
+	return "suspended"
+}
@@ -2,6 +2,8 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"net/http"
 
 	"github.com/h4x4d/parking_net/parking/internal/repository"
 	"github.com/h4x4d/parking_net/parking/internal/utils"
@@ -9,12 +11,35 @@ import (
 	"github.com/h4x4d/parking_net/pkg/errors"
 )
 
+// BookingClient reaches the bookings a parking place still has to serve.
+type BookingClient interface {
+	// GetFutureOccupancy returns how many bookings, holds and waitlist offers of
+	// the parking place have not ended yet and the most of them at one time.
+	GetFutureOccupancy(ctx context.Context, parkingPlaceID int64) (count int64, peak int64, err error)
+	ListActiveBookings(ctx context.Context, parkingPlaceID int64) ([]*domain.Booking, error)
+	// CancelBookings cancels the Waiting and Confirmed bookings of the parking
+	// place that have not ended and returns them. The place takes no bookings
+	// afterwards until ReopenBookings.
+	CancelBookings(ctx context.Context, parkingPlaceID int64, reason string) ([]*domain.Booking, error)
+	// ReopenBookings lets a parking place closed by CancelBookings take bookings
+	// again.
+	ReopenBookings(ctx context.Context, parkingPlaceID int64) error
+	// GetBooking returns nil without an error when the booking does not exist.
+	GetBooking(ctx context.Context, bookingID int64) (*domain.Booking, error)
+}
+
+const (
+	defaultRadiusMeters = 5000
+	maxRadiusMeters     = 100000
+)
+
 type ParkingService struct {
-	repo repository.ParkingRepository
+	repo     repository.ParkingRepository
+	bookings BookingClient
 }
 
-func NewParkingService(repo repository.ParkingRepository) *ParkingService {
-	return &ParkingService{repo: repo}
+func NewParkingService(repo repository.ParkingRepository, bookings BookingClient) *ParkingService {
+	return &ParkingService{repo: repo, bookings: bookings}
 }
 
 func (s *ParkingService) CreateParking(ctx context.Context, parking *domain.ParkingPlace, user *domain.User) (*domain.ParkingPlace, *errors.AppError) {
@@ -49,7 +74,28 @@ func (s *ParkingService) GetParkingByID(ctx context.Context, id int64) (*domain.
 	return parking, nil
 }
 
+// GetParkings searches the parking places. A search near a point defaults to
+// a radius of defaultRadiusMeters and to the nearest places first.
 func (s *ParkingService) GetParkings(ctx context.Context, filters repository.ParkingFilters) ([]*domain.ParkingPlace, *errors.AppError) {
+	if filters.Near == nil {
+		if filters.RadiusMeters != 0 || filters.Sort == repository.SortDistance {
+			return nil, errors.Validation("radius_m and sorting by distance need lat and lon")
+		}
+	} else {
+		if err := filters.Near.IsValid(); err != nil {
+			return nil, errors.Validation(err.Error())
+		}
+		if filters.RadiusMeters <= 0 {
+			filters.RadiusMeters = defaultRadiusMeters
+		}
+		if filters.RadiusMeters > maxRadiusMeters {
+			return nil, errors.Validation(fmt.Sprintf("radius_m must be at most %d", maxRadiusMeters))
+		}
+		if filters.Sort == "" {
+			filters.Sort = repository.SortDistance
+		}
+	}
+
 	parkings, err := s.repo.GetAll(ctx, filters)
 	if err != nil {
 		return nil, errors.Internal(utils.SanitizeError(err))
@@ -78,7 +124,15 @@ func (s *ParkingService) UpdateParking(ctx context.Context, id int64, parking *d
 		return errors.ErrForbidden
 	}
 
+	if existing.Status == domain.ParkingStatusClosing {
+		return errors.New(http.StatusConflict, "parking place is being deleted")
+	}
+
 	parking.ID = id
+	// An update without coordinates keeps the location.
+	if parking.Location == nil {
+		parking.Location = existing.Location
+	}
 	if !user.IsAdmin() {
 		parking.OwnerID = user.ID
 	} else {
@@ -89,37 +143,18 @@ func (s *ParkingService) UpdateParking(ctx context.Context, id int64, parking *d
 		return errors.Validation(err.Error())
 	}
 
-	if err := s.repo.Update(ctx, parking); err != nil {
-		return errors.Internal(utils.SanitizeError(err))
+	if parking.Capacity < existing.Capacity {
+		_, peak, err := s.bookings.GetFutureOccupancy(ctx, id)
+		if err != nil {
+			return errors.Internal(utils.SanitizeError(err))
+		}
+		if peak > int64(parking.Capacity) {
+			return errors.New(http.StatusConflict,
+				fmt.Sprintf("capacity %d is below the %d spots already booked", parking.Capacity, peak))
+		}
 	}
 
-	return nil
-}
-
-func (s *ParkingService) DeleteParking(ctx context.Context, id int64, user *domain.User) *errors.AppError {
-	if !user.IsOwner() && !user.IsAdmin() {
-		return errors.ErrForbidden
-	}
-
-	existing, err := s.repo.GetByID(ctx, id)
-	if err != nil {
-		return errors.Internal(utils.SanitizeError(err))
-	}
-
-	if existing == nil {
-		return errors.NotFound("parking place")
-	}
-
-	if !user.IsAdmin() && existing.OwnerID != user.ID {
-		return errors.ErrForbidden
-	}
-
-	ownerID := user.ID
-	if user.IsAdmin() {
-		ownerID = existing.OwnerID
-	}
-
-	if err := s.repo.Delete(ctx, id, ownerID); err != nil {
+	if err := s.repo.Update(ctx, parking); err != nil {
 		return errors.Internal(utils.SanitizeError(err))
 	}
 
@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	stderrors "errors"
+	"net/http"
+
+	"github.com/h4x4d/parking_net/parking/internal/repository"
+	"github.com/h4x4d/parking_net/parking/internal/utils"
+	"github.com/h4x4d/parking_net/pkg/domain"
+	"github.com/h4x4d/parking_net/pkg/errors"
+)
+
+const (
+	defaultReviewLimit = 20
+	maxReviewLimit     = 100
+)
+
+// ReviewService lets drivers rate the parking places they stayed at, owners
+// answer the reviews of their places and admins hide reviews that break the
+// rules.
+type ReviewService struct {
+	parkings repository.ParkingRepository
+	reviews  repository.ReviewRepository
+	bookings BookingClient
+}
+
+func NewReviewService(parkings repository.ParkingRepository, reviews repository.ReviewRepository,
+	bookings BookingClient) *ReviewService {
+	return &ReviewService{parkings: parkings, reviews: reviews, bookings: bookings}
+}
+
+// CreateReview rates the parking place for one of the driver's bookings there.
+// Only a Completed booking can be reviewed, and only once.
+func (s *ReviewService) CreateReview(ctx context.Context, parkingID int64, review *domain.Review,
+	user *domain.User) (*domain.Review, *errors.AppError) {
+	if !user.IsDriver() {
+		return nil, errors.ErrForbidden
+	}
+	if err := review.IsValid(); err != nil {
+		return nil, errors.Validation(err.Error())
+	}
+
+	if appErr := s.checkParkingExists(ctx, parkingID); appErr != nil {
+		return nil, appErr
+	}
+
+	booking, err := s.bookings.GetBooking(ctx, review.BookingID)
+	if err != nil {
+		return nil, errors.Internal(utils.SanitizeError(err))
+	}
+	if booking == nil || booking.UserID != user.ID {
+		return nil, errors.NotFound("booking")
+	}
+	if booking.ParkingPlaceID != parkingID {
+		return nil, errors.Validation("the booking is for another parking place")
+	}
+	if booking.Status != domain.BookingStatusCompleted {
+		return nil, errors.New(http.StatusConflict, "only completed bookings can be reviewed")
+	}
+
+	review.ParkingPlaceID = parkingID
+	review.UserID = user.ID
+	created, err := s.reviews.Create(ctx, review)
+	if stderrors.Is(err, domain.ErrReviewExists) {
+		return nil, errors.New(http.StatusConflict, err.Error())
+	}
+	if err != nil {
+		return nil, errors.Internal(utils.SanitizeError(err))
+	}
+	return created, nil
+}
+
+// GetReviews returns a page of the published reviews of the parking place,
+// newest first. A limit out of range falls back to the default.
+func (s *ReviewService) GetReviews(ctx context.Context, parkingID int64, limit int64,
+	offset int64) ([]*domain.Review, *errors.AppError) {
+	if appErr := s.checkParkingExists(ctx, parkingID); appErr != nil {
+		return nil, appErr
+	}
+	if limit <= 0 || limit > maxReviewLimit {
+		limit = defaultReviewLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	reviews, err := s.reviews.ListPublished(ctx, parkingID, limit, offset)
+	if err != nil {
+		return nil, errors.Internal(utils.SanitizeError(err))
+	}
+	return reviews, nil
+}
+
+// ReplyToReview stores the reply of the parking place's owner, or of an admin,
+// replacing an earlier reply.
+func (s *ReviewService) ReplyToReview(ctx context.Context, parkingID int64, reviewID int64, reply string,
+	user *domain.User) (*domain.Review, *errors.AppError) {
+	if !user.IsOwner() && !user.IsAdmin() {
+		return nil, errors.ErrForbidden
+	}
+	if err := domain.ValidateReviewReply(reply); err != nil {
+		return nil, errors.Validation(err.Error())
+	}
+
+	parking, err := s.parkings.GetByID(ctx, parkingID)
+	if err != nil {
+		return nil, errors.Internal(utils.SanitizeError(err))
+	}
+	if parking == nil {
+		return nil, errors.NotFound("parking place")
+	}
+	if !user.IsAdmin() && parking.OwnerID != user.ID {
+		return nil, errors.ErrForbidden
+	}
+	if appErr := s.checkReviewOfParking(ctx, parkingID, reviewID); appErr != nil {
+		return nil, appErr
+	}
+
+	updated, err := s.reviews.SetReply(ctx, reviewID, reply)
+	if err != nil {
+		return nil, errors.Internal(utils.SanitizeError(err))
+	}
+	if updated == nil {
+		return nil, errors.NotFound("review")
+	}
+	return updated, nil
+}
+
+// ModerateReview hides a review or publishes it again. Only admins moderate.
+func (s *ReviewService) ModerateReview(ctx context.Context, parkingID int64, reviewID int64,
+	status domain.ReviewStatus, reason string, user *domain.User) (*domain.Review, *errors.AppError) {
+	if !user.IsAdmin() {
+		return nil, errors.ErrForbidden
+	}
+	if !status.IsValid() {
+		return nil, errors.Validation(domain.ErrInvalidReviewStatus.Error())
+	}
+	if appErr := s.checkReviewOfParking(ctx, parkingID, reviewID); appErr != nil {
+		return nil, appErr
+	}
+
+	updated, err := s.reviews.SetStatus(ctx, reviewID, status, reason, user.ID)
+	if err != nil {
+		return nil, errors.Internal(utils.SanitizeError(err))
+	}
+	if updated == nil {
+		return nil, errors.NotFound("review")
+	}
+	return updated, nil
+}
+
+func (s *ReviewService) checkParkingExists(ctx context.Context, parkingID int64) *errors.AppError {
+	exists, err := s.parkings.Exists(ctx, parkingID)
+	if err != nil {
+		return errors.Internal(utils.SanitizeError(err))
+	}
+	if !exists {
+		return errors.NotFound("parking place")
+	}
+	return nil
+}
+
+// checkReviewOfParking makes a review of another parking place look missing,
+// so it cannot be reached through a place its caller manages.
+func (s *ReviewService) checkReviewOfParking(ctx context.Context, parkingID int64, reviewID int64) *errors.AppError {
+	review, err := s.reviews.GetByID(ctx, reviewID)
+	if err != nil {
+		return errors.Internal(utils.SanitizeError(err))
+	}
+	if review == nil || review.ParkingPlaceID != parkingID {
+		return errors.NotFound("review")
+	}
+	return nil
+}
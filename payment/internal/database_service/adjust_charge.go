@@ -0,0 +1,168 @@
+package database_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/h4x4d/parking_net/payment/internal/models"
+	"github.com/h4x4d/parking_net/payment/internal/utils"
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel"
+)
+
+// AdjustCharge settles a change in booking price: a positive delta moves money
+// from the driver to the owner, a negative one back to the driver. Each
+// adjustment is applied once per adjustmentID, so retries are safe.
+func (ds *DatabaseService) AdjustCharge(ctx context.Context, bookingID int64, driverID string, ownerID string,
+	delta int64, adjustmentID int64) (*models.TransactionResponse, error) {
+	if delta == 0 {
+		return &models.TransactionResponse{
+			Status:  "failed",
+			Message: "adjustment must not be zero",
+		}, nil
+	}
+	amount := delta
+	if amount < 0 {
+		amount = -amount
+	}
+	if err := utils.ValidateAmount(amount); err != nil {
+		return &models.TransactionResponse{
+			Status:  "failed",
+			Message: "invalid amount",
+		}, nil
+	}
+
+	if err := utils.ValidateUserID(driverID); err != nil {
+		return &models.TransactionResponse{
+			Status:  "failed",
+			Message: "invalid driver ID",
+		}, nil
+	}
+
+	if err := utils.ValidateUserID(ownerID); err != nil {
+		return &models.TransactionResponse{
+			Status:  "failed",
+			Message: "invalid owner ID",
+		}, nil
+	}
+
+	if err := utils.ValidateBookingID(bookingID); err != nil {
+		return &models.TransactionResponse{
+			Status:  "failed",
+			Message: "invalid booking ID",
+		}, nil
+	}
+
+	tracer := otel.Tracer("Payment")
+	ctx, span := tracer.Start(ctx, "adjust_charge")
+	defer span.End()
+
+	tx, err := ds.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := lockBooking(ctx, tx, bookingID); err != nil {
+		return nil, err
+	}
+
+	var existingID int64
+	err = tx.QueryRow(ctx,
+		`SELECT id FROM transactions
+		WHERE booking_id = $1 AND user_id = $2 AND transaction_type = 'adjustment' AND adjustment_id = $3
+		AND status = 'completed'`,
+		bookingID, driverID, adjustmentID).Scan(&existingID)
+	if err == nil {
+		return &models.TransactionResponse{
+			TransactionID: existingID,
+			Status:        "completed",
+			Message:       "adjustment already processed",
+		}, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("failed to get adjustment: %w", err)
+	}
+
+	payerID, payeeID := driverID, ownerID
+	if delta < 0 {
+		payerID, payeeID = ownerID, driverID
+	}
+
+	balances := make(map[string]int64, 2)
+	for _, userID := range []string{payerID, payeeID} {
+		var balance int64
+		err = tx.QueryRow(ctx, "SELECT balance FROM balances WHERE user_id = $1 FOR UPDATE", userID).Scan(&balance)
+		if err != nil {
+			if !errors.Is(err, pgx.ErrNoRows) {
+				return nil, fmt.Errorf("failed to get balance: %w", err)
+			}
+			_, err = tx.Exec(ctx, "INSERT INTO balances (user_id, balance, currency) VALUES ($1, 0, 'USD')", userID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create balance: %w", err)
+			}
+			balance = 0
+		}
+		balances[userID] = balance
+	}
+
+	if balances[payerID] < amount {
+		return &models.TransactionResponse{
+			Status:  "failed",
+			Message: "insufficient funds",
+		}, nil
+	}
+
+	newPayerBalance, err := utils.SafeSubtractBalance(balances[payerID], amount)
+	if err != nil {
+		return &models.TransactionResponse{
+			Status:  "failed",
+			Message: err.Error(),
+		}, nil
+	}
+
+	newPayeeBalance, err := utils.SafeAddBalance(balances[payeeID], amount)
+	if err != nil {
+		return &models.TransactionResponse{
+			Status:  "failed",
+			Message: "adjustment failed",
+		}, nil
+	}
+
+	_, err = tx.Exec(ctx, "UPDATE balances SET balance = $1 WHERE user_id = $2", newPayerBalance, payerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update payer balance: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, "UPDATE balances SET balance = $1 WHERE user_id = $2", newPayeeBalance, payeeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update payee balance: %w", err)
+	}
+
+	description := fmt.Sprintf("Price adjustment for booking %d", bookingID)
+	var driverTransactionID int64
+	err = tx.QueryRow(ctx,
+		"INSERT INTO transactions (booking_id, user_id, amount, transaction_type, status, description, adjustment_id) VALUES ($1, $2, $3, 'adjustment', 'completed', $4, $5) RETURNING id",
+		bookingID, driverID, -delta, description, adjustmentID).Scan(&driverTransactionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create driver adjustment transaction: %w", err)
+	}
+
+	_, err = tx.Exec(ctx,
+		"INSERT INTO transactions (booking_id, user_id, amount, transaction_type, status, description, adjustment_id) VALUES ($1, $2, $3, 'adjustment', 'completed', $4, $5)",
+		bookingID, ownerID, delta, description, adjustmentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create owner adjustment transaction: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return &models.TransactionResponse{
+		TransactionID: driverTransactionID,
+		Status:        "completed",
+		Message:       "adjustment completed successfully",
+	}, nil
+}
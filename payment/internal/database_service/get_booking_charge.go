@@ -0,0 +1,48 @@
+package database_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/h4x4d/parking_net/payment/internal/utils"
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel"
+)
+
+// GetBookingCharge looks up the completed charge of the driver for a booking.
+// amount is what the driver has paid in total, including price adjustments. A
+// charge that was later refunded does not count. found is false when the
+// booking has not been paid.
+func (ds *DatabaseService) GetBookingCharge(ctx context.Context, bookingID int64) (transactionID int64, amount int64, found bool, err error) {
+	if err := utils.ValidateBookingID(bookingID); err != nil {
+		return 0, 0, false, err
+	}
+
+	tracer := otel.Tracer("Payment")
+	ctx, span := tracer.Start(ctx, "get_booking_charge")
+	defer span.End()
+
+	err = ds.pool.QueryRow(ctx,
+		`WITH charge AS (
+			SELECT id, user_id FROM transactions
+			WHERE booking_id = $1 AND transaction_type = 'charge' AND status = 'completed'
+			ORDER BY id LIMIT 1
+		)
+		SELECT charge.id, -SUM(t.amount) FROM charge
+		JOIN transactions t ON t.booking_id = $1 AND t.user_id = charge.user_id
+			AND t.transaction_type IN ('charge', 'adjustment') AND t.status = 'completed'
+		WHERE NOT EXISTS (
+			SELECT 1 FROM transactions
+			WHERE booking_id = $1 AND transaction_type = 'refund' AND status = 'completed'
+		)
+		GROUP BY charge.id`,
+		bookingID).Scan(&transactionID, &amount)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, 0, false, nil
+	}
+	if err != nil {
+		return 0, 0, false, fmt.Errorf("failed to get booking charge: %w", err)
+	}
+	return transactionID, amount, true, nil
+}
@@ -0,0 +1,36 @@
+package database_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// lockBooking serializes money operations on a booking until the surrounding
+// transaction ends, so a retried call sees the result of the first one.
+func lockBooking(ctx context.Context, tx pgx.Tx, bookingID int64) error {
+	if _, err := tx.Exec(ctx, "SELECT pg_advisory_xact_lock($1)", bookingID); err != nil {
+		return fmt.Errorf("failed to lock booking: %w", err)
+	}
+	return nil
+}
+
+// findCompleted returns the completed transaction of the given type that userID
+// already has for the booking, if any.
+func findCompleted(ctx context.Context, tx pgx.Tx, bookingID int64, userID string, transactionType string) (int64, bool, error) {
+	var transactionID int64
+	err := tx.QueryRow(ctx,
+		`SELECT id FROM transactions
+		WHERE booking_id = $1 AND user_id = $2 AND transaction_type = $3 AND status = 'completed'
+		ORDER BY id LIMIT 1`,
+		bookingID, userID, transactionType).Scan(&transactionID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to get %s transaction: %w", transactionType, err)
+	}
+	return transactionID, true, nil
+}
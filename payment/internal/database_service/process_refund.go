@@ -10,7 +10,10 @@ import (
 	"go.opentelemetry.io/otel"
 )
 
-func (ds *DatabaseService) ProcessRefund(ctx context.Context, bookingID int64, driverID string, ownerID string, amount int64) (*models.TransactionResponse, error) {
+// ProcessRefund returns amount of what the driver paid for the booking, which may
+// be less than the full charge when a cancellation fee applies. reason is kept
+// in the transaction description.
+func (ds *DatabaseService) ProcessRefund(ctx context.Context, bookingID int64, driverID string, ownerID string, amount int64, reason string) (*models.TransactionResponse, error) {
 	if err := utils.ValidateAmount(amount); err != nil {
 		return &models.TransactionResponse{
 			Status:  "failed",
@@ -39,6 +42,13 @@ func (ds *DatabaseService) ProcessRefund(ctx context.Context, bookingID int64, d
 		}, nil
 	}
 
+	if err := utils.ValidateReason(reason); err != nil {
+		return &models.TransactionResponse{
+			Status:  "failed",
+			Message: "invalid reason",
+		}, nil
+	}
+
 	tracer := otel.Tracer("Payment")
 	ctx, span := tracer.Start(ctx, "process_refund")
 	defer span.End()
@@ -49,6 +59,36 @@ func (ds *DatabaseService) ProcessRefund(ctx context.Context, bookingID int64, d
 	}
 	defer tx.Rollback(ctx)
 
+	if err := lockBooking(ctx, tx, bookingID); err != nil {
+		return nil, err
+	}
+	refundID, refunded, err := findCompleted(ctx, tx, bookingID, driverID, "refund")
+	if err != nil {
+		return nil, err
+	}
+	if refunded {
+		return &models.TransactionResponse{
+			TransactionID: refundID,
+			Status:        "completed",
+			Message:       "refund already processed",
+		}, nil
+	}
+
+	var charged int64
+	err = tx.QueryRow(ctx,
+		`SELECT COALESCE(-SUM(amount), 0) FROM transactions
+		WHERE booking_id = $1 AND user_id = $2 AND transaction_type IN ('charge', 'adjustment') AND status = 'completed'`,
+		bookingID, driverID).Scan(&charged)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get charged amount: %w", err)
+	}
+	if amount > charged {
+		return &models.TransactionResponse{
+			Status:  "failed",
+			Message: "refund exceeds charged amount",
+		}, nil
+	}
+
 	var ownerBalance int64
 	err = tx.QueryRow(ctx, "SELECT balance FROM balances WHERE user_id = $1 FOR UPDATE", ownerID).Scan(&ownerBalance)
 	if err != nil {
@@ -105,10 +145,14 @@ func (ds *DatabaseService) ProcessRefund(ctx context.Context, bookingID int64, d
 		return nil, fmt.Errorf("failed to update driver balance: %w", err)
 	}
 
+	description := fmt.Sprintf("Refund for booking %d", bookingID)
+	if reason != "" {
+		description = fmt.Sprintf("%s: %s", description, reason)
+	}
 	var refundTransactionID int64
 	err = tx.QueryRow(ctx,
 		"INSERT INTO transactions (booking_id, user_id, amount, transaction_type, status, description) VALUES ($1, $2, $3, 'refund', 'completed', $4) RETURNING id",
-		bookingID, driverID, amount, fmt.Sprintf("Refund for booking %d", bookingID)).Scan(&refundTransactionID)
+		bookingID, driverID, amount, description).Scan(&refundTransactionID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create refund transaction: %w", err)
 	}
@@ -0,0 +1,200 @@
+package database_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/h4x4d/parking_net/payment/internal/models"
+	"github.com/h4x4d/parking_net/payment/internal/utils"
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel"
+)
+
+// BookingCharge is the amount a single booking of a series costs.
+type BookingCharge struct {
+	BookingID int64
+	Amount    int64
+}
+
+// ProcessSeriesTransaction charges the driver for all the given bookings in a
+// single transaction: the driver pays their total or nothing at all. Every
+// booking gets its own charge and payment, so it can be refunded on its own
+// later. Bookings that were already charged are skipped, so retries are safe.
+func (ds *DatabaseService) ProcessSeriesTransaction(ctx context.Context, driverID string, ownerID string,
+	charges []BookingCharge) (*models.TransactionResponse, error) {
+	if len(charges) == 0 {
+		return &models.TransactionResponse{
+			Status:  "failed",
+			Message: "no bookings to charge",
+		}, nil
+	}
+	for _, charge := range charges {
+		if err := utils.ValidateBookingID(charge.BookingID); err != nil {
+			return &models.TransactionResponse{
+				Status:  "failed",
+				Message: "invalid booking ID",
+			}, nil
+		}
+		if err := utils.ValidateAmount(charge.Amount); err != nil {
+			return &models.TransactionResponse{
+				Status:  "failed",
+				Message: "invalid amount",
+			}, nil
+		}
+	}
+
+	if err := utils.ValidateUserID(driverID); err != nil {
+		return &models.TransactionResponse{
+			Status:  "failed",
+			Message: "invalid driver ID",
+		}, nil
+	}
+
+	if err := utils.ValidateUserID(ownerID); err != nil {
+		return &models.TransactionResponse{
+			Status:  "failed",
+			Message: "invalid owner ID",
+		}, nil
+	}
+
+	tracer := otel.Tracer("Payment")
+	ctx, span := tracer.Start(ctx, "process_series_transaction")
+	defer span.End()
+
+	tx, err := ds.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Lock the bookings in a fixed order, so overlapping series cannot deadlock.
+	sorted := make([]BookingCharge, len(charges))
+	copy(sorted, charges)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].BookingID < sorted[j].BookingID })
+
+	var firstID, total int64
+	pending := make([]BookingCharge, 0, len(sorted))
+	for _, charge := range sorted {
+		if err := lockBooking(ctx, tx, charge.BookingID); err != nil {
+			return nil, err
+		}
+		chargeID, charged, err := findCompleted(ctx, tx, charge.BookingID, driverID, "charge")
+		if err != nil {
+			return nil, err
+		}
+		if charged {
+			if firstID == 0 {
+				firstID = chargeID
+			}
+			continue
+		}
+		total, err = utils.SafeAddBalance(total, charge.Amount)
+		if err != nil {
+			return &models.TransactionResponse{
+				Status:  "failed",
+				Message: "invalid amount",
+			}, nil
+		}
+		pending = append(pending, charge)
+	}
+	if len(pending) == 0 {
+		return &models.TransactionResponse{
+			TransactionID: firstID,
+			Status:        "completed",
+			Message:       "transaction already processed",
+		}, nil
+	}
+
+	var driverBalance int64
+	err = tx.QueryRow(ctx, "SELECT balance FROM balances WHERE user_id = $1 FOR UPDATE", driverID).Scan(&driverBalance)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			_, err = tx.Exec(ctx, "INSERT INTO balances (user_id, balance, currency) VALUES ($1, 0, 'USD')", driverID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create driver balance: %w", err)
+			}
+			driverBalance = 0
+		} else {
+			return nil, fmt.Errorf("failed to get driver balance: %w", err)
+		}
+	}
+
+	if driverBalance < total {
+		return &models.TransactionResponse{
+			Status:  "failed",
+			Message: "insufficient funds",
+		}, nil
+	}
+
+	var ownerBalance int64
+	err = tx.QueryRow(ctx, "SELECT balance FROM balances WHERE user_id = $1 FOR UPDATE", ownerID).Scan(&ownerBalance)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			_, err = tx.Exec(ctx, "INSERT INTO balances (user_id, balance, currency) VALUES ($1, 0, 'USD')", ownerID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create owner balance: %w", err)
+			}
+			ownerBalance = 0
+		} else {
+			return nil, fmt.Errorf("failed to get owner balance: %w", err)
+		}
+	}
+
+	newDriverBalance, err := utils.SafeSubtractBalance(driverBalance, total)
+	if err != nil {
+		return &models.TransactionResponse{
+			Status:  "failed",
+			Message: err.Error(),
+		}, nil
+	}
+
+	newOwnerBalance, err := utils.SafeAddBalance(ownerBalance, total)
+	if err != nil {
+		return &models.TransactionResponse{
+			Status:  "failed",
+			Message: "transaction failed",
+		}, nil
+	}
+
+	_, err = tx.Exec(ctx, "UPDATE balances SET balance = $1 WHERE user_id = $2", newDriverBalance, driverID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update driver balance: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, "UPDATE balances SET balance = $1 WHERE user_id = $2", newOwnerBalance, ownerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update owner balance: %w", err)
+	}
+
+	for _, charge := range pending {
+		var chargeTransactionID int64
+		err = tx.QueryRow(ctx,
+			"INSERT INTO transactions (booking_id, user_id, amount, transaction_type, status, description) VALUES ($1, $2, $3, 'charge', 'completed', $4) RETURNING id",
+			charge.BookingID, driverID, -charge.Amount, fmt.Sprintf("Charge for booking %d", charge.BookingID)).Scan(&chargeTransactionID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create charge transaction: %w", err)
+		}
+		if firstID == 0 {
+			firstID = chargeTransactionID
+		}
+
+		_, err = tx.Exec(ctx,
+			"INSERT INTO transactions (booking_id, user_id, amount, transaction_type, status, description) VALUES ($1, $2, $3, 'payment', 'completed', $4)",
+			charge.BookingID, ownerID, charge.Amount, fmt.Sprintf("Payment for booking %d", charge.BookingID))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create payment transaction: %w", err)
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return &models.TransactionResponse{
+		TransactionID: firstID,
+		Status:        "completed",
+		Message:       "transaction completed successfully",
+	}, nil
+}
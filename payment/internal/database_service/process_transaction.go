@@ -49,6 +49,21 @@ func (ds *DatabaseService) ProcessTransaction(ctx context.Context, bookingID int
 	}
 	defer tx.Rollback(ctx)
 
+	if err := lockBooking(ctx, tx, bookingID); err != nil {
+		return nil, err
+	}
+	chargeID, charged, err := findCompleted(ctx, tx, bookingID, driverID, "charge")
+	if err != nil {
+		return nil, err
+	}
+	if charged {
+		return &models.TransactionResponse{
+			TransactionID: chargeID,
+			Status:        "completed",
+			Message:       "transaction already processed",
+		}, nil
+	}
+
 	var driverBalance int64
 	err = tx.QueryRow(ctx, "SELECT balance FROM balances WHERE user_id = $1 FOR UPDATE", driverID).Scan(&driverBalance)
 	if err != nil {
@@ -0,0 +1,277 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.33.1
+// source: booking.proto
+
+package gen
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Booking_GetBooking_FullMethodName                 = "/gen.Booking/GetBooking"
+	Booking_ListActiveBookingsForPlace_FullMethodName = "/gen.Booking/ListActiveBookingsForPlace"
+	Booking_CountOverlapping_FullMethodName           = "/gen.Booking/CountOverlapping"
+	Booking_CancelBookingsForPlace_FullMethodName     = "/gen.Booking/CancelBookingsForPlace"
+	Booking_ReopenPlace_FullMethodName                = "/gen.Booking/ReopenPlace"
+)
+
+// BookingClient is the client API for Booking service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Times are Unix seconds in UTC.
+type BookingClient interface {
+	GetBooking(ctx context.Context, in *BookingRequest, opts ...grpc.CallOption) (*BookingResponse, error)
+	ListActiveBookingsForPlace(ctx context.Context, in *PlaceBookingsRequest, opts ...grpc.CallOption) (*BookingsResponse, error)
+	CountOverlapping(ctx context.Context, in *CountOverlappingRequest, opts ...grpc.CallOption) (*CountOverlappingResponse, error)
+	CancelBookingsForPlace(ctx context.Context, in *CancelPlaceBookingsRequest, opts ...grpc.CallOption) (*BookingsResponse, error)
+	ReopenPlace(ctx context.Context, in *PlaceBookingsRequest, opts ...grpc.CallOption) (*ReopenPlaceResponse, error)
+}
+
+type bookingClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBookingClient(cc grpc.ClientConnInterface) BookingClient {
+	return &bookingClient{cc}
+}
+
+func (c *bookingClient) GetBooking(ctx context.Context, in *BookingRequest, opts ...grpc.CallOption) (*BookingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BookingResponse)
+	err := c.cc.Invoke(ctx, Booking_GetBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingClient) ListActiveBookingsForPlace(ctx context.Context, in *PlaceBookingsRequest, opts ...grpc.CallOption) (*BookingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BookingsResponse)
+	err := c.cc.Invoke(ctx, Booking_ListActiveBookingsForPlace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingClient) CountOverlapping(ctx context.Context, in *CountOverlappingRequest, opts ...grpc.CallOption) (*CountOverlappingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CountOverlappingResponse)
+	err := c.cc.Invoke(ctx, Booking_CountOverlapping_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingClient) CancelBookingsForPlace(ctx context.Context, in *CancelPlaceBookingsRequest, opts ...grpc.CallOption) (*BookingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BookingsResponse)
+	err := c.cc.Invoke(ctx, Booking_CancelBookingsForPlace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingClient) ReopenPlace(ctx context.Context, in *PlaceBookingsRequest, opts ...grpc.CallOption) (*ReopenPlaceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReopenPlaceResponse)
+	err := c.cc.Invoke(ctx, Booking_ReopenPlace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BookingServer is the server API for Booking service.
+// All implementations must embed UnimplementedBookingServer
+// for forward compatibility.
+//
+// Times are Unix seconds in UTC.
+type BookingServer interface {
+	GetBooking(context.Context, *BookingRequest) (*BookingResponse, error)
+	ListActiveBookingsForPlace(context.Context, *PlaceBookingsRequest) (*BookingsResponse, error)
+	CountOverlapping(context.Context, *CountOverlappingRequest) (*CountOverlappingResponse, error)
+	CancelBookingsForPlace(context.Context, *CancelPlaceBookingsRequest) (*BookingsResponse, error)
+	ReopenPlace(context.Context, *PlaceBookingsRequest) (*ReopenPlaceResponse, error)
+	mustEmbedUnimplementedBookingServer()
+}
+
+// UnimplementedBookingServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBookingServer struct{}
+
+func (UnimplementedBookingServer) GetBooking(context.Context, *BookingRequest) (*BookingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBooking not implemented")
+}
+func (UnimplementedBookingServer) ListActiveBookingsForPlace(context.Context, *PlaceBookingsRequest) (*BookingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListActiveBookingsForPlace not implemented")
+}
+func (UnimplementedBookingServer) CountOverlapping(context.Context, *CountOverlappingRequest) (*CountOverlappingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountOverlapping not implemented")
+}
+func (UnimplementedBookingServer) CancelBookingsForPlace(context.Context, *CancelPlaceBookingsRequest) (*BookingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelBookingsForPlace not implemented")
+}
+func (UnimplementedBookingServer) ReopenPlace(context.Context, *PlaceBookingsRequest) (*ReopenPlaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReopenPlace not implemented")
+}
+func (UnimplementedBookingServer) mustEmbedUnimplementedBookingServer() {}
+func (UnimplementedBookingServer) testEmbeddedByValue()                 {}
+
+// UnsafeBookingServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BookingServer will
+// result in compilation errors.
+type UnsafeBookingServer interface {
+	mustEmbedUnimplementedBookingServer()
+}
+
+func RegisterBookingServer(s grpc.ServiceRegistrar, srv BookingServer) {
+	// If the following call pancis, it indicates UnimplementedBookingServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Booking_ServiceDesc, srv)
+}
+
+func _Booking_GetBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServer).GetBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Booking_GetBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServer).GetBooking(ctx, req.(*BookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Booking_ListActiveBookingsForPlace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaceBookingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServer).ListActiveBookingsForPlace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Booking_ListActiveBookingsForPlace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServer).ListActiveBookingsForPlace(ctx, req.(*PlaceBookingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Booking_CountOverlapping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountOverlappingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServer).CountOverlapping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Booking_CountOverlapping_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServer).CountOverlapping(ctx, req.(*CountOverlappingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Booking_CancelBookingsForPlace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelPlaceBookingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServer).CancelBookingsForPlace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Booking_CancelBookingsForPlace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServer).CancelBookingsForPlace(ctx, req.(*CancelPlaceBookingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Booking_ReopenPlace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaceBookingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServer).ReopenPlace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Booking_ReopenPlace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServer).ReopenPlace(ctx, req.(*PlaceBookingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Booking_ServiceDesc is the grpc.ServiceDesc for Booking service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Booking_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gen.Booking",
+	HandlerType: (*BookingServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetBooking",
+			Handler:    _Booking_GetBooking_Handler,
+		},
+		{
+			MethodName: "ListActiveBookingsForPlace",
+			Handler:    _Booking_ListActiveBookingsForPlace_Handler,
+		},
+		{
+			MethodName: "CountOverlapping",
+			Handler:    _Booking_CountOverlapping_Handler,
+		},
+		{
+			MethodName: "CancelBookingsForPlace",
+			Handler:    _Booking_CancelBookingsForPlace_Handler,
+		},
+		{
+			MethodName: "ReopenPlace",
+			Handler:    _Booking_ReopenPlace_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "booking.proto",
+}
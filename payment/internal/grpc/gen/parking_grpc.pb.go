@@ -19,7 +19,8 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Parking_GetParkingPlace_FullMethodName = "/gen.Parking/GetParkingPlace"
+	Parking_GetParkingPlace_FullMethodName       = "/gen.Parking/GetParkingPlace"
+	Parking_GetOwnerParkingPlaces_FullMethodName = "/gen.Parking/GetOwnerParkingPlaces"
 )
 
 // ParkingClient is the client API for Parking service.
@@ -27,6 +28,7 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ParkingClient interface {
 	GetParkingPlace(ctx context.Context, in *ParkingPlaceRequest, opts ...grpc.CallOption) (*ParkingPlaceResponse, error)
+	GetOwnerParkingPlaces(ctx context.Context, in *OwnerParkingPlacesRequest, opts ...grpc.CallOption) (*OwnerParkingPlacesResponse, error)
 }
 
 type parkingClient struct {
@@ -47,11 +49,22 @@ func (c *parkingClient) GetParkingPlace(ctx context.Context, in *ParkingPlaceReq
 	return out, nil
 }
 
+func (c *parkingClient) GetOwnerParkingPlaces(ctx context.Context, in *OwnerParkingPlacesRequest, opts ...grpc.CallOption) (*OwnerParkingPlacesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OwnerParkingPlacesResponse)
+	err := c.cc.Invoke(ctx, Parking_GetOwnerParkingPlaces_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ParkingServer is the server API for Parking service.
 // All implementations must embed UnimplementedParkingServer
 // for forward compatibility.
 type ParkingServer interface {
 	GetParkingPlace(context.Context, *ParkingPlaceRequest) (*ParkingPlaceResponse, error)
+	GetOwnerParkingPlaces(context.Context, *OwnerParkingPlacesRequest) (*OwnerParkingPlacesResponse, error)
 	mustEmbedUnimplementedParkingServer()
 }
 
@@ -65,6 +78,9 @@ type UnimplementedParkingServer struct{}
 func (UnimplementedParkingServer) GetParkingPlace(context.Context, *ParkingPlaceRequest) (*ParkingPlaceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetParkingPlace not implemented")
 }
+func (UnimplementedParkingServer) GetOwnerParkingPlaces(context.Context, *OwnerParkingPlacesRequest) (*OwnerParkingPlacesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOwnerParkingPlaces not implemented")
+}
 func (UnimplementedParkingServer) mustEmbedUnimplementedParkingServer() {}
 func (UnimplementedParkingServer) testEmbeddedByValue()                 {}
 
@@ -104,6 +120,24 @@ func _Parking_GetParkingPlace_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Parking_GetOwnerParkingPlaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OwnerParkingPlacesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ParkingServer).GetOwnerParkingPlaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Parking_GetOwnerParkingPlaces_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ParkingServer).GetOwnerParkingPlaces(ctx, req.(*OwnerParkingPlacesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Parking_ServiceDesc is the grpc.ServiceDesc for Parking service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -115,6 +149,10 @@ var Parking_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetParkingPlace",
 			Handler:    _Parking_GetParkingPlace_Handler,
 		},
+		{
+			MethodName: "GetOwnerParkingPlaces",
+			Handler:    _Parking_GetOwnerParkingPlaces_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "parking.proto",
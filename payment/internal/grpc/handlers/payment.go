@@ -82,7 +82,7 @@ func (s *GRPCServer) ProcessRefund(ctx context.Context, req *gen.RefundRequest)
 	ctx, span := s.tracer.Start(ctx, "ProcessRefund")
 	defer span.End()
 
-	result, err := s.Database.ProcessRefund(ctx, req.BookingId, req.DriverId, req.OwnerId, req.Amount)
+	result, err := s.Database.ProcessRefund(ctx, req.BookingId, req.DriverId, req.OwnerId, req.Amount, req.Reason)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "refund processing failed")
 	}
@@ -102,6 +102,70 @@ func (s *GRPCServer) ProcessRefund(ctx context.Context, req *gen.RefundRequest)
 	}, nil
 }
 
+func (s *GRPCServer) GetBookingCharge(ctx context.Context, req *gen.BookingChargeRequest) (*gen.BookingChargeResponse, error) {
+	if err := s.validateInternalRequest(ctx); err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "authentication failed")
+	}
+
+	ctx, span := s.tracer.Start(ctx, "GetBookingCharge")
+	defer span.End()
+
+	transactionID, amount, found, err := s.Database.GetBookingCharge(ctx, req.BookingId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "booking charge lookup failed")
+	}
+
+	return &gen.BookingChargeResponse{
+		Charged:       found,
+		TransactionId: transactionID,
+		Amount:        amount,
+	}, nil
+}
+
+func (s *GRPCServer) AdjustCharge(ctx context.Context, req *gen.AdjustChargeRequest) (*gen.TransactionResponse, error) {
+	if err := s.validateInternalRequest(ctx); err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "authentication failed")
+	}
+
+	ctx, span := s.tracer.Start(ctx, "AdjustCharge")
+	defer span.End()
+
+	result, err := s.Database.AdjustCharge(ctx, req.BookingId, req.DriverId, req.OwnerId, req.Delta, req.AdjustmentId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "charge adjustment failed")
+	}
+
+	return &gen.TransactionResponse{
+		TransactionId: result.TransactionID,
+		Status:        result.Status,
+		Message:       result.Message,
+	}, nil
+}
+
+func (s *GRPCServer) ProcessSeriesTransaction(ctx context.Context, req *gen.SeriesTransactionRequest) (*gen.TransactionResponse, error) {
+	if err := s.validateInternalRequest(ctx); err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "authentication failed")
+	}
+
+	ctx, span := s.tracer.Start(ctx, "ProcessSeriesTransaction")
+	defer span.End()
+
+	charges := make([]database_service.BookingCharge, 0, len(req.Charges))
+	for _, charge := range req.Charges {
+		charges = append(charges, database_service.BookingCharge{BookingID: charge.BookingId, Amount: charge.Amount})
+	}
+	result, err := s.Database.ProcessSeriesTransaction(ctx, req.DriverId, req.OwnerId, charges)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "series transaction processing failed")
+	}
+
+	return &gen.TransactionResponse{
+		TransactionId: result.TransactionID,
+		Status:        result.Status,
+		Message:       result.Message,
+	}, nil
+}
+
 func (s *GRPCServer) validateInternalRequest(ctx context.Context) error {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
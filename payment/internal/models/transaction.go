@@ -41,7 +41,7 @@ type Transaction struct {
 	Status string `json:"status,omitempty"`
 
 	// transaction type
-	// Enum: ["charge","payment","refund","promocode_activate","promocode_generate"]
+	// Enum: ["charge","payment","refund","adjustment","promocode_activate","promocode_generate"]
 	TransactionType string `json:"transaction_type,omitempty"`
 
 	// user id
@@ -134,7 +134,7 @@ var transactionTypeTransactionTypePropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["charge","payment","refund","promocode_activate","promocode_generate"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["charge","payment","refund","adjustment","promocode_activate","promocode_generate"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -153,6 +153,9 @@ const (
 	// TransactionTransactionTypeRefund captures enum value "refund"
 	TransactionTransactionTypeRefund string = "refund"
 
+	// TransactionTransactionTypeAdjustment captures enum value "adjustment"
+	TransactionTransactionTypeAdjustment string = "adjustment"
+
 	// TransactionTransactionTypePromocodeActivate captures enum value "promocode_activate"
 	TransactionTransactionTypePromocodeActivate string = "promocode_activate"
 
@@ -526,6 +526,7 @@ func init() {
             "charge",
             "payment",
             "refund",
+            "adjustment",
             "promocode_activate",
             "promocode_generate"
           ]
@@ -1071,6 +1072,7 @@ func init() {
             "charge",
             "payment",
             "refund",
+            "adjustment",
             "promocode_activate",
             "promocode_generate"
           ]
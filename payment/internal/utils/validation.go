@@ -12,6 +12,7 @@ const (
 	MinAmount          = 1
 	MaxPromoCodeLength = 100
 	MinPromoCodeLength = 4
+	MaxReasonLength    = 255
 )
 
 var (
@@ -22,6 +23,7 @@ var (
 	ErrInvalidPromoCode = errors.New("invalid promocode format")
 	ErrEmptyUserID      = errors.New("user ID cannot be empty")
 	ErrEmptyPromoCode   = errors.New("promocode cannot be empty")
+	ErrReasonTooLong    = errors.New("reason is too long")
 )
 
 func ValidateAmount(amount int64) error {
@@ -63,6 +65,13 @@ func ValidatePromoCode(code string) error {
 	return nil
 }
 
+func ValidateReason(reason string) error {
+	if utf8.RuneCountInString(reason) > MaxReasonLength {
+		return ErrReasonTooLong
+	}
+	return nil
+}
+
 func ValidateBookingID(bookingID int64) error {
 	if bookingID <= 0 {
 		return errors.New("booking ID must be positive")
@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"math"
+	"math/bits"
+	"time"
+)
+
+// EarlyCheckIn is how long before date_from a driver may already check in.
+const EarlyCheckIn = 30 * time.Minute
+
+// DefaultOvertimePenalty bills overtime at the plain hourly rate.
+const DefaultOvertimePenalty = 1.0
+
+// ValidateCheckIn reports whether a booking planned for [dateFrom, dateTo) can
+// be checked in at now.
+func ValidateCheckIn(dateFrom, dateTo, now time.Time) error {
+	if now.Before(dateFrom.Add(-EarlyCheckIn)) {
+		return ErrCheckInTooEarly
+	}
+	if !now.Before(dateTo) {
+		return ErrCheckInClosed
+	}
+	return nil
+}
+
+func ValidateOvertimePenalty(penalty float64) error {
+	if penalty < 1 {
+		return ErrInvalidOvertimePenalty
+	}
+	return nil
+}
+
+// OvertimeCost prices the time a driver stayed past dateTo, the planned end of
+// the booking, with PriceParking at hourlyRate and multiplies that by penalty.
+// The penalty is applied in whole percent and the result is rounded down to
+// whole units, like any other price.
+func OvertimeCost(hourlyRate int64, dateTo, checkedOut time.Time, penalty float64) int64 {
+	base := PriceParking(hourlyRate, dateTo, checkedOut).Total
+	percent := int64(math.Round(penalty * 100))
+	if base <= 0 || percent <= 0 {
+		return 0
+	}
+	hi, lo := bits.Mul64(uint64(base), uint64(percent))
+	if hi >= 100 {
+		return math.MaxInt64
+	}
+	cost, _ := bits.Div64(hi, lo, 100)
+	if cost > math.MaxInt64 {
+		return math.MaxInt64
+	}
+	return int64(cost)
+}
+
+// EntryPass lets the driver of a booking through the gate of its parking place
+// between ValidFrom and ValidUntil. QRCode renders Token as a PNG data URI.
+type EntryPass struct {
+	BookingID      int64
+	ParkingPlaceID int64
+	Token          string
+	QRCode         string
+	ValidFrom      time.Time
+	ValidUntil     time.Time
+}
+
+// PassVerification is what the gate learns from an entry pass: whether it lets
+// the vehicle in and, when it does not, the reason why.
+type PassVerification struct {
+	Valid     bool
+	Reason    string
+	Booking   *Booking
+	CheckedIn bool
+}
@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"fmt"
 	"time"
 )
 
@@ -9,17 +10,88 @@ type BookingStatus string
 const (
 	BookingStatusWaiting   BookingStatus = "Waiting"
 	BookingStatusConfirmed BookingStatus = "Confirmed"
+	BookingStatusActive    BookingStatus = "Active"
+	BookingStatusCompleted BookingStatus = "Completed"
 	BookingStatusCanceled  BookingStatus = "Canceled"
+	BookingStatusExpired   BookingStatus = "Expired"
+	BookingStatusNoShow    BookingStatus = "NoShow"
 )
 
+// bookingTransitions lists, for every status, the statuses a booking may move to.
+// Statuses without outgoing transitions are terminal.
+var bookingTransitions = map[BookingStatus][]BookingStatus{
+	BookingStatusWaiting:   {BookingStatusConfirmed, BookingStatusCanceled, BookingStatusExpired},
+	BookingStatusConfirmed: {BookingStatusActive, BookingStatusCanceled, BookingStatusNoShow},
+	BookingStatusActive:    {BookingStatusCompleted},
+	BookingStatusCompleted: {},
+	BookingStatusCanceled:  {},
+	BookingStatusExpired:   {},
+	BookingStatusNoShow:    {},
+}
+
+func (s BookingStatus) IsValid() bool {
+	_, ok := bookingTransitions[s]
+	return ok
+}
+
+func (s BookingStatus) IsTerminal() bool {
+	return len(bookingTransitions[s]) == 0
+}
+
+// OccupiesSpot reports whether a booking in this status holds a parking spot.
+func (s BookingStatus) OccupiesSpot() bool {
+	return s == BookingStatusWaiting || s == BookingStatusConfirmed || s == BookingStatusActive
+}
+
+// SettableByUser reports whether drivers and owners may request this status
+// directly; every other status is reached only through payment or the scheduler.
+func (s BookingStatus) SettableByUser() bool {
+	return s == BookingStatusCanceled
+}
+
+// Extendable reports whether the end of a booking in this status may still be
+// moved later: the booking is paid and the stay is not over.
+func (s BookingStatus) Extendable() bool {
+	return s == BookingStatusConfirmed || s == BookingStatusActive
+}
+
+func (s BookingStatus) CanTransitionTo(next BookingStatus) bool {
+	for _, allowed := range bookingTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateTransition returns ErrInvalidStatusTransition unless the booking may
+// move from one status to the other. Staying in the same status is allowed.
+func ValidateTransition(from BookingStatus, to BookingStatus) error {
+	if !to.IsValid() {
+		return fmt.Errorf("%w: unknown status %q", ErrInvalidStatusTransition, to)
+	}
+	if from == to || from.CanTransitionTo(to) {
+		return nil
+	}
+	return fmt.Errorf("%w: %s -> %s", ErrInvalidStatusTransition, from, to)
+}
+
 type Booking struct {
-	ID            int64
-	DateFrom      time.Time
-	DateTo        time.Time
+	ID             int64
+	DateFrom       time.Time
+	DateTo         time.Time
 	ParkingPlaceID int64
-	FullCost      float64
-	Status        BookingStatus
-	UserID        string
+	FullCost       int64
+	Status         BookingStatus
+	UserID         string
+	// VehicleID is the vehicle of the driver that parks; bookings made before
+	// vehicles were registered have 0.
+	VehicleID int64
+	// SeriesID is 0 for bookings that are not part of a recurring series.
+	SeriesID     int64
+	CheckedInAt  *time.Time
+	CheckedOutAt *time.Time
+	OvertimeCost int64
 }
 
 func (b *Booking) IsValid() error {
@@ -41,9 +113,29 @@ func (b *Booking) IsValid() error {
 	return nil
 }
 
-func (b *Booking) CalculateCost(hourlyRate float64) {
-	duration := b.DateTo.Sub(b.DateFrom)
-	hours := duration.Hours()
-	b.FullCost = hourlyRate * hours
+// CalculateCost prices the booking at hourlyRate and returns the itemized price.
+func (b *Booking) CalculateCost(hourlyRate int64) Price {
+	price := PriceParking(hourlyRate, b.DateFrom, b.DateTo)
+	b.FullCost = price.Total
+	return price
 }
 
+// Extend moves the end of the booking to dateTo and reprices the whole stay
+// from DateFrom at hourlyRate, so a booking extended in steps costs what a
+// booking of the same length would instead of every step losing its fraction
+// of a unit. The cost never drops below what was already paid. It returns the
+// price of the whole stay.
+func (b *Booking) Extend(hourlyRate int64, dateTo time.Time) Price {
+	price := PriceParking(hourlyRate, b.DateFrom, dateTo)
+	b.DateTo = dateTo
+	if price.Total > b.FullCost {
+		b.FullCost = price.Total
+	}
+	return price
+}
+
+// Reschedules reports whether other moves the booking to another place or time.
+func (b *Booking) Reschedules(other *Booking) bool {
+	return b.ParkingPlaceID != other.ParkingPlaceID || !b.DateFrom.Equal(other.DateFrom) ||
+		!b.DateTo.Equal(other.DateTo)
+}
@@ -0,0 +1,56 @@
+package domain
+
+import "time"
+
+// BookingEventType is the kind of change a booking event records.
+type BookingEventType string
+
+const (
+	BookingEventCreated       BookingEventType = "created"
+	BookingEventModified      BookingEventType = "modified"
+	BookingEventStatusChanged BookingEventType = "status_changed"
+	// BookingEventCanceled records the refund decision made when a paid
+	// booking is canceled; the status change itself is a separate event.
+	BookingEventCanceled BookingEventType = "canceled"
+	BookingEventRefunded BookingEventType = "refunded"
+	BookingEventDeleted  BookingEventType = "deleted"
+)
+
+// ActorRoleSystem is the role of changes made by background jobs and other
+// services rather than by a user.
+const ActorRoleSystem UserRole = "system"
+
+// Actor is who made a change: a user in their role, or a system job named by ID.
+type Actor struct {
+	ID   string
+	Role UserRole
+}
+
+func UserActor(user *User) Actor {
+	return Actor{ID: user.ID, Role: user.Role}
+}
+
+func SystemActor(job string) Actor {
+	return Actor{ID: job, Role: ActorRoleSystem}
+}
+
+func (a Actor) IsSystem() bool {
+	return a.Role == ActorRoleSystem
+}
+
+// BookingEvent is an entry of the history of a booking. UserID and
+// ParkingPlaceID are those of the booking when the event happened, so the
+// history outlives the booking itself. OldValue and NewValue hold the fields
+// the event changed, either of them nil when there is nothing to show.
+type BookingEvent struct {
+	ID             int64
+	BookingID      int64
+	UserID         string
+	ParkingPlaceID int64
+	Type           BookingEventType
+	Actor          Actor
+	OldValue       map[string]any
+	NewValue       map[string]any
+	TraceID        string
+	CreatedAt      time.Time
+}
@@ -0,0 +1,51 @@
+package domain
+
+import "time"
+
+const (
+	maxFreeCancellationHours = 24 * 365
+	maxPercent               = 100
+)
+
+// CancellationPolicy decides how much of a paid booking is refunded when it is
+// canceled. Cancellations more than FreeCancellationHours before the start are
+// free, later ones keep LateCancellationFeePercent of the price, and nothing is
+// refunded once the booking has started. Every change of a parking place's policy
+// gets a new Version, so a refund can be traced to the rules it followed.
+type CancellationPolicy struct {
+	Version                    int64
+	FreeCancellationHours      int64
+	LateCancellationFeePercent int64
+}
+
+// DefaultCancellationPolicy applies to parking places whose owner never set a
+// policy: every cancellation before the booking is active is refunded in full.
+var DefaultCancellationPolicy = CancellationPolicy{}
+
+func (p *CancellationPolicy) IsValid() error {
+	if p.FreeCancellationHours < 0 || p.FreeCancellationHours > maxFreeCancellationHours {
+		return ErrInvalidFreeCancellationHours
+	}
+	if p.LateCancellationFeePercent < 0 || p.LateCancellationFeePercent > maxPercent {
+		return ErrInvalidCancellationFee
+	}
+	return nil
+}
+
+// FeePercent returns the share of the price kept by the owner when a booking in
+// the given status, starting at dateFrom, is canceled at now.
+func (p *CancellationPolicy) FeePercent(status BookingStatus, dateFrom time.Time, now time.Time) int64 {
+	if status == BookingStatusActive || !now.Before(dateFrom) {
+		return maxPercent
+	}
+	freeUntil := dateFrom.Add(-time.Duration(p.FreeCancellationHours) * time.Hour)
+	if now.Before(freeUntil) {
+		return 0
+	}
+	return p.LateCancellationFeePercent
+}
+
+// RefundAmount returns the part of amount returned to the driver after the fee.
+func RefundAmount(amount int64, feePercent int64) int64 {
+	return amount * (maxPercent - feePercent) / maxPercent
+}
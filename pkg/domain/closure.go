@@ -0,0 +1,69 @@
+package domain
+
+import "time"
+
+// ClosureKind is what a parking closure does with the place once its bookings
+// are settled.
+type ClosureKind string
+
+const (
+	ClosureDelete  ClosureKind = "delete"
+	ClosureSuspend ClosureKind = "suspend"
+)
+
+// ClosureStep is how far a parking closure got. The steps run in order and each
+// one can be repeated, so an interrupted closure resumes at its step.
+type ClosureStep string
+
+const (
+	ClosureStepCanceling ClosureStep = "Canceling"
+	ClosureStepRefunding ClosureStep = "Refunding"
+	ClosureStepNotifying ClosureStep = "Notifying"
+	ClosureStepDone      ClosureStep = "Done"
+)
+
+// RefundStatus is the outcome of refunding a booking canceled by a closure.
+type RefundStatus string
+
+const (
+	RefundPending  RefundStatus = "Pending"
+	RefundDone     RefundStatus = "Refunded"
+	RefundNone     RefundStatus = "NothingToRefund"
+	RefundDeclined RefundStatus = "Declined"
+)
+
+// ParkingClosure takes a parking place out of service: it stops new bookings,
+// cancels those that have not ended, refunds and notifies their drivers and
+// finally deletes or suspends the place.
+type ParkingClosure struct {
+	ID             int64
+	ParkingPlaceID int64
+	OwnerID        string
+	Kind           ClosureKind
+	Reason         string
+	Step           ClosureStep
+	Bookings       []*ClosureBooking
+	// LastError is why the closure stopped at Step, if it did.
+	LastError string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// ClosureBooking is a booking canceled by a closure and how far its driver was
+// compensated.
+type ClosureBooking struct {
+	BookingID      int64
+	DriverID       string
+	Refund         RefundStatus
+	RefundedAmount int64
+	Notified       bool
+}
+
+// Refunds counts the bookings of the closure by refund outcome.
+func (c *ParkingClosure) Refunds() map[RefundStatus]int {
+	counts := make(map[RefundStatus]int)
+	for _, booking := range c.Bookings {
+		counts[booking.Refund]++
+	}
+	return counts
+}
@@ -16,8 +16,38 @@ var (
 	ErrInvalidParkingPlaceID  = errors.New("parking place ID is required")
 	ErrInvalidUserID          = errors.New("user ID is required")
 	ErrParkingNotFound       = errors.New("parking place not found")
+	ErrParkingUnavailable    = errors.New("parking place does not take bookings")
 	ErrBookingNotFound       = errors.New("booking not found")
 	ErrUnauthorizedAccess     = errors.New("unauthorized access")
 	ErrForbiddenAction        = errors.New("forbidden action")
+	ErrInvalidStatusTransition = errors.New("invalid booking status transition")
+	ErrInvalidFreeCancellationHours = errors.New("free cancellation hours must be between 0 and 8760")
+	ErrInvalidCancellationFee       = errors.New("cancellation fee must be between 0 and 100 percent")
+	ErrInvalidRecurrenceRule        = errors.New("invalid recurrence rule")
+	ErrTooManyOccurrences           = errors.New("too many occurrences in series")
+	ErrCheckInTooEarly              = errors.New("check-in is not open yet")
+	ErrCheckInClosed                = errors.New("check-in is closed, the booking has ended")
+	ErrInvalidOvertimePenalty       = errors.New("overtime penalty must be at least 1")
+	ErrEntryPassInvalid             = errors.New("entry pass is invalid")
+	ErrEntryPassUsed                = errors.New("entry pass was already used")
+	ErrEntryPassOutdated            = errors.New("booking changed after the entry pass was issued")
+	ErrEntryPassWrongPlace          = errors.New("entry pass is for another parking place")
+	ErrInvalidPlate                 = errors.New("license plate must be 2 to 12 letters and digits")
+	ErrInvalidCountry               = errors.New("country must be an ISO 3166-1 alpha-2 code")
+	ErrInvalidVehicleSize           = errors.New("vehicle size must be motorcycle, small, medium or large")
+	ErrVehicleNotFound              = errors.New("vehicle not found")
+	ErrVehicleNotAllowed            = errors.New("parking place does not take vehicles of this size")
+	ErrVehicleRequired              = errors.New("vehicle_id is required unless the driver has exactly one vehicle")
+	ErrInvalidBookingID             = errors.New("booking ID is required")
+	ErrInvalidRating                = errors.New("rating must be between 1 and 5")
+	ErrReviewTextTooLong            = errors.New("review text must be at most 2000 characters")
+	ErrInvalidReviewReply           = errors.New("reply must be 1 to 2000 characters")
+	ErrInvalidReviewStatus          = errors.New("review status must be Published or Hidden")
+	ErrBookingNotExtendable         = errors.New("only Confirmed and Active bookings can be extended")
+	ErrInvalidExtension             = errors.New("exactly one of date_to and duration_minutes is required")
+	ErrInvalidLatitude              = errors.New("latitude must be between -90 and 90")
+	ErrInvalidLongitude             = errors.New("longitude must be between -180 and 180")
+	ErrIncompleteLocation           = errors.New("latitude and longitude must be given together")
+	ErrReviewExists                 = errors.New("the booking was already reviewed")
 )
 
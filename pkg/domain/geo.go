@@ -0,0 +1,69 @@
+package domain
+
+import "math"
+
+// EarthRadiusMeters is the mean radius of the Earth. Distances are computed
+// with the haversine formula as if the Earth were a sphere of this radius.
+const EarthRadiusMeters = 6371008.8
+
+// GeoPoint is a WGS 84 position in degrees.
+type GeoPoint struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// NewGeoPoint returns the point of the coordinates, or nil when neither is
+// given. Giving only one of them is an error.
+func NewGeoPoint(latitude, longitude *float64) (*GeoPoint, error) {
+	if latitude == nil && longitude == nil {
+		return nil, nil
+	}
+	if latitude == nil || longitude == nil {
+		return nil, ErrIncompleteLocation
+	}
+	point := &GeoPoint{Latitude: *latitude, Longitude: *longitude}
+	if err := point.IsValid(); err != nil {
+		return nil, err
+	}
+	return point, nil
+}
+
+func (p GeoPoint) IsValid() error {
+	if math.IsNaN(p.Latitude) || p.Latitude < -90 || p.Latitude > 90 {
+		return ErrInvalidLatitude
+	}
+	if math.IsNaN(p.Longitude) || p.Longitude < -180 || p.Longitude > 180 {
+		return ErrInvalidLongitude
+	}
+	return nil
+}
+
+// BoundingBox returns the south-west and north-east corners of a box holding
+// every point within radius meters of p. The box may hold points farther away,
+// so it only narrows a search down before distances are computed. Near the
+// poles and across the antimeridian it spans every longitude.
+func (p GeoPoint) BoundingBox(radius float64) (GeoPoint, GeoPoint) {
+	latDelta := degrees(radius / EarthRadiusMeters)
+	southWest := GeoPoint{Latitude: math.Max(p.Latitude-latDelta, -90), Longitude: -180}
+	northEast := GeoPoint{Latitude: math.Min(p.Latitude+latDelta, 90), Longitude: 180}
+	if southWest.Latitude == -90 || northEast.Latitude == 90 {
+		return southWest, northEast
+	}
+
+	// The widest part of the circle is off its center latitude, so the
+	// longitude span is not simply the angular radius over cos(latitude).
+	lonDelta := degrees(math.Asin(math.Sin(radius/EarthRadiusMeters) / math.Cos(radians(p.Latitude))))
+	if p.Longitude-lonDelta >= -180 && p.Longitude+lonDelta <= 180 {
+		southWest.Longitude = p.Longitude - lonDelta
+		northEast.Longitude = p.Longitude + lonDelta
+	}
+	return southWest, northEast
+}
+
+func radians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
+
+func degrees(radians float64) float64 {
+	return radians * 180 / math.Pi
+}
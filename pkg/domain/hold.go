@@ -0,0 +1,31 @@
+package domain
+
+import "time"
+
+// BookingHold reserves a spot of a parking place for a driver until ExpiresAt,
+// so a booking of the held period made before then cannot run out of spots.
+type BookingHold struct {
+	ID             int64
+	UserID         string
+	ParkingPlaceID int64
+	DateFrom       time.Time
+	DateTo         time.Time
+	ExpiresAt      time.Time
+}
+
+// Availability tells how booked a parking place is over consecutive slots of
+// time.
+type Availability struct {
+	ParkingPlaceID int64
+	Capacity       int64
+	Slots          []*AvailabilitySlot
+}
+
+// AvailabilitySlot counts the spots taken at the busiest moment of
+// [DateFrom, DateTo) and those that stay free throughout it.
+type AvailabilitySlot struct {
+	DateFrom time.Time
+	DateTo   time.Time
+	Booked   int64
+	Free     int64
+}
@@ -9,6 +9,23 @@ const (
 	ParkingTypeMultiLevel  ParkingType = "multi-level"
 )
 
+// ParkingStatus tells whether a parking place takes new bookings. A Suspended
+// place is out of service until its owner resumes it; a Closing one is being
+// deleted.
+type ParkingStatus string
+
+const (
+	ParkingStatusActive    ParkingStatus = "Active"
+	ParkingStatusSuspended ParkingStatus = "Suspended"
+	ParkingStatusClosing   ParkingStatus = "Closing"
+)
+
+// AcceptsBookings reports whether new bookings may be made. An empty status
+// comes from services that predate it and counts as Active.
+func (s ParkingStatus) AcceptsBookings() bool {
+	return s == "" || s == ParkingStatusActive
+}
+
 type ParkingPlace struct {
 	ID         int64
 	Name       string
@@ -18,6 +35,34 @@ type ParkingPlace struct {
 	HourlyRate float64
 	Capacity   int
 	OwnerID    string
+	Status     ParkingStatus
+	// CancellationPolicy is nil when the owner has not set one.
+	CancellationPolicy *CancellationPolicy
+	// AllowedVehicleSizes restricts the vehicles the place takes; empty takes
+	// every size.
+	AllowedVehicleSizes []VehicleSize
+	// Rating is the average rating of the published reviews, 0 without any.
+	Rating      float64
+	ReviewCount int64
+	// Location is nil for places whose owner has not set it; they are left
+	// out of nearby searches.
+	Location *GeoPoint
+	// DistanceMeters is the distance from the point of a nearby search, nil
+	// outside of one.
+	DistanceMeters *float64
+}
+
+// AcceptsVehicle reports whether vehicles of the size may park here.
+func (p *ParkingPlace) AcceptsVehicle(size VehicleSize) bool {
+	if len(p.AllowedVehicleSizes) == 0 {
+		return true
+	}
+	for _, allowed := range p.AllowedVehicleSizes {
+		if allowed == size {
+			return true
+		}
+	}
+	return false
 }
 
 func (p *ParkingPlace) IsValid() error {
@@ -39,6 +84,21 @@ func (p *ParkingPlace) IsValid() error {
 	if p.Type == "" {
 		return ErrInvalidParkingType
 	}
+	if p.CancellationPolicy != nil {
+		if err := p.CancellationPolicy.IsValid(); err != nil {
+			return err
+		}
+	}
+	for _, size := range p.AllowedVehicleSizes {
+		if !size.IsValid() {
+			return ErrInvalidVehicleSize
+		}
+	}
+	if p.Location != nil {
+		if err := p.Location.IsValid(); err != nil {
+			return err
+		}
+	}
 	// OwnerID is set by the service layer, not validated here
 	return nil
 }
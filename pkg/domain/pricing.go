@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+	"time"
+)
+
+// PriceItemKind is what a line of a price is for.
+type PriceItemKind string
+
+const (
+	PriceItemBase PriceItemKind = "base"
+	// PriceItemRounding takes off the fraction of a unit the exact cost has
+	// beyond whole units, so drivers are never charged for it.
+	PriceItemRounding PriceItemKind = "rounding"
+	PriceItemDiscount PriceItemKind = "discount"
+	PriceItemFee      PriceItemKind = "fee"
+)
+
+// PriceItem is a line of a price. Amounts are in the units of hourly rates and
+// may be fractional; discounts and rounding are negative.
+type PriceItem struct {
+	Kind        PriceItemKind
+	Description string
+	Amount      float64
+}
+
+// Price is the itemized cost of parking for a time range. Its items add up to
+// Total, which is what the driver is charged. Bookings carry neither discounts
+// nor fees today: promocodes credit the balance the charge is paid from rather
+// than lowering prices.
+type Price struct {
+	HourlyRate int64
+	Duration   time.Duration
+	Items      []PriceItem
+	Total      int64
+}
+
+// Hours is the billed duration in hours, fractions included.
+func (p Price) Hours() float64 {
+	return p.Duration.Hours()
+}
+
+// PriceParking prices parking from dateFrom to dateTo at hourlyRate. Time is
+// billed to the nanosecond and the exact cost is rounded down to whole units.
+// Creating a booking and quoting it both price through here, so a quote is
+// what the booking will charge.
+func PriceParking(hourlyRate int64, dateFrom, dateTo time.Time) Price {
+	duration := dateTo.Sub(dateFrom)
+	price := Price{HourlyRate: hourlyRate, Duration: duration}
+	if hourlyRate <= 0 || duration <= 0 {
+		return price
+	}
+
+	// rate * duration / hour overflows int64 for long bookings at high rates,
+	// so it is computed on 128 bits.
+	hi, lo := bits.Mul64(uint64(hourlyRate), uint64(duration))
+	var whole, remainder uint64
+	if hi < uint64(time.Hour) {
+		whole, remainder = bits.Div64(hi, lo, uint64(time.Hour))
+	}
+	if hi >= uint64(time.Hour) || whole > math.MaxInt64 {
+		whole, remainder = math.MaxInt64, 0
+	}
+	fraction := float64(remainder) / float64(time.Hour)
+
+	price.Items = append(price.Items, PriceItem{
+		Kind:        PriceItemBase,
+		Description: fmt.Sprintf("%.2f h at %d per hour", duration.Hours(), hourlyRate),
+		Amount:      float64(whole) + fraction,
+	})
+	if remainder != 0 {
+		price.Items = append(price.Items, PriceItem{
+			Kind:        PriceItemRounding,
+			Description: "Rounded down to whole units",
+			Amount:      -fraction,
+		})
+	}
+	price.Total = int64(whole)
+	return price
+}
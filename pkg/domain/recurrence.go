@@ -0,0 +1,117 @@
+package domain
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const maxRecurrenceInterval = 52
+
+var ruleWeekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// WeeklyRule is the subset of an iCalendar RRULE that booking series support:
+// FREQ=WEEKLY with optional INTERVAL and BYDAY parts, e.g.
+// "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR". The end of a series is given separately.
+type WeeklyRule struct {
+	Interval int
+	Weekdays []time.Weekday
+}
+
+// Period is a single occurrence of a series.
+type Period struct {
+	From time.Time
+	To   time.Time
+}
+
+func ParseWeeklyRule(rule string) (*WeeklyRule, error) {
+	result := &WeeklyRule{Interval: 1}
+	weekly := false
+	for _, part := range strings.Split(strings.ToUpper(strings.TrimSpace(rule)), ";") {
+		if part == "" {
+			continue
+		}
+		name, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("%w: malformed part %q", ErrInvalidRecurrenceRule, part)
+		}
+		switch name {
+		case "FREQ":
+			if value != "WEEKLY" {
+				return nil, fmt.Errorf("%w: only FREQ=WEEKLY is supported", ErrInvalidRecurrenceRule)
+			}
+			weekly = true
+		case "INTERVAL":
+			interval, err := strconv.Atoi(value)
+			if err != nil || interval < 1 || interval > maxRecurrenceInterval {
+				return nil, fmt.Errorf("%w: INTERVAL must be between 1 and %d", ErrInvalidRecurrenceRule,
+					maxRecurrenceInterval)
+			}
+			result.Interval = interval
+		case "BYDAY":
+			seen := make(map[time.Weekday]bool)
+			for _, day := range strings.Split(value, ",") {
+				weekday, ok := ruleWeekdays[day]
+				if !ok {
+					return nil, fmt.Errorf("%w: unknown weekday %q", ErrInvalidRecurrenceRule, day)
+				}
+				if !seen[weekday] {
+					seen[weekday] = true
+					result.Weekdays = append(result.Weekdays, weekday)
+				}
+			}
+		default:
+			return nil, fmt.Errorf("%w: unsupported part %s", ErrInvalidRecurrenceRule, name)
+		}
+	}
+	if !weekly {
+		return nil, fmt.Errorf("%w: FREQ is required", ErrInvalidRecurrenceRule)
+	}
+	return result, nil
+}
+
+// Expand lists the occurrences of a series whose first occurrence is first and
+// that ends at until. Every occurrence starts at the same time of day as the
+// first one and lasts as long. Without BYDAY the series repeats on the weekday
+// of the first occurrence. More than limit occurrences yield ErrTooManyOccurrences.
+func (r *WeeklyRule) Expand(first Period, until time.Time, limit int) ([]Period, error) {
+	weekdays := r.Weekdays
+	if len(weekdays) == 0 {
+		weekdays = []time.Weekday{first.From.Weekday()}
+	}
+	matches := make(map[time.Weekday]bool, len(weekdays))
+	for _, weekday := range weekdays {
+		matches[weekday] = true
+	}
+
+	duration := first.To.Sub(first.From)
+	// Weeks are counted from the Monday of the first occurrence's week, so
+	// INTERVAL skips whole calendar weeks like in iCalendar.
+	weekStart := civilDay(first.From) - int64((first.From.Weekday()+6)%7)
+	occurrences := make([]Period, 0)
+	for day := first.From; !day.After(until); day = day.AddDate(0, 0, 1) {
+		week := (civilDay(day) - weekStart) / 7
+		if week%int64(r.Interval) != 0 || !matches[day.Weekday()] {
+			continue
+		}
+		if len(occurrences) == limit {
+			return nil, fmt.Errorf("%w: more than %d", ErrTooManyOccurrences, limit)
+		}
+		occurrences = append(occurrences, Period{From: day, To: day.Add(duration)})
+	}
+	return occurrences, nil
+}
+
+// civilDay numbers the calendar date of t, ignoring daylight saving shifts.
+func civilDay(t time.Time) int64 {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC).Unix() / 86400
+}
@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	MinReviewRating     = 1
+	MaxReviewRating     = 5
+	MaxReviewTextLength = 2000
+)
+
+// ReviewStatus tells whether a review is shown. Admins hide reviews that break
+// the rules; hidden reviews do not count towards the rating of the place.
+type ReviewStatus string
+
+const (
+	ReviewStatusPublished ReviewStatus = "Published"
+	ReviewStatusHidden    ReviewStatus = "Hidden"
+)
+
+func (s ReviewStatus) IsValid() bool {
+	return s == ReviewStatusPublished || s == ReviewStatusHidden
+}
+
+// Review is a driver's rating of a parking place after a completed booking
+// there. A booking is reviewed at most once.
+type Review struct {
+	ID             int64
+	ParkingPlaceID int64
+	BookingID      int64
+	UserID         string
+	Rating         int64
+	Text           string
+	// Reply is the owner's answer, empty until they reply.
+	Reply     string
+	RepliedAt *time.Time
+	Status    ReviewStatus
+	// ModerationReason is why an admin last changed the status.
+	ModerationReason string
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+func (r *Review) IsValid() error {
+	if r.Rating < MinReviewRating || r.Rating > MaxReviewRating {
+		return ErrInvalidRating
+	}
+	if utf8.RuneCountInString(r.Text) > MaxReviewTextLength {
+		return ErrReviewTextTooLong
+	}
+	if r.BookingID <= 0 {
+		return ErrInvalidBookingID
+	}
+	return nil
+}
+
+// ValidateReviewReply checks an owner's reply, which may not be blank.
+func ValidateReviewReply(reply string) error {
+	if strings.TrimSpace(reply) == "" || utf8.RuneCountInString(reply) > MaxReviewTextLength {
+		return ErrInvalidReviewReply
+	}
+	return nil
+}
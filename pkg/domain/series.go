@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// SeriesBilling tells when the occurrences of a booking series are charged and
+// what happens to the series when a payment fails.
+type SeriesBilling string
+
+const (
+	// SeriesBillingPerOccurrence charges the first occurrence when the series is
+	// created and every other one OccurrenceChargeLead before it starts. A
+	// failed payment cancels only the occurrence that was not paid.
+	SeriesBillingPerOccurrence SeriesBilling = "per_occurrence"
+	// SeriesBillingUpfront charges every occurrence at once when the series is
+	// created, and cancels the whole series if that payment fails.
+	SeriesBillingUpfront SeriesBilling = "upfront"
+)
+
+// OccurrenceChargeLead is how long before it starts an occurrence of a series
+// billed per occurrence is charged.
+const OccurrenceChargeLead = 24 * time.Hour
+
+type SeriesStatus string
+
+const (
+	SeriesStatusActive   SeriesStatus = "Active"
+	SeriesStatusCanceled SeriesStatus = "Canceled"
+)
+
+// BookingSeries books a spot of a parking place for every occurrence of a
+// weekly recurrence rule.
+type BookingSeries struct {
+	ID             int64
+	UserID         string
+	ParkingPlaceID int64
+	VehicleID      int64
+	// DateFrom and DateTo are the first occurrence, which RRule repeats until
+	// Until.
+	DateFrom time.Time
+	DateTo   time.Time
+	RRule    string
+	Until    time.Time
+	Billing  SeriesBilling
+	Status   SeriesStatus
+	// Bookings are the booked occurrences, ordered by start.
+	Bookings []*Booking
+	// Conflicts are the occurrences that had no free spot when the series was
+	// created.
+	Conflicts []*SeriesConflict
+}
+
+// SeriesConflict is an occurrence of a series that could not be booked.
+type SeriesConflict struct {
+	DateFrom time.Time
+	DateTo   time.Time
+	Reason   string
+}
+
+// Occurrences expands the recurrence rule of the series into the periods it
+// books. More than limit occurrences yield ErrTooManyOccurrences, and a series
+// without any occurrence is an invalid rule.
+func (s *BookingSeries) Occurrences(limit int) ([]Period, error) {
+	rule, err := ParseWeeklyRule(s.RRule)
+	if err != nil {
+		return nil, err
+	}
+	occurrences, err := rule.Expand(Period{From: s.DateFrom, To: s.DateTo}, s.Until, limit)
+	if err != nil {
+		return nil, err
+	}
+	if len(occurrences) == 0 {
+		return nil, fmt.Errorf("%w: no occurrence before until", ErrInvalidRecurrenceRule)
+	}
+	return occurrences, nil
+}
@@ -0,0 +1,86 @@
+package domain
+
+import (
+	"strings"
+	"time"
+	"unicode"
+)
+
+// VehicleSize is the size class of a vehicle, from the smallest to the largest.
+type VehicleSize string
+
+const (
+	VehicleSizeMotorcycle VehicleSize = "motorcycle"
+	VehicleSizeSmall      VehicleSize = "small"
+	VehicleSizeMedium     VehicleSize = "medium"
+	VehicleSizeLarge      VehicleSize = "large"
+)
+
+func (s VehicleSize) IsValid() bool {
+	switch s {
+	case VehicleSizeMotorcycle, VehicleSizeSmall, VehicleSizeMedium, VehicleSizeLarge:
+		return true
+	}
+	return false
+}
+
+// MaxVehiclesPerDriver bounds the vehicles a driver may register.
+const MaxVehiclesPerDriver = 10
+
+type Vehicle struct {
+	ID     int64
+	UserID string
+	// Plate is normalized: upper case without spaces and dashes.
+	Plate string
+	// Country is the ISO 3166-1 alpha-2 code of the country of registration.
+	Country   string
+	Size      VehicleSize
+	Electric  bool
+	CreatedAt time.Time
+}
+
+// NormalizePlate upper-cases a license plate and drops the spaces and dashes
+// people write it with, so the same plate always compares equal.
+func NormalizePlate(plate string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) || r == '-' {
+			return -1
+		}
+		return unicode.ToUpper(r)
+	}, plate)
+}
+
+// Normalize brings the plate and country to the form they are stored in.
+func (v *Vehicle) Normalize() {
+	v.Plate = NormalizePlate(v.Plate)
+	v.Country = strings.ToUpper(strings.TrimSpace(v.Country))
+}
+
+// ValidatePlate checks a normalized license plate.
+func ValidatePlate(plate string) error {
+	if n := len([]rune(plate)); n < 2 || n > 12 {
+		return ErrInvalidPlate
+	}
+	for _, r := range plate {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			return ErrInvalidPlate
+		}
+	}
+	return nil
+}
+
+func (v *Vehicle) IsValid() error {
+	if err := ValidatePlate(v.Plate); err != nil {
+		return err
+	}
+	if len(v.Country) != 2 || v.Country[0] < 'A' || v.Country[0] > 'Z' || v.Country[1] < 'A' || v.Country[1] > 'Z' {
+		return ErrInvalidCountry
+	}
+	if !v.Size.IsValid() {
+		return ErrInvalidVehicleSize
+	}
+	if v.UserID == "" {
+		return ErrInvalidUserID
+	}
+	return nil
+}
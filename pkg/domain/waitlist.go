@@ -0,0 +1,31 @@
+package domain
+
+import "time"
+
+type WaitlistStatus string
+
+const (
+	WaitlistStatusQueued  WaitlistStatus = "Queued"
+	WaitlistStatusOffered WaitlistStatus = "Offered"
+	WaitlistStatusBooked  WaitlistStatus = "Booked"
+	WaitlistStatusExpired WaitlistStatus = "Expired"
+	WaitlistStatusLeft    WaitlistStatus = "Left"
+)
+
+// WaitlistEntry queues a driver for a spot of a full parking place during
+// [DateFrom, DateTo). When a spot frees up, an AutoAccept entry is booked
+// right away; any other entry is offered the spot until OfferExpiresAt.
+type WaitlistEntry struct {
+	ID             int64
+	UserID         string
+	ParkingPlaceID int64
+	VehicleID      int64
+	DateFrom       time.Time
+	DateTo         time.Time
+	AutoAccept     bool
+	Status         WaitlistStatus
+	// OfferExpiresAt is nil unless the entry is Offered.
+	OfferExpiresAt *time.Time
+	// BookingID is the booking of a Booked entry, 0 before that.
+	BookingID int64
+}
@@ -0,0 +1,85 @@
+package events
+
+import (
+	_ "embed"
+	"time"
+)
+
+// Types of the booking events, all keyed by the booking ID. A change of status
+// is published as the event of the status it moved to.
+const (
+	BookingCreated   = "booking.created"
+	BookingModified  = "booking.modified"
+	BookingConfirmed = "booking.confirmed"
+	BookingCheckedIn = "booking.checked_in"
+	BookingCompleted = "booking.completed"
+	BookingCanceled  = "booking.canceled"
+	BookingExpired   = "booking.expired"
+	BookingNoShow    = "booking.no_show"
+	BookingDeleted   = "booking.deleted"
+	// BookingCancellationAssessed carries the cancellation fee decided for a
+	// canceled paid booking, before the refund is made.
+	BookingCancellationAssessed = "booking.cancellation_assessed"
+	BookingRefunded             = "booking.refunded"
+)
+
+// BookingTypes lists every booking event type.
+var BookingTypes = []string{
+	BookingCreated, BookingModified, BookingConfirmed, BookingCheckedIn, BookingCompleted, BookingCanceled,
+	BookingExpired, BookingNoShow, BookingDeleted, BookingCancellationAssessed, BookingRefunded,
+}
+
+//go:embed schemas/booking.v1.json
+var bookingSchemaV1 []byte
+
+func init() {
+	for _, eventType := range BookingTypes {
+		RegisterSchema(Schema{Type: eventType, Version: 1, MinVersion: 1, Document: bookingSchemaV1})
+	}
+}
+
+// BookingData is the data of every booking event, version 1.
+type BookingData struct {
+	BookingID      int64  `json:"booking_id"`
+	UserID         string `json:"user_id"`
+	ParkingPlaceID int64  `json:"parking_place_id"`
+	Actor          Actor  `json:"actor"`
+	// Booking holds the fields the event set, Previous the values they had.
+	Booking      *BookingState        `json:"booking,omitempty"`
+	Previous     *BookingState        `json:"previous,omitempty"`
+	Cancellation *BookingCancellation `json:"cancellation,omitempty"`
+	Refund       *BookingRefund       `json:"refund,omitempty"`
+}
+
+// Actor is who caused the event: a user in their role, or a system job.
+type Actor struct {
+	ID   string `json:"id"`
+	Role string `json:"role"`
+}
+
+// BookingState is a part of a booking; only the fields an event is about are
+// set.
+type BookingState struct {
+	Status         string     `json:"status,omitempty"`
+	UserID         string     `json:"user_id,omitempty"`
+	DateFrom       *time.Time `json:"date_from,omitempty"`
+	DateTo         *time.Time `json:"date_to,omitempty"`
+	ParkingPlaceID int64      `json:"parking_place_id,omitempty"`
+	FullCost       *int64     `json:"full_cost,omitempty"`
+	SeriesID       int64      `json:"series_id,omitempty"`
+	VehicleID      int64      `json:"vehicle_id,omitempty"`
+	CheckedInAt    *time.Time `json:"checked_in_at,omitempty"`
+	CheckedOutAt   *time.Time `json:"checked_out_at,omitempty"`
+	OvertimeCost   *int64     `json:"overtime_cost,omitempty"`
+}
+
+type BookingCancellation struct {
+	PolicyVersion int64 `json:"policy_version"`
+	FeePercent    int64 `json:"fee_percent"`
+}
+
+type BookingRefund struct {
+	Amount     int64  `json:"amount"`
+	FeePercent int64  `json:"fee_percent,omitempty"`
+	Reason     string `json:"reason,omitempty"`
+}
@@ -0,0 +1,186 @@
+package events
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+// validate checks value against the keywords of JSON Schema the event schemas
+// use: type, required, properties, enum, minimum, maximum, the date-time
+// format and $ref to a definition of the root schema.
+func validate(root, schema map[string]any, value any, path string) error {
+	if ref, ok := schema["$ref"].(string); ok {
+		name, found := strings.CutPrefix(ref, "#/$defs/")
+		definitions, _ := root["$defs"].(map[string]any)
+		definition, defined := definitions[name].(map[string]any)
+		if !found || !defined {
+			return fmt.Errorf("%s: unresolved $ref %q", path, ref)
+		}
+		return validate(root, definition, value, path)
+	}
+
+	switch schema["type"] {
+	case "object":
+		object, ok := value.(map[string]any)
+		if !ok {
+			return fmt.Errorf("%s: %v is not an object", path, value)
+		}
+		required, _ := schema["required"].([]any)
+		for _, name := range required {
+			if _, ok := object[name.(string)]; !ok {
+				return fmt.Errorf("%s: %s is required", path, name)
+			}
+		}
+		properties, _ := schema["properties"].(map[string]any)
+		for name, property := range object {
+			if propertySchema, ok := properties[name].(map[string]any); ok {
+				if err := validate(root, propertySchema, property, path+"."+name); err != nil {
+					return err
+				}
+			}
+		}
+	case "string":
+		s, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("%s: %v is not a string", path, value)
+		}
+		if schema["format"] == "date-time" {
+			if _, err := time.Parse(time.RFC3339, s); err != nil {
+				return fmt.Errorf("%s: %q is not a date-time", path, s)
+			}
+		}
+	case "integer":
+		number, ok := value.(json.Number)
+		if !ok {
+			return fmt.Errorf("%s: %v is not a number", path, value)
+		}
+		n, err := number.Int64()
+		if err != nil {
+			return fmt.Errorf("%s: %s is not an integer", path, number)
+		}
+		if minimum, ok := schema["minimum"].(json.Number); ok {
+			if limit, _ := minimum.Int64(); n < limit {
+				return fmt.Errorf("%s: %d is below %d", path, n, limit)
+			}
+		}
+		if maximum, ok := schema["maximum"].(json.Number); ok {
+			if limit, _ := maximum.Int64(); n > limit {
+				return fmt.Errorf("%s: %d is above %d", path, n, limit)
+			}
+		}
+	}
+
+	if enum, ok := schema["enum"].([]any); ok {
+		for _, allowed := range enum {
+			if allowed == value {
+				return nil
+			}
+		}
+		return fmt.Errorf("%s: %v is not one of %v", path, value, enum)
+	}
+	return nil
+}
+
+func decodeJSON(t *testing.T, data []byte) any {
+	t.Helper()
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		t.Fatalf("decode %s: %v", data, err)
+	}
+	return value
+}
+
+// validateBookingData validates data against the registered schema of a
+// booking event type.
+func validateBookingData(t *testing.T, eventType string, data any) error {
+	t.Helper()
+	schema, ok := LatestSchema(eventType)
+	if !ok {
+		t.Fatalf("no schema for %s", eventType)
+	}
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		t.Fatalf("marshal data: %v", err)
+	}
+	root := decodeJSON(t, schema.Document).(map[string]any)
+	return validate(root, root, decodeJSON(t, encoded), "data")
+}
+
+func TestBookingDataMatchesSchema(t *testing.T) {
+	from := time.Date(2030, 1, 1, 10, 0, 0, 0, time.UTC)
+	to := from.Add(2 * time.Hour)
+	cost := int64(200)
+	base := func() BookingData {
+		return BookingData{
+			BookingID: 42, UserID: "driver-1", ParkingPlaceID: 7,
+			Actor: Actor{ID: "driver-1", Role: "driver"},
+		}
+	}
+
+	created := base()
+	created.Booking = &BookingState{Status: "Waiting", UserID: "driver-1", DateFrom: &from, DateTo: &to,
+		ParkingPlaceID: 7, FullCost: &cost, VehicleID: 3}
+	confirmed := base()
+	confirmed.Actor = Actor{ID: "expire-holds", Role: "system"}
+	confirmed.Booking = &BookingState{Status: "Confirmed"}
+	confirmed.Previous = &BookingState{Status: "Waiting"}
+	checkedIn := base()
+	checkedIn.Actor = Actor{ID: "owner-1", Role: "owner"}
+	checkedIn.Booking = &BookingState{Status: "Active", CheckedInAt: &from}
+	assessed := base()
+	assessed.Cancellation = &BookingCancellation{PolicyVersion: 2, FeePercent: 50}
+	refunded := base()
+	refunded.Actor = Actor{ID: "admin-1", Role: "admin"}
+	refunded.Refund = &BookingRefund{Amount: 100, FeePercent: 50, Reason: "canceled"}
+
+	valid := map[string]BookingData{
+		BookingCreated:              created,
+		BookingConfirmed:            confirmed,
+		BookingCheckedIn:            checkedIn,
+		BookingDeleted:              base(),
+		BookingCancellationAssessed: assessed,
+		BookingRefunded:             refunded,
+	}
+	for eventType, data := range valid {
+		if err := validateBookingData(t, eventType, data); err != nil {
+			t.Errorf("%s: %v", eventType, err)
+		}
+	}
+
+	invalid := map[string]func(*BookingData){
+		"unknown role":    func(d *BookingData) { d.Actor.Role = "valet" },
+		"no actor":        func(d *BookingData) { d.Actor = Actor{} },
+		"unknown status":  func(d *BookingData) { d.Booking = &BookingState{Status: "Parked"} },
+		"fee above 100":   func(d *BookingData) { d.Cancellation = &BookingCancellation{FeePercent: 120} },
+		"negative refund": func(d *BookingData) { d.Refund = &BookingRefund{Amount: -1} },
+	}
+	for name, change := range invalid {
+		data := base()
+		change(&data)
+		if err := validateBookingData(t, BookingCreated, data); err == nil {
+			t.Errorf("%s: data passed validation", name)
+		}
+	}
+
+	root := decodeJSON(t, bookingSchemaV1).(map[string]any)
+	fractional := decodeJSON(t, []byte(`{"booking_id":4.2,"user_id":"driver-1","parking_place_id":7,`+
+		`"actor":{"id":"driver-1","role":"driver"}}`))
+	if err := validate(root, root, fractional, "data"); err == nil {
+		t.Errorf("fractional booking ID passed validation")
+	}
+}
+
+func TestBookingSchemasRegistered(t *testing.T) {
+	for _, eventType := range BookingTypes {
+		schema, ok := LatestSchema(eventType)
+		if !ok || schema.Version != 1 || schema.MinVersion != 1 {
+			t.Errorf("%s: schema = %+v, %v; want v1 readable from v1", eventType, schema, ok)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// Handler processes an event whose data follows the schema version the
+// handler was registered for.
+type Handler func(ctx context.Context, envelope *Envelope) error
+
+// Consumer reads domain events of a topic as a member of a consumer group and
+// dispatches them by type, in the version negotiated between the event and the
+// versions the consumer handles. Events of types without a handler are
+// skipped.
+type Consumer struct {
+	reader   *kafka.Reader
+	topic    string
+	handlers map[string]map[int]Handler
+}
+
+func NewConsumer(brokers []string, topic string, groupID string) (*Consumer, error) {
+	if len(brokers) == 0 {
+		return nil, errors.New("kafka brokers are required")
+	}
+	if topic == "" {
+		return nil, errors.New("kafka topic is required")
+	}
+	if groupID == "" {
+		return nil, errors.New("kafka group ID is required")
+	}
+	return &Consumer{
+		reader: kafka.NewReader(kafka.ReaderConfig{
+			Brokers: brokers,
+			Topic:   topic,
+			GroupID: groupID,
+		}),
+		topic:    topic,
+		handlers: make(map[string]map[int]Handler),
+	}, nil
+}
+
+// Handle registers the handler of a version of an event type. Registering
+// several versions of a type lets a consumer keep reading old events while
+// producers move on.
+func (c *Consumer) Handle(eventType string, version int, handler Handler) {
+	if c.handlers[eventType] == nil {
+		c.handlers[eventType] = make(map[int]Handler)
+	}
+	c.handlers[eventType][version] = handler
+}
+
+// Dispatch hands a decoded event to the handler of the negotiated version. It
+// reports whether a handler of the type exists.
+func (c *Consumer) Dispatch(ctx context.Context, envelope *Envelope) (bool, error) {
+	byVersion := c.handlers[envelope.Type]
+	if len(byVersion) == 0 {
+		return false, nil
+	}
+	supported := make([]int, 0, len(byVersion))
+	for version := range byVersion {
+		supported = append(supported, version)
+	}
+	sort.Ints(supported)
+	version, err := Negotiate(envelope, supported)
+	if err != nil {
+		return true, err
+	}
+	return true, byVersion[version](ctx, envelope)
+}
+
+// Run consumes events until ctx is canceled. Offsets are committed after the
+// handler returns, so events are delivered at least once; an event that fails
+// to decode or to be handled is logged and skipped rather than blocking its
+// partition.
+func (c *Consumer) Run(ctx context.Context) error {
+	for {
+		message, err := c.reader.FetchMessage(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("failed to fetch event from kafka: %w", err)
+		}
+
+		envelope, err := Decode(message)
+		if err == nil {
+			_, err = c.Dispatch(ctx, envelope)
+		}
+		if err != nil {
+			slog.Error("failed to handle event",
+				slog.String("topic", c.topic),
+				slog.Int("partition", message.Partition),
+				slog.Int64("offset", message.Offset),
+				slog.String("error", err.Error()),
+			)
+		}
+
+		if err := c.reader.CommitMessages(ctx, message); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("failed to commit event offset: %w", err)
+		}
+	}
+}
+
+func (c *Consumer) Close() error {
+	if err := c.reader.Close(); err != nil {
+		return fmt.Errorf("failed to close event consumer: %w", err)
+	}
+	return nil
+}
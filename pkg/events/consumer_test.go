@@ -0,0 +1,60 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestDispatch(t *testing.T) {
+	var handled []int
+	consumer := &Consumer{handlers: make(map[string]map[int]Handler)}
+	for _, version := range []int{1, 2} {
+		version := version
+		consumer.Handle(testType, version, func(ctx context.Context, envelope *Envelope) error {
+			handled = append(handled, version)
+			return nil
+		})
+	}
+
+	tests := []struct {
+		name       string
+		eventType  string
+		version    int
+		minVersion int
+		known      bool
+		wantErr    error
+		handled    []int
+	}{
+		{name: "handled version", eventType: testType, version: 2, minVersion: 1, known: true, handled: []int{2}},
+		{name: "newer compatible event", eventType: testType, version: 3, minVersion: 1, known: true,
+			handled: []int{2}},
+		{name: "newer breaking event", eventType: testType, version: 3, minVersion: 3, known: true,
+			wantErr: ErrUnsupportedVersion},
+		{name: "unknown type", eventType: "test.unknown", version: 1, minVersion: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handled = nil
+			envelope := &Envelope{ID: "1", Type: tt.eventType, Version: tt.version, MinVersion: tt.minVersion}
+			known, err := consumer.Dispatch(context.Background(), envelope)
+			if known != tt.known || !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Dispatch = %v, %v; want %v, %v", known, err, tt.known, tt.wantErr)
+			}
+			if len(handled) != len(tt.handled) || (len(handled) > 0 && handled[0] != tt.handled[0]) {
+				t.Errorf("handled versions %v, want %v", handled, tt.handled)
+			}
+		})
+	}
+}
+
+func TestDispatchReturnsHandlerError(t *testing.T) {
+	failure := errors.New("handler failed")
+	consumer := &Consumer{handlers: make(map[string]map[int]Handler)}
+	consumer.Handle(BookingCreated, 1, func(ctx context.Context, envelope *Envelope) error { return failure })
+
+	known, err := consumer.Dispatch(context.Background(), &Envelope{Type: BookingCreated, Version: 1, MinVersion: 1})
+	if !known || !errors.Is(err, failure) {
+		t.Errorf("Dispatch = %v, %v; want true, %v", known, err, failure)
+	}
+}
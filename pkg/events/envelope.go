@@ -0,0 +1,115 @@
+// Package events publishes and consumes the domain events services emit about
+// what happened to their entities, as opposed to the human-readable
+// notifications of package notification. Every event travels in an Envelope
+// naming its type and the version of the schema its data follows.
+package events
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+const (
+	headerFormat     = "format"
+	headerType       = "event-type"
+	headerVersion    = "event-version"
+	headerMinVersion = "event-min-version"
+)
+
+var (
+	ErrInvalidEnvelope    = errors.New("invalid event envelope")
+	ErrUnsupportedVersion = errors.New("unsupported event version")
+)
+
+// Envelope is a published domain event.
+type Envelope struct {
+	// ID identifies the event. Delivery is at least once, so consumers
+	// deduplicate on it.
+	ID   string `json:"id"`
+	Type string `json:"type"`
+	// Version is the version of the schema of Type that Data follows.
+	Version int `json:"version"`
+	// MinVersion is the oldest schema version whose readers can still decode
+	// Data. Versions that only add fields keep it, a breaking one raises it to
+	// itself.
+	MinVersion int    `json:"min_version"`
+	Source     string `json:"source"`
+	// Subject is the ID of the entity the event is about; it is also the
+	// partition key, so the events of one entity are consumed in order.
+	Subject    string          `json:"subject"`
+	OccurredAt time.Time       `json:"occurred_at"`
+	TraceID    string          `json:"trace_id,omitempty"`
+	Data       json.RawMessage `json:"data"`
+}
+
+// DecodeData unmarshals the event data into v.
+func (e *Envelope) DecodeData(v any) error {
+	if err := json.Unmarshal(e.Data, v); err != nil {
+		return fmt.Errorf("failed to decode %s v%d data: %w", e.Type, e.Version, err)
+	}
+	return nil
+}
+
+func (e *Envelope) validate() error {
+	switch {
+	case e.ID == "":
+		return fmt.Errorf("%w: id is required", ErrInvalidEnvelope)
+	case e.Type == "":
+		return fmt.Errorf("%w: type is required", ErrInvalidEnvelope)
+	case e.Version < 1:
+		return fmt.Errorf("%w: version must be positive", ErrInvalidEnvelope)
+	case e.MinVersion < 1 || e.MinVersion > e.Version:
+		return fmt.Errorf("%w: min_version must be between 1 and version", ErrInvalidEnvelope)
+	}
+	return nil
+}
+
+// Encode builds the Kafka message of the event, keyed by its subject.
+func Encode(envelope *Envelope) (kafka.Message, error) {
+	if err := envelope.validate(); err != nil {
+		return kafka.Message{}, err
+	}
+	value, err := json.Marshal(envelope)
+	if err != nil {
+		return kafka.Message{}, fmt.Errorf("failed to marshal event: %w", err)
+	}
+	return kafka.Message{
+		Key:   []byte(envelope.Subject),
+		Value: value,
+		Headers: []kafka.Header{
+			{Key: headerFormat, Value: []byte("json")},
+			{Key: headerType, Value: []byte(envelope.Type)},
+			{Key: headerVersion, Value: []byte(strconv.Itoa(envelope.Version))},
+			{Key: headerMinVersion, Value: []byte(strconv.Itoa(envelope.MinVersion))},
+		},
+	}, nil
+}
+
+// Decode reads the event of a Kafka message.
+func Decode(message kafka.Message) (*Envelope, error) {
+	if format := header(message.Headers, headerFormat); format != "json" {
+		return nil, fmt.Errorf("%w: expected format 'json', got '%s'", ErrInvalidEnvelope, format)
+	}
+	envelope := new(Envelope)
+	if err := json.Unmarshal(message.Value, envelope); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidEnvelope, err)
+	}
+	if err := envelope.validate(); err != nil {
+		return nil, err
+	}
+	return envelope, nil
+}
+
+func header(headers []kafka.Header, key string) string {
+	for _, h := range headers {
+		if h.Key == key {
+			return string(h.Value)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,94 @@
+package events
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+func testEnvelope() *Envelope {
+	return &Envelope{
+		ID:         "booking-event-1",
+		Type:       BookingCreated,
+		Version:    1,
+		MinVersion: 1,
+		Source:     "booking",
+		Subject:    "42",
+		OccurredAt: time.Date(2030, 1, 1, 10, 0, 0, 0, time.UTC),
+		TraceID:    "trace",
+		Data:       json.RawMessage(`{"booking_id":42}`),
+	}
+}
+
+func TestEncodeDecodeRoundTrip(t *testing.T) {
+	envelope := testEnvelope()
+	message, err := Encode(envelope)
+	if err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+	if string(message.Key) != envelope.Subject {
+		t.Errorf("key = %q, want the subject %q", message.Key, envelope.Subject)
+	}
+	for key, want := range map[string]string{
+		headerFormat: "json", headerType: BookingCreated, headerVersion: "1", headerMinVersion: "1",
+	} {
+		if got := header(message.Headers, key); got != want {
+			t.Errorf("header %s = %q, want %q", key, got, want)
+		}
+	}
+
+	decoded, err := Decode(message)
+	if err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	if !reflect.DeepEqual(decoded, envelope) {
+		t.Errorf("Decode = %+v, want %+v", decoded, envelope)
+	}
+}
+
+func TestEncodeRejectsInvalidEnvelope(t *testing.T) {
+	tests := []struct {
+		name   string
+		change func(*Envelope)
+	}{
+		{"no id", func(e *Envelope) { e.ID = "" }},
+		{"no type", func(e *Envelope) { e.Type = "" }},
+		{"no version", func(e *Envelope) { e.Version = 0 }},
+		{"min_version above version", func(e *Envelope) { e.MinVersion = 2 }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			envelope := testEnvelope()
+			tt.change(envelope)
+			if _, err := Encode(envelope); !errors.Is(err, ErrInvalidEnvelope) {
+				t.Errorf("Encode err = %v, want %v", err, ErrInvalidEnvelope)
+			}
+		})
+	}
+}
+
+func TestDecodeRejectsInvalidMessage(t *testing.T) {
+	valid, err := Encode(testEnvelope())
+	if err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+	tests := []struct {
+		name    string
+		message kafka.Message
+	}{
+		{"no format header", kafka.Message{Value: valid.Value}},
+		{"not JSON", kafka.Message{Headers: valid.Headers, Value: []byte("booking created")}},
+		{"invalid envelope", kafka.Message{Headers: valid.Headers, Value: []byte(`{"id":"1","type":"booking.created"}`)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Decode(tt.message); !errors.Is(err, ErrInvalidEnvelope) {
+				t.Errorf("Decode err = %v, want %v", err, ErrInvalidEnvelope)
+			}
+		})
+	}
+}
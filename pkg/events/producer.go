@@ -0,0 +1,121 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// Event is a domain event to publish, in the latest version of its schema.
+type Event struct {
+	ID         string
+	Type       string
+	Subject    string
+	OccurredAt time.Time
+	TraceID    string
+	Data       any
+}
+
+// Producer publishes the domain events of one source service. Events are
+// partitioned by subject, so the events of an entity keep their order.
+type Producer struct {
+	writer *kafka.Writer
+	source string
+}
+
+func NewProducer(broker string, topic string, source string) (*Producer, error) {
+	if broker == "" {
+		return nil, errors.New("kafka broker is required")
+	}
+	if topic == "" {
+		return nil, errors.New("kafka topic is required")
+	}
+	if source == "" {
+		return nil, errors.New("event source is required")
+	}
+	return &Producer{
+		writer: &kafka.Writer{
+			Addr:                   kafka.TCP(broker),
+			Topic:                  topic,
+			Balancer:               &kafka.Hash{},
+			RequiredAcks:           kafka.RequireAll,
+			AllowAutoTopicCreation: true,
+		},
+		source: source,
+	}, nil
+}
+
+func NewEnvProducer(source string) (*Producer, error) {
+	broker := os.Getenv("KAFKA_BROKER")
+	topic := os.Getenv("KAFKA_EVENTS_TOPIC")
+	if broker == "" {
+		return nil, errors.New("KAFKA_BROKER environment variable is required")
+	}
+	if topic == "" {
+		return nil, errors.New("KAFKA_EVENTS_TOPIC environment variable is required")
+	}
+	return NewProducer(broker, topic, source)
+}
+
+// Publish writes the events in order and returns once all of them are
+// acknowledged, or fails without telling which were written.
+func (p *Producer) Publish(ctx context.Context, events ...Event) error {
+	if p == nil || p.writer == nil {
+		return errors.New("event producer is not initialized")
+	}
+	messages := make([]kafka.Message, 0, len(events))
+	for _, event := range events {
+		message, err := p.encode(event)
+		if err != nil {
+			return err
+		}
+		messages = append(messages, message)
+	}
+	if len(messages) == 0 {
+		return nil
+	}
+	if err := p.writer.WriteMessages(ctx, messages...); err != nil {
+		return fmt.Errorf("failed to write events to kafka: %w", err)
+	}
+	return nil
+}
+
+func (p *Producer) encode(event Event) (kafka.Message, error) {
+	schema, ok := LatestSchema(event.Type)
+	if !ok {
+		return kafka.Message{}, fmt.Errorf("no schema registered for event type %q", event.Type)
+	}
+	if event.Subject == "" {
+		return kafka.Message{}, fmt.Errorf("%s event %s has no subject", event.Type, event.ID)
+	}
+	data, err := json.Marshal(event.Data)
+	if err != nil {
+		return kafka.Message{}, fmt.Errorf("failed to marshal %s event data: %w", event.Type, err)
+	}
+	return Encode(&Envelope{
+		ID:         event.ID,
+		Type:       event.Type,
+		Version:    schema.Version,
+		MinVersion: schema.MinVersion,
+		Source:     p.source,
+		Subject:    event.Subject,
+		OccurredAt: event.OccurredAt.UTC(),
+		TraceID:    event.TraceID,
+		Data:       data,
+	})
+}
+
+func (p *Producer) Close() error {
+	if p == nil || p.writer == nil {
+		return errors.New("event producer is nil")
+	}
+	if err := p.writer.Close(); err != nil {
+		return fmt.Errorf("failed to close event producer: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Schema describes one version of the data of an event type.
+type Schema struct {
+	Type    string
+	Version int
+	// MinVersion is the oldest version whose readers can decode data of this
+	// version, and the oldest version of data readers of this version can
+	// decode.
+	MinVersion int
+	// Document is the JSON Schema of the data.
+	Document json.RawMessage
+}
+
+var registry = struct {
+	sync.RWMutex
+	// schemas holds the versions of every type, oldest first.
+	schemas map[string][]Schema
+}{schemas: make(map[string][]Schema)}
+
+// RegisterSchema makes a version of an event type known to producers and
+// consumers. It panics on a malformed or duplicate schema, as schemas are
+// registered at init.
+func RegisterSchema(schema Schema) {
+	if schema.Type == "" || schema.Version < 1 || schema.MinVersion < 1 || schema.MinVersion > schema.Version {
+		panic(fmt.Sprintf("events: malformed schema %s v%d", schema.Type, schema.Version))
+	}
+	if !json.Valid(schema.Document) {
+		panic(fmt.Sprintf("events: schema %s v%d is not valid JSON", schema.Type, schema.Version))
+	}
+
+	registry.Lock()
+	defer registry.Unlock()
+	versions := registry.schemas[schema.Type]
+	for _, registered := range versions {
+		if registered.Version == schema.Version {
+			panic(fmt.Sprintf("events: schema %s v%d registered twice", schema.Type, schema.Version))
+		}
+	}
+	versions = append(versions, schema)
+	sort.Slice(versions, func(i, j int) bool { return versions[i].Version < versions[j].Version })
+	registry.schemas[schema.Type] = versions
+}
+
+// LookupSchema returns a version of the schema of an event type.
+func LookupSchema(eventType string, version int) (Schema, bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+	for _, schema := range registry.schemas[eventType] {
+		if schema.Version == version {
+			return schema, true
+		}
+	}
+	return Schema{}, false
+}
+
+// LatestSchema returns the version of the schema producers publish an event
+// type in.
+func LatestSchema(eventType string) (Schema, bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+	versions := registry.schemas[eventType]
+	if len(versions) == 0 {
+		return Schema{}, false
+	}
+	return versions[len(versions)-1], true
+}
+
+// Schemas lists every registered schema by type and version.
+func Schemas() []Schema {
+	registry.RLock()
+	defer registry.RUnlock()
+	all := make([]Schema, 0, len(registry.schemas))
+	for _, versions := range registry.schemas {
+		all = append(all, versions...)
+	}
+	sort.Slice(all, func(i, j int) bool {
+		if all[i].Type != all[j].Type {
+			return all[i].Type < all[j].Type
+		}
+		return all[i].Version < all[j].Version
+	})
+	return all
+}
+
+// Negotiate picks which of the versions a consumer reads an event in. It
+// prefers the version of the event, then the newest older version the event
+// is still readable in, then the oldest newer version whose readers can read
+// it, and fails with ErrUnsupportedVersion when none of them fits.
+func Negotiate(envelope *Envelope, supported []int) (int, error) {
+	older, newer := 0, 0
+	for _, version := range supported {
+		switch {
+		case version == envelope.Version:
+			return version, nil
+		case version < envelope.Version:
+			if version >= envelope.MinVersion && version > older {
+				older = version
+			}
+		default:
+			schema, ok := LookupSchema(envelope.Type, version)
+			if ok && schema.MinVersion <= envelope.Version && (newer == 0 || version < newer) {
+				newer = version
+			}
+		}
+	}
+	if older != 0 {
+		return older, nil
+	}
+	if newer != 0 {
+		return newer, nil
+	}
+	return 0, fmt.Errorf("%w: %s v%d (readable from v%d), consumer reads %v",
+		ErrUnsupportedVersion, envelope.Type, envelope.Version, envelope.MinVersion, supported)
+}
@@ -0,0 +1,72 @@
+package events
+
+import (
+	"errors"
+	"testing"
+)
+
+// testType is registered in three versions: v2 only adds fields to v1, v3
+// breaks their readers.
+const testType = "test.negotiated"
+
+func init() {
+	document := []byte(`{"type": "object"}`)
+	RegisterSchema(Schema{Type: testType, Version: 1, MinVersion: 1, Document: document})
+	RegisterSchema(Schema{Type: testType, Version: 2, MinVersion: 1, Document: document})
+	RegisterSchema(Schema{Type: testType, Version: 3, MinVersion: 3, Document: document})
+}
+
+func TestNegotiate(t *testing.T) {
+	tests := []struct {
+		name       string
+		version    int
+		minVersion int
+		supported  []int
+		want       int
+	}{
+		{name: "same version", version: 2, minVersion: 1, supported: []int{1, 2, 3}, want: 2},
+		{name: "older reader of a compatible event", version: 2, minVersion: 1, supported: []int{1}, want: 1},
+		{name: "newest compatible older reader", version: 3, minVersion: 1, supported: []int{1, 2}, want: 2},
+		{name: "newer reader of an old event", version: 1, minVersion: 1, supported: []int{2}, want: 2},
+		{name: "oldest compatible newer reader", version: 1, minVersion: 1, supported: []int{3, 2}, want: 2},
+		{name: "older reader of a breaking event", version: 3, minVersion: 3, supported: []int{1, 2}},
+		{name: "newer reader that dropped the version", version: 2, minVersion: 1, supported: []int{3}},
+		{name: "no readers", version: 1, minVersion: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			envelope := &Envelope{Type: testType, Version: tt.version, MinVersion: tt.minVersion}
+			got, err := Negotiate(envelope, tt.supported)
+			if tt.want == 0 {
+				if !errors.Is(err, ErrUnsupportedVersion) {
+					t.Fatalf("Negotiate = %d, %v; want %v", got, err, ErrUnsupportedVersion)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Fatalf("Negotiate = %d, %v; want %d", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestRegisterSchemaRejectsMalformed(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema Schema
+	}{
+		{"duplicate", Schema{Type: testType, Version: 1, MinVersion: 1, Document: []byte(`{}`)}},
+		{"min_version above version", Schema{Type: "test.malformed", Version: 1, MinVersion: 2, Document: []byte(`{}`)}},
+		{"invalid JSON", Schema{Type: "test.malformed", Version: 1, MinVersion: 1, Document: []byte(`{`)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("RegisterSchema did not panic")
+				}
+			}()
+			RegisterSchema(tt.schema)
+		})
+	}
+}
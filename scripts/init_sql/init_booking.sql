@@ -1,3 +1,35 @@
+-- Vehicles of drivers. A removed vehicle is only marked deleted, so the
+-- bookings made with it keep their plate.
+CREATE TABLE IF NOT EXISTS vehicles
+(
+    id         SERIAL PRIMARY KEY,
+    user_id    TEXT      NOT NULL,
+    plate      TEXT      NOT NULL,
+    country    TEXT      NOT NULL,
+    size       TEXT      NOT NULL CHECK ( size IN ('motorcycle', 'small', 'medium', 'large') ),
+    electric   BOOLEAN   NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_vehicles_user_plate ON vehicles(user_id, country, plate) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_vehicles_plate ON vehicles(plate);
+
+CREATE TABLE IF NOT EXISTS booking_series
+(
+    id               SERIAL PRIMARY KEY,
+    user_id          TEXT      NOT NULL,
+    parking_place_id INTEGER   NOT NULL,
+    date_from        TIMESTAMP NOT NULL,
+    date_to          TIMESTAMP NOT NULL,
+    rrule            TEXT      NOT NULL,
+    until            TIMESTAMP NOT NULL,
+    billing          TEXT      NOT NULL CHECK ( billing IN ('per_occurrence', 'upfront') ) DEFAULT 'per_occurrence',
+    status           TEXT      NOT NULL CHECK ( status IN ('Active', 'Canceled') ) DEFAULT 'Active',
+    created_at       TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    vehicle_id       INTEGER REFERENCES vehicles (id)
+);
+
 CREATE TABLE IF NOT EXISTS bookings
 (
     id               SERIAL PRIMARY KEY,
@@ -5,6 +37,175 @@ CREATE TABLE IF NOT EXISTS bookings
     date_to          TIMESTAMP    NOT NULL,
     parking_place_id INTEGER NOT NULL,
     full_cost        INTEGER                                                                     DEFAULT 0,
-    status           TEXT CHECK ( status in ('Waiting', 'Confirmed', 'Canceled') ) DEFAULT 'Waiting',
-    user_id          TEXT    NOT NULL
-);
\ No newline at end of file
+    status           TEXT CHECK ( status in ('Waiting', 'Confirmed', 'Active', 'Completed', 'Canceled', 'Expired', 'NoShow') ) DEFAULT 'Waiting',
+    user_id          TEXT    NOT NULL,
+    created_at       TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    series_id        INTEGER REFERENCES booking_series (id),
+    checked_in_at    TIMESTAMP,
+    checked_out_at   TIMESTAMP,
+    overtime_cost    INTEGER NOT NULL DEFAULT 0,
+    updated_at       TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    revision         INTEGER NOT NULL DEFAULT 0,
+    vehicle_id       INTEGER REFERENCES vehicles (id)
+);
+
+-- Calendar feeds publish revision as the SEQUENCE of a booking's event, so it
+-- grows with every change a calendar shows.
+CREATE OR REPLACE FUNCTION bump_booking_revision()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF (NEW.date_from, NEW.date_to, NEW.parking_place_id, NEW.status) IS DISTINCT FROM
+       (OLD.date_from, OLD.date_to, OLD.parking_place_id, OLD.status) THEN
+        NEW.revision = OLD.revision + 1;
+        NEW.updated_at = CURRENT_TIMESTAMP;
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trigger_booking_revision ON bookings;
+CREATE TRIGGER trigger_booking_revision
+    BEFORE UPDATE ON bookings
+    FOR EACH ROW
+    EXECUTE FUNCTION bump_booking_revision();
+
+CREATE INDEX IF NOT EXISTS idx_bookings_status_created_at ON bookings(status, created_at);
+CREATE INDEX IF NOT EXISTS idx_bookings_series_id ON bookings(series_id);
+CREATE INDEX IF NOT EXISTS idx_bookings_vehicle_id ON bookings(vehicle_id);
+CREATE INDEX IF NOT EXISTS idx_bookings_parking_place_date_from ON bookings(parking_place_id, date_from, id);
+CREATE INDEX IF NOT EXISTS idx_bookings_user_id ON bookings(user_id, id);
+CREATE TABLE IF NOT EXISTS outbox
+(
+    id              SERIAL PRIMARY KEY,
+    booking_id      INTEGER   NOT NULL,
+    command         TEXT      NOT NULL CHECK ( command IN ('charge', 'refund', 'adjust', 'notify') ),
+    payload         JSONB     NOT NULL,
+    status          TEXT      NOT NULL CHECK ( status IN ('pending', 'done', 'failed', 'compensated') ) DEFAULT 'pending',
+    attempts        INTEGER   NOT NULL DEFAULT 0,
+    last_error      TEXT,
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at      TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at      TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_outbox_pending ON outbox(next_attempt_at) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS idx_outbox_booking_id ON outbox(booking_id);
+
+CREATE TABLE IF NOT EXISTS booking_idempotency
+(
+    user_id      TEXT      NOT NULL,
+    key          TEXT      NOT NULL,
+    request_hash TEXT      NOT NULL,
+    booking_id   INTEGER   NOT NULL,
+    created_at   TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, key)
+);
+
+-- Cancellations of paid bookings with the cancellation policy version that
+-- decided the refund. Rows outlive deleted bookings.
+CREATE TABLE IF NOT EXISTS booking_cancellations
+(
+    booking_id       INTEGER   PRIMARY KEY,
+    user_id          TEXT      NOT NULL,
+    parking_place_id INTEGER   NOT NULL,
+    policy_version   INTEGER   NOT NULL,
+    fee_percent      INTEGER   NOT NULL CHECK ( fee_percent BETWEEN 0 AND 100 ),
+    canceled_at      TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Drivers waiting for a spot of a fully booked parking place. An Offered entry
+-- holds a spot until offer_expires_at.
+CREATE TABLE IF NOT EXISTS waitlist_entries
+(
+    id               SERIAL PRIMARY KEY,
+    user_id          TEXT      NOT NULL,
+    parking_place_id INTEGER   NOT NULL,
+    date_from        TIMESTAMP NOT NULL,
+    date_to          TIMESTAMP NOT NULL,
+    auto_accept      BOOLEAN   NOT NULL DEFAULT FALSE,
+    status           TEXT      NOT NULL CHECK ( status IN ('Queued', 'Offered', 'Booked', 'Expired', 'Left') ) DEFAULT 'Queued',
+    offer_expires_at TIMESTAMP,
+    booking_id       INTEGER,
+    created_at       TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    vehicle_id       INTEGER REFERENCES vehicles (id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_waitlist_entries_queued ON waitlist_entries(created_at) WHERE status = 'Queued';
+CREATE INDEX IF NOT EXISTS idx_waitlist_entries_offered ON waitlist_entries(parking_place_id) WHERE status = 'Offered';
+CREATE INDEX IF NOT EXISTS idx_waitlist_entries_user_id ON waitlist_entries(user_id);
+
+-- Spots reserved for a driver between choosing a period and paying for it. A
+-- Held row takes a spot until expires_at; booking_id is set once it is used.
+CREATE TABLE IF NOT EXISTS booking_holds
+(
+    id               SERIAL PRIMARY KEY,
+    user_id          TEXT      NOT NULL,
+    parking_place_id INTEGER   NOT NULL,
+    date_from        TIMESTAMP NOT NULL,
+    date_to          TIMESTAMP NOT NULL,
+    status           TEXT      NOT NULL CHECK ( status IN ('Held', 'Used', 'Expired') ) DEFAULT 'Held',
+    expires_at       TIMESTAMP NOT NULL,
+    booking_id       INTEGER,
+    created_at       TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_booking_holds_held ON booking_holds(parking_place_id) WHERE status = 'Held';
+CREATE INDEX IF NOT EXISTS idx_booking_holds_user_id ON booking_holds(user_id);
+
+-- Parking places whose bookings a closure canceled. Nothing books them again
+-- until a suspended place is resumed and its row removed; both are done under
+-- the advisory lock of the place, like every capacity check.
+CREATE TABLE IF NOT EXISTS closed_parking_places
+(
+    parking_place_id INTEGER   PRIMARY KEY,
+    closed_at        TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Secret calendar feed URLs. Only the SHA-256 of a token is stored, and a user
+-- has at most one feed that is not revoked.
+CREATE TABLE IF NOT EXISTS calendar_feeds
+(
+    token_hash TEXT      PRIMARY KEY,
+    user_id    TEXT      NOT NULL,
+    role       TEXT      NOT NULL CHECK ( role IN ('driver', 'owner') ),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    revoked_at TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_calendar_feeds_user_id ON calendar_feeds(user_id) WHERE revoked_at IS NULL;
+
+-- Entry passes spent at the gate; a pass is refused once it is listed here.
+-- Rows outlive deleted bookings, like booking_events, so booking_id is not a
+-- foreign key and a spent pass stays spent.
+CREATE TABLE IF NOT EXISTS entry_pass_uses
+(
+    pass_id          TEXT      PRIMARY KEY,
+    booking_id       INTEGER   NOT NULL,
+    parking_place_id INTEGER   NOT NULL,
+    used_at          TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+ALTER TABLE entry_pass_uses DROP CONSTRAINT IF EXISTS entry_pass_uses_booking_id_fkey;
+
+CREATE INDEX IF NOT EXISTS idx_entry_pass_uses_booking_id ON entry_pass_uses(booking_id);
+
+-- Append-only history of every change of a booking. Rows outlive deleted
+-- bookings; user_id and parking_place_id are those of the booking at the time.
+CREATE TABLE IF NOT EXISTS booking_events
+(
+    id               BIGSERIAL PRIMARY KEY,
+    booking_id       INTEGER   NOT NULL,
+    user_id          TEXT      NOT NULL,
+    parking_place_id INTEGER   NOT NULL,
+    event_type       TEXT      NOT NULL CHECK ( event_type IN ('created', 'modified', 'status_changed', 'canceled', 'refunded', 'deleted') ),
+    actor_id         TEXT      NOT NULL,
+    actor_role       TEXT      NOT NULL CHECK ( actor_role IN ('driver', 'owner', 'admin', 'system') ),
+    old_value        JSONB,
+    new_value        JSONB,
+    trace_id         TEXT,
+    created_at       TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    published_at     TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_booking_events_booking_id ON booking_events(booking_id, id);
+CREATE INDEX IF NOT EXISTS idx_booking_events_unpublished ON booking_events(id) WHERE published_at IS NULL;
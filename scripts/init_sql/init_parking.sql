@@ -7,5 +7,80 @@ CREATE TABLE IF NOT EXISTS parking_places
     parking_type TEXT CHECK ( parking_type IN ('outdoor', 'covered', 'underground', 'multi-level') ),
     hourly_rate  INT  NOT NULL,
     capacity     INT  NOT NULL DEFAULT 0,
-    owner_id     TEXT
-);
\ No newline at end of file
+    owner_id     TEXT,
+    status       TEXT NOT NULL CHECK ( status IN ('Active', 'Suspended', 'Closing') ) DEFAULT 'Active',
+    -- vehicle size classes the place takes; empty takes every size
+    allowed_vehicle_sizes TEXT[] NOT NULL DEFAULT '{}'
+        CHECK ( allowed_vehicle_sizes <@ ARRAY ['motorcycle', 'small', 'medium', 'large'] ),
+    -- WGS 84 degrees; both NULL until the owner sets the location
+    latitude     DOUBLE PRECISION CHECK ( latitude BETWEEN -90 AND 90 ),
+    longitude    DOUBLE PRECISION CHECK ( longitude BETWEEN -180 AND 180 ),
+    CHECK ( (latitude IS NULL) = (longitude IS NULL) )
+);
+-- Nearby searches narrow the places down to a bounding box before computing
+-- distances.
+CREATE INDEX IF NOT EXISTS idx_parking_places_location ON parking_places(latitude, longitude)
+    WHERE latitude IS NOT NULL;
+-- Every change of a parking place's cancellation policy is stored as a new
+-- version; the latest one applies to new cancellations.
+CREATE TABLE IF NOT EXISTS cancellation_policies
+(
+    parking_place_id              INT       NOT NULL REFERENCES parking_places (id) ON DELETE CASCADE,
+    version                       INT       NOT NULL,
+    free_cancellation_hours       INT       NOT NULL CHECK ( free_cancellation_hours BETWEEN 0 AND 8760 ),
+    late_cancellation_fee_percent INT       NOT NULL CHECK ( late_cancellation_fee_percent BETWEEN 0 AND 100 ),
+    created_at                    TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (parking_place_id, version)
+);
+
+-- A closure takes a parking place out of service: it cancels the bookings that
+-- have not ended, refunds and notifies their drivers, and then deletes or
+-- suspends the place. step records the progress so an interrupted closure
+-- resumes; the place may already be gone once a delete is Done.
+CREATE TABLE IF NOT EXISTS parking_closures
+(
+    id               SERIAL PRIMARY KEY,
+    parking_place_id INT       NOT NULL,
+    owner_id         TEXT      NOT NULL,
+    kind             TEXT      NOT NULL CHECK ( kind IN ('delete', 'suspend') ),
+    reason           TEXT      NOT NULL,
+    step             TEXT      NOT NULL CHECK ( step IN ('Canceling', 'Refunding', 'Notifying', 'Done') ) DEFAULT 'Canceling',
+    last_error       TEXT,
+    created_at       TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at       TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_parking_closures_open ON parking_closures(parking_place_id) WHERE step <> 'Done';
+
+CREATE TABLE IF NOT EXISTS parking_closure_bookings
+(
+    closure_id      INT     NOT NULL REFERENCES parking_closures (id) ON DELETE CASCADE,
+    booking_id      INT     NOT NULL,
+    driver_id       TEXT    NOT NULL,
+    refund          TEXT    NOT NULL CHECK ( refund IN ('Pending', 'Refunded', 'NothingToRefund', 'Declined') ) DEFAULT 'Pending',
+    refunded_amount INT     NOT NULL DEFAULT 0,
+    notified        BOOLEAN NOT NULL DEFAULT FALSE,
+    PRIMARY KEY (closure_id, booking_id)
+);
+
+-- A driver rates a parking place once per completed booking there. Only
+-- Published reviews are listed and count towards the rating of the place.
+CREATE TABLE IF NOT EXISTS parking_reviews
+(
+    id                SERIAL PRIMARY KEY,
+    parking_place_id  INT       NOT NULL REFERENCES parking_places (id) ON DELETE CASCADE,
+    booking_id        INT       NOT NULL UNIQUE,
+    user_id           TEXT      NOT NULL,
+    rating            INT       NOT NULL CHECK ( rating BETWEEN 1 AND 5 ),
+    text              TEXT      NOT NULL DEFAULT '',
+    reply             TEXT,
+    replied_at        TIMESTAMP,
+    status            TEXT      NOT NULL CHECK ( status IN ('Published', 'Hidden') ) DEFAULT 'Published',
+    moderation_reason TEXT,
+    moderated_by      TEXT,
+    created_at        TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at        TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_parking_reviews_place ON parking_reviews(parking_place_id, created_at DESC)
+    WHERE status = 'Published';
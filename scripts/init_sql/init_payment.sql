@@ -13,9 +13,10 @@ CREATE TABLE IF NOT EXISTS transactions
     booking_id       INTEGER,
     user_id          TEXT    NOT NULL,
     amount           BIGINT  NOT NULL,
-    transaction_type TEXT    NOT NULL CHECK ( transaction_type IN ('charge', 'payment', 'refund', 'promocode_activate', 'promocode_generate') ),
+    transaction_type TEXT    NOT NULL CHECK ( transaction_type IN ('charge', 'payment', 'refund', 'adjustment', 'promocode_activate', 'promocode_generate') ),
     status           TEXT    NOT NULL CHECK ( status IN ('pending', 'completed', 'failed', 'canceled') ) DEFAULT 'pending',
     description      TEXT,
+    adjustment_id    BIGINT,
     created_at       TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
     updated_at       TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
 );
@@ -38,6 +39,7 @@ CREATE INDEX IF NOT EXISTS idx_promocodes_created_by ON promocodes(created_by);
 CREATE INDEX IF NOT EXISTS idx_transactions_user_id ON transactions(user_id);
 CREATE INDEX IF NOT EXISTS idx_transactions_booking_id ON transactions(booking_id);
 CREATE INDEX IF NOT EXISTS idx_transactions_status ON transactions(status);
+CREATE INDEX IF NOT EXISTS idx_transactions_adjustment_id ON transactions(adjustment_id);
 
 CREATE OR REPLACE FUNCTION update_balance_updated_at()
 RETURNS TRIGGER AS $$
@@ -0,0 +1,58 @@
+package api_service
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"telegram_bot/models"
+	"time"
+)
+
+func (s *Service) GetAvailability(parkingPlaceID int64, from time.Time, to time.Time, granularity string,
+	user *models.User) (*models.Availability, error) {
+	path := s.bookingUrl + "booking/availability"
+
+	urlObject, errUrl := url.Parse(path)
+	if errUrl != nil {
+		return nil, fmt.Errorf("failed to parse URL")
+	}
+
+	params := url.Values{}
+	params.Add("parking_place_id", strconv.FormatInt(parkingPlaceID, 10))
+	params.Add("from", from.UTC().Format(time.RFC3339))
+	params.Add("to", to.UTC().Format(time.RFC3339))
+	params.Add("granularity", granularity)
+
+	urlObject.RawQuery = params.Encode()
+
+	request, errRequest := s.CreateRequest("GET", urlObject.String(), user)
+	if errRequest != nil {
+		return nil, fmt.Errorf("failed to create request")
+	}
+
+	responseAvailability, errAvailability := s.httpClient.Do(request)
+	if errAvailability != nil {
+		return nil, errAvailability
+	}
+	defer responseAvailability.Body.Close()
+
+	if responseAvailability.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get availability")
+	}
+
+	availabilityJSON, errJSON := ioutil.ReadAll(responseAvailability.Body)
+	if errJSON != nil {
+		return nil, errJSON
+	}
+
+	availability := new(models.Availability)
+
+	errDecode := json.Unmarshal(availabilityJSON, availability)
+	if errDecode != nil {
+		return nil, errDecode
+	}
+	return availability, nil
+}
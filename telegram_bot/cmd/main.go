@@ -121,6 +121,7 @@ func main() {
 				msg.Text += "/balance - view balance\n"
 				msg.Text += "/bookings - view my bookings (for drivers)\n"
 				msg.Text += "/parkings - view my parking places (for owners)\n"
+				msg.Text += "/availability <parking_id> - free spots for the next 7 days\n"
 				msg.Text += "/help - show this help"
 
 			case "login":
@@ -250,6 +251,35 @@ func main() {
 					}
 				}
 
+			case "availability":
+				if err := utils.ValidateTelegramID(int64(telegramID)); err != nil {
+					msg.Text = "❌ Error: invalid Telegram ID."
+					break
+				}
+				parkingID, errParse := strconv.ParseInt(strings.TrimSpace(update.Message.CommandArguments()), 10, 64)
+				if errParse != nil || parkingID < 1 {
+					msg.Text = "❌ Usage: /availability <parking_id>"
+					break
+				}
+				userInfo, err := apiService.GetUserByTelegramID(ctx, int64(telegramID))
+				if err != nil {
+					msg.Text = "❌ User not found. Please register on the website using your Telegram ID: " + strconv.FormatInt(int64(telegramID), 10)
+				} else if userInfo.Token == "" {
+					msg.Text = "❌ Authorization required. Use the /login command to sign in."
+				} else {
+					user := &models.User{
+						UserID:     userInfo.UserID,
+						TelegramID: int64(telegramID),
+					}
+					from := time.Now().UTC().Truncate(24 * time.Hour)
+					availability, err := apiService.GetAvailability(parkingID, from, from.AddDate(0, 0, 7), "day", user)
+					if err != nil {
+						msg.Text = "❌ Error getting availability."
+					} else {
+						msg.Text = "📅 " + data_representation.GetAvailability(availability)
+					}
+				}
+
 			default:
 				msg.Text = "❌ Unknown command. Use /help for a list of commands."
 			}
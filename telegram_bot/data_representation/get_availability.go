@@ -0,0 +1,28 @@
+package data_representation
+
+import (
+	"strconv"
+	"telegram_bot/models"
+	"time"
+)
+
+func GetAvailability(availability *models.Availability) string {
+	var result string
+	result += "Availability of parking place " + "\"" + strconv.FormatInt(availability.ParkingPlaceID, 10) + "\"" + ";\n"
+	result += "Capacity: " + strconv.FormatInt(availability.Capacity, 10) + " spaces" + ";\n\n"
+
+	layout := "02-01-2006 15:04"
+	if availability.Granularity == "day" {
+		layout = "02-01-2006"
+	}
+	for _, slot := range availability.Slots {
+		from, errParse := time.Parse(time.RFC3339, slot.DateFrom)
+		period := slot.DateFrom
+		if errParse == nil {
+			period = from.Format(layout)
+		}
+		result += period + ": " + strconv.FormatInt(slot.Free, 10) + " free" + ";\n"
+	}
+
+	return result
+}
@@ -0,0 +1,15 @@
+package models
+
+type AvailabilitySlot struct {
+	DateFrom string `json:"date_from"`
+	DateTo   string `json:"date_to"`
+	Booked   int64  `json:"booked"`
+	Free     int64  `json:"free"`
+}
+
+type Availability struct {
+	ParkingPlaceID int64               `json:"parking_place_id"`
+	Capacity       int64               `json:"capacity"`
+	Granularity    string              `json:"granularity"`
+	Slots          []*AvailabilitySlot `json:"slots"`
+}
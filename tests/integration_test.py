@@ -102,6 +102,7 @@ class TestRunner:
         self.driver_token: Optional[str] = None
         self.admin_token: Optional[str] = None
         self.parking_id: Optional[int] = None
+        self.vehicle_id: Optional[int] = None
         self.booking_ids: List[int] = []
         self.parking_ids: List[int] = []
         self.promocode_codes: List[str] = []
@@ -325,6 +326,64 @@ class TestRunner:
         self.log(f"Found {len(parkings)} underground parking places")
         return True
     
+    def test_driver_registers_vehicle(self):
+        self.log("Test 54: Driver Registers Vehicle")
+        if not self.driver_token:
+            self.log("SKIP: No driver token available (previous test failed)", "WARN")
+            return True
+        self.booking_client.set_token(self.driver_token)
+        
+        data = {
+            "plate": f"a {self.timestamp % 1000:03d} bc-77",
+            "country": "ru",
+            "size": "medium"
+        }
+        resp = self.booking_client.post("/booking/vehicles", data)
+        if not self.assert_status(resp, 200, "Register Vehicle"):
+            return False
+        
+        vehicle = resp.json()
+        self.vehicle_id = vehicle.get('vehicle_id')
+        expected_plate = f"A{self.timestamp % 1000:03d}BC77"
+        if not self.vehicle_id or vehicle.get('plate') != expected_plate:
+            self.log(f"FAILED: Unexpected vehicle in response. Response: {vehicle}", "ERROR")
+            self.failed += 1
+            return False
+        
+        self.log(f"Vehicle registered: ID={self.vehicle_id}, Plate={vehicle.get('plate')}")
+        return True
+    
+    def test_driver_quotes_booking(self):
+        self.log("Test 56: Driver Quotes Booking")
+        if not self.driver_token:
+            self.log("SKIP: No driver token available (previous test failed)", "WARN")
+            return True
+        if not self.parking_id:
+            self.log("SKIP: No parking ID available (previous test failed)", "WARN")
+            return True
+        self.booking_client.set_token(self.driver_token)
+
+        start = (datetime.now(timezone.utc) + timedelta(days=2)).replace(microsecond=0)
+        end = start + timedelta(hours=2, minutes=20)
+        resp = self.booking_client.post("/booking/quote", {
+            "parking_place_id": self.parking_id,
+            "date_from": self.format_datetime(start),
+            "date_to": self.format_datetime(end)
+        })
+        if not self.assert_status(resp, 200, "Quote Booking"):
+            return False
+
+        quote = resp.json()
+        rate = quote.get('hourly_rate', 0)
+        expected = rate * int((end - start).total_seconds()) // 3600
+        items_sum = sum(item.get('amount', 0) for item in quote.get('items', []))
+        if quote.get('total', 0) != expected or abs(items_sum - expected) > 1e-6:
+            self.log(f"FAILED: Quote total {quote.get('total')} (items {items_sum}), expected {expected}", "ERROR")
+            self.failed += 1
+            return False
+        self.log(f"Quote: {quote.get('hours')} h at {rate} = {quote.get('total')}")
+        return True
+
     def test_driver_creates_booking(self):
         self.log("Test 24: Driver Creates Booking")
         if not self.driver_token:
@@ -343,6 +402,8 @@ class TestRunner:
             "date_from": date_from,
             "date_to": date_to
         }
+        if self.vehicle_id:
+            data["vehicle_id"] = self.vehicle_id
         resp = self.booking_client.post("/booking", data)
         if not self.assert_status(resp, 200, "Create Booking"):
             return False
@@ -365,6 +426,115 @@ class TestRunner:
         
         return True
     
+    def test_driver_gets_booking_history(self):
+        self.log("Test 55: Driver Gets Booking History")
+        if not self.driver_token:
+            self.log("SKIP: No driver token available (previous test failed)", "WARN")
+            return True
+        if not self.booking_ids:
+            self.log("SKIP: No booking available (previous test failed)", "WARN")
+            return True
+        self.booking_client.set_token(self.driver_token)
+        
+        resp = self.booking_client.get(f"/booking/{self.booking_ids[0]}/history")
+        if not self.assert_status(resp, 200, "Get Booking History"):
+            return False
+        
+        events = resp.json()
+        if not events or events[0].get('event_type') != 'created' or events[0].get('actor_role') != 'driver':
+            self.log(f"FAILED: History does not start with the driver creating the booking. Response: {events}", "ERROR")
+            self.failed += 1
+            return False
+        
+        self.log(f"Booking history has {len(events)} events: {[e.get('event_type') for e in events]}")
+        return True
+    
+    def test_driver_cannot_review_unfinished_booking(self):
+        self.log("Test 57: Driver Cannot Review Unfinished Booking")
+        if not self.driver_token:
+            self.log("SKIP: No driver token available (previous test failed)", "WARN")
+            return True
+        if not self.booking_ids or not self.parking_id:
+            self.log("SKIP: No booking available (previous test failed)", "WARN")
+            return True
+        self.parking_client.set_token(self.driver_token)
+        
+        resp = self.parking_client.post(f"/parking/{self.parking_id}/reviews", {
+            "booking_id": self.booking_ids[0],
+            "rating": 5,
+            "text": "Great spot"
+        })
+        if not self.assert_status(resp, 409, "Review Unfinished Booking"):
+            return False
+        
+        resp = self.parking_client.get(f"/parking/{self.parking_id}/reviews")
+        if not self.assert_status(resp, 200, "Get Parking Reviews"):
+            return False
+        if resp.json():
+            self.log(f"FAILED: Parking place has reviews it should not have: {resp.json()}", "ERROR")
+            self.failed += 1
+            return False
+        
+        resp = self.parking_client.get("/parking", params={"sort": "-rating"})
+        if not self.assert_status(resp, 200, "Sort Parkings by Rating"):
+            return False
+        unrated = [p.get('id') for p in resp.json() if not p.get('review_count')]
+        if self.parking_id not in unrated:
+            self.log(f"FAILED: Parking place {self.parking_id} is missing or rated", "ERROR")
+            self.failed += 1
+            return False
+        
+        resp = self.parking_client.get("/parking", params={"min_rating": 1})
+        if not self.assert_status(resp, 200, "Filter Parkings by Rating"):
+            return False
+        if any(p.get('id') == self.parking_id for p in resp.json()):
+            self.log("FAILED: Unrated parking place passed the rating filter", "ERROR")
+            self.failed += 1
+            return False
+        return True
+    
+    def test_driver_extends_booking(self):
+        self.log("Test 58: Driver Extends Booking")
+        if not self.driver_token:
+            self.log("SKIP: No driver token available (previous test failed)", "WARN")
+            return True
+        if not self.booking_ids:
+            self.log("SKIP: No booking available (previous test failed)", "WARN")
+            return True
+        self.booking_client.set_token(self.driver_token)
+        booking_id = self.booking_ids[0]
+        
+        resp = self.booking_client.post(f"/booking/{booking_id}/extend", {
+            "date_to": self.format_datetime(datetime.now(timezone.utc) + timedelta(days=2)),
+            "duration_minutes": 30
+        })
+        if not self.assert_status(resp, 400, "Extend Booking with Both End and Duration"):
+            return False
+        
+        resp = self.booking_client.get(f"/booking/{booking_id}")
+        if not self.assert_status(resp, 200, "Get Booking Before Extension"):
+            return False
+        before = resp.json()
+        
+        resp = self.booking_client.post(f"/booking/{booking_id}/extend", {"duration_minutes": 60})
+        if before.get('status') not in ('Confirmed', 'Active'):
+            return self.assert_status(resp, 409, "Extend Unpaid Booking")
+        if resp.status_code == 400:
+            self.log("INFO: Extension declined (payment failed or insufficient funds)")
+            return True
+        if not self.assert_status(resp, 200, "Extend Booking"):
+            return False
+        
+        extended = resp.json()
+        old_end = datetime.fromisoformat(before['date_to'].replace('Z', '+00:00'))
+        new_end = datetime.fromisoformat(extended['date_to'].replace('Z', '+00:00'))
+        if new_end - old_end != timedelta(hours=1) or extended.get('full_cost', 0) < before.get('full_cost', 0):
+            self.log(f"FAILED: Unexpected extension. Before: {before}, after: {extended}", "ERROR")
+            self.failed += 1
+            return False
+        self.log(f"Booking extended until {extended['date_to']}, FullCost={extended.get('full_cost')}")
+        return True
+    
     def test_driver_gets_booking_by_id(self):
         self.log("Test 25: Driver Gets Booking by ID")
         if not self.driver_token:
@@ -463,6 +633,72 @@ class TestRunner:
         self.log("Parking place updated successfully")
         return True
     
+    def test_driver_searches_parking_nearby(self):
+        self.log("Test 59: Driver Searches Parking Nearby")
+        if not self.owner_token or not self.driver_token:
+            self.log("SKIP: No owner or driver token available (previous test failed)", "WARN")
+            return True
+        if not self.parking_id:
+            self.log("SKIP: No parking ID available (previous test failed)", "WARN")
+            return True
+        self.parking_client.set_token(self.owner_token)
+        
+        data = {
+            "name": "Updated Central Parking",
+            "city": "Moscow",
+            "address": "Red Square 1, Updated",
+            "parking_type": "underground",
+            "hourly_rate": 180,
+            "capacity": 250,
+            "latitude": 55.7539,
+            "longitude": 37.6208
+        }
+        resp = self.parking_client.put(f"/parking/{self.parking_id}", {**data, "latitude": 91})
+        if resp.status_code not in [400, 422]:
+            self.log(f"FAILED: Expected 400/422 for invalid latitude, got {resp.status_code}", "ERROR")
+            self.failed += 1
+            return False
+        resp = self.parking_client.put(f"/parking/{self.parking_id}", {**data, "longitude": None})
+        if not self.assert_status(resp, 400, "Update Parking with Latitude Only"):
+            return False
+        resp = self.parking_client.put(f"/parking/{self.parking_id}", data)
+        if not self.assert_status(resp, 200, "Update Parking Location"):
+            return False
+        
+        self.parking_client.set_token(self.driver_token)
+        resp = self.parking_client.get("/parking", params={"lat": 55.7558})
+        if not self.assert_status(resp, 400, "Search Nearby without Longitude"):
+            return False
+        resp = self.parking_client.get("/parking", params={"sort": "distance"})
+        if not self.assert_status(resp, 400, "Sort by Distance without Point"):
+            return False
+        
+        resp = self.parking_client.get("/parking", params={"lat": 55.7558, "lon": 37.6173, "radius_m": 1000})
+        if not self.assert_status(resp, 200, "Search Parking Nearby"):
+            return False
+        parkings = resp.json()
+        found = next((p for p in parkings if p.get('id') == self.parking_id), None)
+        if found is None or not 0 < found.get('distance_m', 0) <= 1000:
+            self.log(f"FAILED: Expected parking {self.parking_id} within 1000 m, got {parkings}", "ERROR")
+            self.failed += 1
+            return False
+        distances = [p.get('distance_m') for p in parkings]
+        if distances != sorted(distances):
+            self.log(f"FAILED: Nearby parkings are not sorted by distance: {distances}", "ERROR")
+            self.failed += 1
+            return False
+        
+        resp = self.parking_client.get("/parking", params={"lat": 59.9343, "lon": 30.3351, "radius_m": 1000})
+        if not self.assert_status(resp, 200, "Search Parking Far Away"):
+            return False
+        if any(p.get('id') == self.parking_id for p in resp.json()):
+            self.log("FAILED: Parking found outside the search radius", "ERROR")
+            self.failed += 1
+            return False
+        
+        self.log(f"Parking found {found['distance_m']:.0f} m away")
+        return True
+    
     def test_driver_cannot_create_parking(self):
         self.log("Test 28: Driver Cannot Create Parking (Forbidden)")
         if not self.driver_token:
@@ -1881,10 +2117,16 @@ class TestRunner:
             self.test_owner_gets_balance,
             self.test_admin_creates_promocode,
             self.test_driver_activates_promocode,
+            self.test_driver_registers_vehicle,
+            self.test_driver_quotes_booking,
             self.test_driver_creates_booking,
+            self.test_driver_gets_booking_history,
+            self.test_driver_cannot_review_unfinished_booking,
+            self.test_driver_extends_booking,
             self.test_driver_gets_booking_by_id,
             self.test_owner_gets_bookings,
             self.test_owner_updates_parking,
+            self.test_driver_searches_parking_nearby,
             self.test_driver_cannot_create_parking,
             self.test_driver_cannot_update_parking,
             self.test_get_nonexistent_parking,